@@ -0,0 +1,141 @@
+package autopilot
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	config "github.com/spf13/viper"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/status"
+
+	"git.coinninja.net/backend/thunderdome/metrics"
+	"git.coinninja.net/backend/thunderdome/tdrpc"
+)
+
+// ExecuteChannelProposal opens or closes the channel of an approved proposal and records the outcome
+// It returns the completed proposal and the error if the channel could not be opened or closed
+func ExecuteChannelProposal(ctx context.Context, s tdrpc.Store, lclient lnrpc.LightningClient, proposal *tdrpc.ChannelProposal) (*tdrpc.ChannelProposal, error) {
+
+	var txid string
+	var err error
+	switch proposal.Action {
+	case tdrpc.ChannelProposalActionOpen:
+		txid, err = openChannel(ctx, lclient, proposal)
+	case tdrpc.ChannelProposalActionClose:
+		txid, err = closeChannel(ctx, lclient, proposal)
+	default:
+		err = fmt.Errorf("Unknown action %s", proposal.Action)
+	}
+
+	result := tdrpc.ChannelProposalStatusCompleted
+	var errorMessage string
+	if err != nil {
+		result = tdrpc.ChannelProposalStatusFailed
+		errorMessage = err.Error()
+	}
+	metrics.Incr("autopilot.executions", metrics.Labels{"action": proposal.Action, "result": result})
+
+	completed, cerr := s.CompleteChannelProposal(ctx, proposal.Id, result, txid, errorMessage)
+	if cerr != nil {
+		zap.S().Errorw("CompleteChannelProposal Error", "package", "autopilot", "id", proposal.Id, "status", result, "txid", txid, "error", cerr)
+		if err == nil {
+			err = cerr
+		}
+		return proposal, err
+	}
+
+	zap.S().Infow("Channel Proposal Executed", "package", "autopilot", zap.Any("proposal", completed))
+
+	return completed, err
+
+}
+
+// openChannel connects to the node and opens the channel, it returns the funding txid
+func openChannel(ctx context.Context, lclient lnrpc.LightningClient, proposal *tdrpc.ChannelProposal) (string, error) {
+
+	nodeInfo, err := lclient.GetNodeInfo(ctx, &lnrpc.NodeInfoRequest{PubKey: proposal.NodePubkey})
+	if err != nil {
+		return "", fmt.Errorf("Could not GetNodeInfo: %v", status.Convert(err).Message())
+	}
+	if nodeInfo.Node == nil || len(nodeInfo.Node.Addresses) == 0 {
+		return "", fmt.Errorf("Node has no addresses")
+	}
+
+	_, err = lclient.ConnectPeer(ctx, &lnrpc.ConnectPeerRequest{
+		Addr: &lnrpc.LightningAddress{
+			Pubkey: proposal.NodePubkey,
+			Host:   nodeInfo.Node.Addresses[0].Addr,
+		},
+	})
+	if err != nil && !strings.Contains(status.Convert(err).Message(), "already connected") {
+		return "", fmt.Errorf("Could not ConnectPeer: %v", status.Convert(err).Message())
+	}
+
+	channelPoint, err := lclient.OpenChannelSync(ctx, &lnrpc.OpenChannelRequest{
+		NodePubkeyString:   proposal.NodePubkey,
+		LocalFundingAmount: proposal.Value,
+		TargetConf:         config.GetInt32("autopilot.target_conf"),
+	})
+	if err != nil {
+		return "", fmt.Errorf("Could not OpenChannelSync: %v", status.Convert(err).Message())
+	}
+
+	if txid := channelPoint.GetFundingTxidStr(); txid != "" {
+		return txid, nil
+	}
+	hash, err := chainhash.NewHash(channelPoint.GetFundingTxidBytes())
+	if err != nil {
+		return "", fmt.Errorf("Invalid funding txid: %v", err)
+	}
+
+	return hash.String(), nil
+
+}
+
+// closeChannel cooperatively closes the channel, it returns the closing txid
+func closeChannel(ctx context.Context, lclient lnrpc.LightningClient, proposal *tdrpc.ChannelProposal) (string, error) {
+
+	parts := strings.Split(proposal.ChanPoint, ":")
+	if len(parts) != 2 {
+		return "", fmt.Errorf("Invalid channel point %s", proposal.ChanPoint)
+	}
+	outputIndex, err := strconv.ParseUint(parts[1], 10, 32)
+	if err != nil {
+		return "", fmt.Errorf("Invalid channel point %s", proposal.ChanPoint)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := lclient.CloseChannel(ctx, &lnrpc.CloseChannelRequest{
+		ChannelPoint: &lnrpc.ChannelPoint{
+			FundingTxid: &lnrpc.ChannelPoint_FundingTxidStr{FundingTxidStr: parts[0]},
+			OutputIndex: uint32(outputIndex),
+		},
+		TargetConf: config.GetInt32("autopilot.target_conf"),
+	})
+	if err != nil {
+		return "", fmt.Errorf("Could not CloseChannel: %v", status.Convert(err).Message())
+	}
+
+	// The first update is the closing transaction being broadcast
+	update, err := stream.Recv()
+	if err != nil {
+		return "", fmt.Errorf("Could not CloseChannel: %v", status.Convert(err).Message())
+	}
+	pending := update.GetClosePending()
+	if pending == nil {
+		return "", nil
+	}
+	hash, err := chainhash.NewHash(pending.Txid)
+	if err != nil {
+		return "", fmt.Errorf("Invalid closing txid: %v", err)
+	}
+
+	return hash.String(), nil
+
+}
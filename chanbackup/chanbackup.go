@@ -0,0 +1,251 @@
+package chanbackup
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	config "github.com/spf13/viper"
+
+	"git.coinninja.net/backend/thunderdome/store"
+)
+
+const (
+	namePrefix = "chanbackup-"
+	nameSuffix = ".enc"
+	nameLayout = "20060102T150405.000000000Z"
+)
+
+// Sink stores encrypted channel backups somewhere other than the database
+type Sink interface {
+	// Put stores a backup with name
+	Put(ctx context.Context, name string, data []byte) error
+	// Get returns the backup with name or store.ErrNotFound
+	Get(ctx context.Context, name string) ([]byte, error)
+	// List returns the names of all the backups
+	List(ctx context.Context) ([]string, error)
+	// Delete removes the backup with name
+	Delete(ctx context.Context, name string) error
+}
+
+// Name returns the name of a backup taken at t, names sort in the order they were taken
+func Name(t time.Time) string {
+	return namePrefix + t.UTC().Format(nameLayout) + nameSuffix
+}
+
+// ParseName returns the time the backup with name was taken
+func ParseName(name string) (time.Time, error) {
+	if !strings.HasPrefix(name, namePrefix) || !strings.HasSuffix(name, nameSuffix) {
+		return time.Time{}, fmt.Errorf("Invalid backup name %s", name)
+	}
+	return time.Parse(nameLayout, strings.TrimSuffix(strings.TrimPrefix(name, namePrefix), nameSuffix))
+}
+
+// Backups returns the names of the backups in the sink, oldest first. Other files are ignored.
+func Backups(ctx context.Context, sink Sink) ([]string, error) {
+
+	names, err := sink.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	backups := make([]string, 0, len(names))
+	for _, name := range names {
+		if _, err := ParseName(name); err == nil {
+			backups = append(backups, name)
+		}
+	}
+	sort.Strings(backups)
+
+	return backups, nil
+
+}
+
+// Prune deletes backups beyond the newest count and those older than maxAge. The newest backup is never deleted.
+// A count or maxAge of 0 is unlimited. It returns the number of backups deleted.
+func Prune(ctx context.Context, sink Sink, count int, maxAge time.Duration, now time.Time) (int, error) {
+
+	backups, err := Backups(ctx, sink)
+	if err != nil {
+		return 0, err
+	}
+
+	var deleted int
+	for i, name := range backups {
+		// The newest is always kept
+		if i == len(backups)-1 {
+			break
+		}
+		remove := count > 0 && len(backups)-i > count
+		if !remove && maxAge > 0 {
+			t, _ := ParseName(name)
+			remove = now.Sub(t) > maxAge
+		}
+		if !remove {
+			continue
+		}
+		if err = sink.Delete(ctx, name); err != nil {
+			return deleted, err
+		}
+		deleted++
+	}
+
+	return deleted, nil
+
+}
+
+// Exporter encrypts channel backups and stores them in every sink
+type Exporter struct {
+	sinks map[string]Sink
+	// The order they are configured in
+	names []string
+	key   []byte
+}
+
+// NewExporter creates an exporter that stores backups encrypted with key in sinks
+func NewExporter(key []byte, sinks map[string]Sink) (*Exporter, error) {
+
+	if len(key) != KeySize {
+		return nil, fmt.Errorf("The encryption key must be %d bytes", KeySize)
+	}
+
+	e := &Exporter{
+		sinks: sinks,
+		key:   key,
+	}
+	for name := range sinks {
+		e.names = append(e.names, name)
+	}
+	sort.Strings(e.names)
+
+	return e, nil
+
+}
+
+// New creates the exporter with the sinks configured in chanbackup.sinks, it returns nil if there are none
+func New() (*Exporter, error) {
+
+	names := config.GetStringSlice("chanbackup.sinks")
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	key, err := ParseKey(config.GetString("chanbackup.encryption_key"))
+	if err != nil {
+		return nil, err
+	}
+
+	sinks := make(map[string]Sink)
+	for _, name := range names {
+		switch name {
+		case "fs":
+			sinks[name], err = NewFSSink(config.GetString("chanbackup.fs.path"))
+			if err != nil {
+				return nil, err
+			}
+		case "s3":
+			if config.GetString("chanbackup.s3.bucket") == "" {
+				return nil, fmt.Errorf("chanbackup.s3.bucket is required")
+			}
+			sinks[name] = NewS3Sink(S3Config{
+				Endpoint:  config.GetString("chanbackup.s3.endpoint"),
+				Region:    config.GetString("chanbackup.s3.region"),
+				Bucket:    config.GetString("chanbackup.s3.bucket"),
+				Prefix:    config.GetString("chanbackup.s3.prefix"),
+				AccessKey: config.GetString("chanbackup.s3.access_key"),
+				SecretKey: config.GetString("chanbackup.s3.secret_key"),
+				PathStyle: config.GetBool("chanbackup.s3.path_style"),
+				Timeout:   config.GetDuration("chanbackup.s3.timeout"),
+			})
+		default:
+			return nil, fmt.Errorf("Unknown channel backup sink %s", name)
+		}
+	}
+
+	e, err := NewExporter(key, sinks)
+	if err != nil {
+		return nil, err
+	}
+	e.names = names
+
+	return e, nil
+
+}
+
+// Sinks returns the names of the sinks
+func (e *Exporter) Sinks() []string {
+	return e.names
+}
+
+// Sink returns the sink with name
+func (e *Exporter) Sink(name string) (Sink, error) {
+	sink, ok := e.sinks[name]
+	if !ok {
+		return nil, fmt.Errorf("Channel backup sink %s is not configured", name)
+	}
+	return sink, nil
+}
+
+// Export encrypts the backup and stores it in every sink, pruning the old ones with the retention policy
+// It tries every sink and returns the first error.
+func (e *Exporter) Export(ctx context.Context, data []byte, at time.Time) error {
+
+	encrypted, err := Encrypt(e.key, data)
+	if err != nil {
+		return err
+	}
+
+	name := Name(at)
+	var firstErr error
+	for _, sinkName := range e.names {
+		sink := e.sinks[sinkName]
+		if err = sink.Put(ctx, name, encrypted); err != nil {
+			if firstErr == nil {
+				firstErr = fmt.Errorf("Could not store backup in %s: %v", sinkName, err)
+			}
+			continue
+		}
+		if _, err = Prune(ctx, sink, config.GetInt("chanbackup.retention_count"), config.GetDuration("chanbackup.retention_age"), at); err != nil && firstErr == nil {
+			firstErr = fmt.Errorf("Could not prune backups in %s: %v", sinkName, err)
+		}
+	}
+
+	return firstErr
+
+}
+
+// Fetch returns the decrypted backup with name from the sink, a blank name is the latest backup
+// It returns the name of the backup as well
+func (e *Exporter) Fetch(ctx context.Context, sinkName string, name string) ([]byte, string, error) {
+
+	sink, err := e.Sink(sinkName)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if name == "" {
+		backups, err := Backups(ctx, sink)
+		if err != nil {
+			return nil, "", err
+		}
+		if len(backups) == 0 {
+			return nil, "", store.ErrNotFound
+		}
+		name = backups[len(backups)-1]
+	}
+
+	encrypted, err := sink.Get(ctx, name)
+	if err != nil {
+		return nil, "", err
+	}
+
+	data, err := Decrypt(e.key, encrypted)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return data, name, nil
+
+}
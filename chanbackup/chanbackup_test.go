@@ -0,0 +1,93 @@
+package chanbackup
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	config "github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"git.coinninja.net/backend/thunderdome/store"
+)
+
+func TestExporter(t *testing.T) {
+
+	ctx := context.Background()
+
+	dir, err := ioutil.TempDir("", "chanbackup")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	sink, err := NewFSSink(dir)
+	require.Nil(t, err)
+
+	key := bytes.Repeat([]byte{1}, KeySize)
+	e, err := NewExporter(key, map[string]Sink{"fs": sink})
+	require.Nil(t, err)
+
+	// Nothing yet
+	_, _, err = e.Fetch(ctx, "fs", "")
+	assert.Equal(t, store.ErrNotFound, err)
+
+	config.Set("chanbackup.retention_count", 3)
+	config.Set("chanbackup.retention_age", "48h")
+	defer config.Set("chanbackup.retention_count", 0)
+	defer config.Set("chanbackup.retention_age", "0s")
+
+	// Export a few, only the newest 3 are kept
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 5; i++ {
+		assert.Nil(t, e.Export(ctx, []byte{byte(i)}, start.Add(time.Duration(i)*time.Minute)))
+	}
+	backups, err := Backups(ctx, sink)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{Name(start.Add(2 * time.Minute)), Name(start.Add(3 * time.Minute)), Name(start.Add(4 * time.Minute))}, backups)
+
+	// The backups are encrypted at rest
+	raw, err := sink.Get(ctx, backups[0])
+	assert.Nil(t, err)
+	assert.NotEqual(t, []byte{2}, raw)
+
+	// The latest
+	data, name, err := e.Fetch(ctx, "fs", "")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{4}, data)
+	assert.Equal(t, backups[2], name)
+
+	// A specific one
+	data, _, err = e.Fetch(ctx, "fs", backups[0])
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{2}, data)
+
+	_, _, err = e.Fetch(ctx, "s3", "")
+	assert.NotNil(t, err)
+
+	// Old ones are removed but the newest is always kept
+	deleted, err := Prune(ctx, sink, 0, 48*time.Hour, start.Add(72*time.Hour))
+	assert.Nil(t, err)
+	assert.Equal(t, 2, deleted)
+	backups, err = Backups(ctx, sink)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{Name(start.Add(4 * time.Minute))}, backups)
+
+}
+
+func TestName(t *testing.T) {
+
+	now := time.Date(2020, 1, 2, 3, 4, 5, 6, time.UTC)
+	name := Name(now)
+	assert.Equal(t, "chanbackup-20200102T030405.000000006Z.enc", name)
+
+	parsed, err := ParseName(name)
+	assert.Nil(t, err)
+	assert.True(t, now.Equal(parsed))
+
+	_, err = ParseName("other.txt")
+	assert.NotNil(t, err)
+
+}
@@ -0,0 +1,78 @@
+package chanbackup
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// KeySize is the size of the AES-256 encryption key
+const KeySize = 32
+
+// Encrypted backups start with this so the format can change
+var magic = []byte("TDCB1")
+
+// ErrDecrypt is returned when a backup cannot be decrypted, the key is wrong or it was modified
+var ErrDecrypt = errors.New("Could not decrypt backup")
+
+// ParseKey decodes a hex encoded encryption key
+func ParseKey(s string) ([]byte, error) {
+	key, err := hex.DecodeString(s)
+	if err != nil || len(key) != KeySize {
+		return nil, fmt.Errorf("The encryption key must be %d hex encoded bytes", KeySize)
+	}
+	return key, nil
+}
+
+// Encrypt encrypts and authenticates data with AES-256-GCM
+func Encrypt(key []byte, data []byte) ([]byte, error) {
+
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err = io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+
+	out := append(append([]byte{}, magic...), nonce...)
+	return gcm.Seal(out, nonce, data, magic), nil
+
+}
+
+// Decrypt decrypts data encrypted with Encrypt
+func Decrypt(key []byte, data []byte) ([]byte, error) {
+
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) < len(magic)+gcm.NonceSize() || !bytes.Equal(data[:len(magic)], magic) {
+		return nil, ErrDecrypt
+	}
+	data = data[len(magic):]
+
+	plain, err := gcm.Open(nil, data[:gcm.NonceSize()], data[gcm.NonceSize():], magic)
+	if err != nil {
+		return nil, ErrDecrypt
+	}
+
+	return plain, nil
+
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,39 @@
+package chanbackup
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncrypt(t *testing.T) {
+
+	key := bytes.Repeat([]byte{1}, KeySize)
+	data := []byte("multi channel backup")
+
+	encrypted, err := Encrypt(key, data)
+	assert.Nil(t, err)
+	assert.NotContains(t, string(encrypted), string(data))
+
+	decrypted, err := Decrypt(key, encrypted)
+	assert.Nil(t, err)
+	assert.Equal(t, data, decrypted)
+
+	// Wrong key
+	_, err = Decrypt(bytes.Repeat([]byte{2}, KeySize), encrypted)
+	assert.Equal(t, ErrDecrypt, err)
+
+	// Modified
+	encrypted[len(encrypted)-1] ^= 1
+	_, err = Decrypt(key, encrypted)
+	assert.Equal(t, ErrDecrypt, err)
+
+	// Keys
+	_, err = ParseKey("abcd")
+	assert.NotNil(t, err)
+	parsed, err := ParseKey("0101010101010101010101010101010101010101010101010101010101010101")
+	assert.Nil(t, err)
+	assert.Equal(t, key, parsed)
+
+}
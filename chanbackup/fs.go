@@ -0,0 +1,65 @@
+package chanbackup
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"git.coinninja.net/backend/thunderdome/store"
+)
+
+// FSSink stores backups in a local directory, it should be on a different disk than the database
+type FSSink struct {
+	dir string
+}
+
+// NewFSSink creates the directory if needed and returns a sink that stores backups in it
+func NewFSSink(dir string) (*FSSink, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	return &FSSink{dir: dir}, nil
+}
+
+// Put writes the backup to a temporary file and renames it so a partial backup is never seen
+func (fs *FSSink) Put(ctx context.Context, name string, data []byte) error {
+	tmp := filepath.Join(fs.dir, "."+name+".tmp")
+	if err := ioutil.WriteFile(tmp, data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, filepath.Join(fs.dir, filepath.Base(name)))
+}
+
+// Get reads a backup
+func (fs *FSSink) Get(ctx context.Context, name string) ([]byte, error) {
+	data, err := ioutil.ReadFile(filepath.Join(fs.dir, filepath.Base(name)))
+	if os.IsNotExist(err) {
+		return nil, store.ErrNotFound
+	}
+	return data, err
+}
+
+// List returns the files in the directory
+func (fs *FSSink) List(ctx context.Context) ([]string, error) {
+	files, err := ioutil.ReadDir(fs.dir)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(files))
+	for _, f := range files {
+		if !f.IsDir() {
+			names = append(names, f.Name())
+		}
+	}
+	return names, nil
+}
+
+// Delete removes a backup
+func (fs *FSSink) Delete(ctx context.Context, name string) error {
+	err := os.Remove(filepath.Join(fs.dir, filepath.Base(name)))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
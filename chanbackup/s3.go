@@ -0,0 +1,248 @@
+package chanbackup
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"git.coinninja.net/backend/thunderdome/store"
+)
+
+// S3Config configures an S3 compatible object store
+type S3Config struct {
+	// The url of the service, ie https://s3.amazonaws.com or http://minio:9000
+	Endpoint  string
+	Region    string
+	Bucket    string
+	Prefix    string
+	AccessKey string
+	SecretKey string
+	// Put the bucket in the path instead of the host name (required by most S3 compatible services)
+	PathStyle bool
+	Timeout   time.Duration
+}
+
+// S3Sink stores backups in an S3 compatible object store. Requests are signed with AWS signature version 4.
+type S3Sink struct {
+	config S3Config
+	client *http.Client
+}
+
+// NewS3Sink creates a sink that stores backups in a bucket
+func NewS3Sink(config S3Config) *S3Sink {
+	config.Endpoint = strings.TrimSuffix(config.Endpoint, "/")
+	return &S3Sink{
+		config: config,
+		client: &http.Client{Timeout: config.Timeout},
+	}
+}
+
+// Put stores a backup
+func (s *S3Sink) Put(ctx context.Context, name string, data []byte) error {
+	resp, err := s.do(ctx, http.MethodPut, s.config.Prefix+name, nil, data)
+	if err != nil {
+		return err
+	}
+	_ = resp.Body.Close()
+	return nil
+}
+
+// Get fetches a backup
+func (s *S3Sink) Get(ctx context.Context, name string) ([]byte, error) {
+	resp, err := s.do(ctx, http.MethodGet, s.config.Prefix+name, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	return ioutil.ReadAll(resp.Body)
+}
+
+// List returns the names of the objects with the prefix
+func (s *S3Sink) List(ctx context.Context) ([]string, error) {
+
+	var names []string
+	var token string
+	for {
+		query := url.Values{
+			"list-type": []string{"2"},
+			"prefix":    []string{s.config.Prefix},
+		}
+		if token != "" {
+			query.Set("continuation-token", token)
+		}
+
+		resp, err := s.do(ctx, http.MethodGet, "", query, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var result struct {
+			Contents []struct {
+				Key string `xml:"Key"`
+			} `xml:"Contents"`
+			IsTruncated           bool   `xml:"IsTruncated"`
+			NextContinuationToken string `xml:"NextContinuationToken"`
+		}
+		err = xml.NewDecoder(resp.Body).Decode(&result)
+		_ = resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("Could not parse object list: %v", err)
+		}
+
+		for _, object := range result.Contents {
+			names = append(names, strings.TrimPrefix(object.Key, s.config.Prefix))
+		}
+
+		if !result.IsTruncated || result.NextContinuationToken == "" {
+			break
+		}
+		token = result.NextContinuationToken
+	}
+
+	return names, nil
+
+}
+
+// Delete removes a backup
+func (s *S3Sink) Delete(ctx context.Context, name string) error {
+	resp, err := s.do(ctx, http.MethodDelete, s.config.Prefix+name, nil, nil)
+	if err != nil {
+		return err
+	}
+	_ = resp.Body.Close()
+	return nil
+}
+
+// do sends a signed request for the object key (or the bucket if blank). A 404 returns store.ErrNotFound
+// and any other error status returns an error. The caller must close the body.
+func (s *S3Sink) do(ctx context.Context, method string, key string, query url.Values, body []byte) (*http.Response, error) {
+
+	endpoint, err := url.Parse(s.config.Endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid endpoint: %v", err)
+	}
+
+	path := "/" + key
+	if s.config.PathStyle {
+		path = "/" + s.config.Bucket + path
+	} else {
+		endpoint.Host = s.config.Bucket + "." + endpoint.Host
+	}
+	endpoint.Path = path
+	endpoint.RawPath = s3Escape(path, false)
+	endpoint.RawQuery = s3Query(query)
+
+	req, err := http.NewRequest(method, endpoint.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	s.sign(req, body, time.Now().UTC())
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		_ = resp.Body.Close()
+		return nil, store.ErrNotFound
+	} else if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		data, _ := ioutil.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		var s3Err struct {
+			Code    string `xml:"Code"`
+			Message string `xml:"Message"`
+		}
+		if xml.Unmarshal(data, &s3Err) == nil && s3Err.Code != "" {
+			return nil, fmt.Errorf("S3 error %d %s: %s", resp.StatusCode, s3Err.Code, s3Err.Message)
+		}
+		return nil, fmt.Errorf("S3 error %d", resp.StatusCode)
+	}
+
+	return resp, nil
+
+}
+
+// sign adds the AWS signature version 4 headers to the request
+func (s *S3Sink) sign(req *http.Request, body []byte, now time.Time) {
+
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+	payloadHash := sha256Hex(body)
+
+	req.Header.Set("x-amz-date", amzDate)
+	req.Header.Set("x-amz-content-sha256", payloadHash)
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		"host:" + req.URL.Host + "\n" + "x-amz-content-sha256:" + payloadHash + "\n" + "x-amz-date:" + amzDate + "\n",
+		"host;x-amz-content-sha256;x-amz-date",
+		payloadHash,
+	}, "\n")
+
+	scope := date + "/" + s.config.Region + "/s3/aws4_request"
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + sha256Hex([]byte(canonicalRequest))
+
+	signingKey := hmacSHA256([]byte("AWS4"+s.config.SecretKey), date)
+	signingKey = hmacSHA256(signingKey, s.config.Region)
+	signingKey = hmacSHA256(signingKey, "s3")
+	signingKey = hmacSHA256(signingKey, "aws4_request")
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=host;x-amz-content-sha256;x-amz-date, Signature=%s",
+		s.config.AccessKey, scope, hex.EncodeToString(hmacSHA256(signingKey, stringToSign))))
+
+}
+
+// s3Query returns the canonical query string, sorted by key with S3 escaping
+func s3Query(query url.Values) string {
+	keys := make([]string, 0, len(query))
+	for k := range query {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	parts := make([]string, 0, len(keys))
+	for _, k := range keys {
+		for _, v := range query[k] {
+			parts = append(parts, s3Escape(k, true)+"="+s3Escape(v, true))
+		}
+	}
+	return strings.Join(parts, "&")
+}
+
+// s3Escape escapes everything but unreserved characters (and / in paths)
+func s3Escape(s string, escapeSlash bool) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if (c >= 'A' && c <= 'Z') || (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9') || c == '-' || c == '_' || c == '.' || c == '~' || (c == '/' && !escapeSlash) {
+			b.WriteByte(c)
+		} else {
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+func sha256Hex(data []byte) string {
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	_, _ = mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
@@ -23,6 +23,8 @@ var (
 		Long:  `Start API`,
 		Run: func(cmd *cli.Command, args []string) { // Initialize the databse
 
+			initMetrics()
+
 			tdrpcServer, err := NewTDRPCServer()
 			if err != nil {
 				logger.Fatalw("Could not create tdrpcserver",
@@ -52,6 +54,10 @@ var (
 			tdrpc.RegisterAdminRPCServer(server.GRPCServer(), adminServer)
 			server.GWReg(tdrpc.RegisterAdminRPCHandlerFromEndpoint)
 
+			// Register the health checks
+			hc := NewHealthChecker()
+			hc.Routes(server.Router())
+
 			// Start it up
 			err = server.ListenAndServe()
 			if err != nil {
@@ -61,7 +67,7 @@ var (
 			}
 
 			if apiCmdMonitor {
-				startMonitor()
+				hc.SetMonitor(startMonitor())
 			}
 
 			<-conf.Stop.Chan() // Wait until StopChan
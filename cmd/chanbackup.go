@@ -0,0 +1,120 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	cli "github.com/spf13/cobra"
+
+	"git.coinninja.net/backend/thunderdome/chanbackup"
+)
+
+func init() {
+	rootCmd.AddCommand(chanBackupCmd)
+	chanBackupCmd.AddCommand(chanBackupListCmd)
+	chanBackupCmd.AddCommand(chanBackupRestoreCmd)
+
+	chanBackupCmd.PersistentFlags().StringVarP(&chanBackupCmdSink, "sink", "s", "", "The sink to use (defaults to the first in chanbackup.sinks)")
+	chanBackupRestoreCmd.Flags().StringVarP(&chanBackupCmdName, "name", "n", "", "The name of the backup to restore (defaults to the latest)")
+	chanBackupRestoreCmd.Flags().BoolVar(&chanBackupCmdVerifyOnly, "verify-only", false, "Verify the backup with lnd without restoring it")
+}
+
+var (
+	chanBackupCmdSink       string
+	chanBackupCmdName       string
+	chanBackupCmdVerifyOnly bool
+
+	chanBackupCmd = &cli.Command{
+		Use:   "chanbackup",
+		Short: "Channel Backups",
+		Long:  `Manage the encrypted channel backups exported to chanbackup.sinks`,
+	}
+
+	chanBackupListCmd = &cli.Command{
+		Use:   "list",
+		Short: "List the backups in a sink",
+		Run: func(cmd *cli.Command, args []string) {
+
+			sink, sinkName := chanBackupSink()
+
+			backups, err := chanbackup.Backups(context.Background(), sink)
+			if err != nil {
+				logger.Fatalw("Could not list channel backups", "sink", sinkName, "error", err)
+			}
+			for _, name := range backups {
+				fmt.Println(name)
+			}
+
+		},
+	}
+
+	chanBackupRestoreCmd = &cli.Command{
+		Use:   "restore",
+		Short: "Restore channels from a backup",
+		Long:  `Fetches and decrypts a backup from a sink, verifies it with lnd and restores the channels with RestoreChannelBackups`,
+		Run: func(cmd *cli.Command, args []string) {
+
+			exporter := NewChanBackupExporter()
+			if exporter == nil {
+				logger.Fatal("No channel backup sinks are configured in chanbackup.sinks")
+			}
+			if chanBackupCmdSink == "" {
+				chanBackupCmdSink = exporter.Sinks()[0]
+			}
+
+			data, name, err := exporter.Fetch(context.Background(), chanBackupCmdSink, chanBackupCmdName)
+			if err != nil {
+				logger.Fatalw("Could not fetch channel backup", "sink", chanBackupCmdSink, "name", chanBackupCmdName, "error", err)
+			}
+			logger.Infow("Fetched channel backup", "sink", chanBackupCmdSink, "name", name, "size", len(data))
+
+			lclient := NewLightningClient()
+
+			_, err = lclient.VerifyChanBackup(context.Background(), &lnrpc.ChanBackupSnapshot{
+				MultiChanBackup: &lnrpc.MultiChanBackup{
+					MultiChanBackup: data,
+				},
+			})
+			if err != nil {
+				logger.Fatalw("Channel backup failed verification", "name", name, "error", err)
+			}
+			logger.Infow("Channel backup verified", "name", name)
+
+			if chanBackupCmdVerifyOnly {
+				return
+			}
+
+			_, err = lclient.RestoreChannelBackups(context.Background(), &lnrpc.RestoreChanBackupRequest{
+				Backup: &lnrpc.RestoreChanBackupRequest_MultiChanBackup{
+					MultiChanBackup: data,
+				},
+			})
+			if err != nil {
+				logger.Fatalw("Could not RestoreChannelBackups", "name", name, "error", err)
+			}
+			logger.Infow("Channels restored, lnd will close them and sweep the funds", "name", name)
+
+		},
+	}
+)
+
+// chanBackupSink returns the sink selected with --sink
+func chanBackupSink() (chanbackup.Sink, string) {
+
+	exporter := NewChanBackupExporter()
+	if exporter == nil {
+		logger.Fatal("No channel backup sinks are configured in chanbackup.sinks")
+	}
+	if chanBackupCmdSink == "" {
+		chanBackupCmdSink = exporter.Sinks()[0]
+	}
+
+	sink, err := exporter.Sink(chanBackupCmdSink)
+	if err != nil {
+		logger.Fatalw("Invalid sink", "error", err)
+	}
+
+	return sink, chanBackupCmdSink
+
+}
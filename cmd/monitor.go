@@ -1,27 +1,48 @@
 package cmd
 
 import (
+	"context"
+
 	cli "github.com/spf13/cobra"
 	"go.uber.org/zap"
 
 	"git.coinninja.net/backend/thunderdome/conf"
+	"git.coinninja.net/backend/thunderdome/monitor"
 	"git.coinninja.net/backend/thunderdome/server"
+	"git.coinninja.net/backend/thunderdome/tdrpc"
 )
 
 func init() {
 	rootCmd.AddCommand(monitorCmd)
+
+	monitorCmd.PersistentFlags().BoolVarP(&monitorCmdRescan, "rescan", "r", false, "Rescan all wallet transactions instead of starting at the last checkpoint")
 }
 
 var (
+	monitorCmdRescan bool
+
 	monitorCmd = &cli.Command{
 		Use:   "monitor",
 		Short: "Monitor",
 		Long:  `Monitor`,
 		Run: func(cmd *cli.Command, args []string) { // Initialize the databse
 
-			startMonitor()
+			initMetrics()
+
+			// Reset the checkpoint so every wallet transaction is processed again
+			if monitorCmdRescan {
+				if err := NewStore().SaveMonitorCheckpoint(context.Background(), tdrpc.MonitorCheckpointBTCBlockHeight, 0); err != nil {
+					logger.Fatalw("Could not reset transaction checkpoint", "error", err)
+				}
+				logger.Info("Rescanning all wallet transactions")
+			}
+
+			m := startMonitor()
+
+			hc := NewMonitorHealthChecker()
+			hc.SetMonitor(m)
 
-			go server.VersionHTTPServer()
+			go server.VersionHTTPServer(m, hc)
 
 			<-conf.Stop.Chan() // Wait until StopChan
 			conf.Stop.Wait()   // Wait until everyone cleans up
@@ -31,9 +52,10 @@ var (
 	}
 )
 
-func startMonitor() {
-	_, err := NewMonitor()
+func startMonitor() *monitor.Monitor {
+	m, err := NewMonitor()
 	if err != nil {
 		logger.Fatalw("Could not create Monitor", "error", err)
 	}
+	return m
 }
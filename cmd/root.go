@@ -13,6 +13,7 @@ import (
 	"go.uber.org/zap"
 
 	"git.coinninja.net/backend/thunderdome/conf"
+	"git.coinninja.net/backend/thunderdome/metrics"
 )
 
 var (
@@ -97,3 +98,13 @@ func initProfiler() {
 		logger.Infof("Profiler enabled on http://%s", hostPort)
 	}
 }
+
+// initMetrics sends metrics to prometheus and datadog if they are enabled
+func initMetrics() {
+	if config.GetBool("prometheus.enabled") {
+		metrics.EnablePrometheus(config.GetString("prometheus.namespace"))
+	}
+	if ddclient := NewDogStatsDClient(); ddclient != nil {
+		metrics.AddSink(metrics.NewDogStatsDSink(ddclient))
+	}
+}
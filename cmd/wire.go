@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net"
+	"sync"
 	"time"
 
 	"git.coinninja.net/backend/blocc/blocc"
@@ -17,6 +18,7 @@ import (
 	"github.com/DataDog/datadog-go/statsd"
 	"github.com/google/wire"
 	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/invoicesrpc"
 	"github.com/lightningnetwork/lnd/macaroons"
 	config "github.com/spf13/viper"
 	"google.golang.org/grpc"
@@ -25,6 +27,9 @@ import (
 	"google.golang.org/grpc/status"
 	macaroon "gopkg.in/macaroon.v2"
 
+	"git.coinninja.net/backend/thunderdome/chanbackup"
+	"git.coinninja.net/backend/thunderdome/lsp"
+	"git.coinninja.net/backend/thunderdome/metrics"
 	"git.coinninja.net/backend/thunderdome/monitor"
 	"git.coinninja.net/backend/thunderdome/server"
 	"git.coinninja.net/backend/thunderdome/store"
@@ -43,22 +48,34 @@ func NewServer() (*server.Server, error) {
 
 // NewTDRPCServer will create a new grpc/rest server on the webserver
 func NewTDRPCServer() (tdrpc.ThunderdomeRPCServer, error) {
-	wire.Build(tdrpcserver.NewTDRPCServer, NewStore, NewLightningClient, NewDistCache)
+	wire.Build(tdrpcserver.NewTDRPCServer, NewStore, NewLightningClient, NewInvoicesClient, NewDistCache)
 	return nil, nil
 }
 
 // NewTDRPCServer will create a new grpc/rest server on the webserver
 func NewAdminRPCServer() (tdrpc.AdminRPCServer, error) {
-	wire.Build(adminrpcserver.NewAdminRPCServer, NewStore, NewCNAuthClient)
+	wire.Build(adminrpcserver.NewAdminRPCServer, NewStore, NewChannelBackupStore, NewLightningClient, NewCNAuthClient)
 	return nil, nil
 }
 
 // NewTXMonitor will create a new BTC and LN transaction monitor
 func NewMonitor() (*monitor.Monitor, error) {
-	wire.Build(monitor.NewMonitor, NewStore, NewChannelBackupStore, NewLightningClient, NewBloccClient, NewDogStatsDClient)
+	wire.Build(monitor.NewMonitor, NewStore, NewChannelBackupStore, NewLightningClient, NewInvoicesClient, NewBloccClient, NewMonitorDistCache, NewDogStatsDClient, NewLSPProvider, NewChanBackupExporter)
 	return nil, nil
 }
 
+// NewHealthChecker will check the dependencies of the api
+func NewHealthChecker() *server.HealthChecker {
+	wire.Build(server.NewHealthChecker, NewStore, NewDistCache, NewLightningClient, NewBloccClient)
+	return nil
+}
+
+// NewMonitorHealthChecker will check the dependencies of the monitor
+func NewMonitorHealthChecker() *server.HealthChecker {
+	wire.Build(server.NewHealthChecker, NewStore, NewMonitorDistCache, NewLightningClient, NewBloccClient)
+	return nil
+}
+
 // NewStore is the store for the application
 func NewStore() tdrpc.Store {
 	var store tdrpc.Store
@@ -122,6 +139,11 @@ func NewLightningClient() lnrpc.LightningClient {
 	return lclient
 }
 
+// NewInvoicesClient connects to the lnd invoices sub-server used for hold invoices
+func NewInvoicesClient() invoicesrpc.InvoicesClient {
+	return invoicesrpc.NewInvoicesClient(NewLndGrpcClientConn())
+}
+
 // NewLndGrpcClientConn creates a new GRPC connection to LND
 func NewLndGrpcClientConn() *grpc.ClientConn {
 
@@ -129,6 +151,7 @@ func NewLndGrpcClientConn() *grpc.ClientConn {
 	dialOptions := []grpc.DialOption{
 		grpc.WithTimeout(10 * time.Second),
 		grpc.WithBlock(),
+		grpc.WithUnaryInterceptor(metrics.UnaryClientInterceptor("lnd.request_seconds")),
 	}
 
 	if config.GetBool("lnd.tls_insecure") {
@@ -195,6 +218,7 @@ func NewBloccClient() blocc.BloccRPCClient {
 	dialOptions := []grpc.DialOption{
 		grpc.WithTimeout(10 * time.Second),
 		grpc.WithBlock(),
+		grpc.WithUnaryInterceptor(metrics.UnaryClientInterceptor("blocc.request_seconds")),
 	}
 
 	if config.GetBool("blocc.tls") {
@@ -235,8 +259,28 @@ func NewDistCache() store.DistCache {
 
 }
 
-// NewDogStatsDClient creates a new statsd client
+// NewMonitorDistCache only connects to redis when it's needed for leader election
+func NewMonitorDistCache() store.DistCache {
+	if !config.GetBool("monitor.leader_election") {
+		return nil
+	}
+	return NewDistCache()
+}
+
+var (
+	ddclient     *statsd.Client
+	ddclientOnce sync.Once
+)
+
+// NewDogStatsDClient creates a new statsd client, it is shared by everything that uses it
 func NewDogStatsDClient() *statsd.Client {
+	ddclientOnce.Do(func() {
+		ddclient = newDogStatsDClient()
+	})
+	return ddclient
+}
+
+func newDogStatsDClient() *statsd.Client {
 
 	if !config.GetBool("dogstatsd.enabled") {
 		return nil
@@ -259,3 +303,31 @@ func NewDogStatsDClient() *statsd.Client {
 
 	return client
 }
+
+// NewLSPProvider is the inbound liquidity provider, it's only needed when lsp.enabled is set
+func NewLSPProvider() lsp.Provider {
+
+	if !config.GetBool("lsp.enabled") {
+		return nil
+	}
+
+	provider, err := lsp.New()
+	if err != nil {
+		logger.Fatalw("Could not create liquidity provider", "error", err, "provider", config.GetString("lsp.provider"))
+	}
+
+	return provider
+
+}
+
+// NewChanBackupExporter exports channel backups to the sinks in chanbackup.sinks, it's nil if there are none
+func NewChanBackupExporter() *chanbackup.Exporter {
+
+	exporter, err := chanbackup.New()
+	if err != nil {
+		logger.Fatalw("Could not create channel backup exporter", "error", err, "sinks", config.GetStringSlice("chanbackup.sinks"))
+	}
+
+	return exporter
+
+}
@@ -11,6 +11,9 @@ import (
 	"fmt"
 	"git.coinninja.net/backend/blocc/blocc"
 	"git.coinninja.net/backend/cnauth"
+	"git.coinninja.net/backend/thunderdome/chanbackup"
+	"git.coinninja.net/backend/thunderdome/lsp"
+	"git.coinninja.net/backend/thunderdome/metrics"
 	"git.coinninja.net/backend/thunderdome/monitor"
 	"git.coinninja.net/backend/thunderdome/server"
 	"git.coinninja.net/backend/thunderdome/store"
@@ -21,6 +24,7 @@ import (
 	"git.coinninja.net/backend/thunderdome/tdrpc/tdrpcserver"
 	"github.com/DataDog/datadog-go/statsd"
 	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/invoicesrpc"
 	"github.com/lightningnetwork/lnd/macaroons"
 	"github.com/spf13/viper"
 	"google.golang.org/grpc"
@@ -30,6 +34,7 @@ import (
 	"gopkg.in/macaroon.v2"
 	"io/ioutil"
 	"net"
+	"sync"
 	"time"
 )
 
@@ -50,8 +55,9 @@ func NewServer() (*server.Server, error) {
 func NewTDRPCServer() (tdrpc.ThunderdomeRPCServer, error) {
 	store := NewStore()
 	lightningClient := NewLightningClient()
+	invoicesClient := NewInvoicesClient()
 	distCache := NewDistCache()
-	thunderdomeRPCServer, err := tdrpcserver.NewTDRPCServer(store, lightningClient, distCache)
+	thunderdomeRPCServer, err := tdrpcserver.NewTDRPCServer(store, lightningClient, invoicesClient, distCache)
 	if err != nil {
 		return nil, err
 	}
@@ -60,11 +66,13 @@ func NewTDRPCServer() (tdrpc.ThunderdomeRPCServer, error) {
 
 func NewAdminRPCServer() (tdrpc.AdminRPCServer, error) {
 	store := NewStore()
+	chanBackupStore := NewChannelBackupStore()
+	lightningClient := NewLightningClient()
 	client, err := NewCNAuthClient()
 	if err != nil {
 		return nil, err
 	}
-	adminRPCServer, err := adminrpcserver.NewAdminRPCServer(store, client)
+	adminRPCServer, err := adminrpcserver.NewAdminRPCServer(store, chanBackupStore, lightningClient, client)
 	if err != nil {
 		return nil, err
 	}
@@ -75,15 +83,39 @@ func NewMonitor() (*monitor.Monitor, error) {
 	store := NewStore()
 	chanBackupStore := NewChannelBackupStore()
 	lightningClient := NewLightningClient()
+	invoicesClient := NewInvoicesClient()
 	bloccRPCClient := NewBloccClient()
+	distCache := NewMonitorDistCache()
 	client := NewDogStatsDClient()
-	monitorMonitor, err := monitor.NewMonitor(store, chanBackupStore, lightningClient, bloccRPCClient, client)
+	provider := NewLSPProvider()
+	exporter := NewChanBackupExporter()
+	monitorMonitor, err := monitor.NewMonitor(store, chanBackupStore, lightningClient, invoicesClient, bloccRPCClient, distCache, client, provider, exporter)
 	if err != nil {
 		return nil, err
 	}
 	return monitorMonitor, nil
 }
 
+// NewHealthChecker will check the dependencies of the api
+func NewHealthChecker() *server.HealthChecker {
+	store := NewStore()
+	distCache := NewDistCache()
+	lightningClient := NewLightningClient()
+	bloccRPCClient := NewBloccClient()
+	healthChecker := server.NewHealthChecker(store, distCache, lightningClient, bloccRPCClient)
+	return healthChecker
+}
+
+// NewMonitorHealthChecker will check the dependencies of the monitor
+func NewMonitorHealthChecker() *server.HealthChecker {
+	store := NewStore()
+	distCache := NewMonitorDistCache()
+	lightningClient := NewLightningClient()
+	bloccRPCClient := NewBloccClient()
+	healthChecker := server.NewHealthChecker(store, distCache, lightningClient, bloccRPCClient)
+	return healthChecker
+}
+
 // wire.go:
 
 // NewStore is the store for the application
@@ -148,10 +180,15 @@ func NewLightningClient() lnrpc.LightningClient {
 	return lclient
 }
 
+// NewInvoicesClient connects to the lnd invoices sub-server used for hold invoices
+func NewInvoicesClient() invoicesrpc.InvoicesClient {
+	return invoicesrpc.NewInvoicesClient(NewLndGrpcClientConn())
+}
+
 // NewLndGrpcClientConn creates a new GRPC connection to LND
 func NewLndGrpcClientConn() *grpc.ClientConn {
 
-	dialOptions := []grpc.DialOption{grpc.WithTimeout(10 * time.Second), grpc.WithBlock()}
+	dialOptions := []grpc.DialOption{grpc.WithTimeout(10 * time.Second), grpc.WithBlock(), grpc.WithUnaryInterceptor(metrics.UnaryClientInterceptor("lnd.request_seconds"))}
 
 	if viper.GetBool("lnd.tls_insecure") {
 		creds := credentials.NewTLS(&tls.Config{
@@ -210,7 +247,7 @@ func NewBloccClient() blocc.BloccRPCClient {
 		return nil
 	}
 
-	dialOptions := []grpc.DialOption{grpc.WithTimeout(10 * time.Second), grpc.WithBlock()}
+	dialOptions := []grpc.DialOption{grpc.WithTimeout(10 * time.Second), grpc.WithBlock(), grpc.WithUnaryInterceptor(metrics.UnaryClientInterceptor("blocc.request_seconds"))}
 
 	if viper.GetBool("blocc.tls") {
 		if viper.GetBool("blocc.tls_insecure") {
@@ -249,8 +286,28 @@ func NewDistCache() store.DistCache {
 
 }
 
-// NewDogStatsDClient creates a new statsd client
+// NewMonitorDistCache only connects to redis when it's needed for leader election
+func NewMonitorDistCache() store.DistCache {
+	if !viper.GetBool("monitor.leader_election") {
+		return nil
+	}
+	return NewDistCache()
+}
+
+var (
+	ddclient     *statsd.Client
+	ddclientOnce sync.Once
+)
+
+// NewDogStatsDClient creates a new statsd client, it is shared by everything that uses it
 func NewDogStatsDClient() *statsd.Client {
+	ddclientOnce.Do(func() {
+		ddclient = newDogStatsDClient()
+	})
+	return ddclient
+}
+
+func newDogStatsDClient() *statsd.Client {
 
 	if !viper.GetBool("dogstatsd.enabled") {
 		return nil
@@ -273,3 +330,31 @@ func NewDogStatsDClient() *statsd.Client {
 
 	return client
 }
+
+// NewLSPProvider is the inbound liquidity provider, it's only needed when lsp.enabled is set
+func NewLSPProvider() lsp.Provider {
+
+	if !viper.GetBool("lsp.enabled") {
+		return nil
+	}
+
+	provider, err := lsp.New()
+	if err != nil {
+		logger.Fatalw("Could not create liquidity provider", "error", err, "provider", viper.GetString("lsp.provider"))
+	}
+
+	return provider
+
+}
+
+// NewChanBackupExporter exports channel backups to the sinks in chanbackup.sinks, it's nil if there are none
+func NewChanBackupExporter() *chanbackup.Exporter {
+
+	exporter, err := chanbackup.New()
+	if err != nil {
+		logger.Fatalw("Could not create channel backup exporter", "error", err, "sinks", viper.GetStringSlice("chanbackup.sinks"))
+	}
+
+	return exporter
+
+}
@@ -38,6 +38,10 @@ func init() {
 	config.SetDefault("dogstatsd.namespace", "thunderdome.")
 	config.SetDefault("dogstatsd.tags", []string{})
 
+	// Prometheus Configuration
+	config.SetDefault("prometheus.enabled", true)
+	config.SetDefault("prometheus.namespace", "thunderdome")
+
 	// Server Configuration
 	config.SetDefault("server.host", "")
 	config.SetDefault("server.port", "8080")
@@ -47,11 +51,12 @@ func init() {
 	config.SetDefault("server.keyfile", "server.key")
 	config.SetDefault("server.log_requests", true)
 	config.SetDefault("server.log_requests_body", false)
-	config.SetDefault("server.log_disabled_http", []string{"/version"})
+	config.SetDefault("server.log_disabled_http", []string{"/version", "/leader", "/healthz", "/readyz"})
 	config.SetDefault("server.log_disabled_grpc", []string{"/versionrpc.VersionRPC/Version"})
 	config.SetDefault("server.log_disabled_grpc_stream", []string{})
 	config.SetDefault("server.profiler_enabled", false)
 	config.SetDefault("server.profiler_path", "/debug")
+	config.SetDefault("server.health_check_timeout", "5s")
 
 	// Database Settings
 	config.SetDefault("storage.type", "postgres")
@@ -85,6 +90,66 @@ func init() {
 	config.SetDefault("lnd.unlock_password", "testtest")
 	config.SetDefault("lnd.health_check_interval", "30s")
 
+	// Monitor Settings
+	config.SetDefault("monitor.leader_election", false) // Only run the monitors on the instance holding a lock in redis
+	config.SetDefault("monitor.leader_lock_ttl", "15s")
+	config.SetDefault("monitor.leader_lock_margin", "5s") // Stop the monitors this long before the lock could expire
+	config.SetDefault("monitor.leader_retry_interval", "5s")
+	config.SetDefault("monitor.ln_settle_attempts", 10) // Skip a settled invoice with an alert after it fails this many times
+
+	// Liquidity Settings
+	config.SetDefault("liquidity.enabled", false)               // Track channel balances and alert when imbalanced
+	config.SetDefault("liquidity.low_ratio", 0.2)               // Channels with less of the balance on our side are imbalanced
+	config.SetDefault("liquidity.high_ratio", 0.8)              // Channels with more of the balance on our side are imbalanced
+	config.SetDefault("liquidity.rebalance_enabled", false)     // Rebalance imbalanced channels with circular payments
+	config.SetDefault("liquidity.min_rebalance_value", 10000)   // Don't bother rebalancing less than this
+	config.SetDefault("liquidity.max_rebalance_value", 1000000) // The most moved in a single rebalance
+	config.SetDefault("liquidity.max_rebalance_attempts", 3)    // Pairs of channels tried each interval
+	config.SetDefault("liquidity.max_fee_ppm", 1000)            // The most paid per million sats moved
+	config.SetDefault("liquidity.daily_fee_budget", 10000)      // The most paid in rebalance fees in 24 hours
+
+	// Autopilot Settings
+	config.SetDefault("autopilot.enabled", false)           // Propose opening channels to frequently paid nodes and closing inactive ones
+	config.SetDefault("autopilot.lookback", "168h")         // How far back payments and unroutable payments are counted
+	config.SetDefault("autopilot.min_payments", 10)         // Payments to a node before a channel is proposed
+	config.SetDefault("autopilot.max_proposals", 3)         // The most channel opens proposed each interval
+	config.SetDefault("autopilot.channel_value", 1000000)   // The value of proposed channels
+	config.SetDefault("autopilot.auto_open", false)         // Open proposed channels without approval
+	config.SetDefault("autopilot.daily_budget", 5000000)    // The most committed to automatically opened channels in 24 hours
+	config.SetDefault("autopilot.min_reserve", 1000000)     // The wallet balance that must remain after automatically opening a channel
+	config.SetDefault("autopilot.target_conf", 6)           // The confirmation target for channel open and close transactions
+	config.SetDefault("autopilot.inactive_after", "720h")   // Propose closing channels with no updates for this long
+	config.SetDefault("autopilot.auto_close", false)        // Close inactive channels without approval
+	config.SetDefault("autopilot.proposal_cooloff", "168h") // Don't propose the same open or close again for this long after it was rejected or failed
+
+	// Inbound Liquidity Provider Settings
+	config.SetDefault("lsp.enabled", false)   // Request channels from a liquidity provider when inbound liquidity is low
+	config.SetDefault("lsp.provider", "http") // The provider API (http, fake)
+	config.SetDefault("lsp.url", "")          // The provider API url
+	config.SetDefault("lsp.token", "")        // The provider API token
+	config.SetDefault("lsp.timeout", "30s")
+	config.SetDefault("lsp.min_inbound", 2000000)      // Request a channel when the total inbound liquidity is below this
+	config.SetDefault("lsp.channel_capacity", 5000000) // The capacity of requested channels
+	config.SetDefault("lsp.request_cooldown", "24h")   // The least time between channel requests
+
+	// Channel Backup Export Settings
+	config.SetDefault("chanbackup.sinks", []string{})  // Where to export encrypted channel backups (fs, s3)
+	config.SetDefault("chanbackup.encryption_key", "") // 32 byte hex AES-256 key
+	config.SetDefault("chanbackup.retention_count", 100)
+	config.SetDefault("chanbackup.retention_age", "720h")
+	config.SetDefault("chanbackup.fs.path", "chanbackups")
+	config.SetDefault("chanbackup.s3.endpoint", "https://s3.amazonaws.com")
+	config.SetDefault("chanbackup.s3.region", "us-east-1")
+	config.SetDefault("chanbackup.s3.bucket", "")
+	config.SetDefault("chanbackup.s3.prefix", "thunderdome/")
+	config.SetDefault("chanbackup.s3.access_key", "")
+	config.SetDefault("chanbackup.s3.secret_key", "")
+	config.SetDefault("chanbackup.s3.path_style", true) // Put the bucket in the path (required by most S3 compatible services)
+	config.SetDefault("chanbackup.s3.timeout", "30s")
+	config.SetDefault("chanbackup.db_prune", false)           // Periodically delete old channel backups from the database
+	config.SetDefault("chanbackup.db_retention_count", 1000)  // Keep at most this many (0 for no limit)
+	config.SetDefault("chanbackup.db_retention_age", "2160h") // Delete those older than this (0 for no limit)
+
 	config.SetDefault("blocc.host", "blocc")
 	config.SetDefault("blocc.port", 8080)
 	config.SetDefault("blocc.tls", false)
@@ -97,7 +162,12 @@ func init() {
 	config.SetDefault("tdome.lock_new_accounts", false)
 	config.SetDefault("tdome.firebase_credentials_file", "")
 	config.SetDefault("tdome.firebase_admin_role", cnauth.ClaimRolePrefix) // cn_role is the default
-	config.SetDefault("tdome.agent_secret", "")                            // If left blank, it cannot be used
+	config.SetDefault("tdome.auth_time_window", "10m")                     // Signed timestamps must be within +/- this window
+	config.SetDefault("tdome.require_nonce", false)                        // Require a nonce with every signed request
+	config.SetDefault("tdome.token_secret", "")                            // Root key for bearer tokens, blank disables tokens
+	config.SetDefault("tdome.token_default_expires", 86400)
+	config.SetDefault("tdome.token_max_expires", 2592000)
+	config.SetDefault("tdome.delegation_max_expires", 2592000) // Delegations expiring later than this from now are rejected
 
 	config.SetDefault("tdome.value_limit", 1000000)
 	config.SetDefault("tdome.processing_fee_rate", 0.0)
@@ -105,7 +175,32 @@ func init() {
 	config.SetDefault("tdome.default_request_expires", 172800)
 	config.SetDefault("tdome.create_generated_expires", 2592000)
 	config.SetDefault("tdome.create_request_limit", 5)
-	config.SetDefault("tdome.agent_pay_value_limit", 100000)
+	config.SetDefault("tdome.inbound_check", "warn")          // Warn or reject when an invoice is larger than we can receive, blank disables
+	config.SetDefault("tdome.idempotency_key_expires", 86400) // How long an idempotency key returns the original response
+
+	// Per account limits, a limit of 0 is unlimited. Accounts without a tier use the default tier.
+	config.SetDefault("tdome.default_limit_tier", "default")
+	config.SetDefault("tdome.limit_tiers", map[string]interface{}{
+		"default": map[string]interface{}{
+			"daily_send":     0,
+			"weekly_send":    0,
+			"max_payment":    0,
+			"daily_withdraw": 0,
+		},
+	})
+
+	// When set, only destinations on the allow list can be paid or withdrawn to
+	config.SetDefault("tdome.screening_allow_only_address", false)
+	config.SetDefault("tdome.screening_allow_only_node", false)
+
+	// Outbound transactions larger than these values are held for manual review, 0 disables
+	config.SetDefault("tdome.hold_pay_threshold", 0)
+	config.SetDefault("tdome.hold_withdraw_threshold", 0)
+
+	// Hold invoices must be settled or canceled before the htlcs paying them expire
+	config.SetDefault("tdome.hold_max_expires", 86400) // The longest a hold invoice can be paid for (seconds)
+	config.SetDefault("tdome.hold_cltv_expiry", 144)   // The htlcs paying a hold invoice expire at least this many blocks after they are accepted
+	config.SetDefault("tdome.hold_cancel_blocks", 24)  // Cancel accepted hold invoices this many blocks before the htlcs expire
 
 	config.SetDefault("tdome.default_withdraw_target_blocks", 6)
 	config.SetDefault("tdome.withdraw_fee_rate", 1.0)
@@ -12,8 +12,10 @@ require (
 	github.com/DataDog/datadog-go v3.2.0+incompatible
 	github.com/Microsoft/go-winio v0.4.14 // indirect
 	github.com/blendle/zapdriver v1.3.1
-	github.com/btcsuite/btcd v0.20.1-beta
-	github.com/btcsuite/btcutil v0.0.0-20190425235716-9e5f4b9a998d
+	github.com/boltdb/bolt v1.3.1 // indirect
+	github.com/btcsuite/btcd v0.20.1-beta.0.20200730232343-1db1b6f8217f
+	github.com/btcsuite/btcutil v1.0.2
+	github.com/btcsuite/fastsha256 v0.0.0-20160815193821-637e65642941 // indirect
 	github.com/containerd/containerd v1.2.8 // indirect
 	github.com/davecgh/go-spew v1.1.1
 	github.com/dhui/dktest v0.3.1 // indirect
@@ -27,30 +29,27 @@ require (
 	github.com/go-redis/redis v6.15.6+incompatible
 	github.com/gogo/protobuf v1.3.1
 	github.com/golang-migrate/migrate/v4 v4.7.0
-	github.com/golang/groupcache v0.0.0-20191027212112-611e8accdfc9 // indirect
 	github.com/golang/protobuf v1.3.2
 	github.com/google/wire v0.3.0
 	github.com/gorilla/mux v1.7.3 // indirect
 	github.com/grpc-ecosystem/go-grpc-middleware v1.1.0
-	github.com/grpc-ecosystem/grpc-gateway v1.12.1
+	github.com/grpc-ecosystem/grpc-gateway v1.14.3
 	github.com/jmoiron/sqlx v1.2.0
 	github.com/jstemmer/go-junit-report v0.9.1 // indirect
 	github.com/lib/pq v1.2.0
-	github.com/lightningnetwork/lnd v0.7.1-beta
+	github.com/lightningnetwork/lnd v0.11.0-beta
 	github.com/mattn/go-sqlite3 v1.11.0 // indirect
 	github.com/pelletier/go-toml v1.6.0 // indirect
+	github.com/prometheus/client_golang v1.1.0
 	github.com/rs/xid v1.2.1
 	github.com/snowzach/certtools v1.0.2
 	github.com/spf13/cobra v0.0.5
 	github.com/spf13/pflag v1.0.5 // indirect
 	github.com/spf13/viper v1.5.0
-	github.com/stretchr/testify v1.4.0
+	github.com/stretchr/testify v1.5.1
 	go.opencensus.io v0.22.2 // indirect
-	go.uber.org/multierr v1.4.0 // indirect
-	go.uber.org/zap v1.13.0
-	golang.org/x/crypto v0.0.0-20191117063200-497ca9f6d64f // indirect
+	go.uber.org/zap v1.14.1
 	golang.org/x/net v0.0.0-20191116160921-f9c825593386
-	golang.org/x/sys v0.0.0-20191118133127-cf1e2d577169 // indirect
 	golang.org/x/text v0.3.2
 	golang.org/x/tools v0.0.0-20191118051429-5a76f03bc7c3 // indirect
 	google.golang.org/api v0.14.0 // indirect
@@ -64,4 +63,6 @@ require (
 
 replace github.com/btcsuite/btcwallet v0.0.0-20180904010540-284e2e0e696e33d5be388f7f3d9a26db703e0c06 => github.com/btcsuite/btcwallet v0.0.0-20181130030754-284e2e0e696e
 
+replace git.schwanenlied.me/yawning/bsaes.git => github.com/Yawning/bsaes v0.0.0-20180720073208-c0276d75487e
+
 replace github.com/coreos/bbolt v0.0.0-20180223184059-7ee3ded59d4835e10f3e7d0f7603c42aa5e83820 => github.com/coreos/bbolt v1.3.1-etcd.8
@@ -0,0 +1,37 @@
+package lsp
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// NewFakeProvider returns a provider that accepts every request without opening a channel
+// It's used for tests and local development
+func NewFakeProvider() *FakeProvider {
+	return &FakeProvider{}
+}
+
+// FakeProvider records the channels requested
+type FakeProvider struct {
+	Requests []*ChannelRequest
+	// Err is returned by RequestChannel when set
+	Err error
+}
+
+// RequestChannel records the request and returns a pending lease
+func (fp *FakeProvider) RequestChannel(ctx context.Context, request *ChannelRequest) (*ChannelLease, error) {
+
+	if fp.Err != nil {
+		return nil, fp.Err
+	}
+
+	fp.Requests = append(fp.Requests, request)
+
+	return &ChannelLease{
+		Id:       fmt.Sprintf("fake:%d", time.Now().UnixNano()),
+		Status:   "pending",
+		Capacity: request.Capacity,
+	}, nil
+
+}
@@ -0,0 +1,77 @@
+package lsp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// HTTPProvider requests channels with a generic JSON API. Requests are a POST to {url}/v1/channel with a
+// ChannelRequest body and a bearer token, the response is a ChannelLease or an {"error": "message"} body.
+type HTTPProvider struct {
+	url    string
+	token  string
+	client *http.Client
+}
+
+// NewHTTPProvider creates a provider that calls the API at url
+func NewHTTPProvider(url string, token string, timeout time.Duration) *HTTPProvider {
+	return &HTTPProvider{
+		url:    strings.TrimSuffix(url, "/"),
+		token:  token,
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+// RequestChannel asks the provider to open a channel to our node
+func (hp *HTTPProvider) RequestChannel(ctx context.Context, request *ChannelRequest) (*ChannelLease, error) {
+
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, hp.url+"/v1/channel", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
+	if hp.token != "" {
+		req.Header.Set("Authorization", "Bearer "+hp.token)
+	}
+
+	resp, err := hp.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("Could not request channel: %v", err)
+	}
+	defer resp.Body.Close()
+
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("Could not read response: %v", err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		var apiErr struct {
+			Error string `json:"error"`
+		}
+		if json.Unmarshal(data, &apiErr) == nil && apiErr.Error != "" {
+			return nil, fmt.Errorf("Provider error %d: %s", resp.StatusCode, apiErr.Error)
+		}
+		return nil, fmt.Errorf("Provider error %d", resp.StatusCode)
+	}
+
+	var lease = new(ChannelLease)
+	if err = json.Unmarshal(data, lease); err != nil {
+		return nil, fmt.Errorf("Could not parse response: %v", err)
+	}
+
+	return lease, nil
+
+}
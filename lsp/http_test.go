@@ -0,0 +1,54 @@
+package lsp
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHTTPProvider(t *testing.T) {
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/channel", r.URL.Path)
+		assert.Equal(t, http.MethodPost, r.Method)
+
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"error":"bad token"}`))
+			return
+		}
+
+		var request ChannelRequest
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&request))
+		assert.Equal(t, "pubkey", request.NodePubkey)
+
+		_ = json.NewEncoder(w).Encode(&ChannelLease{
+			Id:       "lease1",
+			Status:   "pending",
+			Capacity: request.Capacity,
+			Fee:      100,
+		})
+	}))
+	defer ts.Close()
+
+	lease, err := NewHTTPProvider(ts.URL+"/", "secret", time.Second).RequestChannel(context.Background(), &ChannelRequest{
+		NodePubkey: "pubkey",
+		Capacity:   1000000,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "lease1", lease.Id)
+	assert.Equal(t, int64(1000000), lease.Capacity)
+	assert.Equal(t, int64(100), lease.Fee)
+
+	_, err = NewHTTPProvider(ts.URL, "wrong", time.Second).RequestChannel(context.Background(), &ChannelRequest{
+		NodePubkey: "pubkey",
+		Capacity:   1000000,
+	})
+	assert.EqualError(t, err, "Provider error 401: bad token")
+
+}
@@ -0,0 +1,51 @@
+package lsp
+
+import (
+	"context"
+	"fmt"
+
+	config "github.com/spf13/viper"
+)
+
+// ChannelRequest asks a provider to open a channel to our node
+type ChannelRequest struct {
+	// Our node public key
+	NodePubkey string `json:"node_pubkey"`
+	// The host:port the provider can connect to us at, blank if it should use the gossip network
+	NodeAddress string `json:"node_address,omitempty"`
+	// The capacity of the channel, all of it is inbound liquidity for us
+	Capacity int64 `json:"capacity"`
+}
+
+// ChannelLease is the channel a provider agreed to open
+type ChannelLease struct {
+	Id          string `json:"id"`
+	Status      string `json:"status"`
+	Capacity    int64  `json:"capacity"`
+	FundingTxid string `json:"funding_txid,omitempty"`
+	// The fee charged by the provider (sats)
+	Fee int64 `json:"fee"`
+}
+
+// Provider is a lightning service provider that sells inbound liquidity
+type Provider interface {
+	// RequestChannel asks the provider to open a channel to our node
+	RequestChannel(ctx context.Context, request *ChannelRequest) (*ChannelLease, error)
+}
+
+// New creates the provider configured with lsp.provider
+func New() (Provider, error) {
+
+	switch config.GetString("lsp.provider") {
+	case "http":
+		if config.GetString("lsp.url") == "" {
+			return nil, fmt.Errorf("lsp.url is required")
+		}
+		return NewHTTPProvider(config.GetString("lsp.url"), config.GetString("lsp.token"), config.GetDuration("lsp.timeout")), nil
+	case "fake":
+		return NewFakeProvider(), nil
+	}
+
+	return nil, fmt.Errorf("Unknown lsp.provider %s", config.GetString("lsp.provider"))
+
+}
@@ -0,0 +1,39 @@
+package metrics
+
+import (
+	"github.com/DataDog/datadog-go/statsd"
+)
+
+// DogStatsDSink sends metrics to datadog
+type DogStatsDSink struct {
+	client *statsd.Client
+}
+
+// NewDogStatsDSink creates a sink that sends metrics with client
+func NewDogStatsDSink(client *statsd.Client) *DogStatsDSink {
+	return &DogStatsDSink{client: client}
+}
+
+// Count adds value to a counter
+func (ds *DogStatsDSink) Count(name string, value int64, labels Labels) {
+	_ = ds.client.Count(name, value, tags(labels), 1)
+}
+
+// Gauge sets the current value of a gauge
+func (ds *DogStatsDSink) Gauge(name string, value float64, labels Labels) {
+	_ = ds.client.Gauge(name, value, tags(labels), 1)
+}
+
+// Observe records a value in a histogram
+func (ds *DogStatsDSink) Observe(name string, value float64, labels Labels) {
+	_ = ds.client.Histogram(name, value, tags(labels), 1)
+}
+
+// tags converts labels to datadog key:value tags
+func tags(labels Labels) []string {
+	t := make([]string, 0, len(labels))
+	for name, value := range labels {
+		t = append(t, name+":"+value)
+	}
+	return t
+}
@@ -0,0 +1,39 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryServerInterceptor records the latency and status code of every unary request in name
+func UnaryServerInterceptor(name string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		Since(name, start, Labels{"method": info.FullMethod, "code": status.Code(err).String()})
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor records the duration and status code of every stream in name
+func StreamServerInterceptor(name string) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		err := handler(srv, ss)
+		Since(name, start, Labels{"method": info.FullMethod, "code": status.Code(err).String()})
+		return err
+	}
+}
+
+// UnaryClientInterceptor records the latency and status code of every unary call in name
+func UnaryClientInterceptor(name string) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		start := time.Now()
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		Since(name, start, Labels{"method": method, "code": status.Code(err).String()})
+		return err
+	}
+}
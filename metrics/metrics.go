@@ -0,0 +1,90 @@
+package metrics
+
+import (
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Labels are the dimensions of a metric. Every call for a metric name must use the same label names.
+type Labels map[string]string
+
+// Sink receives metrics and sends them somewhere
+type Sink interface {
+	// Count adds value to a counter
+	Count(name string, value int64, labels Labels)
+	// Gauge sets the current value of a gauge
+	Gauge(name string, value float64, labels Labels)
+	// Observe records a value in a histogram, durations are in seconds
+	Observe(name string, value float64, labels Labels)
+}
+
+var (
+	sinks     []Sink
+	sinksLock sync.RWMutex
+
+	// The sink served by Handler
+	prometheusSink *PrometheusSink
+)
+
+// EnablePrometheus sends all metrics to prometheus as well, they are served by Handler
+func EnablePrometheus(namespace string) {
+	sinksLock.Lock()
+	prometheusSink = NewPrometheusSink(namespace)
+	sinks = append(sinks, prometheusSink)
+	sinksLock.Unlock()
+}
+
+// Handler serves the prometheus metrics, it returns not found if prometheus is not enabled
+func Handler() http.Handler {
+	sinksLock.RLock()
+	defer sinksLock.RUnlock()
+	if prometheusSink == nil {
+		return http.NotFoundHandler()
+	}
+	return prometheusSink.Handler()
+}
+
+// AddSink sends all metrics to sink as well
+func AddSink(sink Sink) {
+	sinksLock.Lock()
+	sinks = append(sinks, sink)
+	sinksLock.Unlock()
+}
+
+// Count adds value to a counter in every sink
+func Count(name string, value int64, labels Labels) {
+	sinksLock.RLock()
+	defer sinksLock.RUnlock()
+	for _, sink := range sinks {
+		sink.Count(name, value, labels)
+	}
+}
+
+// Incr adds one to a counter in every sink
+func Incr(name string, labels Labels) {
+	Count(name, 1, labels)
+}
+
+// Gauge sets a gauge in every sink
+func Gauge(name string, value float64, labels Labels) {
+	sinksLock.RLock()
+	defer sinksLock.RUnlock()
+	for _, sink := range sinks {
+		sink.Gauge(name, value, labels)
+	}
+}
+
+// Observe records a value in a histogram in every sink
+func Observe(name string, value float64, labels Labels) {
+	sinksLock.RLock()
+	defer sinksLock.RUnlock()
+	for _, sink := range sinks {
+		sink.Observe(name, value, labels)
+	}
+}
+
+// Since records the seconds elapsed since start in a histogram in every sink
+func Since(name string, start time.Time, labels Labels) {
+	Observe(name, time.Since(start).Seconds(), labels)
+}
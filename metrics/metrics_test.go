@@ -0,0 +1,30 @@
+package metrics
+
+import (
+	"io/ioutil"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrometheusSink(t *testing.T) {
+
+	ps := NewPrometheusSink("test")
+	AddSink(ps)
+
+	Incr("ledger.transitions", Labels{"status": "completed", "type": "lightning"})
+	Count("ledger.transitions", 2, Labels{"status": "completed", "type": "lightning"})
+	Gauge("lnd.total_balance", 1000, nil)
+	Observe("lnd.call_seconds", 0.2, Labels{"method": "GetInfo", "code": "OK"})
+
+	w := httptest.NewRecorder()
+	ps.Handler().ServeHTTP(w, httptest.NewRequest("GET", "/metrics", nil))
+	body, err := ioutil.ReadAll(w.Body)
+	assert.Nil(t, err)
+
+	assert.Contains(t, string(body), `test_ledger_transitions{status="completed",type="lightning"} 3`)
+	assert.Contains(t, string(body), `test_lnd_total_balance 1000`)
+	assert.Contains(t, string(body), `test_lnd_call_seconds_count{code="OK",method="GetInfo"} 1`)
+
+}
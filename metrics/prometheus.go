@@ -0,0 +1,133 @@
+package metrics
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.uber.org/zap"
+)
+
+// PrometheusSink keeps metrics in a prometheus registry to be scraped from Handler
+type PrometheusSink struct {
+	namespace string
+	registry  *prometheus.Registry
+
+	counters   map[string]*prometheus.CounterVec
+	gauges     map[string]*prometheus.GaugeVec
+	histograms map[string]*prometheus.HistogramVec
+	lock       sync.Mutex
+}
+
+// NewPrometheusSink creates a prometheus sink, metric names are prefixed with namespace
+func NewPrometheusSink(namespace string) *PrometheusSink {
+
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(prometheus.NewGoCollector())
+	registry.MustRegister(prometheus.NewProcessCollector(prometheus.ProcessCollectorOpts{}))
+
+	return &PrometheusSink{
+		namespace:  namespace,
+		registry:   registry,
+		counters:   make(map[string]*prometheus.CounterVec),
+		gauges:     make(map[string]*prometheus.GaugeVec),
+		histograms: make(map[string]*prometheus.HistogramVec),
+	}
+}
+
+// Handler serves the metrics to prometheus
+func (ps *PrometheusSink) Handler() http.Handler {
+	return promhttp.HandlerFor(ps.registry, promhttp.HandlerOpts{})
+}
+
+// Count adds value to a counter
+func (ps *PrometheusSink) Count(name string, value int64, labels Labels) {
+
+	ps.lock.Lock()
+	vec, ok := ps.counters[name]
+	if !ok {
+		vec = prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: ps.namespace,
+			Name:      promName(name),
+			Help:      name,
+		}, labelNames(labels))
+		ps.register(name, vec)
+		ps.counters[name] = vec
+	}
+	ps.lock.Unlock()
+
+	if counter, err := vec.GetMetricWith(prometheus.Labels(labels)); err == nil {
+		counter.Add(float64(value))
+	}
+
+}
+
+// Gauge sets the current value of a gauge
+func (ps *PrometheusSink) Gauge(name string, value float64, labels Labels) {
+
+	ps.lock.Lock()
+	vec, ok := ps.gauges[name]
+	if !ok {
+		vec = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: ps.namespace,
+			Name:      promName(name),
+			Help:      name,
+		}, labelNames(labels))
+		ps.register(name, vec)
+		ps.gauges[name] = vec
+	}
+	ps.lock.Unlock()
+
+	if gauge, err := vec.GetMetricWith(prometheus.Labels(labels)); err == nil {
+		gauge.Set(value)
+	}
+
+}
+
+// Observe records a value in a histogram
+func (ps *PrometheusSink) Observe(name string, value float64, labels Labels) {
+
+	ps.lock.Lock()
+	vec, ok := ps.histograms[name]
+	if !ok {
+		vec = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: ps.namespace,
+			Name:      promName(name),
+			Help:      name,
+			Buckets:   prometheus.DefBuckets,
+		}, labelNames(labels))
+		ps.register(name, vec)
+		ps.histograms[name] = vec
+	}
+	ps.lock.Unlock()
+
+	if histogram, err := vec.GetMetricWith(prometheus.Labels(labels)); err == nil {
+		histogram.Observe(value)
+	}
+
+}
+
+// register adds a collector to the registry, a name used as more than one metric type is only exported once
+func (ps *PrometheusSink) register(name string, c prometheus.Collector) {
+	if err := ps.registry.Register(c); err != nil {
+		zap.S().Warnw("Could not register prometheus metric", "package", "metrics", "name", name, "error", err)
+	}
+}
+
+// promName converts a dotted metric name to a prometheus name
+func promName(name string) string {
+	return strings.NewReplacer(".", "_", "-", "_").Replace(name)
+}
+
+// labelNames returns the sorted names of the labels
+func labelNames(labels Labels) []string {
+	names := make([]string, 0, len(labels))
+	for name := range labels {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,204 @@
+package monitor
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"os"
+	"sync/atomic"
+	"time"
+
+	config "github.com/spf13/viper"
+
+	"git.coinninja.net/backend/thunderdome/conf"
+	"git.coinninja.net/backend/thunderdome/tdrpc"
+)
+
+const (
+	leaderBucket = "monitor"
+	leaderKey    = "leader"
+)
+
+// startMonitors runs the monitors while this instance is the leader
+var startMonitors = (*Monitor).start
+
+// leaderExit ends the process if the monitors do not stop in time after losing leadership
+var leaderExit = os.Exit
+
+// IsLeader returns true if this instance is running the monitors
+func (m *Monitor) IsLeader() bool {
+	return atomic.LoadInt32(&m.leader) == 1
+}
+
+// InstanceID returns the id this instance uses for leader election
+func (m *Monitor) InstanceID() string {
+	return m.instanceID
+}
+
+// elect waits until this instance holds the leader lock and runs the monitors while it keeps it
+// If leadership is lost the monitors are stopped and it goes back to waiting as a standby
+func (m *Monitor) elect() {
+
+	conf.Stop.Add(1)
+	defer conf.Stop.Done()
+
+	for {
+		expires, token, ok := m.acquire()
+		if !ok {
+			return
+		}
+		m.lead(expires, token)
+		if conf.Stop.Bool() {
+			return
+		}
+	}
+
+}
+
+// acquire waits for the leader lock and takes a new fencing token
+// It returns when the lease must be given up by and false if shutting down
+func (m *Monitor) acquire() (time.Time, int64, bool) {
+
+	ttl, margin := leaderLease()
+	retry := config.GetDuration("monitor.leader_retry_interval")
+
+	m.logger.Infow("Waiting for monitor leadership", "instance_id", m.instanceID)
+
+	for {
+		// The lease is measured from before the request in case it's slow
+		attempt := time.Now()
+		held, err := m.cache.Lock(leaderBucket, leaderKey, m.instanceID, ttl)
+		if err != nil {
+			m.logger.Errorw("Could not acquire monitor leader lock", "error", err)
+		} else if held {
+			// A new token lets the previous leader know it has been replaced
+			token, err := m.store.IncrMonitorCheckpoint(context.Background(), tdrpc.MonitorCheckpointLeaderToken)
+			if err == nil {
+				return attempt.Add(ttl - margin), token, true
+			}
+			m.logger.Errorw("Could not increment monitor leader token", "error", err)
+			if err = m.cache.Unlock(leaderBucket, leaderKey, m.instanceID); err != nil {
+				m.logger.Errorw("Could not release monitor leader lock", "error", err)
+			}
+		}
+
+		select {
+		case <-time.After(retry):
+		case <-conf.Stop.Chan():
+			return time.Time{}, 0, false
+		}
+	}
+
+}
+
+// lead runs the monitors and refreshes the lock until shutting down or leadership is lost
+// The monitors are stopped before the lease expires, margin before another instance could take over
+func (m *Monitor) lead(expires time.Time, token int64) {
+
+	ttl, margin := leaderLease()
+
+	m.logger.Infow("Acquired monitor leadership", "instance_id", m.instanceID, "token", token)
+	atomic.StoreInt32(&m.leader, 1)
+
+	t := startMonitors(m)
+
+	for {
+		// Refresh the lock well before it expires
+		wait := ttl / 3
+		if until := time.Until(expires); until < wait {
+			wait = until
+		}
+
+		select {
+		case <-time.After(wait):
+		case <-t.Chan():
+			// Shutting down, release the lock so a standby can take over right away
+			m.stepDown(t)
+			if err := m.cache.Unlock(leaderBucket, leaderKey, m.instanceID); err != nil {
+				m.logger.Errorw("Could not release monitor leader lock", "error", err)
+			}
+			return
+		}
+
+		attempt := time.Now()
+		held, err := m.cache.Lock(leaderBucket, leaderKey, m.instanceID, ttl)
+		if err != nil {
+			m.logger.Errorw("Could not refresh monitor leader lock", "error", err)
+		} else if !held {
+			m.logger.Errorw("Monitor leader lock is held by another instance", "instance_id", m.instanceID)
+			break
+		} else {
+			// Another instance has become the leader since the last refresh if the token changed
+			current, err := m.store.GetMonitorCheckpoint(context.Background(), tdrpc.MonitorCheckpointLeaderToken)
+			if err != nil {
+				m.logger.Errorw("Could not get monitor leader token", "error", err)
+			} else if current != token {
+				m.logger.Errorw("Monitor leader token replaced", "instance_id", m.instanceID, "token", token, "current", current)
+				break
+			} else {
+				expires = attempt.Add(ttl - margin)
+				continue
+			}
+		}
+
+		// Keep going until the lease could expire in case the next refresh works
+		if !time.Now().Before(expires) {
+			m.logger.Errorw("Monitor leader lease expired", "instance_id", m.instanceID)
+			break
+		}
+	}
+
+	m.stepDown(t)
+
+}
+
+// stepDown stops the monitors and waits for them to return
+// It starts at least margin before the lease expires, if the monitors are still running after margin
+// another instance could be the leader so the process exits rather than let them keep going
+func (m *Monitor) stepDown(t *term) {
+
+	atomic.StoreInt32(&m.leader, 0)
+	t.end()
+
+	_, margin := leaderLease()
+	stopped := make(chan struct{})
+	go func() {
+		t.Wait()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-time.After(margin):
+		m.logger.Errorw("Monitors did not stop before the leader lease could expire, exiting", "instance_id", m.instanceID, "margin", margin.String())
+		leaderExit(1)
+		return
+	}
+
+	m.logger.Infow("Gave up monitor leadership", "instance_id", m.instanceID)
+
+}
+
+// leaderLease returns the lock ttl and how long before it expires the monitors are stopped
+func leaderLease() (time.Duration, time.Duration) {
+
+	ttl := config.GetDuration("monitor.leader_lock_ttl")
+	margin := config.GetDuration("monitor.leader_lock_margin")
+	if margin <= 0 || margin >= ttl {
+		margin = ttl / 3
+	}
+
+	return ttl, margin
+
+}
+
+// newInstanceID returns a unique id for this instance using the hostname when available
+func newInstanceID() string {
+	b := make([]byte, 4)
+	_, _ = rand.Read(b)
+	id := hex.EncodeToString(b)
+	if hostname, err := os.Hostname(); err == nil {
+		id = hostname + "-" + id
+	}
+	return id
+}
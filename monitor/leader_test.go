@@ -0,0 +1,223 @@
+package monitor
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	config "github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+
+	_ "git.coinninja.net/backend/thunderdome/conf"
+	"git.coinninja.net/backend/thunderdome/mocks"
+	"git.coinninja.net/backend/thunderdome/tdrpc"
+)
+
+const (
+	testLeaderTTL    = 300 * time.Millisecond
+	testLeaderMargin = 100 * time.Millisecond
+)
+
+// newLeaderTest returns a monitor with a short leader lease that starts a single monitor running until the end of its term
+// Every term started is sent on the returned channel
+func newLeaderTest(t *testing.T) (*Monitor, *mocks.Store, *mocks.DistCache, chan *term) {
+
+	config.Set("monitor.leader_lock_ttl", testLeaderTTL)
+	config.Set("monitor.leader_lock_margin", testLeaderMargin)
+	config.Set("monitor.leader_retry_interval", time.Millisecond)
+
+	mockStore := new(mocks.Store)
+	mockCache := new(mocks.DistCache)
+
+	m := &Monitor{
+		logger:     zap.S(),
+		store:      mockStore,
+		cache:      mockCache,
+		instanceID: "instance1",
+		health:     make(map[string]*MonitorHealth),
+	}
+
+	started := make(chan *term, 10)
+	prevStart := startMonitors
+	startMonitors = func(m *Monitor) *term {
+		tm := newTerm()
+		m.term.Store(tm)
+		tm.Add(1)
+		go m.supervise(tm, "test", func() error {
+			<-tm.Chan()
+			return nil
+		})
+		started <- tm
+		return tm
+	}
+	t.Cleanup(func() { startMonitors = prevStart })
+
+	return m, mockStore, mockCache, started
+
+}
+
+func TestLeaderAcquire(t *testing.T) {
+
+	m, mockStore, mockCache, _ := newLeaderTest(t)
+
+	// Redis is down, then another instance holds the lock
+	mockCache.On("Lock", leaderBucket, leaderKey, m.instanceID, testLeaderTTL).Once().Return(false, errors.New("down"))
+	mockCache.On("Lock", leaderBucket, leaderKey, m.instanceID, testLeaderTTL).Once().Return(false, nil)
+
+	// The lock is released if the token can't be taken
+	mockCache.On("Lock", leaderBucket, leaderKey, m.instanceID, testLeaderTTL).Once().Return(true, nil)
+	mockStore.On("IncrMonitorCheckpoint", mock.AnythingOfType("*context.emptyCtx"), tdrpc.MonitorCheckpointLeaderToken).Once().Return(int64(0), errors.New("down"))
+	mockCache.On("Unlock", leaderBucket, leaderKey, m.instanceID).Once().Return(nil)
+
+	// Acquired
+	mockCache.On("Lock", leaderBucket, leaderKey, m.instanceID, testLeaderTTL).Once().Return(true, nil)
+	mockStore.On("IncrMonitorCheckpoint", mock.AnythingOfType("*context.emptyCtx"), tdrpc.MonitorCheckpointLeaderToken).Once().Return(int64(7), nil)
+
+	before := time.Now()
+	expires, token, ok := m.acquire()
+	assert.True(t, ok)
+	assert.Equal(t, int64(7), token)
+
+	// The lease ends margin before the lock could expire
+	assert.False(t, expires.Before(before.Add(testLeaderTTL-testLeaderMargin)))
+	assert.False(t, expires.After(time.Now().Add(testLeaderTTL-testLeaderMargin)))
+	assert.False(t, m.IsLeader())
+
+	mockStore.AssertExpectations(t)
+	mockCache.AssertExpectations(t)
+
+}
+
+func TestLeaderLeadTokenReplaced(t *testing.T) {
+
+	m, mockStore, mockCache, started := newLeaderTest(t)
+
+	// The lock is refreshed once then another instance has taken a new token
+	mockCache.On("Lock", leaderBucket, leaderKey, m.instanceID, testLeaderTTL).Twice().Return(true, nil)
+	mockStore.On("GetMonitorCheckpoint", mock.AnythingOfType("*context.emptyCtx"), tdrpc.MonitorCheckpointLeaderToken).Once().Return(int64(7), nil)
+	mockStore.On("GetMonitorCheckpoint", mock.AnythingOfType("*context.emptyCtx"), tdrpc.MonitorCheckpointLeaderToken).Once().Return(int64(8), nil)
+
+	m.lead(time.Now().Add(testLeaderTTL-testLeaderMargin), 7)
+
+	// The monitors were started and stopped
+	tm := <-started
+	assert.True(t, tm.Bool())
+	assert.False(t, m.IsLeader())
+	for _, mh := range m.Health() {
+		assert.False(t, mh.Running)
+	}
+
+	mockStore.AssertExpectations(t)
+	mockCache.AssertExpectations(t)
+
+}
+
+func TestLeaderLeadLeaseExpired(t *testing.T) {
+
+	m, mockStore, mockCache, started := newLeaderTest(t)
+
+	// The lock can't be refreshed
+	mockCache.On("Lock", leaderBucket, leaderKey, m.instanceID, testLeaderTTL).Return(false, errors.New("down"))
+
+	start := time.Now()
+	expires := start.Add(testLeaderTTL - testLeaderMargin)
+	m.lead(expires, 7)
+
+	// It keeps leading until the lease expires and stops before the lock could expire
+	assert.False(t, time.Now().Before(expires))
+	assert.True(t, time.Since(start) < testLeaderTTL)
+	assert.True(t, (<-started).Bool())
+	assert.False(t, m.IsLeader())
+
+	mockStore.AssertExpectations(t)
+	mockCache.AssertExpectations(t)
+
+}
+
+func TestLeaderStepDown(t *testing.T) {
+
+	m, _, _, _ := newLeaderTest(t)
+
+	var exitCode int
+	defer func(exit func(int)) { leaderExit = exit }(leaderExit)
+	leaderExit = func(code int) { exitCode = code }
+
+	// The monitors stop right away
+	tm := newTerm()
+	tm.Add(1)
+	go m.supervise(tm, "test", func() error {
+		<-tm.Chan()
+		return nil
+	})
+	m.leader = 1
+	m.stepDown(tm)
+	assert.False(t, m.IsLeader())
+	assert.Equal(t, 0, exitCode)
+
+	// A monitor ignores the end of the term, the process exits after the margin
+	release := make(chan struct{})
+	defer close(release)
+	tm = newTerm()
+	tm.Add(1)
+	go m.supervise(tm, "stuck", func() error {
+		<-release
+		return nil
+	})
+	m.leader = 1
+	start := time.Now()
+	m.stepDown(tm)
+	assert.False(t, m.IsLeader())
+	assert.Equal(t, 1, exitCode)
+	assert.False(t, time.Since(start) < testLeaderMargin)
+
+}
+
+func TestLeaderElect(t *testing.T) {
+
+	m, mockStore, mockCache, started := newLeaderTest(t)
+
+	// Acquire and lose the lock on the first refresh
+	mockCache.On("Lock", leaderBucket, leaderKey, m.instanceID, testLeaderTTL).Once().Return(true, nil)
+	mockStore.On("IncrMonitorCheckpoint", mock.AnythingOfType("*context.emptyCtx"), tdrpc.MonitorCheckpointLeaderToken).Once().Return(int64(1), nil)
+	mockCache.On("Lock", leaderBucket, leaderKey, m.instanceID, testLeaderTTL).Once().Return(false, nil)
+
+	// Acquire it again and keep leading
+	mockCache.On("Lock", leaderBucket, leaderKey, m.instanceID, testLeaderTTL).Once().Return(true, nil)
+	mockStore.On("IncrMonitorCheckpoint", mock.AnythingOfType("*context.emptyCtx"), tdrpc.MonitorCheckpointLeaderToken).Once().Return(int64(2), nil)
+	refreshing := make(chan struct{})
+	mockCache.On("Lock", leaderBucket, leaderKey, m.instanceID, testLeaderTTL).Once().Run(func(mock.Arguments) {
+		close(refreshing)
+		select {}
+	}).Return(true, nil)
+
+	go m.elect()
+
+	next := func() *term {
+		select {
+		case tm := <-started:
+			return tm
+		case <-time.After(5 * time.Second):
+			t.Fatal("monitors were not started")
+		}
+		return nil
+	}
+
+	// The monitors of the first term are stopped when the lock is lost
+	tm := next()
+	select {
+	case <-tm.Chan():
+	case <-time.After(5 * time.Second):
+		t.Fatal("monitors were not stopped")
+	}
+
+	// It goes back to being a standby and leads again
+	tm = next()
+	<-refreshing
+	assert.False(t, tm.Bool())
+	assert.True(t, m.IsLeader())
+
+	mockStore.AssertExpectations(t)
+
+}
@@ -3,6 +3,7 @@ package monitor
 import (
 	"context"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"io"
 	"strconv"
@@ -20,96 +21,146 @@ import (
 
 	"git.coinninja.net/backend/blocc/blocc"
 
-	"git.coinninja.net/backend/thunderdome/conf"
 	"git.coinninja.net/backend/thunderdome/store"
 	"git.coinninja.net/backend/thunderdome/tdrpc"
 )
 
+// The number of blocks before the checkpoint to rescan on startup in case of a reorg
+const btcCheckpointReorgBlocks = 6
+
 // MonitorBTC will spin up, search for existing transactions, and listen for incoming transactions
-func (m *Monitor) MonitorBTC() {
+// It returns when the connection to lnd is lost so the supervisor can reconnect
+func (m *Monitor) MonitorBTC() error {
+
+	stop := m.stop()
 
 	// Handle shutting down
 	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
 	go func() {
-		<-conf.Stop.Chan()
-		cancel()
+		select {
+		case <-stop.Chan():
+			cancel()
+		case <-ctx.Done():
+		}
 	}()
 
-	conf.Stop.Add(1)
-	var txclient lnrpc.Lightning_SubscribeTransactionsClient
-	var err error
+	// Connect to the transaction stream, subscribe to transactions
+	txclient, err := m.lclient.SubscribeTransactions(ctx, &lnrpc.GetTransactionsRequest{})
+	if err != nil {
+		return fmt.Errorf("Could not SubscribeTransactions: %v", err)
+	}
+	defer func() { _ = txclient.CloseSend() }()
+	m.logger.Infow("Listening for transactions...", "monitor", "btc")
+
+	// Process what we missed while disconnected
+	if err = m.catchUpBTC(ctx); err != nil {
+		return err
+	}
+	m.event(monitorBTC)
+
+	// Main loop
+	for {
+		tx, err := txclient.Recv()
+		if err == io.EOF {
+			return errors.New("TXM Closed Connection")
+		} else if status.Code(err) == codes.Canceled {
+			m.logger.Info("TXM Shutting Down")
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("TXM Error: %v", err)
+		}
 
-	// If we disconnect, loop and try again
-	for !conf.Stop.Bool() {
+		m.event(monitorBTC)
 
-		// Connect to the transaction stream, subscribe to transactions
-		txclient, err = m.lclient.SubscribeTransactions(ctx, &lnrpc.GetTransactionsRequest{})
+		m.logger.Infow("Processing transaction", "monitor", "btc", "hash", tx.TxHash, "confirmations", tx.NumConfirmations, "value", tx.Amount, "fees", tx.TotalFees)
+		rawTx, err := hex.DecodeString(tx.RawTxHex)
 		if err != nil {
-			m.logger.Fatalw("Could not SubscribeTransactions", "error", err)
+			m.logger.Errorw("Could not decode transaction", "monitor", "btc", "hash", tx.TxHash)
+			continue
+		}
+		if err = m.parseBTCTranaction(ctx, rawTx, tx.NumConfirmations, true); err != nil {
+			return err
 		}
-		m.logger.Infow("Listening for transactions...", "monitor", "btc")
+		m.saveBTCCheckpoint(ctx, tx.BlockHeight)
+	}
+
+}
+
+// catchUpBTC processes the wallet transactions confirmed since the last checkpoint and any unconfirmed ones
+func (m *Monitor) catchUpBTC(ctx context.Context) error {
+
+	// Only catch up on transactions from the last checkpoint, less a few blocks in case of a reorg
+	startHeight, err := m.store.GetMonitorCheckpoint(ctx, tdrpc.MonitorCheckpointBTCBlockHeight)
+	if err != nil && err != store.ErrNotFound {
+		m.logger.Errorw("Could not GetMonitorCheckpoint, scanning all transactions", "monitor", "btc", "error", err)
+	}
+	if startHeight -= btcCheckpointReorgBlocks; startHeight < 0 {
+		startHeight = 0
+	}
+
+	// An end height of -1 includes the unconfirmed transactions
+	txsDetails, err := m.lclient.GetTransactions(ctx, &lnrpc.GetTransactionsRequest{
+		StartHeight: int32(startHeight),
+		EndHeight:   -1,
+	})
+	if err != nil {
+		return fmt.Errorf("Could not GetTransactions: %v", err)
+	}
 
-		// Catch up on existing transactions
-		// TODO: Optimize what transactions we will fetch by setting addr index
-		txsDetails, err := m.lclient.GetTransactions(ctx, &lnrpc.GetTransactionsRequest{})
+	var skipped int
+	var blockHeight int32
+	for _, tx := range txsDetails.Transactions {
+		// lnd before v0.11 ignores the heights and returns every transaction
+		if tx.BlockHeight > 0 && int64(tx.BlockHeight) < startHeight {
+			skipped++
+			continue
+		}
+		m.logger.Infow("Processing existing transaction", "monitor", "btc", "hash", tx.TxHash, "confirmations", tx.NumConfirmations, "value", tx.Amount, "fees", tx.TotalFees)
+		rawTx, err := hex.DecodeString(tx.RawTxHex)
 		if err != nil {
-			m.logger.Fatalw("Could not GetTransactions", "monitor", "btc", "error", err)
+			m.logger.Errorw("Could not decode transaction", "monitor", "btc", "hash", tx.TxHash)
+			continue
 		}
-
-		for _, tx := range txsDetails.Transactions {
-			m.logger.Infow("Processing existing transaction", "monitor", "btc", "hash", tx.TxHash, "confirmations", tx.NumConfirmations, "value", tx.Amount, "fees", tx.TotalFees)
-			rawTx, err := hex.DecodeString(tx.RawTxHex)
-			if err != nil {
-				m.logger.Errorw("Could not decode transaction", "monitor", "btc", "hash", tx.TxHash)
-				continue
-			}
-			m.parseBTCTranaction(ctx, rawTx, tx.NumConfirmations, false)
+		if err = m.parseBTCTranaction(ctx, rawTx, tx.NumConfirmations, false); err != nil {
+			return err
 		}
+		if tx.BlockHeight > blockHeight {
+			blockHeight = tx.BlockHeight
+		}
+	}
+	m.logger.Infow("Caught up on existing transactions", "monitor", "btc", "start_height", startHeight, "processed", len(txsDetails.Transactions)-skipped, "skipped", skipped)
+	m.saveBTCCheckpoint(ctx, blockHeight)
 
-		// Main loop
-		for {
-			tx, err := txclient.Recv()
-			if err == io.EOF {
-				m.logger.Errorw("TXM Closed Connection", "monitor", "btc")
-				break
-			} else if status.Code(err) == codes.Canceled {
-				m.logger.Info("TXM Shutting Down")
-				break
-			} else if err != nil {
-				m.logger.Errorw("TXM Error", "monitor", "btc", "error", err)
-				break
-			}
+	return nil
 
-			m.logger.Infow("Processing transaction", "monitor", "btc", "hash", tx.TxHash, "confirmations", tx.NumConfirmations, "value", tx.Amount, "fees", tx.TotalFees)
-			rawTx, err := hex.DecodeString(tx.RawTxHex)
-			if err != nil {
-				m.logger.Errorw("Could not decode transaction", "monitor", "btc", "hash", tx.TxHash)
-				continue
-			}
-			m.parseBTCTranaction(ctx, rawTx, tx.NumConfirmations, true)
-		}
+}
 
-		// We were disconnected, reconnect and try again
-		select {
-		case <-time.After(10 * time.Second):
-		case <-conf.Stop.Chan():
-		}
+// saveBTCCheckpoint saves the block height of the last confirmed transaction processed if it has increased
+func (m *Monitor) saveBTCCheckpoint(ctx context.Context, blockHeight int32) {
+
+	if blockHeight <= m.lastBlockHeight {
+		return
 	}
 
-	if txclient != nil {
-		_ = txclient.CloseSend()
+	if err := m.store.SaveMonitorCheckpoint(ctx, tdrpc.MonitorCheckpointBTCBlockHeight, int64(blockHeight)); err != nil {
+		m.logger.Errorw("Could not SaveMonitorCheckpoint", "monitor", "btc", "error", err, "block_height", blockHeight)
+		return
 	}
-	conf.Stop.Done()
+
+	m.lastBlockHeight = blockHeight
+
 }
 
 // This will parse the transaction and add it to the ledger
-func (m *Monitor) parseBTCTranaction(ctx context.Context, rawTx []byte, confirmations int32, shouldAlert bool) {
+// It returns an error if the ledger could not be updated so the transaction can be processed again
+func (m *Monitor) parseBTCTranaction(ctx context.Context, rawTx []byte, confirmations int32, shouldAlert bool) error {
 
 	// Decode the transaction
 	tx, err := btcutil.NewTxFromBytes(rawTx)
 	if err != nil {
 		m.logger.Errorw("Could not decode transaction", "monitor", "btc", "error", err)
-		return
+		return nil
 	}
 	txHash := tx.Hash().String() // Get txHash
 	wTx := tx.MsgTx()            // Convert to wire format
@@ -124,7 +175,7 @@ func (m *Monitor) parseBTCTranaction(ctx context.Context, rawTx []byte, confirma
 			lrOut.Status = tdrpc.COMPLETED
 			err = m.store.ProcessLedgerRecord(ctx, lrOut)
 			if err != nil {
-				m.logger.Fatalw("ProcessLedgerRecord Out Error", "monitor", "btc", "error", err)
+				return fmt.Errorf("ProcessLedgerRecord Out Error: %v", err)
 			}
 		}
 		// On the insane chance we somehow paid another address in this wallet, let it continue to process
@@ -156,7 +207,7 @@ func (m *Monitor) parseBTCTranaction(ctx context.Context, rawTx []byte, confirma
 		if err == store.ErrNotFound {
 			continue
 		} else if err != nil {
-			m.logger.Fatalw("GetAccountByAddress Error", "monitor", "btc", "error", err)
+			return fmt.Errorf("GetAccountByAddress Error: %v", err)
 		}
 
 		// The ledgerRecordId is the txHash:height
@@ -167,7 +218,7 @@ func (m *Monitor) parseBTCTranaction(ctx context.Context, rawTx []byte, confirma
 		if err == store.ErrNotFound {
 			prevLr = nil // No prevLr
 		} else if err != nil {
-			m.logger.Fatalw("GetLedgerRecord Error", "monitor", "btc", "error", err)
+			return fmt.Errorf("GetLedgerRecord Error: %v", err)
 		}
 
 		// If the record is already completed in the database and the request isn't still instant_pending, there is nothing to process
@@ -387,6 +438,8 @@ func (m *Monitor) parseBTCTranaction(ctx context.Context, rawTx []byte, confirma
 		m.logger.Warnw("No account found for transaction", "monitor", "btc", "hash", txHash)
 	}
 
+	return nil
+
 }
 
 // Returns the fee for this transaction (or zero) by fetching the inputs from this transaction and calculating fees
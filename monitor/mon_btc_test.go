@@ -0,0 +1,137 @@
+package monitor
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+
+	_ "git.coinninja.net/backend/thunderdome/conf"
+	"git.coinninja.net/backend/thunderdome/mocks"
+	"git.coinninja.net/backend/thunderdome/store"
+	"git.coinninja.net/backend/thunderdome/tdrpc"
+)
+
+// newTestBTCTransaction returns a wallet transaction paying an address at a block height (0 if unconfirmed)
+// The transactions are processed if they are looked up by hash and address
+func newTestBTCTransaction(t *testing.T, mockStore *mocks.Store, blockHeight int32, processed bool) *lnrpc.Transaction {
+
+	address, err := btcutil.NewAddressPubKeyHash(bytes.Repeat([]byte{byte(blockHeight)}, 20), &chaincfg.RegressionNetParams)
+	assert.Nil(t, err)
+	pkScript, err := txscript.PayToAddrScript(address)
+	assert.Nil(t, err)
+
+	wTx := wire.NewMsgTx(wire.TxVersion)
+	wTx.AddTxIn(wire.NewTxIn(&wire.OutPoint{Index: uint32(blockHeight)}, nil, nil))
+	wTx.AddTxOut(wire.NewTxOut(1000, pkScript))
+	var buf bytes.Buffer
+	assert.Nil(t, wTx.Serialize(&buf))
+
+	var confirmations int32
+	if blockHeight > 0 {
+		confirmations = 1
+	}
+
+	if processed {
+		mockStore.On("GetLedgerRecord", mock.AnythingOfType("*context.emptyCtx"), wTx.TxHash().String(), tdrpc.OUT).Once().Return(nil, store.ErrNotFound)
+		mockStore.On("GetAccountByAddress", mock.AnythingOfType("*context.emptyCtx"), address.String()).Once().Return(nil, store.ErrNotFound)
+	}
+
+	return &lnrpc.Transaction{
+		TxHash:           wTx.TxHash().String(),
+		BlockHeight:      blockHeight,
+		NumConfirmations: confirmations,
+		RawTxHex:         hex.EncodeToString(buf.Bytes()),
+	}
+
+}
+
+func TestCatchUpBTC(t *testing.T) {
+
+	// Mocks
+	mockStore := new(mocks.Store)
+	mockLClient := new(mocks.LightningClient)
+
+	m := &Monitor{
+		logger:  zap.S(),
+		store:   mockStore,
+		lclient: mockLClient,
+		chain:   &chaincfg.RegressionNetParams,
+	}
+
+	// Transactions are fetched from the reorg window before the checkpoint including unconfirmed ones
+	mockStore.On("GetMonitorCheckpoint", mock.AnythingOfType("*context.emptyCtx"), tdrpc.MonitorCheckpointBTCBlockHeight).Once().Return(int64(100), nil)
+	mockLClient.On("GetTransactions", mock.AnythingOfType("*context.emptyCtx"), &lnrpc.GetTransactionsRequest{StartHeight: 100 - btcCheckpointReorgBlocks, EndHeight: -1}).Once().Return(&lnrpc.TransactionDetails{
+		Transactions: []*lnrpc.Transaction{
+			// Older transactions are skipped if lnd returns them
+			newTestBTCTransaction(t, mockStore, 50, false),
+			newTestBTCTransaction(t, mockStore, 100-btcCheckpointReorgBlocks-1, false),
+			newTestBTCTransaction(t, mockStore, 100-btcCheckpointReorgBlocks, true),
+			newTestBTCTransaction(t, mockStore, 102, true),
+			newTestBTCTransaction(t, mockStore, 0, true),
+		},
+	}, nil)
+
+	// The highest block processed is saved
+	mockStore.On("SaveMonitorCheckpoint", mock.AnythingOfType("*context.emptyCtx"), tdrpc.MonitorCheckpointBTCBlockHeight, int64(102)).Once().Return(nil)
+
+	err := m.catchUpBTC(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, int32(102), m.lastBlockHeight)
+
+	mockStore.AssertExpectations(t)
+	mockLClient.AssertExpectations(t)
+
+}
+
+func TestCatchUpBTCNoCheckpoint(t *testing.T) {
+
+	// Mocks
+	mockStore := new(mocks.Store)
+	mockLClient := new(mocks.LightningClient)
+
+	m := &Monitor{
+		logger:  zap.S(),
+		store:   mockStore,
+		lclient: mockLClient,
+		chain:   &chaincfg.RegressionNetParams,
+	}
+
+	// Without a checkpoint every transaction is processed
+	mockStore.On("GetMonitorCheckpoint", mock.AnythingOfType("*context.emptyCtx"), tdrpc.MonitorCheckpointBTCBlockHeight).Once().Return(int64(0), store.ErrNotFound)
+	mockLClient.On("GetTransactions", mock.AnythingOfType("*context.emptyCtx"), &lnrpc.GetTransactionsRequest{EndHeight: -1}).Once().Return(&lnrpc.TransactionDetails{
+		Transactions: []*lnrpc.Transaction{
+			newTestBTCTransaction(t, mockStore, 1, true),
+			newTestBTCTransaction(t, mockStore, 5, true),
+		},
+	}, nil)
+	mockStore.On("SaveMonitorCheckpoint", mock.AnythingOfType("*context.emptyCtx"), tdrpc.MonitorCheckpointBTCBlockHeight, int64(5)).Once().Return(nil)
+
+	err := m.catchUpBTC(context.Background())
+	assert.Nil(t, err)
+
+	// Nothing new since a later checkpoint (from an lnd that ignores the heights), nothing is processed or saved
+	mockStore.On("GetMonitorCheckpoint", mock.AnythingOfType("*context.emptyCtx"), tdrpc.MonitorCheckpointBTCBlockHeight).Once().Return(int64(20), nil)
+	mockLClient.On("GetTransactions", mock.AnythingOfType("*context.emptyCtx"), &lnrpc.GetTransactionsRequest{StartHeight: 20 - btcCheckpointReorgBlocks, EndHeight: -1}).Once().Return(&lnrpc.TransactionDetails{
+		Transactions: []*lnrpc.Transaction{
+			newTestBTCTransaction(t, mockStore, 1, false),
+			newTestBTCTransaction(t, mockStore, 5, false),
+		},
+	}, nil)
+
+	err = m.catchUpBTC(context.Background())
+	assert.Nil(t, err)
+
+	mockStore.AssertExpectations(t)
+	mockLClient.AssertExpectations(t)
+
+}
@@ -0,0 +1,59 @@
+package monitor
+
+import (
+	"context"
+	"time"
+
+	config "github.com/spf13/viper"
+
+	"git.coinninja.net/backend/thunderdome/metrics"
+)
+
+// MonitorChanBackupPrune deletes old channel backups from the database. The newest is always kept.
+func (m *Monitor) MonitorChanBackupPrune() error {
+
+	stop := m.stop()
+
+	// Handle shutting down
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		<-stop.Chan()
+		cancel()
+	}()
+
+	firstRun := make(chan struct{}, 1)
+	firstRun <- struct{}{}
+
+monLoop:
+	for !stop.Bool() {
+
+		select {
+		case <-firstRun:
+		case <-stop.Chan():
+			break monLoop
+		case <-time.After(monitorIntervals[monitorCBPrune]):
+		}
+
+		var before time.Time
+		if age := config.GetDuration("chanbackup.db_retention_age"); age > 0 {
+			before = time.Now().Add(-age)
+		}
+
+		deleted, err := m.cbstore.PruneChanBackups(ctx, config.GetInt("chanbackup.db_retention_count"), before)
+		if err != nil {
+			m.logger.Errorw("Could not prune channel backups", "monitor", "chanbackup_prune", "error", err)
+			continue
+		}
+
+		if deleted > 0 {
+			metrics.Count("chanbackup.pruned", deleted, nil)
+			m.logger.Infow("Channel Backups Pruned", "monitor", "chanbackup_prune", "deleted", deleted)
+		}
+
+		m.event(monitorCBPrune)
+	}
+
+	return nil
+
+}
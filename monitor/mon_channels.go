@@ -0,0 +1,341 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	config "github.com/spf13/viper"
+	"go.uber.org/zap"
+
+	"git.coinninja.net/backend/thunderdome/autopilot"
+	"git.coinninja.net/backend/thunderdome/metrics"
+	"git.coinninja.net/backend/thunderdome/store"
+	"git.coinninja.net/backend/thunderdome/tdrpc"
+)
+
+// channelDemand is how much our users want to pay a node we have no channel with
+type channelDemand struct {
+	nodePubkey string
+	payments   int64
+	failures   int64
+	value      int64
+}
+
+// MonitorChannels proposes opening channels to the nodes our users pay the most and closing channels that
+// have been inactive for a long time. With autopilot.auto_open and autopilot.auto_close it carries them out itself.
+func (m *Monitor) MonitorChannels() error {
+
+	stop := m.stop()
+
+	// Handle shutting down
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		<-stop.Chan()
+		cancel()
+	}()
+
+	firstRun := make(chan struct{}, 1)
+	firstRun <- struct{}{}
+
+monLoop:
+	for !stop.Bool() {
+
+		select {
+		case <-firstRun:
+		case <-stop.Chan():
+			break monLoop
+		case <-time.After(monitorIntervals[monitorChannels]):
+		}
+
+		channelsResponse, err := m.lclient.ListChannels(ctx, &lnrpc.ListChannelsRequest{})
+		if err != nil {
+			m.logger.Errorw("Could not list channels", "monitor", "channels", "error", err)
+			continue
+		}
+
+		if err = m.proposeOpens(ctx, channelsResponse.Channels); err != nil {
+			m.logger.Errorw("Could not propose channel opens", "monitor", "channels", "error", err)
+		}
+
+		if err = m.proposeCloses(ctx, channelsResponse.Channels); err != nil {
+			m.logger.Errorw("Could not propose channel closes", "monitor", "channels", "error", err)
+		}
+
+		m.event(monitorChannels)
+	}
+
+	return nil
+
+}
+
+// proposeOpens proposes channels to the most paid nodes we have no channel with
+func (m *Monitor) proposeOpens(ctx context.Context, channels []*lnrpc.Channel) error {
+
+	info, err := m.lclient.GetInfo(ctx, &lnrpc.GetInfoRequest{})
+	if err != nil {
+		return fmt.Errorf("Could not GetInfo: %v", err)
+	}
+
+	// Skip nodes we already have a channel (or a pending one) with
+	peers := map[string]bool{info.IdentityPubkey: true}
+	for _, channel := range channels {
+		peers[channel.RemotePubkey] = true
+	}
+	pending, err := m.lclient.PendingChannels(ctx, &lnrpc.PendingChannelsRequest{})
+	if err != nil {
+		return fmt.Errorf("Could not PendingChannels: %v", err)
+	}
+	for _, channel := range pending.PendingOpenChannels {
+		if channel.Channel != nil {
+			peers[channel.Channel.RemoteNodePub] = true
+		}
+	}
+
+	demand, err := m.channelDemand(ctx, peers)
+	if err != nil {
+		return err
+	}
+
+	// Most wanted first
+	sort.Slice(demand, func(i, j int) bool {
+		if demand[i].payments+demand[i].failures != demand[j].payments+demand[j].failures {
+			return demand[i].payments+demand[i].failures > demand[j].payments+demand[j].failures
+		}
+		return demand[i].value > demand[j].value
+	})
+
+	// Skip nodes that were recently rejected or could not be opened
+	coolingOff, err := m.coolingOff(ctx, tdrpc.ChannelProposalActionOpen)
+	if err != nil {
+		return err
+	}
+
+	proposals := config.GetInt("autopilot.max_proposals")
+	for _, d := range demand {
+		if proposals <= 0 {
+			break
+		}
+		if d.payments+d.failures < config.GetInt64("autopilot.min_payments") {
+			break
+		}
+		if coolingOff[proposalKey(d.nodePubkey, "")] {
+			continue
+		}
+		proposals--
+
+		proposal, err := m.store.CreateChannelProposal(ctx, &tdrpc.ChannelProposal{
+			Action:     tdrpc.ChannelProposalActionOpen,
+			NodePubkey: d.nodePubkey,
+			Value:      config.GetInt64("autopilot.channel_value"),
+			Reason:     fmt.Sprintf("%d payments and %d unroutable payments totaling %d sats in the last %s", d.payments, d.failures, d.value, config.GetDuration("autopilot.lookback")),
+			Status:     tdrpc.ChannelProposalStatusProposed,
+		})
+		if err == store.ErrAlreadyExists {
+			continue
+		} else if err != nil {
+			return fmt.Errorf("Could not CreateChannelProposal: %v", err)
+		}
+
+		metrics.Incr("autopilot.proposals", metrics.Labels{"action": proposal.Action})
+		m.logger.Infow("Channel Proposed", "monitor", "channels", zap.Any("proposal", proposal))
+
+		if config.GetBool("autopilot.auto_open") {
+			if err = m.autoOpen(ctx, proposal); err != nil {
+				m.logger.Infow("Channel not opened automatically", "monitor", "channels", "id", proposal.Id, "reason", err)
+			}
+		}
+	}
+
+	return nil
+
+}
+
+// channelDemand returns the nodes that have been paid or could not be paid in the lookback period, excluding peers
+func (m *Monitor) channelDemand(ctx context.Context, peers map[string]bool) ([]*channelDemand, error) {
+
+	after := time.Now().UTC().Add(-config.GetDuration("autopilot.lookback"))
+	demand := make(map[string]*channelDemand)
+	get := func(nodePubkey string) *channelDemand {
+		d, ok := demand[nodePubkey]
+		if !ok {
+			d = &channelDemand{nodePubkey: nodePubkey}
+			demand[nodePubkey] = d
+		}
+		return d
+	}
+
+	lrs, err := m.store.GetLedger(ctx, map[string]string{
+		"type":      tdrpc.LIGHTNING.String(),
+		"direction": tdrpc.OUT.String(),
+		"status":    tdrpc.COMPLETED.String(),
+		"hidden":    "*",
+	}, after, 0, 0)
+	if err != nil {
+		return nil, fmt.Errorf("Could not GetLedger: %v", err)
+	}
+
+	for _, lr := range lrs {
+		// Internal payments never leave the node
+		if strings.HasSuffix(lr.Id, tdrpc.InternalIdSuffix) || lr.Request == "" || lr.Request == tdrpc.PreAuthRequest {
+			continue
+		}
+		pr, err := m.lclient.DecodePayReq(ctx, &lnrpc.PayReqString{PayReq: lr.Request})
+		if err != nil {
+			m.logger.Debugw("Could not DecodePayReq", "monitor", "channels", "id", lr.Id, "error", err)
+			continue
+		}
+		if peers[pr.Destination] {
+			continue
+		}
+		d := get(pr.Destination)
+		d.payments++
+		d.value += lr.Value
+	}
+
+	failures, err := m.store.GetRouteFailureStats(ctx, after)
+	if err != nil {
+		return nil, fmt.Errorf("Could not GetRouteFailureStats: %v", err)
+	}
+	for _, failure := range failures {
+		if peers[failure.Destination] {
+			continue
+		}
+		d := get(failure.Destination)
+		d.failures += failure.Count
+		d.value += failure.Value
+	}
+
+	ret := make([]*channelDemand, 0, len(demand))
+	for _, d := range demand {
+		ret = append(ret, d)
+	}
+
+	return ret, nil
+
+}
+
+// autoOpen opens the channel of a proposal if it's within the daily budget and leaves the reserve in the wallet
+func (m *Monitor) autoOpen(ctx context.Context, proposal *tdrpc.ChannelProposal) error {
+
+	opened, err := m.store.GetChannelProposals(ctx, map[string]string{
+		"action":   tdrpc.ChannelProposalActionOpen,
+		"status":   tdrpc.ChannelProposalStatusCompleted,
+		"operator": tdrpc.ChannelProposalOperatorAutopilot,
+	}, time.Now().UTC().Add(-24*time.Hour), 0, 0)
+	if err != nil {
+		return fmt.Errorf("Could not GetChannelProposals: %v", err)
+	}
+	var spent int64
+	for _, p := range opened {
+		spent += p.Value
+	}
+	if spent+proposal.Value > config.GetInt64("autopilot.daily_budget") {
+		return fmt.Errorf("Daily budget exceeded, spent %d", spent)
+	}
+
+	walletBalance, err := m.lclient.WalletBalance(ctx, &lnrpc.WalletBalanceRequest{})
+	if err != nil {
+		return fmt.Errorf("Could not WalletBalance: %v", err)
+	}
+	if walletBalance.ConfirmedBalance-proposal.Value < config.GetInt64("autopilot.min_reserve") {
+		return fmt.Errorf("Wallet balance %d would be below the reserve", walletBalance.ConfirmedBalance)
+	}
+
+	proposal, err = m.store.ReviewChannelProposal(ctx, proposal.Id, tdrpc.ChannelProposalStatusApproved, tdrpc.ChannelProposalOperatorAutopilot)
+	if err != nil {
+		return fmt.Errorf("Could not ReviewChannelProposal: %v", err)
+	}
+
+	// ********* AT THIS POINT IN TIME ALL FUNCTIONS BELOW MUST COMPLETE *********
+	_, err = autopilot.ExecuteChannelProposal(context.Background(), m.store, m.lclient, proposal)
+	return err
+
+}
+
+// proposeCloses proposes closing channels that have not been used in autopilot.inactive_after
+func (m *Monitor) proposeCloses(ctx context.Context, channels []*lnrpc.Channel) error {
+
+	inactiveAfter := time.Now().UTC().Add(-config.GetDuration("autopilot.inactive_after"))
+
+	// Skip channels that were recently rejected or could not be closed
+	coolingOff, err := m.coolingOff(ctx, tdrpc.ChannelProposalActionClose)
+	if err != nil {
+		return err
+	}
+
+	for _, channel := range channels {
+
+		// The last time the channel state changed is the last time it was used
+		activity, err := m.store.SaveChannelActivity(ctx, channel.ChannelPoint, channel.NumUpdates)
+		if err != nil {
+			return fmt.Errorf("Could not SaveChannelActivity: %v", err)
+		}
+		if activity.ActiveAt.After(inactiveAfter) || coolingOff[proposalKey(channel.RemotePubkey, channel.ChannelPoint)] {
+			continue
+		}
+
+		proposal, err := m.store.CreateChannelProposal(ctx, &tdrpc.ChannelProposal{
+			Action:     tdrpc.ChannelProposalActionClose,
+			NodePubkey: channel.RemotePubkey,
+			ChanPoint:  channel.ChannelPoint,
+			Value:      channel.Capacity,
+			Reason:     fmt.Sprintf("Inactive since %s", activity.ActiveAt.Format(time.RFC3339)),
+			Status:     tdrpc.ChannelProposalStatusProposed,
+		})
+		if err == store.ErrAlreadyExists {
+			continue
+		} else if err != nil {
+			return fmt.Errorf("Could not CreateChannelProposal: %v", err)
+		}
+
+		metrics.Incr("autopilot.proposals", metrics.Labels{"action": proposal.Action})
+		m.logger.Infow("Channel Proposed", "monitor", "channels", zap.Any("proposal", proposal))
+
+		if config.GetBool("autopilot.auto_close") {
+			proposal, err = m.store.ReviewChannelProposal(ctx, proposal.Id, tdrpc.ChannelProposalStatusApproved, tdrpc.ChannelProposalOperatorAutopilot)
+			if err != nil {
+				return fmt.Errorf("Could not ReviewChannelProposal: %v", err)
+			}
+			// ********* AT THIS POINT IN TIME ALL FUNCTIONS BELOW MUST COMPLETE *********
+			if _, err = autopilot.ExecuteChannelProposal(context.Background(), m.store, m.lclient, proposal); err != nil {
+				m.logger.Warnw("Channel not closed automatically", "monitor", "channels", "id", proposal.Id, "error", err)
+			}
+		}
+	}
+
+	return nil
+
+}
+
+// coolingOff returns the proposals with action that were rejected or failed in autopilot.proposal_cooloff by proposalKey
+func (m *Monitor) coolingOff(ctx context.Context, action string) (map[string]bool, error) {
+
+	after := time.Now().UTC().Add(-config.GetDuration("autopilot.proposal_cooloff"))
+	ret := make(map[string]bool)
+
+	for _, status := range []string{tdrpc.ChannelProposalStatusRejected, tdrpc.ChannelProposalStatusFailed} {
+		proposals, err := m.store.GetChannelProposals(ctx, map[string]string{
+			"action": action,
+			"status": status,
+		}, after, 0, 0)
+		if err != nil {
+			return nil, fmt.Errorf("Could not GetChannelProposals: %v", err)
+		}
+		for _, proposal := range proposals {
+			ret[proposalKey(proposal.NodePubkey, proposal.ChanPoint)] = true
+		}
+	}
+
+	return ret, nil
+
+}
+
+// proposalKey identifies the node and channel of a proposal
+func proposalKey(nodePubkey string, chanPoint string) string {
+	return nodePubkey + "/" + chanPoint
+}
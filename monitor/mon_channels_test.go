@@ -0,0 +1,114 @@
+package monitor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+
+	_ "git.coinninja.net/backend/thunderdome/conf"
+	"git.coinninja.net/backend/thunderdome/mocks"
+	"git.coinninja.net/backend/thunderdome/tdrpc"
+)
+
+func TestProposeClosesCoolOff(t *testing.T) {
+
+	// Mocks
+	mockStore := new(mocks.Store)
+	mockLClient := new(mocks.LightningClient)
+
+	m := &Monitor{
+		logger:  zap.S(),
+		store:   mockStore,
+		lclient: mockLClient,
+	}
+
+	channels := []*lnrpc.Channel{
+		{RemotePubkey: "02aa", ChannelPoint: "tx1:0", Capacity: 1000000, NumUpdates: 5},
+		{RemotePubkey: "02bb", ChannelPoint: "tx2:0", Capacity: 1000000, NumUpdates: 7},
+	}
+
+	// Both have been inactive for a long time
+	for _, channel := range channels {
+		mockStore.On("SaveChannelActivity", mock.AnythingOfType("*context.emptyCtx"), channel.ChannelPoint, channel.NumUpdates).Once().Return(&tdrpc.ChannelActivity{
+			ChanPoint:  channel.ChannelPoint,
+			NumUpdates: channel.NumUpdates,
+			ActiveAt:   time.Now().UTC().Add(-1000 * time.Hour),
+		}, nil)
+	}
+
+	// Closing the first was rejected recently
+	mockStore.On("GetChannelProposals", mock.AnythingOfType("*context.emptyCtx"), map[string]string{
+		"action": tdrpc.ChannelProposalActionClose,
+		"status": tdrpc.ChannelProposalStatusRejected,
+	}, mock.AnythingOfType("time.Time"), 0, 0).Once().Return([]*tdrpc.ChannelProposal{
+		{Action: tdrpc.ChannelProposalActionClose, NodePubkey: "02aa", ChanPoint: "tx1:0", Status: tdrpc.ChannelProposalStatusRejected},
+	}, nil)
+	mockStore.On("GetChannelProposals", mock.AnythingOfType("*context.emptyCtx"), map[string]string{
+		"action": tdrpc.ChannelProposalActionClose,
+		"status": tdrpc.ChannelProposalStatusFailed,
+	}, mock.AnythingOfType("time.Time"), 0, 0).Once().Return([]*tdrpc.ChannelProposal{}, nil)
+
+	// Only the second is proposed
+	mockStore.On("CreateChannelProposal", mock.AnythingOfType("*context.emptyCtx"), mock.MatchedBy(func(proposal *tdrpc.ChannelProposal) bool {
+		return proposal.ChanPoint == "tx2:0"
+	})).Once().Return(&tdrpc.ChannelProposal{Id: "proposal1", Action: tdrpc.ChannelProposalActionClose, NodePubkey: "02bb", ChanPoint: "tx2:0"}, nil)
+
+	err := m.proposeCloses(context.Background(), channels)
+	assert.Nil(t, err)
+
+	mockStore.AssertExpectations(t)
+	mockLClient.AssertExpectations(t)
+
+}
+
+func TestProposeOpensCoolOff(t *testing.T) {
+
+	// Mocks
+	mockStore := new(mocks.Store)
+	mockLClient := new(mocks.LightningClient)
+
+	m := &Monitor{
+		logger:  zap.S(),
+		store:   mockStore,
+		lclient: mockLClient,
+	}
+
+	mockLClient.On("GetInfo", mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("*lnrpc.GetInfoRequest")).Once().Return(&lnrpc.GetInfoResponse{IdentityPubkey: "02me"}, nil)
+	mockLClient.On("PendingChannels", mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("*lnrpc.PendingChannelsRequest")).Once().Return(&lnrpc.PendingChannelsResponse{}, nil)
+	mockStore.On("GetLedger", mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("map[string]string"), mock.AnythingOfType("time.Time"), 0, 0).Once().Return([]*tdrpc.LedgerRecord{}, nil)
+
+	// Both nodes could not be paid often enough to propose a channel
+	mockStore.On("GetRouteFailureStats", mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("time.Time")).Once().Return([]*tdrpc.RouteFailureStats{
+		{Destination: "02aa", Count: 20, Value: 20000},
+		{Destination: "02bb", Count: 15, Value: 15000},
+	}, nil)
+
+	// Opening to the first failed recently
+	mockStore.On("GetChannelProposals", mock.AnythingOfType("*context.emptyCtx"), map[string]string{
+		"action": tdrpc.ChannelProposalActionOpen,
+		"status": tdrpc.ChannelProposalStatusRejected,
+	}, mock.AnythingOfType("time.Time"), 0, 0).Once().Return([]*tdrpc.ChannelProposal{}, nil)
+	mockStore.On("GetChannelProposals", mock.AnythingOfType("*context.emptyCtx"), map[string]string{
+		"action": tdrpc.ChannelProposalActionOpen,
+		"status": tdrpc.ChannelProposalStatusFailed,
+	}, mock.AnythingOfType("time.Time"), 0, 0).Once().Return([]*tdrpc.ChannelProposal{
+		{Action: tdrpc.ChannelProposalActionOpen, NodePubkey: "02aa", Status: tdrpc.ChannelProposalStatusFailed},
+	}, nil)
+
+	// Only the second is proposed
+	mockStore.On("CreateChannelProposal", mock.AnythingOfType("*context.emptyCtx"), mock.MatchedBy(func(proposal *tdrpc.ChannelProposal) bool {
+		return proposal.NodePubkey == "02bb"
+	})).Once().Return(&tdrpc.ChannelProposal{Id: "proposal1", Action: tdrpc.ChannelProposalActionOpen, NodePubkey: "02bb"}, nil)
+
+	err := m.proposeOpens(context.Background(), []*lnrpc.Channel{})
+	assert.Nil(t, err)
+
+	mockStore.AssertExpectations(t)
+	mockLClient.AssertExpectations(t)
+
+}
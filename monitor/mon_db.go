@@ -6,17 +6,17 @@ import (
 	"time"
 
 	"github.com/DataDog/datadog-go/statsd"
-
-	"git.coinninja.net/backend/thunderdome/conf"
 )
 
 // MonitorDB will chgeck t
-func (m *Monitor) MonitorDB() {
+func (m *Monitor) MonitorDB() error {
+
+	stop := m.stop()
 
 	// Handle shutting down
 	ctx, cancel := context.WithCancel(context.Background())
 	go func() {
-		<-conf.Stop.Chan()
+		<-stop.Chan()
 		cancel()
 	}()
 
@@ -24,14 +24,14 @@ func (m *Monitor) MonitorDB() {
 	firstRun <- struct{}{}
 
 monLoop:
-	for !conf.Stop.Bool() {
+	for !stop.Bool() {
 
 		// Sleep 1 minute
 		select {
 		case <-firstRun:
-		case <-conf.Stop.Chan():
+		case <-stop.Chan():
 			break monLoop
-		case <-time.After(15 * time.Minute):
+		case <-time.After(monitorIntervals[monitorDB]):
 		}
 
 		err := m.store.CheckDatabaseConsistency(ctx)
@@ -50,6 +50,10 @@ monLoop:
 			m.logger.Info("Database validation complete.")
 		}
 
+		m.event(monitorDB)
+
 	}
 
+	return nil
+
 }
@@ -2,26 +2,93 @@ package monitor
 
 import (
 	"context"
+	"encoding/hex"
+	"fmt"
 	"time"
 
-	"git.coinninja.net/backend/thunderdome/conf"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/invoicesrpc"
+
+	"git.coinninja.net/backend/thunderdome/tdrpc"
 )
 
 // MonitorExpired will mark records as expired once every 2 minutes
 // This will restore handle balance processing as well
-func (m *Monitor) MonitorExpired() {
+func (m *Monitor) MonitorExpired() error {
+
+	stop := m.stop()
+
+	for !stop.Bool() {
 
-	for !conf.Stop.Bool() {
+		// Cancel hold invoices that were not settled in time before they are expired
+		m.cancelExpiredHolds(context.Background())
 
 		err := m.store.ExpireLedgerRequests(context.Background())
 		if err != nil {
-			m.logger.Fatalw("Could not ExpireLedgerRequests", "error", err)
+			return fmt.Errorf("Could not ExpireLedgerRequests: %v", err)
+		}
+
+		err = m.store.ExpireIdempotencyKeys(context.Background())
+		if err != nil {
+			m.logger.Errorw("Could not ExpireIdempotencyKeys", "error", err)
 		}
 
+		m.event(monitorExpired)
+
 		select {
-		case <-time.After(2 * time.Minute):
-		case <-conf.Stop.Chan():
+		case <-time.After(monitorIntervals[monitorExpired]):
+		case <-stop.Chan():
+		}
+	}
+
+	return nil
+
+}
+
+// cancelExpiredHolds cancels accepted hold invoices in lnd returning the funds to the payer and expires their ledger records
+// They are canceled at their cancel height, tdome.hold_cancel_blocks before their htlcs expire. The expiration of
+// the invoice no longer applies once it is accepted, the payer's funds are locked until the htlcs expire either way.
+// If the cancel fails the ledger record stays accepted and it is tried again
+func (m *Monitor) cancelExpiredHolds(ctx context.Context) {
+
+	lrs, err := m.store.GetLedger(ctx, map[string]string{
+		"status":    tdrpc.ACCEPTED.String(),
+		"type":      tdrpc.LIGHTNING.String(),
+		"direction": tdrpc.IN.String(),
+		"hidden":    "*",
+	}, time.Time{}, 0, 0)
+	if err != nil {
+		m.logger.Errorw("GetLedger Error", "error", err)
+		return
+	} else if len(lrs) == 0 {
+		return
+	}
+
+	info, err := m.lclient.GetInfo(ctx, &lnrpc.GetInfoRequest{})
+	if err != nil {
+		m.logger.Errorw("LND GetInfo Error", "error", err)
+		return
+	}
+
+	for _, lr := range lrs {
+		if !lr.Hold || lr.CancelHeight == 0 || int64(info.BlockHeight) < lr.CancelHeight {
+			continue
+		}
+		paymentHash, err := hex.DecodeString(lr.Id)
+		if err != nil {
+			m.logger.Errorw("Invalid Hold Invoice Id", "id", lr.Id, "error", err)
+			continue
+		}
+		if _, err = m.iclient.CancelInvoice(ctx, &invoicesrpc.CancelInvoiceMsg{PaymentHash: paymentHash}); err != nil {
+			m.logger.Errorw("LND CancelInvoice Error", "payment_hash", lr.Id, "error", err)
+			continue
+		}
+		lr.Status = tdrpc.EXPIRED
+		if err = m.store.ProcessLedgerRecord(ctx, lr); err != nil {
+			m.logger.Errorw("ProcessLedgerRecord Error", "payment_hash", lr.Id, "error", err)
+			continue
 		}
+		m.logger.Infow("Expired Hold Invoice Canceled", "payment_hash", lr.Id, "account_id", lr.AccountId, "value", lr.Value, "block_height", info.BlockHeight, "cancel_height", lr.CancelHeight)
 	}
 
 }
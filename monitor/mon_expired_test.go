@@ -0,0 +1,65 @@
+package monitor
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/invoicesrpc"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+
+	_ "git.coinninja.net/backend/thunderdome/conf"
+	"git.coinninja.net/backend/thunderdome/mocks"
+	"git.coinninja.net/backend/thunderdome/tdrpc"
+)
+
+func TestCancelExpiredHolds(t *testing.T) {
+
+	// Mocks
+	mockStore := new(mocks.Store)
+	mockLClient := new(mocks.LightningClient)
+	mockIClient := new(mocks.InvoicesClient)
+
+	m := &Monitor{
+		logger:  zap.S(),
+		store:   mockStore,
+		lclient: mockLClient,
+		iclient: mockIClient,
+	}
+
+	expired := time.Now().UTC().Add(-time.Hour)
+	mockStore.On("GetLedger", mock.AnythingOfType("*context.emptyCtx"), map[string]string{
+		"status":    tdrpc.ACCEPTED.String(),
+		"type":      tdrpc.LIGHTNING.String(),
+		"direction": tdrpc.IN.String(),
+		"hidden":    "*",
+	}, time.Time{}, 0, 0).Once().Return([]*tdrpc.LedgerRecord{
+		// Past its expiration but not its cancel height, it stays accepted
+		{Id: "01", Status: tdrpc.ACCEPTED, Direction: tdrpc.IN, Hold: true, ExpiresAt: &expired, CancelHeight: 600101},
+		// Without a cancel height lnd cancels it when the htlcs expire
+		{Id: "02", Status: tdrpc.ACCEPTED, Direction: tdrpc.IN, Hold: true, ExpiresAt: &expired},
+		// Not a hold invoice
+		{Id: "03", Status: tdrpc.ACCEPTED, Direction: tdrpc.IN, CancelHeight: 600000},
+		// At its cancel height
+		{Id: "04", Status: tdrpc.ACCEPTED, Direction: tdrpc.IN, Hold: true, CancelHeight: 600100},
+		// The cancel fails, it is tried again
+		{Id: "05", Status: tdrpc.ACCEPTED, Direction: tdrpc.IN, Hold: true, CancelHeight: 600050},
+	}, nil)
+	mockLClient.On("GetInfo", mock.AnythingOfType("*context.emptyCtx"), &lnrpc.GetInfoRequest{}).Once().Return(&lnrpc.GetInfoResponse{BlockHeight: 600100}, nil)
+
+	mockIClient.On("CancelInvoice", mock.AnythingOfType("*context.emptyCtx"), &invoicesrpc.CancelInvoiceMsg{PaymentHash: []byte{0x04}}).Once().Return(&invoicesrpc.CancelInvoiceResp{}, nil)
+	mockStore.On("ProcessLedgerRecord", mock.AnythingOfType("*context.emptyCtx"), mock.MatchedBy(func(lr *tdrpc.LedgerRecord) bool {
+		return lr.Id == "04" && lr.Status == tdrpc.EXPIRED
+	})).Once().Return(nil)
+	mockIClient.On("CancelInvoice", mock.AnythingOfType("*context.emptyCtx"), &invoicesrpc.CancelInvoiceMsg{PaymentHash: []byte{0x05}}).Once().Return(nil, errors.New("down"))
+
+	m.cancelExpiredHolds(context.Background())
+
+	mockStore.AssertExpectations(t)
+	mockLClient.AssertExpectations(t)
+	mockIClient.AssertExpectations(t)
+
+}
@@ -0,0 +1,173 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/DataDog/datadog-go/statsd"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	config "github.com/spf13/viper"
+	"go.uber.org/zap"
+
+	"git.coinninja.net/backend/thunderdome/lsp"
+	"git.coinninja.net/backend/thunderdome/metrics"
+	"git.coinninja.net/backend/thunderdome/store"
+	"git.coinninja.net/backend/thunderdome/tdrpc"
+)
+
+// MonitorInbound tracks how much we can receive and requests a channel from the liquidity provider when it's too low
+func (m *Monitor) MonitorInbound() error {
+
+	stop := m.stop()
+
+	if m.lsp == nil {
+		return fmt.Errorf("No liquidity provider configured")
+	}
+
+	// Handle shutting down
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		<-stop.Chan()
+		cancel()
+	}()
+
+	firstRun := make(chan struct{}, 1)
+	firstRun <- struct{}{}
+
+monLoop:
+	for !stop.Bool() {
+
+		select {
+		case <-firstRun:
+		case <-stop.Chan():
+			break monLoop
+		case <-time.After(monitorIntervals[monitorInbound]):
+		}
+
+		if err := m.checkInbound(ctx); err != nil {
+			m.logger.Errorw("Could not check inbound liquidity", "monitor", "inbound", "error", err)
+			continue
+		}
+
+		m.event(monitorInbound)
+	}
+
+	return nil
+
+}
+
+// checkInbound requests a channel when inbound liquidity is low and no channel was requested within lsp.request_cooldown
+// The time of the last request is kept in a monitor checkpoint so restarts and leader changes don't request again
+func (m *Monitor) checkInbound(ctx context.Context) error {
+
+	inbound, err := m.inboundLiquidity(ctx)
+	if err != nil {
+		return err
+	}
+
+	metrics.Gauge("lnd.inbound_liquidity", float64(inbound), nil)
+
+	if inbound >= config.GetInt64("lsp.min_inbound") {
+		return nil
+	}
+
+	// When a channel was last requested
+	var lastRequest time.Time
+	lastRequestUnix, err := m.store.GetMonitorCheckpoint(ctx, tdrpc.MonitorCheckpointLSPLastRequest)
+	if err == nil {
+		lastRequest = time.Unix(lastRequestUnix, 0)
+	} else if err != store.ErrNotFound {
+		return fmt.Errorf("Could not GetMonitorCheckpoint: %v", err)
+	}
+
+	if time.Since(lastRequest) <= config.GetDuration("lsp.request_cooldown") {
+		return nil
+	}
+
+	// Save it before requesting so a failing provider isn't asked again until the cooldown passes
+	if err = m.store.SaveMonitorCheckpoint(ctx, tdrpc.MonitorCheckpointLSPLastRequest, time.Now().Unix()); err != nil {
+		return fmt.Errorf("Could not SaveMonitorCheckpoint: %v", err)
+	}
+
+	if err = m.requestInbound(ctx, inbound); err != nil {
+		metrics.Incr("lsp.channel_requests", metrics.Labels{"result": "failed"})
+		m.logger.Errorw("Could not request inbound channel", "monitor", "inbound", "inbound", inbound, "error", err)
+	} else {
+		metrics.Incr("lsp.channel_requests", metrics.Labels{"result": "completed"})
+	}
+
+	return nil
+
+}
+
+// inboundLiquidity returns the total we can receive in active and pending channels
+func (m *Monitor) inboundLiquidity(ctx context.Context) (int64, error) {
+
+	channelsResponse, err := m.lclient.ListChannels(ctx, &lnrpc.ListChannelsRequest{ActiveOnly: true})
+	if err != nil {
+		return 0, fmt.Errorf("Could not ListChannels: %v", err)
+	}
+
+	var inbound int64
+	for _, channel := range channelsResponse.Channels {
+		if receivable := channel.RemoteBalance - channel.RemoteChanReserveSat; receivable > 0 {
+			inbound += receivable
+		}
+	}
+
+	// A requested channel is pending until it confirms
+	pending, err := m.lclient.PendingChannels(ctx, &lnrpc.PendingChannelsRequest{})
+	if err != nil {
+		return 0, fmt.Errorf("Could not PendingChannels: %v", err)
+	}
+	for _, channel := range pending.PendingOpenChannels {
+		if channel.Channel != nil {
+			inbound += channel.Channel.RemoteBalance
+		}
+	}
+
+	return inbound, nil
+
+}
+
+// requestInbound asks the liquidity provider for a channel to our node
+func (m *Monitor) requestInbound(ctx context.Context, inbound int64) error {
+
+	info, err := m.lclient.GetInfo(ctx, &lnrpc.GetInfoRequest{})
+	if err != nil {
+		return fmt.Errorf("Could not GetInfo: %v", err)
+	}
+
+	request := &lsp.ChannelRequest{
+		NodePubkey: info.IdentityPubkey,
+		Capacity:   config.GetInt64("lsp.channel_capacity"),
+	}
+	// Uris are pubkey@host:port
+	if len(info.Uris) > 0 {
+		if parts := strings.SplitN(info.Uris[0], "@", 2); len(parts) == 2 {
+			request.NodeAddress = parts[1]
+		}
+	}
+
+	lease, err := m.lsp.RequestChannel(ctx, request)
+	if err != nil {
+		return err
+	}
+
+	m.logger.Infow("Inbound Channel Requested", "monitor", "inbound", "inbound", inbound, zap.Any("request", request), zap.Any("lease", lease))
+
+	if m.ddclient != nil {
+		_ = m.ddclient.Event(&statsd.Event{
+			Title:     "Thunderdome Inbound Channel Requested",
+			Text:      fmt.Sprintf(`Thunderdome Inbound Channel Requested: inbound:%d capacity:%d lease:%s fee:%d`, inbound, lease.Capacity, lease.Id, lease.Fee),
+			Priority:  statsd.Normal,
+			AlertType: statsd.Info,
+		})
+	}
+
+	return nil
+
+}
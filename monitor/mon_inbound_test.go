@@ -0,0 +1,73 @@
+package monitor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	config "github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+
+	_ "git.coinninja.net/backend/thunderdome/conf"
+	"git.coinninja.net/backend/thunderdome/lsp"
+	"git.coinninja.net/backend/thunderdome/mocks"
+	"git.coinninja.net/backend/thunderdome/store"
+	"git.coinninja.net/backend/thunderdome/tdrpc"
+)
+
+func TestCheckInbound(t *testing.T) {
+
+	// Mocks
+	mockStore := new(mocks.Store)
+	mockLClient := new(mocks.LightningClient)
+	provider := lsp.NewFakeProvider()
+
+	m := &Monitor{
+		logger:  zap.S(),
+		store:   mockStore,
+		lclient: mockLClient,
+		lsp:     provider,
+	}
+
+	// Inbound liquidity is below lsp.min_inbound
+	mockLClient.On("ListChannels", mock.AnythingOfType("*context.emptyCtx"), &lnrpc.ListChannelsRequest{ActiveOnly: true}).Return(&lnrpc.ListChannelsResponse{
+		Channels: []*lnrpc.Channel{
+			{RemoteBalance: 100000, RemoteChanReserveSat: 1000},
+		},
+	}, nil)
+	mockLClient.On("PendingChannels", mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("*lnrpc.PendingChannelsRequest")).Return(&lnrpc.PendingChannelsResponse{}, nil)
+
+	// A channel has never been requested
+	mockStore.On("GetMonitorCheckpoint", mock.AnythingOfType("*context.emptyCtx"), tdrpc.MonitorCheckpointLSPLastRequest).Once().Return(int64(0), store.ErrNotFound)
+	mockStore.On("SaveMonitorCheckpoint", mock.AnythingOfType("*context.emptyCtx"), tdrpc.MonitorCheckpointLSPLastRequest, mock.MatchedBy(func(value int64) bool {
+		return time.Since(time.Unix(value, 0)) < time.Minute
+	})).Once().Return(nil)
+	mockLClient.On("GetInfo", mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("*lnrpc.GetInfoRequest")).Once().Return(&lnrpc.GetInfoResponse{
+		IdentityPubkey: "02me",
+		Uris:           []string{"02me@127.0.0.1:9735"},
+	}, nil)
+
+	err := m.checkInbound(context.Background())
+	assert.Nil(t, err)
+	if assert.Len(t, provider.Requests, 1) {
+		assert.Equal(t, &lsp.ChannelRequest{
+			NodePubkey:  "02me",
+			NodeAddress: "127.0.0.1:9735",
+			Capacity:    config.GetInt64("lsp.channel_capacity"),
+		}, provider.Requests[0])
+	}
+
+	// The persisted request is within the cooldown so nothing is requested
+	mockStore.On("GetMonitorCheckpoint", mock.AnythingOfType("*context.emptyCtx"), tdrpc.MonitorCheckpointLSPLastRequest).Once().Return(time.Now().Add(-time.Hour).Unix(), nil)
+
+	err = m.checkInbound(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, provider.Requests, 1)
+
+	mockStore.AssertExpectations(t)
+	mockLClient.AssertExpectations(t)
+
+}
@@ -0,0 +1,305 @@
+package monitor
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/DataDog/datadog-go/statsd"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	config "github.com/spf13/viper"
+	"go.uber.org/zap"
+
+	"git.coinninja.net/backend/thunderdome/metrics"
+	"git.coinninja.net/backend/thunderdome/tdrpc"
+)
+
+const (
+	// The final cltv delta and expiry of the invoice we pay ourselves when rebalancing
+	rebalanceFinalCltvDelta = 40
+	rebalanceInvoiceExpiry  = 600
+)
+
+// MonitorLiquidity tracks the balance of each channel, alerts when they become imbalanced and
+// moves funds from channels with too much local balance to channels with too little with circular payments
+func (m *Monitor) MonitorLiquidity() error {
+
+	stop := m.stop()
+
+	// Handle shutting down
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		<-stop.Chan()
+		cancel()
+	}()
+
+	firstRun := make(chan struct{}, 1)
+	firstRun <- struct{}{}
+
+	// The channels that were imbalanced last time so we only alert when it changes
+	imbalanced := make(map[uint64]bool)
+
+monLoop:
+	for !stop.Bool() {
+
+		select {
+		case <-firstRun:
+		case <-stop.Chan():
+			break monLoop
+		case <-time.After(monitorIntervals[monitorLiquidity]):
+		}
+
+		channelsResponse, err := m.lclient.ListChannels(ctx, &lnrpc.ListChannelsRequest{ActiveOnly: true})
+		if err != nil {
+			m.logger.Errorw("Could not list channels", "monitor", "liquidity", "error", err)
+			continue
+		}
+
+		lowRatio := config.GetFloat64("liquidity.low_ratio")
+		highRatio := config.GetFloat64("liquidity.high_ratio")
+
+		// Sources have too much local balance, sinks have too little
+		var sources, sinks []*lnrpc.Channel
+		current := make(map[uint64]bool)
+		for _, channel := range channelsResponse.Channels {
+			ratio := localRatio(channel)
+			metrics.Gauge("lnd.channel_local_ratio", ratio, metrics.Labels{"chan_id": strconv.FormatUint(channel.ChanId, 10)})
+
+			if ratio > highRatio {
+				sources = append(sources, channel)
+			} else if ratio < lowRatio {
+				sinks = append(sinks, channel)
+			} else {
+				continue
+			}
+
+			current[channel.ChanId] = true
+			if !imbalanced[channel.ChanId] {
+				m.alertImbalance(channel, ratio)
+			}
+		}
+		imbalanced = current
+
+		metrics.Gauge("lnd.imbalanced_channel_count", float64(len(imbalanced)), nil)
+
+		if config.GetBool("liquidity.rebalance_enabled") && len(sources) > 0 && len(sinks) > 0 {
+			m.rebalance(ctx, channelsResponse.Channels, sources, sinks)
+		}
+
+		m.event(monitorLiquidity)
+	}
+
+	return nil
+
+}
+
+// alertImbalance logs and sends an event when a channel becomes imbalanced
+func (m *Monitor) alertImbalance(channel *lnrpc.Channel, ratio float64) {
+
+	m.logger.Warnw("Channel Imbalanced", "monitor", "liquidity", "chan_id", channel.ChanId, "remote_pubkey", channel.RemotePubkey,
+		"local_balance", channel.LocalBalance, "remote_balance", channel.RemoteBalance, "ratio", ratio)
+
+	if m.ddclient != nil {
+		_ = m.ddclient.Event(&statsd.Event{
+			Title:     "Thunderdome Channel Imbalanced",
+			Text:      fmt.Sprintf(`Thunderdome Channel Imbalanced: chan_id:%d local_balance:%d remote_balance:%d ratio:%.2f`, channel.ChanId, channel.LocalBalance, channel.RemoteBalance, ratio),
+			Priority:  statsd.Normal,
+			AlertType: statsd.Warning,
+		})
+	}
+
+}
+
+// rebalance tries the most imbalanced pairs of channels until one rebalance succeeds or it runs out of attempts
+func (m *Monitor) rebalance(ctx context.Context, channels []*lnrpc.Channel, sources []*lnrpc.Channel, sinks []*lnrpc.Channel) {
+
+	// Fees paid in the last day count towards the budget
+	lrStats, err := m.store.GetLedgerRecordStats(ctx, map[string]string{
+		"account_id": tdrpc.HouseAccountId,
+		"type":       tdrpc.REBALANCE.String(),
+		"direction":  tdrpc.OUT.String(),
+		"status":     tdrpc.COMPLETED.String(),
+	}, time.Now().UTC().Add(-24*time.Hour))
+	if err != nil {
+		m.logger.Errorw("Could not get rebalance fee stats", "monitor", "liquidity", "error", err)
+		return
+	}
+	budget := config.GetInt64("liquidity.daily_fee_budget") - lrStats.NetworkFee
+	if budget <= 0 {
+		m.logger.Infow("Rebalance fee budget exhausted", "monitor", "liquidity", "spent", lrStats.NetworkFee)
+		return
+	}
+
+	sort.Slice(sources, func(i, j int) bool { return localRatio(sources[i]) > localRatio(sources[j]) })
+	sort.Slice(sinks, func(i, j int) bool { return localRatio(sinks[i]) < localRatio(sinks[j]) })
+
+	attempts := config.GetInt("liquidity.max_rebalance_attempts")
+	for _, source := range sources {
+		for _, sink := range sinks {
+			if attempts <= 0 {
+				return
+			}
+
+			// Move enough to bring both channels back towards even, within the limits
+			value := min64(source.LocalBalance-(source.LocalBalance+source.RemoteBalance)/2, (sink.LocalBalance+sink.RemoteBalance)/2-sink.LocalBalance)
+			value = min64(value, config.GetInt64("liquidity.max_rebalance_value"))
+			if value < config.GetInt64("liquidity.min_rebalance_value") {
+				continue
+			}
+			maxFee := min64(value*config.GetInt64("liquidity.max_fee_ppm")/1000000, budget)
+
+			attempts--
+			fee, err := m.rebalanceChannels(ctx, channels, source, sink, value, maxFee)
+			if err != nil {
+				metrics.Incr("liquidity.rebalances", metrics.Labels{"result": "failed"})
+				m.logger.Warnw("Rebalance Failed", "monitor", "liquidity", "source_chan_id", source.ChanId, "sink_chan_id", sink.ChanId, "value", value, "max_fee", maxFee, "error", err)
+				continue
+			}
+
+			metrics.Incr("liquidity.rebalances", metrics.Labels{"result": "completed"})
+			m.logger.Infow("Rebalance Completed", "monitor", "liquidity", "source_chan_id", source.ChanId, "sink_chan_id", sink.ChanId, "value", value, "fee", fee)
+			return
+		}
+	}
+
+}
+
+// rebalanceChannels pays ourselves value out through the source channel and back in through the sink channel
+// and records the fee paid in the house account. It returns the fee paid.
+func (m *Monitor) rebalanceChannels(ctx context.Context, channels []*lnrpc.Channel, source *lnrpc.Channel, sink *lnrpc.Channel, value int64, maxFee int64) (int64, error) {
+
+	info, err := m.lclient.GetInfo(ctx, &lnrpc.GetInfoRequest{})
+	if err != nil {
+		return 0, fmt.Errorf("Could not GetInfo: %v", err)
+	}
+
+	// The peer on the sink channel charges a fee to send the funds back to us
+	edge, err := m.lclient.GetChanInfo(ctx, &lnrpc.ChanInfoRequest{ChanId: sink.ChanId})
+	if err != nil {
+		return 0, fmt.Errorf("Could not GetChanInfo: %v", err)
+	}
+	policy := edge.Node1Policy
+	if edge.Node1Pub != sink.RemotePubkey {
+		policy = edge.Node2Policy
+	}
+	if policy == nil || policy.Disabled {
+		return 0, fmt.Errorf("The sink channel has no routing policy")
+	}
+
+	valueMsat := value * 1000
+	lastHopFee := (policy.FeeBaseMsat + valueMsat*policy.FeeRateMilliMsat/1000000 + 999) / 1000
+	if lastHopFee > maxFee {
+		return 0, fmt.Errorf("The sink channel fee %d is more than the max fee %d", lastHopFee, maxFee)
+	}
+
+	// Only our edge on the source channel can be used to leave our node
+	var ignoredEdges []*lnrpc.EdgeLocator
+	for _, channel := range channels {
+		if channel.ChanId == source.ChanId {
+			continue
+		}
+		ignoredEdges = append(ignoredEdges, &lnrpc.EdgeLocator{
+			ChannelId:        channel.ChanId,
+			DirectionReverse: info.IdentityPubkey > channel.RemotePubkey,
+		})
+	}
+
+	// Find a route to the peer on the sink channel that pays its fee and leaves room for its time lock delta
+	routesResponse, err := m.lclient.QueryRoutes(ctx, &lnrpc.QueryRoutesRequest{
+		PubKey:            sink.RemotePubkey,
+		Amt:               value + lastHopFee,
+		FinalCltvDelta:    int32(rebalanceFinalCltvDelta + policy.TimeLockDelta),
+		FeeLimit:          &lnrpc.FeeLimit{Limit: &lnrpc.FeeLimit_Fixed{Fixed: maxFee - lastHopFee}},
+		IgnoredEdges:      ignoredEdges,
+		UseMissionControl: true,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("Could not QueryRoutes: %v", err)
+	}
+	if len(routesResponse.Routes) == 0 || len(routesResponse.Routes[0].Hops) == 0 {
+		return 0, fmt.Errorf("No route found")
+	}
+	route := routesResponse.Routes[0]
+	if route.Hops[0].ChanId != source.ChanId {
+		return 0, fmt.Errorf("Route does not use the source channel")
+	}
+
+	// The peer on the sink channel forwards the value back to us instead of keeping it
+	lastHop := route.Hops[len(route.Hops)-1]
+	lastHop.FeeMsat = lastHop.AmtToForwardMsat - valueMsat
+	lastHop.AmtToForwardMsat = valueMsat
+	lastHop.Expiry -= policy.TimeLockDelta
+	route.Hops = append(route.Hops, &lnrpc.Hop{
+		ChanId:           sink.ChanId,
+		ChanCapacity:     sink.Capacity,
+		AmtToForwardMsat: valueMsat,
+		Expiry:           lastHop.Expiry,
+		PubKey:           info.IdentityPubkey,
+	})
+	route.TotalFeesMsat = route.TotalAmtMsat - valueMsat
+
+	fee := (route.TotalFeesMsat + 999) / 1000
+	if fee > maxFee {
+		return 0, fmt.Errorf("The route fee %d is more than the max fee %d", fee, maxFee)
+	}
+
+	// Create the invoice we pay ourselves
+	invoice, err := m.lclient.AddInvoice(ctx, &lnrpc.Invoice{
+		Memo:       fmt.Sprintf("Rebalance %d to %d", source.ChanId, sink.ChanId),
+		Value:      value,
+		Expiry:     rebalanceInvoiceExpiry,
+		CltvExpiry: rebalanceFinalCltvDelta,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("Could not AddInvoice: %v", err)
+	}
+
+	response, err := m.lclient.SendToRouteSync(ctx, &lnrpc.SendToRouteRequest{
+		PaymentHash: invoice.RHash,
+		Route:       route,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("Could not SendToRouteSync: %v", err)
+	} else if response.PaymentError != "" {
+		return 0, fmt.Errorf("Payment Error: %s", response.PaymentError)
+	}
+
+	// ********* AT THIS POINT IN TIME ALL FUNCTIONS BELOW MUST COMPLETE *********
+	// The fee has been paid, record it in the house account
+	lr := &tdrpc.LedgerRecord{
+		Id:         tdrpc.RebalanceLedgerRecordIdPrefix + hex.EncodeToString(invoice.RHash),
+		AccountId:  tdrpc.HouseAccountId,
+		Status:     tdrpc.COMPLETED,
+		Type:       tdrpc.REBALANCE,
+		Direction:  tdrpc.OUT,
+		NetworkFee: fee,
+		Memo:       fmt.Sprintf("Rebalance %d from %d to %d", value, source.ChanId, sink.ChanId),
+		Request:    invoice.PaymentRequest,
+	}
+	if err = m.store.ProcessLedgerRecord(context.Background(), lr); err != nil {
+		m.logger.Errorw("ProcessLedgerRecord Error", "monitor", "liquidity", zap.Any("lr", lr), "error", err)
+	}
+
+	return fee, nil
+
+}
+
+// localRatio is the portion of the channel balance on our side
+func localRatio(channel *lnrpc.Channel) float64 {
+	total := channel.LocalBalance + channel.RemoteBalance
+	if total == 0 {
+		return 0.5
+	}
+	return float64(channel.LocalBalance) / float64(total)
+}
+
+func min64(a, b int64) int64 {
+	if a < b {
+		return a
+	}
+	return b
+}
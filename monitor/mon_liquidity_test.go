@@ -0,0 +1,159 @@
+package monitor
+
+import (
+	"context"
+	"encoding/hex"
+	"testing"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+
+	_ "git.coinninja.net/backend/thunderdome/conf"
+	"git.coinninja.net/backend/thunderdome/mocks"
+	"git.coinninja.net/backend/thunderdome/tdrpc"
+)
+
+// rebalanceMocks sets up a rebalance of 100000 sats from the channel with 02aa to the channel with 02bb
+// through a channel between 02aa and 02bb with a route fee of 13 sats
+func rebalanceMocks(mockLClient *mocks.LightningClient, maxFee int64) ([]*lnrpc.Channel, *lnrpc.Channel, *lnrpc.Channel) {
+
+	source := &lnrpc.Channel{ChanId: 1, RemotePubkey: "02aa", Capacity: 1000000, LocalBalance: 900000, RemoteBalance: 100000}
+	sink := &lnrpc.Channel{ChanId: 2, RemotePubkey: "02bb", Capacity: 1000000, LocalBalance: 100000, RemoteBalance: 900000}
+	other := &lnrpc.Channel{ChanId: 4, RemotePubkey: "02cc", Capacity: 1000000, LocalBalance: 500000, RemoteBalance: 500000}
+
+	mockLClient.On("GetInfo", mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("*lnrpc.GetInfoRequest")).Once().Return(&lnrpc.GetInfoResponse{IdentityPubkey: "02me"}, nil)
+
+	// 02bb charges 1 sat + 100 ppm and a delta of 40 blocks to send it back to us
+	mockLClient.On("GetChanInfo", mock.AnythingOfType("*context.emptyCtx"), &lnrpc.ChanInfoRequest{ChanId: sink.ChanId}).Once().Return(&lnrpc.ChannelEdge{
+		ChannelId:   sink.ChanId,
+		Node1Pub:    "02bb",
+		Node2Pub:    "02me",
+		Node1Policy: &lnrpc.RoutingPolicy{TimeLockDelta: 40, FeeBaseMsat: 1000, FeeRateMilliMsat: 100},
+		Node2Policy: &lnrpc.RoutingPolicy{TimeLockDelta: 144, FeeBaseMsat: 5000, FeeRateMilliMsat: 5000},
+	}, nil)
+
+	// The route to 02bb pays the 11 sat last hop fee, leaves room for its delta and only leaves through the source channel
+	mockLClient.On("QueryRoutes", mock.AnythingOfType("*context.emptyCtx"), &lnrpc.QueryRoutesRequest{
+		PubKey:         "02bb",
+		Amt:            100011,
+		FinalCltvDelta: rebalanceFinalCltvDelta + 40,
+		FeeLimit:       &lnrpc.FeeLimit{Limit: &lnrpc.FeeLimit_Fixed{Fixed: maxFee - 11}},
+		IgnoredEdges: []*lnrpc.EdgeLocator{
+			{ChannelId: sink.ChanId, DirectionReverse: true},
+			{ChannelId: other.ChanId, DirectionReverse: true},
+		},
+		UseMissionControl: true,
+	}).Once().Return(&lnrpc.QueryRoutesResponse{
+		Routes: []*lnrpc.Route{{
+			TotalTimeLock: 1160,
+			TotalAmtMsat:  100013000,
+			TotalFeesMsat: 2000,
+			Hops: []*lnrpc.Hop{
+				{ChanId: source.ChanId, ChanCapacity: 1000000, AmtToForwardMsat: 100011000, FeeMsat: 2000, Expiry: 1120, PubKey: "02aa"},
+				{ChanId: 3, ChanCapacity: 2000000, AmtToForwardMsat: 100011000, FeeMsat: 0, Expiry: 1120, PubKey: "02bb"},
+			},
+		}},
+	}, nil)
+
+	mockLClient.On("AddInvoice", mock.AnythingOfType("*context.emptyCtx"), &lnrpc.Invoice{
+		Memo:       "Rebalance 1 to 2",
+		Value:      100000,
+		Expiry:     rebalanceInvoiceExpiry,
+		CltvExpiry: rebalanceFinalCltvDelta,
+	}).Once().Return(&lnrpc.AddInvoiceResponse{RHash: []byte{0x01, 0x02}, PaymentRequest: "lnbc1"}, nil)
+
+	return []*lnrpc.Channel{source, sink, other}, source, sink
+
+}
+
+func TestRebalanceChannels(t *testing.T) {
+
+	// Mocks
+	mockStore := new(mocks.Store)
+	mockLClient := new(mocks.LightningClient)
+
+	m := &Monitor{
+		logger:  zap.S(),
+		store:   mockStore,
+		lclient: mockLClient,
+	}
+
+	channels, source, sink := rebalanceMocks(mockLClient, 1000)
+
+	var sent *lnrpc.SendToRouteRequest
+	mockLClient.On("SendToRouteSync", mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("*lnrpc.SendToRouteRequest")).Once().Run(func(args mock.Arguments) {
+		sent = args.Get(1).(*lnrpc.SendToRouteRequest)
+	}).Return(&lnrpc.SendResponse{}, nil)
+
+	// The fee is recorded in the house account
+	mockStore.On("ProcessLedgerRecord", mock.AnythingOfType("*context.emptyCtx"), &tdrpc.LedgerRecord{
+		Id:         tdrpc.RebalanceLedgerRecordIdPrefix + hex.EncodeToString([]byte{0x01, 0x02}),
+		AccountId:  tdrpc.HouseAccountId,
+		Status:     tdrpc.COMPLETED,
+		Type:       tdrpc.REBALANCE,
+		Direction:  tdrpc.OUT,
+		NetworkFee: 13,
+		Memo:       "Rebalance 100000 from 1 to 2",
+		Request:    "lnbc1",
+	}).Once().Return(nil)
+
+	fee, err := m.rebalanceChannels(context.Background(), channels, source, sink, 100000, 1000)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(13), fee)
+
+	// 02bb keeps its fee and forwards the value back to us through the sink channel
+	if assert.NotNil(t, sent) {
+		assert.Equal(t, []byte{0x01, 0x02}, sent.PaymentHash)
+		assert.Equal(t, &lnrpc.Route{
+			TotalTimeLock: 1160,
+			TotalAmtMsat:  100013000,
+			TotalFeesMsat: 13000,
+			Hops: []*lnrpc.Hop{
+				{ChanId: source.ChanId, ChanCapacity: 1000000, AmtToForwardMsat: 100011000, FeeMsat: 2000, Expiry: 1120, PubKey: "02aa"},
+				{ChanId: 3, ChanCapacity: 2000000, AmtToForwardMsat: 100000000, FeeMsat: 11000, Expiry: 1080, PubKey: "02bb"},
+				{ChanId: sink.ChanId, ChanCapacity: sink.Capacity, AmtToForwardMsat: 100000000, Expiry: 1080, PubKey: "02me"},
+			},
+		}, sent.Route)
+	}
+
+	mockStore.AssertExpectations(t)
+	mockLClient.AssertExpectations(t)
+
+}
+
+func TestRebalanceChannelsFailed(t *testing.T) {
+
+	// Mocks
+	mockStore := new(mocks.Store)
+	mockLClient := new(mocks.LightningClient)
+
+	m := &Monitor{
+		logger:  zap.S(),
+		store:   mockStore,
+		lclient: mockLClient,
+	}
+
+	channels, source, sink := rebalanceMocks(mockLClient, 1000)
+
+	// Nothing is recorded when the payment fails
+	mockLClient.On("SendToRouteSync", mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("*lnrpc.SendToRouteRequest")).Once().Return(&lnrpc.SendResponse{
+		PaymentError: "TemporaryChannelFailure",
+	}, nil)
+
+	_, err := m.rebalanceChannels(context.Background(), channels, source, sink, 100000, 1000)
+	assert.NotNil(t, err)
+
+	// A route that costs more than the max fee is not paid
+	mockLClient = new(mocks.LightningClient)
+	m.lclient = mockLClient
+	channels, source, sink = rebalanceMocks(mockLClient, 12)
+	_, err = m.rebalanceChannels(context.Background(), channels, source, sink, 100000, 12)
+	assert.NotNil(t, err)
+	mockLClient.AssertNotCalled(t, "AddInvoice", mock.Anything, mock.Anything)
+	mockLClient.AssertNotCalled(t, "SendToRouteSync", mock.Anything, mock.Anything)
+
+	mockStore.AssertExpectations(t)
+
+}
@@ -3,121 +3,292 @@ package monitor
 import (
 	"context"
 	"encoding/hex"
+	"errors"
+	"fmt"
 	"io"
 
+	"github.com/DataDog/datadog-go/statsd"
 	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/invoicesrpc"
+	config "github.com/spf13/viper"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
-	"git.coinninja.net/backend/thunderdome/conf"
+	"git.coinninja.net/backend/thunderdome/metrics"
 	"git.coinninja.net/backend/thunderdome/store"
 	"git.coinninja.net/backend/thunderdome/tdrpc"
 )
 
-func (m *Monitor) MonitorLN() {
+// MonitorLN listens for lightning invoice updates and resumes from the last settle index processed
+// It returns when the connection to lnd is lost so the supervisor can reconnect
+func (m *Monitor) MonitorLN() error {
+
+	stop := m.stop()
 
 	// Handle shutting down
 	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
 	go func() {
-		<-conf.Stop.Chan()
-		cancel()
+		select {
+		case <-stop.Chan():
+			cancel()
+		case <-ctx.Done():
+		}
 	}()
 
+	invclient, err := m.subscribeInvoices(ctx)
+	if err != nil {
+		return fmt.Errorf("Could not SubscribeInvoices: %v", err)
+	}
+	defer func() { _ = invclient.CloseSend() }()
+
+	return m.receiveInvoices(ctx, invclient)
+
+}
+
+// subscribeInvoices connects to the invoice stream starting from the earliest active invoice and the settle index checkpoint
+func (m *Monitor) subscribeInvoices(ctx context.Context) (lnrpc.Lightning_SubscribeInvoicesClient, error) {
+
 	// Get the earliest pending invoice address index
 	startAddIndex, err := m.store.GetEarliestActiveAddIndex(ctx)
 	if err != nil {
-		m.logger.Fatalw("GetEarliestActiveAddIndex Error", "monitor", "ln", "error", err)
+		return nil, fmt.Errorf("GetEarliestActiveAddIndex Error: %v", err)
+	}
+
+	// Resume after the last settle processed, without a checkpoint replay them all
+	settleIndex, err := m.store.GetMonitorCheckpoint(ctx, tdrpc.MonitorCheckpointLNSettleIndex)
+	if err == store.ErrNotFound {
+		settleIndex = 1
+	} else if err != nil {
+		return nil, fmt.Errorf("GetMonitorCheckpoint Error: %v", err)
 	}
 
-	// Connect to the transaction stream
-	conf.Stop.Add(1)
 	invclient, err := m.lclient.SubscribeInvoices(ctx, &lnrpc.InvoiceSubscription{
-		// TODO: We could start with the highest completed, not expired index
 		AddIndex:    startAddIndex,
-		SettleIndex: 1,
+		SettleIndex: uint64(settleIndex),
 	})
 	if err != nil {
-		m.logger.Fatalw("Could not SubscribeInvoices", "monitor", "ln", "error", err)
+		return nil, err
 	}
 
-	m.logger.Infow("Listening for lightning transactions...", "monitor", "ln", "add_index", startAddIndex)
+	m.lastSettleIndex = uint64(settleIndex)
+
+	m.logger.Infow("Listening for lightning transactions...", "monitor", "ln", "add_index", startAddIndex, "settle_index", settleIndex)
+
+	return invclient, nil
+
+}
 
-	for !conf.Stop.Bool() {
+// receiveInvoices processes invoices from the stream until it's disconnected
+func (m *Monitor) receiveInvoices(ctx context.Context, invclient lnrpc.Lightning_SubscribeInvoicesClient) error {
 
-		var handledTx bool
+	for {
 
 		// Get the next message
 		invoice, err := invclient.Recv()
 		if err == io.EOF {
-			m.logger.Fatalw("LightningMonitor EOF", "monitor", "ln")
-			continue
+			return errors.New("LightningMonitor Closed Connection")
 		} else if status.Code(err) == codes.Canceled {
 			m.logger.Info("LightningMonitor Shutting Down")
-			break
+			return nil
 		} else if err != nil {
-			m.logger.Fatalw("LightningMonitor Failure", "monitor", "ln", "error", err)
+			return fmt.Errorf("LightningMonitor Failure: %v", err)
 		}
 
-		// Get the payment_hash
-		paymentHash := hex.EncodeToString(invoice.RHash)
+		m.event(monitorLN)
+
+		m.logger.Debugw("Handling Invoice", "monitor", "ln", "payment_hash", hex.EncodeToString(invoice.RHash), zap.Any("invoice", invoice))
 
-		m.logger.Debugw("Handling Invoice", "monitor", "ln", "payment_hash", paymentHash, zap.Any("invoice", invoice))
+		// Hold invoices that were paid or canceled
+		if invoice.State == lnrpc.Invoice_ACCEPTED || invoice.State == lnrpc.Invoice_CANCELED {
+			m.handleHoldInvoice(ctx, invoice)
+			continue
+		}
 
 		// We only need to process settled transactions
 		if !invoice.Settled {
 			continue
 		}
 
-		// Find the existing ledger record outbound
-		lr, err := m.store.GetLedgerRecord(ctx, paymentHash, tdrpc.OUT)
-		if err == nil {
-			// Update it with the value and status
-			lr.Status = tdrpc.COMPLETED
-			lr.Value = invoice.AmtPaidSat
-			handledTx = true
+		if err = m.handleSettledInvoice(ctx, invoice); err != nil {
+			// Reconnect to retry it from the checkpoint until it has failed too many times
+			if attempts := m.settleFailed(invoice.SettleIndex); attempts < config.GetInt("monitor.ln_settle_attempts") {
+				return fmt.Errorf("Settled invoice %s failed attempt %d: %v", hex.EncodeToString(invoice.RHash), attempts, err)
+			}
+			m.skipSettledInvoice(invoice, err)
+		}
+		delete(m.settleAttempts, invoice.SettleIndex)
 
-			// Process the payment
-			err = m.store.ProcessLedgerRecord(ctx, lr)
-			if err != nil {
-				m.logger.Errorw("ProcessLedgerRecord Out Error", "monitor", "ln", "error", err, "payment_hash", paymentHash)
-				continue
+		// Save where we are so a restart resumes from here
+		if invoice.SettleIndex > m.lastSettleIndex {
+			if err = m.store.SaveMonitorCheckpoint(ctx, tdrpc.MonitorCheckpointLNSettleIndex, int64(invoice.SettleIndex)); err != nil {
+				m.logger.Errorw("SaveMonitorCheckpoint Error", "monitor", "ln", "error", err, "settle_index", invoice.SettleIndex)
+			} else {
+				m.lastSettleIndex = invoice.SettleIndex
 			}
+		}
+
+	}
+
+}
 
-			m.logger.Infow("Processed Out Invoice", "monitor", "ln", "payment_hash", paymentHash, "value", invoice.AmtPaidSat)
+// settleFailed counts a failed attempt to process the invoice with settleIndex and returns the number of attempts
+func (m *Monitor) settleFailed(settleIndex uint64) int {
+
+	if m.settleAttempts == nil {
+		m.settleAttempts = make(map[uint64]int)
+	}
+	m.settleAttempts[settleIndex]++
 
-		} else if err != store.ErrNotFound {
-			m.logger.Fatalw("GetLedgerRecord Error", "monitor", "ln", "error", err)
+	return m.settleAttempts[settleIndex]
+
+}
+
+// skipSettledInvoice alerts that a settled invoice is being skipped so the checkpoint can move past it
+// Its ledger records need to be resolved manually
+func (m *Monitor) skipSettledInvoice(invoice *lnrpc.Invoice, err error) {
+
+	paymentHash := hex.EncodeToString(invoice.RHash)
+
+	m.logger.Errorw("Skipping Settled Invoice", "monitor", "ln", "error", err, "payment_hash", paymentHash, "settle_index", invoice.SettleIndex, "value", invoice.AmtPaidSat)
+	metrics.Incr("ln.settled_invoices_skipped", nil)
+
+	if m.ddclient != nil {
+		_ = m.ddclient.Event(&statsd.Event{
+			Title:     "Thunderdome Settled Invoice Skipped",
+			Text:      fmt.Sprintf(`Thunderdome Settled Invoice Skipped: payment_hash:%s settle_index:%d value:%d error:%v`, paymentHash, invoice.SettleIndex, invoice.AmtPaidSat, err),
+			Priority:  statsd.Normal,
+			AlertType: statsd.Error,
+		})
+	}
+
+}
+
+// handleSettledInvoice completes the ledger records for a settled invoice
+func (m *Monitor) handleSettledInvoice(ctx context.Context, invoice *lnrpc.Invoice) error {
+
+	var handledTx bool
+
+	// Get the payment_hash
+	paymentHash := hex.EncodeToString(invoice.RHash)
+
+	// Find the existing ledger record outbound
+	lr, err := m.store.GetLedgerRecord(ctx, paymentHash, tdrpc.OUT)
+	if err == nil {
+		// Update it with the value and status
+		lr.Status = tdrpc.COMPLETED
+		lr.Value = invoice.AmtPaidSat
+		handledTx = true
+
+		// Process the payment
+		err = m.store.ProcessLedgerRecord(ctx, lr)
+		if err != nil {
+			return fmt.Errorf("ProcessLedgerRecord Out Error: %v", err)
 		}
 
-		// Find the existing ledger record inbound
-		lr, err = m.store.GetLedgerRecord(ctx, paymentHash, tdrpc.IN)
-		if err == nil {
-			// Update it with the value and status
-			lr.Status = tdrpc.COMPLETED
-			lr.Value = invoice.AmtPaidSat
-			handledTx = true
+		m.logger.Infow("Processed Out Invoice", "monitor", "ln", "payment_hash", paymentHash, "value", invoice.AmtPaidSat)
 
-			// Process the payment
-			err = m.store.ProcessLedgerRecord(ctx, lr)
-			if err != nil {
-				m.logger.Errorw("ProcessLedgerRecord In Error", "monitor", "ln", "error", err, "payment_hash", paymentHash)
-				continue
-			}
+	} else if err != store.ErrNotFound {
+		return fmt.Errorf("GetLedgerRecord Error: %v", err)
+	}
 
-			m.logger.Infow("Processed In Invoice", "monitor", "ln", "payment_hash", paymentHash, "value", invoice.AmtPaidSat)
+	// Find the existing ledger record inbound
+	lr, err = m.store.GetLedgerRecord(ctx, paymentHash, tdrpc.IN)
+	if err == nil {
+		// Update it with the value and status
+		lr.Status = tdrpc.COMPLETED
+		lr.Value = invoice.AmtPaidSat
+		handledTx = true
 
-		} else if err != store.ErrNotFound {
-			m.logger.Fatalw("GetLedgerRecord Error", "monitor", "ln", "error", err)
+		// Process the payment
+		err = m.store.ProcessLedgerRecord(ctx, lr)
+		if err != nil {
+			return fmt.Errorf("ProcessLedgerRecord In Error: %v", err)
 		}
 
-		if !handledTx {
-			m.logger.Infow("Did not find LedgerRecord for Invoice", "monitor", "ln", "payment_hash", paymentHash, "value", invoice.AmtPaidSat)
+		m.logger.Infow("Processed In Invoice", "monitor", "ln", "payment_hash", paymentHash, "value", invoice.AmtPaidSat)
+
+	} else if err != store.ErrNotFound {
+		return fmt.Errorf("GetLedgerRecord Error: %v", err)
+	}
+
+	if !handledTx {
+		m.logger.Infow("Did not find LedgerRecord for Invoice", "monitor", "ln", "payment_hash", paymentHash, "value", invoice.AmtPaidSat)
+	}
+
+	return nil
+
+}
+
+// handleHoldInvoice updates the ledger when a hold invoice is accepted (paid but not settled) or canceled
+func (m *Monitor) handleHoldInvoice(ctx context.Context, invoice *lnrpc.Invoice) {
+
+	paymentHash := hex.EncodeToString(invoice.RHash)
+
+	lr, err := m.store.GetLedgerRecord(ctx, paymentHash, tdrpc.IN)
+	if err == store.ErrNotFound {
+		m.logger.Infow("Did not find LedgerRecord for Hold Invoice", "monitor", "ln", "payment_hash", paymentHash, "state", invoice.State.String())
+		return
+	} else if err != nil {
+		m.logger.Errorw("GetLedgerRecord Error", "monitor", "ln", "error", err, "payment_hash", paymentHash)
+		return
+	}
+
+	if !lr.Hold {
+		return
+	}
+
+	switch invoice.State {
+	case lnrpc.Invoice_ACCEPTED:
+		if lr.Status == tdrpc.EXPIRED {
+			// It was paid after it expired, return the funds to the payer
+			if _, err = m.iclient.CancelInvoice(ctx, &invoicesrpc.CancelInvoiceMsg{PaymentHash: invoice.RHash}); err != nil {
+				m.logger.Errorw("LND CancelInvoice Error", "monitor", "ln", "error", err, "payment_hash", paymentHash)
+			}
+			return
+		} else if lr.Status != tdrpc.PENDING {
+			return
+		}
+		lr.Status = tdrpc.ACCEPTED
+		if invoice.AmtPaidSat > 0 {
+			lr.Value = invoice.AmtPaidSat
+		}
+		lr.CancelHeight = holdCancelHeight(invoice)
+		if lr.CancelHeight == 0 {
+			m.logger.Warnw("Hold Invoice Accepted Without HTLCs", "monitor", "ln", "payment_hash", paymentHash)
+		}
+
+	case lnrpc.Invoice_CANCELED:
+		if lr.Status != tdrpc.PENDING && lr.Status != tdrpc.ACCEPTED {
+			return
 		}
+		lr.Status = tdrpc.EXPIRED
+	}
+
+	if err = m.store.ProcessLedgerRecord(ctx, lr); err != nil {
+		m.logger.Errorw("ProcessLedgerRecord Hold Error", "monitor", "ln", "error", err, "payment_hash", paymentHash)
+		return
+	}
 
+	m.logger.Infow("Processed Hold Invoice", "monitor", "ln", "payment_hash", paymentHash, "status", lr.Status.String(), "value", lr.Value)
+
+}
+
+// holdCancelHeight returns the block height an accepted hold invoice is canceled at, tdome.hold_cancel_blocks before
+// its first accepted htlc expires. It returns 0 without accepted htlcs, lnd cancels them itself when they expire.
+func holdCancelHeight(invoice *lnrpc.Invoice) int64 {
+
+	var expiry int32
+	for _, htlc := range invoice.Htlcs {
+		if htlc.State == lnrpc.InvoiceHTLCState_ACCEPTED && (expiry == 0 || htlc.ExpiryHeight < expiry) {
+			expiry = htlc.ExpiryHeight
+		}
+	}
+	if expiry == 0 {
+		return 0
 	}
 
-	_ = invclient.CloseSend()
-	conf.Stop.Done()
+	return int64(expiry) - config.GetInt64("tdome.hold_cancel_blocks")
 
 }
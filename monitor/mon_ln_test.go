@@ -0,0 +1,128 @@
+package monitor
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/invoicesrpc"
+	config "github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+
+	_ "git.coinninja.net/backend/thunderdome/conf"
+	"git.coinninja.net/backend/thunderdome/mocks"
+	"git.coinninja.net/backend/thunderdome/store"
+	"git.coinninja.net/backend/thunderdome/tdrpc"
+)
+
+// invoiceStream returns invoices and then io.EOF
+type invoiceStream struct {
+	lnrpc.Lightning_SubscribeInvoicesClient
+	invoices []*lnrpc.Invoice
+}
+
+func (is *invoiceStream) Recv() (*lnrpc.Invoice, error) {
+	if len(is.invoices) == 0 {
+		return nil, io.EOF
+	}
+	invoice := is.invoices[0]
+	is.invoices = is.invoices[1:]
+	return invoice, nil
+}
+
+func TestReceiveInvoicesSkipsFailed(t *testing.T) {
+
+	defer config.Set("monitor.ln_settle_attempts", config.GetInt("monitor.ln_settle_attempts"))
+	config.Set("monitor.ln_settle_attempts", 2)
+
+	// Mocks
+	mockStore := new(mocks.Store)
+	mockLClient := new(mocks.LightningClient)
+
+	m := &Monitor{
+		logger:          zap.S(),
+		store:           mockStore,
+		lclient:         mockLClient,
+		health:          make(map[string]*MonitorHealth),
+		lastSettleIndex: 4,
+	}
+
+	failing := &lnrpc.Invoice{RHash: []byte{0x01}, Settled: true, SettleIndex: 5, AmtPaidSat: 1000}
+	working := &lnrpc.Invoice{RHash: []byte{0x02}, Settled: true, SettleIndex: 6, AmtPaidSat: 2000}
+
+	// The first invoice can't be processed
+	mockStore.On("GetLedgerRecord", mock.AnythingOfType("*context.emptyCtx"), "01", tdrpc.OUT).Return(nil, errors.New("broken"))
+
+	// It's retried from the checkpoint by reconnecting
+	err := m.receiveInvoices(context.Background(), &invoiceStream{invoices: []*lnrpc.Invoice{failing, working}})
+	assert.NotNil(t, err)
+	assert.NotEqual(t, io.EOF, err)
+	mockStore.AssertNotCalled(t, "SaveMonitorCheckpoint", mock.Anything, mock.Anything, mock.Anything)
+
+	// After failing again it's skipped and the checkpoint moves past it
+	mockStore.On("SaveMonitorCheckpoint", mock.AnythingOfType("*context.emptyCtx"), tdrpc.MonitorCheckpointLNSettleIndex, int64(5)).Once().Return(nil)
+	mockStore.On("GetLedgerRecord", mock.AnythingOfType("*context.emptyCtx"), "02", tdrpc.OUT).Once().Return(nil, store.ErrNotFound)
+	mockStore.On("GetLedgerRecord", mock.AnythingOfType("*context.emptyCtx"), "02", tdrpc.IN).Once().Return(&tdrpc.LedgerRecord{Id: "02", Status: tdrpc.PENDING, Direction: tdrpc.IN}, nil)
+	mockStore.On("ProcessLedgerRecord", mock.AnythingOfType("*context.emptyCtx"), mock.MatchedBy(func(lr *tdrpc.LedgerRecord) bool {
+		return lr.Id == "02" && lr.Status == tdrpc.COMPLETED && lr.Value == 2000
+	})).Once().Return(nil)
+	mockStore.On("SaveMonitorCheckpoint", mock.AnythingOfType("*context.emptyCtx"), tdrpc.MonitorCheckpointLNSettleIndex, int64(6)).Once().Return(nil)
+
+	err = m.receiveInvoices(context.Background(), &invoiceStream{invoices: []*lnrpc.Invoice{failing, working}})
+	assert.EqualError(t, err, "LightningMonitor Closed Connection")
+	assert.Equal(t, uint64(6), m.lastSettleIndex)
+	assert.Empty(t, m.settleAttempts)
+
+	mockStore.AssertExpectations(t)
+
+}
+
+func TestHandleHoldInvoice(t *testing.T) {
+
+	// Mocks
+	mockStore := new(mocks.Store)
+	mockIClient := new(mocks.InvoicesClient)
+
+	m := &Monitor{
+		logger:  zap.S(),
+		store:   mockStore,
+		iclient: mockIClient,
+	}
+
+	// Accepted, the cancel height is before the first accepted htlc expires
+	accepted := &lnrpc.Invoice{RHash: []byte{0x01}, State: lnrpc.Invoice_ACCEPTED, AmtPaidSat: 1200, Htlcs: []*lnrpc.InvoiceHTLC{
+		{State: lnrpc.InvoiceHTLCState_CANCELED, ExpiryHeight: 600000},
+		{State: lnrpc.InvoiceHTLCState_ACCEPTED, ExpiryHeight: 600200},
+		{State: lnrpc.InvoiceHTLCState_ACCEPTED, ExpiryHeight: 600144},
+	}}
+	mockStore.On("GetLedgerRecord", mock.AnythingOfType("*context.emptyCtx"), "01", tdrpc.IN).Once().Return(&tdrpc.LedgerRecord{Id: "01", Status: tdrpc.PENDING, Direction: tdrpc.IN, Value: 1000, Hold: true}, nil)
+	mockStore.On("ProcessLedgerRecord", mock.AnythingOfType("*context.emptyCtx"), mock.MatchedBy(func(lr *tdrpc.LedgerRecord) bool {
+		return lr.Id == "01" && lr.Status == tdrpc.ACCEPTED && lr.Value == 1200 && lr.CancelHeight == 600144-config.GetInt64("tdome.hold_cancel_blocks")
+	})).Once().Return(nil)
+	m.handleHoldInvoice(context.Background(), accepted)
+
+	// Canceled in lnd, the accepted record is expired
+	canceled := &lnrpc.Invoice{RHash: []byte{0x01}, State: lnrpc.Invoice_CANCELED}
+	mockStore.On("GetLedgerRecord", mock.AnythingOfType("*context.emptyCtx"), "01", tdrpc.IN).Once().Return(&tdrpc.LedgerRecord{Id: "01", Status: tdrpc.ACCEPTED, Direction: tdrpc.IN, Value: 1200, Hold: true, CancelHeight: 600120}, nil)
+	mockStore.On("ProcessLedgerRecord", mock.AnythingOfType("*context.emptyCtx"), mock.MatchedBy(func(lr *tdrpc.LedgerRecord) bool {
+		return lr.Id == "01" && lr.Status == tdrpc.EXPIRED
+	})).Once().Return(nil)
+	m.handleHoldInvoice(context.Background(), canceled)
+
+	// Accepted after it expired, it's canceled in lnd and the record is left expired
+	mockStore.On("GetLedgerRecord", mock.AnythingOfType("*context.emptyCtx"), "01", tdrpc.IN).Once().Return(&tdrpc.LedgerRecord{Id: "01", Status: tdrpc.EXPIRED, Direction: tdrpc.IN, Hold: true}, nil)
+	mockIClient.On("CancelInvoice", mock.AnythingOfType("*context.emptyCtx"), &invoicesrpc.CancelInvoiceMsg{PaymentHash: []byte{0x01}}).Once().Return(&invoicesrpc.CancelInvoiceResp{}, nil)
+	m.handleHoldInvoice(context.Background(), accepted)
+
+	// Already completed, nothing changes
+	mockStore.On("GetLedgerRecord", mock.AnythingOfType("*context.emptyCtx"), "01", tdrpc.IN).Once().Return(&tdrpc.LedgerRecord{Id: "01", Status: tdrpc.COMPLETED, Direction: tdrpc.IN, Hold: true}, nil)
+	m.handleHoldInvoice(context.Background(), canceled)
+
+	mockStore.AssertExpectations(t)
+	mockIClient.AssertExpectations(t)
+
+}
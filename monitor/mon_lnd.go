@@ -4,7 +4,7 @@ import (
 	"context"
 	"time"
 
-	"git.coinninja.net/backend/thunderdome/conf"
+	"git.coinninja.net/backend/thunderdome/metrics"
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"go.uber.org/zap"
 )
@@ -20,12 +20,14 @@ type LNDStats struct {
 	ChannelCount          int   `json:"channel_count"`
 }
 
-func (m *Monitor) MonitorLND() {
+func (m *Monitor) MonitorLND() error {
+
+	stop := m.stop()
 
 	// Handle shutting down
 	ctx, cancel := context.WithCancel(context.Background())
 	go func() {
-		<-conf.Stop.Chan()
+		<-stop.Chan()
 		cancel()
 	}()
 
@@ -34,14 +36,14 @@ func (m *Monitor) MonitorLND() {
 
 	// Monitor the channels and balance of the LND node
 monLoop:
-	for !conf.Stop.Bool() {
+	for !stop.Bool() {
 
 		// Sleep 1 minute
 		select {
 		case <-firstRun:
-		case <-conf.Stop.Chan():
+		case <-stop.Chan():
 			break monLoop
-		case <-time.After(1 * time.Minute):
+		case <-time.After(monitorIntervals[monitorLND]):
 		}
 
 		var stats LNDStats
@@ -78,16 +80,18 @@ monLoop:
 		}
 		m.logger.Debugw("LND Stats", zap.Any("lnd_stats", stats))
 
-		if m.ddclient != nil {
-			_ = m.ddclient.Gauge("lnd.total_balance", float64(stats.TotalBalance), []string{}, 1)
-			_ = m.ddclient.Gauge("lnd.confirmed_balance", float64(stats.ConfirmedBalance), []string{}, 1)
-			_ = m.ddclient.Gauge("lnd.unconfirmed_balance", float64(stats.UnconfirmedBalance), []string{}, 1)
-			_ = m.ddclient.Gauge("lnd.channel_balance", float64(stats.ChannelBalance), []string{}, 1)
-			_ = m.ddclient.Gauge("lnd.channel_pending_balance", float64(stats.ChannelPendingBalance), []string{}, 1)
-			_ = m.ddclient.Gauge("lnd.local_balance", float64(stats.LocalBalance), []string{}, 1)
-			_ = m.ddclient.Gauge("lnd.remote_balance", float64(stats.RemoteBalance), []string{}, 1)
-			_ = m.ddclient.Gauge("lnd.channel_count", float64(stats.ChannelCount), []string{}, 1)
-		}
+		metrics.Gauge("lnd.total_balance", float64(stats.TotalBalance), nil)
+		metrics.Gauge("lnd.confirmed_balance", float64(stats.ConfirmedBalance), nil)
+		metrics.Gauge("lnd.unconfirmed_balance", float64(stats.UnconfirmedBalance), nil)
+		metrics.Gauge("lnd.channel_balance", float64(stats.ChannelBalance), nil)
+		metrics.Gauge("lnd.channel_pending_balance", float64(stats.ChannelPendingBalance), nil)
+		metrics.Gauge("lnd.local_balance", float64(stats.LocalBalance), nil)
+		metrics.Gauge("lnd.remote_balance", float64(stats.RemoteBalance), nil)
+		metrics.Gauge("lnd.channel_count", float64(stats.ChannelCount), nil)
+
+		m.event(monitorLND)
 	}
 
+	return nil
+
 }
@@ -2,12 +2,14 @@ package monitor
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"sort"
 	"strings"
+	"time"
 
-	"git.coinninja.net/backend/thunderdome/conf"
+	"git.coinninja.net/backend/thunderdome/metrics"
 	"git.coinninja.net/backend/thunderdome/store"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/lightningnetwork/lnd/lnrpc"
@@ -15,30 +17,37 @@ import (
 	"google.golang.org/grpc/status"
 )
 
-func (m *Monitor) MonitorLNDChan() {
+// MonitorLNDChan keeps the channel backup current and stores each change to it
+// It returns when the connection to lnd is lost so the supervisor can reconnect
+func (m *Monitor) MonitorLNDChan() error {
+
+	stop := m.stop()
 
 	// Handle shutting down
 	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
 	go func() {
-		<-conf.Stop.Chan()
-		cancel()
+		select {
+		case <-stop.Chan():
+			cancel()
+		case <-ctx.Done():
+		}
 	}()
 
 	// Fetch the current backup
 	snapshot, err := m.lclient.ExportAllChannelBackups(ctx, &lnrpc.ChanBackupExportRequest{})
 	if err != nil {
-		m.logger.Fatalw("LND ExportAllChannelBackups Error", "monitor", "lnd_chan", "error", err)
+		return fmt.Errorf("LND ExportAllChannelBackups Error: %v", err)
 	}
 
 	cb, err := m.cbstore.GetLastChanBackup(ctx)
 	if err == store.ErrNotFound {
 		// No backup, always store
-		_, err = m.cbstore.StoreChanBackup(ctx, getChanBackupSnapshotFundingTXIDs(snapshot), snapshot.MultiChanBackup.MultiChanBackup)
-		if err != nil {
-			m.logger.Fatalw("Could not StoreChanBackup", "monitor", "lnd_chan", "error", err)
+		if err = m.storeChanBackup(ctx, snapshot); err != nil {
+			return err
 		}
 	} else if err != nil {
-		m.logger.Fatalw("LND GetLastChanBackup Error", "monitor", "lnd_chan", "error", err)
+		return fmt.Errorf("LND GetLastChanBackup Error: %v", err)
 	} else {
 		// We fetched an existing channel backup, validate it
 		_, err := m.lclient.VerifyChanBackup(ctx, &lnrpc.ChanBackupSnapshot{
@@ -49,9 +58,8 @@ func (m *Monitor) MonitorLNDChan() {
 		// Failed validation, perform backup
 		if err != nil {
 			m.logger.Infow("Channel Backup fails validation, performing backup", "error", err)
-			_, err = m.cbstore.StoreChanBackup(ctx, getChanBackupSnapshotFundingTXIDs(snapshot), snapshot.MultiChanBackup.MultiChanBackup)
-			if err != nil {
-				m.logger.Fatalw("Could not StoreChanBackup", "monitor", "lnd_chan", "error", err)
+			if err = m.storeChanBackup(ctx, snapshot); err != nil {
+				return err
 			}
 		} else {
 			m.logger.Debugw("Channel backup passes validation", "monitor", "lnd_chan")
@@ -59,51 +67,83 @@ func (m *Monitor) MonitorLNDChan() {
 			// Check to make sure the funding TXIDs list matches
 			if cb.FundingTXIDs != getChanBackupSnapshotFundingTXIDs(snapshot) {
 				m.logger.Infow("Channel Backup funding TXID mismatch, performing backup")
-				_, err = m.cbstore.StoreChanBackup(ctx, getChanBackupSnapshotFundingTXIDs(snapshot), snapshot.MultiChanBackup.MultiChanBackup)
-				if err != nil {
-					m.logger.Fatalw("Could not StoreChanBackup", "monitor", "lnd_chan", "error", err)
+				if err = m.storeChanBackup(ctx, snapshot); err != nil {
+					return err
 				}
 			} else {
 				m.logger.Infow("Channel Backup Current", "monitor", "lnd_chan")
+				// The sinks may not have it yet
+				m.exportChanBackup(ctx, snapshot.MultiChanBackup.MultiChanBackup)
 			}
 		}
 	}
 
+	m.event(monitorLNDChan)
+
 	// Connect to the channel stream
-	conf.Stop.Add(1)
 	chanBackupClient, err := m.lclient.SubscribeChannelBackups(ctx, &lnrpc.ChannelBackupSubscription{})
 	if err != nil {
-		m.logger.Fatalw("Could not SubscribeChannelBackups", "monitor", "lnd_chan", "error", err)
+		return fmt.Errorf("Could not SubscribeChannelBackups: %v", err)
 	}
+	defer func() { _ = chanBackupClient.CloseSend() }()
 
 	m.logger.Infow("Listening for channel backups...", "monitor", "lnd_chan")
 
-	for !conf.Stop.Bool() {
+	for {
 
 		// Get the next message
 		snapshot, err = chanBackupClient.Recv()
 		if err == io.EOF {
-			m.logger.Fatalw("LND Chan Backup EOF", "monitor", "lnd_chan")
-			continue
+			return errors.New("LND Chan Backup EOF")
 		} else if status.Code(err) == codes.Canceled {
 			m.logger.Info("LND Chan Backup Shutting Down")
-			break
+			return nil
 		} else if err != nil {
-			m.logger.Fatalw("LND Chan Backup Failure", "monitor", "lnd_chan", "error", err)
+			return fmt.Errorf("LND Chan Backup Failure: %v", err)
 		}
 
 		// Store the channel backup
-		_, err = m.cbstore.StoreChanBackup(ctx, getChanBackupSnapshotFundingTXIDs(snapshot), snapshot.MultiChanBackup.MultiChanBackup)
-		if err != nil {
-			m.logger.Fatalw("Could not StoreChanBackup", "monitor", "lnd_chan", "error", err)
+		if err = m.storeChanBackup(ctx, snapshot); err != nil {
+			return err
 		}
 
+		m.event(monitorLNDChan)
+
 		m.logger.Infow("Backed Up Channel Snapshot Change", "monitor", "lnd_chan")
 
 	}
 
-	_ = chanBackupClient.CloseSend()
-	conf.Stop.Done()
+}
+
+// storeChanBackup stores the backup in the database and exports it to the configured sinks
+func (m *Monitor) storeChanBackup(ctx context.Context, snapshot *lnrpc.ChanBackupSnapshot) error {
+
+	_, err := m.cbstore.StoreChanBackup(ctx, getChanBackupSnapshotFundingTXIDs(snapshot), snapshot.MultiChanBackup.MultiChanBackup)
+	if err != nil {
+		return fmt.Errorf("Could not StoreChanBackup: %v", err)
+	}
+
+	m.exportChanBackup(ctx, snapshot.MultiChanBackup.MultiChanBackup)
+
+	return nil
+
+}
+
+// exportChanBackup exports the backup to the configured sinks
+// The sinks are off-site so a failure is only logged, the monitor keeps running
+func (m *Monitor) exportChanBackup(ctx context.Context, data []byte) {
+
+	if m.cbexporter == nil {
+		return
+	}
+
+	if err := m.cbexporter.Export(ctx, data, time.Now()); err != nil {
+		metrics.Incr("chanbackup.exports", metrics.Labels{"result": "failed"})
+		m.logger.Errorw("Could not export channel backup", "monitor", "lnd_chan", "error", err)
+		return
+	}
+
+	metrics.Incr("chanbackup.exports", metrics.Labels{"result": "completed"})
 
 }
 
@@ -6,7 +6,7 @@ import (
 
 	"go.uber.org/zap"
 
-	"git.coinninja.net/backend/thunderdome/conf"
+	"git.coinninja.net/backend/thunderdome/metrics"
 	"git.coinninja.net/backend/thunderdome/tdrpc"
 )
 
@@ -22,15 +22,18 @@ type Stats struct {
 	TopupInstantPendingValue int64 `json:"topup_instant_pending_value"`
 	NetworkFee               int64 `json:"network_fee"`
 	ProcessingFee            int64 `json:"processing_fee"`
+	RebalanceFee             int64 `json:"rebalance_fee"`
 }
 
 // MonitorStats will log stats from the local system
-func (m *Monitor) MonitorStats() {
+func (m *Monitor) MonitorStats() error {
+
+	stop := m.stop()
 
 	// Handle shutting down
 	ctx, cancel := context.WithCancel(context.Background())
 	go func() {
-		<-conf.Stop.Chan()
+		<-stop.Chan()
 		cancel()
 	}()
 
@@ -62,18 +65,29 @@ func (m *Monitor) MonitorStats() {
 	}
 	stats.ProcessingFee = lrStats.ProcessingFee
 
+	// For channel rebalance fees paid
+	lrStats, err = m.store.GetLedgerRecordStats(ctx, map[string]string{
+		"type":      tdrpc.REBALANCE.String(),
+		"direction": tdrpc.OUT.String(),
+		"status":    tdrpc.COMPLETED.String(),
+	}, time.Time{})
+	if err != nil {
+		m.logger.Errorw("Could not get system ledger record stats", "error", err)
+	}
+	stats.RebalanceFee = lrStats.NetworkFee
+
 	lastPoll := time.Now()
 
 	// Monitor the channels and balance of the LND node
 monLoop:
-	for !conf.Stop.Bool() {
+	for !stop.Bool() {
 
 		// Sleep 1 minute
 		select {
 		case <-firstRun:
-		case <-conf.Stop.Chan():
+		case <-stop.Chan():
 			break monLoop
-		case <-time.After(1 * time.Minute):
+		case <-time.After(monitorIntervals[monitorStats]):
 		}
 
 		// Get regular pending stats
@@ -138,24 +152,39 @@ monLoop:
 		}
 		stats.ProcessingFee += lrStats.ProcessingFee
 
+		// Get the rebalance fee stats
+		lrStats, err = m.store.GetLedgerRecordStats(ctx, map[string]string{
+			"type":      tdrpc.REBALANCE.String(),
+			"direction": tdrpc.OUT.String(),
+			"status":    tdrpc.COMPLETED.String(),
+		}, lastPoll)
+		if err != nil {
+			m.logger.Errorw("Could not get polled rebalance fee stats", "error", err)
+			continue
+		}
+		stats.RebalanceFee += lrStats.NetworkFee
+
 		// TODO: This could miss some transactions in between when above stats queries are run and this value is set
 		lastPoll = time.Now()
 
 		m.logger.Debugw("Stats", zap.Any("stats", stats))
 
-		if m.ddclient != nil {
-			_ = m.ddclient.Gauge("user_count", float64(stats.UserCount), []string{}, 1)
-			_ = m.ddclient.Gauge("user_locked_count", float64(stats.UserLockedCount), []string{}, 1)
-			_ = m.ddclient.Gauge("user_balance", float64(stats.UserBalance), []string{}, 1)
-			_ = m.ddclient.Gauge("user_pending_in", float64(stats.UserPendingIn), []string{}, 1)
-			_ = m.ddclient.Gauge("user_pending_out", float64(stats.UserPendingOut), []string{}, 1)
-			_ = m.ddclient.Gauge("topup_pending_count", float64(stats.TopupPendingCount), []string{}, 1)
-			_ = m.ddclient.Gauge("topup_pending_value", float64(stats.TopupPendingValue), []string{}, 1)
-			_ = m.ddclient.Gauge("topup_instant_pending_count", float64(stats.TopupInstantPendingCount), []string{}, 1)
-			_ = m.ddclient.Gauge("topup_instant_pending_value", float64(stats.TopupInstantPendingValue), []string{}, 1)
-			_ = m.ddclient.Gauge("network_fee", float64(stats.NetworkFee), []string{}, 1)
-			_ = m.ddclient.Gauge("processing_fee", float64(stats.ProcessingFee), []string{}, 1)
-		}
+		metrics.Gauge("user_count", float64(stats.UserCount), nil)
+		metrics.Gauge("user_locked_count", float64(stats.UserLockedCount), nil)
+		metrics.Gauge("user_balance", float64(stats.UserBalance), nil)
+		metrics.Gauge("user_pending_in", float64(stats.UserPendingIn), nil)
+		metrics.Gauge("user_pending_out", float64(stats.UserPendingOut), nil)
+		metrics.Gauge("topup_pending_count", float64(stats.TopupPendingCount), nil)
+		metrics.Gauge("topup_pending_value", float64(stats.TopupPendingValue), nil)
+		metrics.Gauge("topup_instant_pending_count", float64(stats.TopupInstantPendingCount), nil)
+		metrics.Gauge("topup_instant_pending_value", float64(stats.TopupInstantPendingValue), nil)
+		metrics.Gauge("network_fee", float64(stats.NetworkFee), nil)
+		metrics.Gauge("processing_fee", float64(stats.ProcessingFee), nil)
+		metrics.Gauge("rebalance_fee", float64(stats.RebalanceFee), nil)
+
+		m.event(monitorStats)
 	}
 
+	return nil
+
 }
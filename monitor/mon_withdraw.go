@@ -0,0 +1,122 @@
+package monitor
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+
+	"git.coinninja.net/backend/thunderdome/store"
+	"git.coinninja.net/backend/thunderdome/tdrpc"
+	"git.coinninja.net/backend/thunderdome/tdrpc/sender"
+)
+
+// withdrawRecoveryGrace is how long a withdraw can keep its temporary id before it's considered orphaned
+const withdrawRecoveryGrace = 5 * time.Minute
+
+// MonitorWithdraws will recover withdraws that were sent but never renamed from their temporary id
+// This happens if the process stops between sending the coins and updating the ledger record id
+func (m *Monitor) MonitorWithdraws() error {
+
+	stop := m.stop()
+
+	// Handle shutting down
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-stop.Chan()
+		cancel()
+	}()
+
+	firstRun := make(chan struct{}, 1)
+	firstRun <- struct{}{}
+
+monLoop:
+	for !stop.Bool() {
+
+		select {
+		case <-firstRun:
+		case <-stop.Chan():
+			break monLoop
+		case <-time.After(monitorIntervals[monitorWithdraw]):
+		}
+
+		if err := m.recoverWithdraws(ctx); err != nil {
+			m.logger.Errorw("Withdraw Recovery Error", "monitor", "withdraw", "error", err)
+			continue
+		}
+
+		m.event(monitorWithdraw)
+
+	}
+
+	return nil
+
+}
+
+// recoverWithdraws matches orphaned temporary withdraws against the wallet transactions by address and amount and renames them
+func (m *Monitor) recoverWithdraws(ctx context.Context) error {
+
+	lrs, err := m.store.GetLedger(ctx, map[string]string{
+		"type":      tdrpc.BTC.String(),
+		"direction": tdrpc.OUT.String(),
+		"status":    tdrpc.PENDING.String(),
+		"hidden":    "*",
+	}, time.Time{}, 0, 0)
+	if err != nil {
+		return err
+	}
+
+	var txs *lnrpc.TransactionDetails
+	for _, lr := range lrs {
+
+		if !strings.HasPrefix(lr.Id, tdrpc.TempLedgerRecordIdPrefix) || lr.CreatedAt == nil || time.Since(*lr.CreatedAt) < withdrawRecoveryGrace {
+			continue
+		}
+
+		address := lr.WithdrawAddress()
+		if address == "" {
+			m.logger.Warnw("Could not determine withdraw address", "monitor", "withdraw", "id", lr.Id)
+			continue
+		}
+
+		// Only fetch the transactions if there is something to recover
+		if txs == nil {
+			txs, err = m.lclient.GetTransactions(ctx, &lnrpc.GetTransactionsRequest{})
+			if err != nil {
+				return err
+			}
+		}
+
+		tx, err := sender.MatchWithdrawTransaction(ctx, m.store, lr, address, txs.Transactions)
+		if err != nil {
+			return err
+		}
+		if tx == nil {
+			m.logger.Warnw("Withdraw transaction not found", "monitor", "withdraw", "id", lr.Id, "account_id", lr.AccountId, "address", address, "value", lr.Value)
+			continue
+		}
+
+		err = m.store.UpdateLedgerRecordID(ctx, lr.Id, tx.TxHash, tdrpc.OUT)
+		if err == store.ErrAlreadyExists {
+			continue
+		} else if err != nil {
+			return err
+		}
+		lr.Id = tx.TxHash
+
+		m.logger.Infow("Recovered Withdraw", "monitor", "withdraw", "id", lr.Id, "account_id", lr.AccountId, "address", address, "value", lr.Value)
+
+		// The BTC monitor may have already seen it confirm without knowing about it
+		if tx.NumConfirmations > 0 {
+			lr.Status = tdrpc.COMPLETED
+			if err = m.store.ProcessLedgerRecord(ctx, lr); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	return nil
+
+}
@@ -3,14 +3,21 @@ package monitor
 import (
 	"context"
 	"fmt"
+	"sync"
+	"sync/atomic"
 
 	"github.com/DataDog/datadog-go/statsd"
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/invoicesrpc"
+	config "github.com/spf13/viper"
 	"go.uber.org/zap"
 
 	"git.coinninja.net/backend/blocc/blocc"
 
+	"git.coinninja.net/backend/thunderdome/chanbackup"
+	"git.coinninja.net/backend/thunderdome/lsp"
+	"git.coinninja.net/backend/thunderdome/store"
 	"git.coinninja.net/backend/thunderdome/tdrpc"
 )
 
@@ -19,15 +26,41 @@ type Monitor struct {
 	store   tdrpc.Store
 	cbstore tdrpc.ChanBackupStore
 
+	// Exports channel backups off-site, nil if there are no sinks
+	cbexporter *chanbackup.Exporter
+
 	lclient lnrpc.LightningClient
+	iclient invoicesrpc.InvoicesClient
 	bclient blocc.BloccRPCClient
 
+	cache store.DistCache
+
 	ddclient *statsd.Client
 
+	// The liquidity provider inbound channels are requested from
+	lsp lsp.Provider
+
 	chain *chaincfg.Params
+
+	// The last invoice settle index saved by MonitorLN
+	lastSettleIndex uint64
+	// The last block height saved by MonitorBTC
+	lastBlockHeight int32
+	// The failed attempts to process each settled invoice by settle index
+	settleAttempts map[uint64]int
+
+	// Leader election state, leader is 1 while this instance runs the monitors
+	instanceID string
+	leader     int32
+	// The *term of the running monitors
+	term atomic.Value
+
+	// The health of each monitor tracked by the supervisor
+	health     map[string]*MonitorHealth
+	healthLock sync.Mutex
 }
 
-func NewMonitor(store tdrpc.Store, cbstore tdrpc.ChanBackupStore, lclient lnrpc.LightningClient, bclient blocc.BloccRPCClient, ddclient *statsd.Client) (*Monitor, error) {
+func NewMonitor(store tdrpc.Store, cbstore tdrpc.ChanBackupStore, lclient lnrpc.LightningClient, iclient invoicesrpc.InvoicesClient, bclient blocc.BloccRPCClient, cache store.DistCache, ddclient *statsd.Client, provider lsp.Provider, cbexporter *chanbackup.Exporter) (*Monitor, error) {
 
 	logger := zap.S().With("package", "txmonitor")
 
@@ -84,22 +117,69 @@ func NewMonitor(store tdrpc.Store, cbstore tdrpc.ChanBackupStore, lclient lnrpc.
 		store:   store,
 		cbstore: cbstore,
 
+		cbexporter: cbexporter,
+
 		lclient: lclient,
+		iclient: iclient,
 		bclient: bclient,
 
+		cache: cache,
+
 		ddclient: ddclient,
 
+		lsp: provider,
+
 		chain: chain,
+
+		instanceID: newInstanceID(),
+
+		health: make(map[string]*MonitorHealth),
 	}
 
-	go m.MonitorBTC()
-	go m.MonitorLN()
-	go m.MonitorExpired()
-	go m.MonitorLND()
-	go m.MonitorLNDChan()
-	go m.MonitorStats()
-	go m.MonitorDB()
+	// With leader election only one instance runs the monitors at a time
+	if config.GetBool("monitor.leader_election") {
+		go m.elect()
+	} else {
+		atomic.StoreInt32(&m.leader, 1)
+		m.start()
+	}
 
 	return m, nil
 
 }
+
+// start runs all of the monitors under the supervisor and returns their term
+func (m *Monitor) start() *term {
+
+	t := newTerm()
+	m.term.Store(t)
+
+	supervise := func(name string, run func() error) {
+		t.Add(1)
+		go m.supervise(t, name, run)
+	}
+
+	supervise(monitorBTC, m.MonitorBTC)
+	supervise(monitorLN, m.MonitorLN)
+	supervise(monitorExpired, m.MonitorExpired)
+	supervise(monitorLND, m.MonitorLND)
+	supervise(monitorLNDChan, m.MonitorLNDChan)
+	supervise(monitorStats, m.MonitorStats)
+	supervise(monitorDB, m.MonitorDB)
+	supervise(monitorWithdraw, m.MonitorWithdraws)
+	if config.GetBool("liquidity.enabled") {
+		supervise(monitorLiquidity, m.MonitorLiquidity)
+	}
+	if config.GetBool("autopilot.enabled") {
+		supervise(monitorChannels, m.MonitorChannels)
+	}
+	if config.GetBool("lsp.enabled") {
+		supervise(monitorInbound, m.MonitorInbound)
+	}
+	if config.GetBool("chanbackup.db_prune") {
+		supervise(monitorCBPrune, m.MonitorChanBackupPrune)
+	}
+
+	return t
+
+}
@@ -0,0 +1,232 @@
+package monitor
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"git.coinninja.net/backend/thunderdome/conf"
+)
+
+// The names of the monitors
+const (
+	monitorBTC       = "btc"
+	monitorLN        = "ln"
+	monitorExpired   = "expired"
+	monitorLND       = "lnd"
+	monitorLNDChan   = "lnd_chan"
+	monitorStats     = "stats"
+	monitorDB        = "db"
+	monitorWithdraw  = "withdraw"
+	monitorLiquidity = "liquidity"
+	monitorChannels  = "channels"
+	monitorInbound   = "inbound"
+	monitorCBPrune   = "chanbackup_prune"
+)
+
+const (
+	superviseMinBackoff = time.Second
+	superviseMaxBackoff = time.Minute
+)
+
+// monitorIntervals is how often the polling monitors do work. They are stale if they have not done any work
+// in staleIntervals times as long. The streaming monitors only do work when something happens so they are never stale.
+var monitorIntervals = map[string]time.Duration{
+	monitorExpired:   2 * time.Minute,
+	monitorLND:       time.Minute,
+	monitorStats:     time.Minute,
+	monitorDB:        15 * time.Minute,
+	monitorWithdraw:  10 * time.Minute,
+	monitorLiquidity: 10 * time.Minute,
+	monitorChannels:  time.Hour,
+	monitorInbound:   10 * time.Minute,
+	monitorCBPrune:   time.Hour,
+}
+
+const staleIntervals = 3
+
+// superviseAfter waits for the backoff before restarting a monitor
+var superviseAfter = time.After
+
+// MonitorHealth is the health of a single monitor
+type MonitorHealth struct {
+	Name      string     `json:"name"`
+	Running   bool       `json:"running"`
+	Restarts  int        `json:"restarts"`
+	LastEvent *time.Time `json:"last_event,omitempty"`
+	LastError string     `json:"last_error,omitempty"`
+	Stale     bool       `json:"stale"`
+
+	// When it was last started
+	started time.Time
+}
+
+// Healthy returns true if the monitor is running and not stale
+func (mh MonitorHealth) Healthy() bool {
+	return mh.Running && !mh.Stale
+}
+
+// Health returns the health of every monitor that has been started
+func (m *Monitor) Health() []MonitorHealth {
+
+	m.healthLock.Lock()
+	defer m.healthLock.Unlock()
+
+	now := time.Now()
+	health := make([]MonitorHealth, 0, len(m.health))
+	for _, h := range m.health {
+		mh := *h
+		lastActive := h.started
+		if h.LastEvent != nil {
+			lastEvent := *h.LastEvent
+			mh.LastEvent = &lastEvent
+			if lastEvent.After(lastActive) {
+				lastActive = lastEvent
+			}
+		}
+		if interval, ok := monitorIntervals[h.Name]; ok && h.Running && now.Sub(lastActive) > staleIntervals*interval {
+			mh.Stale = true
+		}
+		health = append(health, mh)
+	}
+
+	sort.Slice(health, func(i, j int) bool { return health[i].Name < health[j].Name })
+
+	return health
+
+}
+
+// term stops the monitors that were started together. It ends when shutting down or when this instance
+// is no longer the leader and waits for the supervisors of its monitors to return.
+type term struct {
+	c    chan struct{}
+	once sync.Once
+	sync.WaitGroup
+}
+
+// newTerm returns a term that ends when shutting down
+func newTerm() *term {
+	t := &term{c: make(chan struct{})}
+	go func() {
+		select {
+		case <-conf.Stop.Chan():
+			t.end()
+		case <-t.c:
+		}
+	}()
+	return t
+}
+
+// Chan returns a channel that is closed when the term ends
+func (t *term) Chan() <-chan struct{} {
+	return t.c
+}
+
+// Bool returns true if the term has ended
+func (t *term) Bool() bool {
+	select {
+	case <-t.c:
+		return true
+	default:
+		return false
+	}
+}
+
+// end stops the monitors of the term, it can be called more than once
+func (t *term) end() {
+	t.once.Do(func() { close(t.c) })
+}
+
+// stop returns the term of the running monitors
+func (m *Monitor) stop() *term {
+	if t, ok := m.term.Load().(*term); ok {
+		return t
+	}
+	return newTerm()
+}
+
+// supervise runs a monitor and restarts it with an exponential backoff whenever it exits before the term ends
+// t.Add must be called before starting it
+func (m *Monitor) supervise(t *term, name string, run func() error) {
+
+	defer t.Done()
+	conf.Stop.Add(1)
+	defer conf.Stop.Done()
+
+	backoff := superviseMinBackoff
+
+	for {
+
+		started := time.Now()
+		m.updateHealth(name, func(h *MonitorHealth) {
+			h.Running = true
+			h.started = started
+		})
+		err := runRecover(run)
+		m.updateHealth(name, func(h *MonitorHealth) { h.Running = false })
+
+		if t.Bool() {
+			return
+		}
+
+		if err == nil {
+			err = errors.New("exited unexpectedly")
+		}
+
+		// If it was working before it failed, start the backoff over
+		var working bool
+		m.updateHealth(name, func(h *MonitorHealth) {
+			working = h.LastEvent != nil && h.LastEvent.After(started)
+			h.Restarts++
+			h.LastError = err.Error()
+		})
+		if working {
+			backoff = superviseMinBackoff
+		}
+
+		m.logger.Errorw("Monitor Failure, restarting", "monitor", name, "error", err, "retry", backoff.String())
+
+		select {
+		case <-superviseAfter(backoff):
+		case <-t.Chan():
+			return
+		}
+		if backoff *= 2; backoff > superviseMaxBackoff {
+			backoff = superviseMaxBackoff
+		}
+	}
+
+}
+
+// event records that a monitor did some work
+func (m *Monitor) event(name string) {
+	now := time.Now().UTC()
+	m.updateHealth(name, func(h *MonitorHealth) { h.LastEvent = &now })
+}
+
+// updateHealth calls update with the health of a monitor while holding the lock
+func (m *Monitor) updateHealth(name string, update func(h *MonitorHealth)) {
+
+	m.healthLock.Lock()
+	defer m.healthLock.Unlock()
+
+	h, ok := m.health[name]
+	if !ok {
+		h = &MonitorHealth{Name: name}
+		m.health[name] = h
+	}
+	update(h)
+
+}
+
+// runRecover runs a monitor returning any panic as an error
+func runRecover(run func() error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	return run()
+}
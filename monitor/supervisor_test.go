@@ -0,0 +1,120 @@
+package monitor
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func TestRunRecover(t *testing.T) {
+
+	assert.Nil(t, runRecover(func() error { return nil }))
+
+	err := errors.New("failed")
+	assert.Equal(t, err, runRecover(func() error { return err }))
+
+	// A panic is returned as an error
+	err = runRecover(func() error { panic("boom") })
+	assert.EqualError(t, err, "panic: boom")
+
+}
+
+func TestSupervise(t *testing.T) {
+
+	m := &Monitor{
+		logger: zap.S(),
+		health: make(map[string]*MonitorHealth),
+	}
+
+	// Record the backoff instead of waiting
+	var backoffs []time.Duration
+	defer func(after func(time.Duration) <-chan time.Time) { superviseAfter = after }(superviseAfter)
+	superviseAfter = func(d time.Duration) <-chan time.Time {
+		backoffs = append(backoffs, d)
+		c := make(chan time.Time, 1)
+		c <- time.Now()
+		return c
+	}
+
+	tm := newTerm()
+	var runs int
+	run := func() error {
+		runs++
+		switch {
+		case runs <= 8:
+			// Fails right away
+			return errors.New("failed")
+		case runs == 9:
+			// Works for a while before it fails
+			time.Sleep(time.Millisecond)
+			m.event("test")
+			panic("boom")
+		default:
+			// Stops at the end of the term
+			tm.end()
+			return nil
+		}
+	}
+
+	tm.Add(1)
+	done := make(chan struct{})
+	go func() {
+		m.supervise(tm, "test", run)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("supervise did not return at the end of the term")
+	}
+	tm.Wait()
+
+	// The backoff doubles up to the max and starts over after it was working
+	assert.Equal(t, 10, runs)
+	assert.Equal(t, []time.Duration{
+		time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 16 * time.Second, 32 * time.Second, time.Minute, time.Minute,
+		time.Second,
+	}, backoffs)
+
+	// Every restart is counted with the last error and it's not running once the term ends
+	health := m.Health()
+	if assert.Len(t, health, 1) {
+		assert.Equal(t, "test", health[0].Name)
+		assert.Equal(t, 9, health[0].Restarts)
+		assert.Equal(t, "panic: boom", health[0].LastError)
+		assert.False(t, health[0].Running)
+		assert.NotNil(t, health[0].LastEvent)
+	}
+
+}
+
+func TestSuperviseExitedUnexpectedly(t *testing.T) {
+
+	m := &Monitor{
+		logger: zap.S(),
+		health: make(map[string]*MonitorHealth),
+	}
+
+	tm := newTerm()
+	defer func(after func(time.Duration) <-chan time.Time) { superviseAfter = after }(superviseAfter)
+	superviseAfter = func(d time.Duration) <-chan time.Time {
+		// End the term while waiting to restart
+		tm.end()
+		return make(chan time.Time)
+	}
+
+	tm.Add(1)
+	m.supervise(tm, "test", func() error { return nil })
+
+	health := m.Health()
+	if assert.Len(t, health, 1) {
+		assert.Equal(t, 1, health[0].Restarts)
+		assert.Equal(t, "exited unexpectedly", health[0].LastError)
+		assert.False(t, health[0].Running)
+	}
+
+}
@@ -0,0 +1,217 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"sync"
+
+	"github.com/go-chi/chi"
+	"github.com/go-chi/render"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	config "github.com/spf13/viper"
+
+	"git.coinninja.net/backend/blocc/blocc"
+
+	"git.coinninja.net/backend/thunderdome/store"
+	"git.coinninja.net/backend/thunderdome/tdrpc"
+)
+
+// The status of a health check
+const (
+	HealthStatusOK       = "ok"
+	HealthStatusError    = "error"
+	HealthStatusDisabled = "disabled"
+)
+
+// HealthCheck is the result of checking a single dependency
+type HealthCheck struct {
+	Status string      `json:"status"`
+	Error  string      `json:"error,omitempty"`
+	Info   interface{} `json:"info,omitempty"`
+}
+
+// HealthResponse is the result of checking all dependencies
+type HealthResponse struct {
+	Status string                  `json:"status"`
+	Checks map[string]*HealthCheck `json:"checks"`
+}
+
+// HealthChecker checks the health of all of the dependencies
+type HealthChecker struct {
+	store   tdrpc.Store
+	cache   store.DistCache
+	lclient lnrpc.LightningClient
+	bclient blocc.BloccRPCClient
+
+	monitor     MonitorStatus
+	monitorLock sync.RWMutex
+}
+
+// NewHealthChecker creates a new health checker, the cache and bclient are optional
+func NewHealthChecker(store tdrpc.Store, cache store.DistCache, lclient lnrpc.LightningClient, bclient blocc.BloccRPCClient) *HealthChecker {
+	return &HealthChecker{
+		store:   store,
+		cache:   cache,
+		lclient: lclient,
+		bclient: bclient,
+	}
+}
+
+// SetMonitor includes the health of the monitors in the checks
+func (hc *HealthChecker) SetMonitor(monitor MonitorStatus) {
+	hc.monitorLock.Lock()
+	hc.monitor = monitor
+	hc.monitorLock.Unlock()
+}
+
+// Routes registers /healthz and /readyz
+// /healthz only reports that the process is up, /readyz returns a 503 if any check fails or the system is disabled with tdome.disabled
+func (hc *HealthChecker) Routes(r chi.Router) {
+
+	r.Get("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		hc.render(w, r, &HealthResponse{Status: HealthStatusOK, Checks: map[string]*HealthCheck{}})
+	})
+
+	r.Get("/readyz", func(w http.ResponseWriter, r *http.Request) {
+		response := hc.Check(r.Context())
+		if config.GetBool("tdome.disabled") {
+			response.Status = HealthStatusError
+			response.Checks["tdome"] = &HealthCheck{Status: HealthStatusError, Error: "tdome.disabled is set"}
+		}
+		hc.render(w, r, response)
+	})
+
+}
+
+func (hc *HealthChecker) render(w http.ResponseWriter, r *http.Request, response *HealthResponse) {
+	if response.Status != HealthStatusOK {
+		render.Status(r, http.StatusServiceUnavailable)
+	}
+	render.JSON(w, r, response)
+}
+
+// Check runs all of the health checks at once
+func (hc *HealthChecker) Check(ctx context.Context) *HealthResponse {
+
+	ctx, cancel := context.WithTimeout(ctx, config.GetDuration("server.health_check_timeout"))
+	defer cancel()
+
+	checks := map[string]func(context.Context) *HealthCheck{
+		"postgres": hc.checkPostgres,
+		"redis":    hc.checkRedis,
+		"lnd":      hc.checkLND,
+		"blocc":    hc.checkBlocc,
+		"monitor":  hc.checkMonitor,
+	}
+
+	response := &HealthResponse{
+		Status: HealthStatusOK,
+		Checks: make(map[string]*HealthCheck, len(checks)),
+	}
+
+	var wg sync.WaitGroup
+	var lock sync.Mutex
+	for name, check := range checks {
+		wg.Add(1)
+		go func(name string, check func(context.Context) *HealthCheck) {
+			defer wg.Done()
+			result := check(ctx)
+			lock.Lock()
+			response.Checks[name] = result
+			if result.Status == HealthStatusError {
+				response.Status = HealthStatusError
+			}
+			lock.Unlock()
+		}(name, check)
+	}
+	wg.Wait()
+
+	return response
+
+}
+
+// checkPostgres ensures the database is reachable and the schema is current
+func (hc *HealthChecker) checkPostgres(ctx context.Context) *HealthCheck {
+	version, err := hc.store.CheckSchema(ctx)
+	if err != nil {
+		return &HealthCheck{Status: HealthStatusError, Error: err.Error(), Info: map[string]int64{"version": version}}
+	}
+	return &HealthCheck{Status: HealthStatusOK, Info: map[string]int64{"version": version}}
+}
+
+// checkRedis ensures redis is reachable
+func (hc *HealthChecker) checkRedis(ctx context.Context) *HealthCheck {
+	if hc.cache == nil {
+		return &HealthCheck{Status: HealthStatusDisabled}
+	}
+	if err := hc.cache.Ping(); err != nil {
+		return &HealthCheck{Status: HealthStatusError, Error: err.Error()}
+	}
+	return &HealthCheck{Status: HealthStatusOK}
+}
+
+// checkLND ensures lnd is reachable and synced to the chain, being synced to the graph is only reported
+func (hc *HealthChecker) checkLND(ctx context.Context) *HealthCheck {
+	info, err := hc.lclient.GetInfo(ctx, &lnrpc.GetInfoRequest{})
+	if err != nil {
+		return &HealthCheck{Status: HealthStatusError, Error: err.Error()}
+	}
+	lndInfo := map[string]interface{}{
+		"synced_to_chain": info.SyncedToChain,
+		"synced_to_graph": info.SyncedToGraph,
+		"block_height":    info.BlockHeight,
+	}
+	if !info.SyncedToChain {
+		return &HealthCheck{Status: HealthStatusError, Error: "lnd is not synced to chain", Info: lndInfo}
+	}
+	return &HealthCheck{Status: HealthStatusOK, Info: lndInfo}
+}
+
+// checkBlocc ensures blocc is reachable if it's used
+func (hc *HealthChecker) checkBlocc(ctx context.Context) *HealthCheck {
+	if hc.bclient == nil {
+		return &HealthCheck{Status: HealthStatusDisabled}
+	}
+	if _, err := hc.bclient.GetBlock(ctx, &blocc.Get{Id: blocc.BlockIdTip}); err != nil {
+		return &HealthCheck{Status: HealthStatusError, Error: err.Error()}
+	}
+	return &HealthCheck{Status: HealthStatusOK}
+}
+
+// checkMonitor ensures every monitor is running and has done work recently if this instance is the leader
+func (hc *HealthChecker) checkMonitor(ctx context.Context) *HealthCheck {
+
+	hc.monitorLock.RLock()
+	monitor := hc.monitor
+	hc.monitorLock.RUnlock()
+
+	if monitor == nil {
+		return &HealthCheck{Status: HealthStatusDisabled}
+	}
+
+	leader := monitor.IsLeader()
+	monitors := monitor.Health()
+	check := &HealthCheck{Status: HealthStatusOK, Info: map[string]interface{}{
+		"leader":   leader,
+		"monitors": monitors,
+	}}
+
+	// A standby has stopped its monitors, they are only expected to be running on the leader
+	if !leader {
+		return check
+	}
+
+	for _, mh := range monitors {
+		if !mh.Healthy() {
+			check.Status = HealthStatusError
+			check.Error = "monitor " + mh.Name + " is not healthy"
+			if mh.LastError != "" {
+				check.Error += ": " + mh.LastError
+			}
+			break
+		}
+	}
+
+	return check
+
+}
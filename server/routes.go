@@ -5,8 +5,10 @@ import (
 	"net/http"
 
 	assetfs "github.com/elazarl/go-bindata-assetfs"
+	config "github.com/spf13/viper"
 
 	"git.coinninja.net/backend/thunderdome/embed"
+	"git.coinninja.net/backend/thunderdome/metrics"
 	"git.coinninja.net/backend/thunderdome/server/versionrpc"
 	"git.coinninja.net/backend/thunderdome/server/versionrpc/versionrpcserver"
 )
@@ -17,6 +19,11 @@ func (s *Server) SetupRoutes() {
 	// Register our routes - you need at aleast one route
 	s.router.Get("/none", func(w http.ResponseWriter, r *http.Request) {})
 
+	// Serve prometheus metrics
+	if config.GetBool("prometheus.enabled") {
+		s.router.Handle("/metrics", metrics.Handler())
+	}
+
 	// Register RPC Services
 	versionrpc.RegisterVersionRPCServer(s.GRPCServer(), versionrpcserver.New())
 	s.GWReg(versionrpc.RegisterVersionRPCHandlerFromEndpoint)
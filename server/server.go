@@ -31,6 +31,8 @@ import (
 	"google.golang.org/grpc/reflection"
 
 	"git.coinninja.net/backend/thunderdome/conf"
+	"git.coinninja.net/backend/thunderdome/metrics"
+	"git.coinninja.net/backend/thunderdome/monitor"
 	"git.coinninja.net/backend/thunderdome/tdrpc"
 )
 
@@ -48,6 +50,19 @@ func authenticate(ctx context.Context) (context.Context, error) {
 	return ctx, nil
 }
 
+// ServiceUnaryInterceptorOverride allows a registered service to wrap its own unary requests after authentication
+type ServiceUnaryInterceptorOverride interface {
+	UnaryInterceptorOverride(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error)
+}
+
+// serviceUnaryInterceptor calls the UnaryInterceptorOverride of the service handling the request (if implemented)
+func serviceUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if overrideSrv, ok := info.Server.(ServiceUnaryInterceptorOverride); ok {
+		return overrideSrv.UnaryInterceptorOverride(ctx, req, info, handler)
+	}
+	return handler(ctx, req)
+}
+
 // When starting to listen, we will reigster gateway functions
 type gwRegFunc func(ctx context.Context, mux *gwruntime.ServeMux, endpoint string, opts []grpc.DialOption) error
 
@@ -69,10 +84,13 @@ func New() (*Server, error) {
 
 	// GRPC Interceptors
 	streamInterceptors := []grpc.StreamServerInterceptor{
+		metrics.StreamServerInterceptor("grpc.server.stream_seconds"),
 		grpc_auth.StreamServerInterceptor(authenticate),
 	}
 	unaryInterceptors := []grpc.UnaryServerInterceptor{
+		metrics.UnaryServerInterceptor("grpc.server.request_seconds"),
 		grpc_auth.UnaryServerInterceptor(authenticate),
+		serviceUnaryInterceptor,
 	}
 
 	// Log Requests - Use appropriate format depending on the encoding
@@ -197,6 +215,12 @@ func (s *Server) ListenAndServe() error {
 				return header, true
 			case tdrpc.MetadataAuthNonce:
 				return header, true
+			case tdrpc.MetadataAuthSigType:
+				return header, true
+			case tdrpc.MetadataAuthDelegation:
+				return header, true
+			case tdrpc.MetadataAuthDelegationSignature:
+				return header, true
 			}
 			return header, false
 		}),
@@ -237,6 +261,11 @@ func (s *Server) GWReg(gwrf gwRegFunc) {
 	s.gwRegFuncs = append(s.gwRegFuncs, gwrf)
 }
 
+// Router will return the http router to allow functions to register routes
+func (s *Server) Router() chi.Router {
+	return s.router
+}
+
 // GRPCServer will return the grpc server to allow functions to register themselves
 func (s *Server) GRPCServer() *grpc.Server {
 	return s.grpcServer
@@ -261,8 +290,15 @@ func RenderOrErrInternal(w http.ResponseWriter, r *http.Request, d render.Render
 	}
 }
 
-// VersionHttpServer listens on http for the /version endpoint only
-func VersionHTTPServer() {
+// MonitorStatus reports the monitor leader election state and health
+type MonitorStatus interface {
+	IsLeader() bool
+	InstanceID() string
+	Health() []monitor.MonitorHealth
+}
+
+// VersionHttpServer listens on http for the /version, /leader, /healthz, /readyz and /metrics endpoints only
+func VersionHTTPServer(status MonitorStatus, hc *HealthChecker) {
 
 	r := chi.NewRouter()
 	r.Use(render.SetContentType(render.ContentTypeJSON))
@@ -283,6 +319,20 @@ func VersionHTTPServer() {
 
 	r.Get("/version", getVersion())
 
+	// Leader returns if this instance is the monitor leader
+	r.Get("/leader", func(w http.ResponseWriter, r *http.Request) {
+		render.JSON(w, r, map[string]interface{}{
+			"leader":      status.IsLeader(),
+			"instance_id": status.InstanceID(),
+		})
+	})
+
+	hc.Routes(r)
+
+	if config.GetBool("prometheus.enabled") {
+		r.Handle("/metrics", metrics.Handler())
+	}
+
 	address := net.JoinHostPort(config.GetString("server.host"), config.GetString("server.port"))
 	zap.S().Infow("Version HTTP Server Listening", "address", address)
 
@@ -116,7 +116,8 @@ func (c *Client) GetAccountStats(ctx context.Context) (*tdrpc.AccountStats, erro
 	COALESCE(SUM(pending_out),0) as pending_out
 	FROM
 	account
-	`)
+	WHERE id <> $1
+	`, tdrpc.HouseAccountId)
 	return stats, err
 
 }
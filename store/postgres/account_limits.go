@@ -0,0 +1,118 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+
+	"git.coinninja.net/backend/thunderdome/store"
+	"git.coinninja.net/backend/thunderdome/tdrpc"
+)
+
+// GetAccountLimits fetches the limit tier and overrides for an account
+func (c *Client) GetAccountLimits(ctx context.Context, accountID string) (*tdrpc.AccountLimits, error) {
+
+	al := new(tdrpc.AccountLimits)
+	err := c.db.GetContext(ctx, al, `SELECT * FROM account_limits WHERE account_id = $1`, accountID)
+	if err == sql.ErrNoRows {
+		return nil, store.ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return al, nil
+}
+
+// SaveAccountLimits creates or updates the limit tier and overrides for an account
+func (c *Client) SaveAccountLimits(ctx context.Context, limits *tdrpc.AccountLimits) (*tdrpc.AccountLimits, error) {
+
+	al := new(tdrpc.AccountLimits)
+	err := c.db.GetContext(ctx, al, `
+		INSERT INTO account_limits (account_id, created_at, updated_at, tier, daily_send, weekly_send, max_payment, daily_withdraw)
+		VALUES($1, NOW(), NOW(), $2, $3, $4, $5, $6)
+		ON CONFLICT (account_id) DO UPDATE
+		SET
+		updated_at = NOW(),
+		tier = $2,
+		daily_send = $3,
+		weekly_send = $4,
+		max_payment = $5,
+		daily_withdraw = $6
+		RETURNING *
+	`, limits.AccountId, limits.Tier, limits.DailySend, limits.WeeklySend, limits.MaxPayment, limits.DailyWithdraw)
+	if err != nil {
+		return nil, err
+	}
+
+	return al, nil
+
+}
+
+// checkAccountLimits ensures an outbound ledger record adding value to what was already sent does not exceed the limits of the account
+// It must be called inside the serializable transaction that processes the record so concurrent requests are accounted for
+func (c *Client) checkAccountLimits(ctx context.Context, tx *sqlx.Tx, lr *tdrpc.LedgerRecord, value int64) error {
+
+	al := &tdrpc.AccountLimits{AccountId: lr.AccountId}
+	err := tx.GetContext(ctx, al, `SELECT * FROM account_limits WHERE account_id = $1`, lr.AccountId)
+	if err != nil && err != sql.ErrNoRows {
+		return fmt.Errorf("Could not get account limits: %v", err)
+	}
+	limits := al.Effective()
+
+	// Single payment or pre-auth
+	if limits.MaxPayment > 0 && lr.Type == tdrpc.LIGHTNING && lr.Value > limits.MaxPayment {
+		return tdrpc.ErrMaxPaymentExceeded
+	}
+
+	// Everything sent in the window counts, including anything still pending or held but not adjustments
+	sent := func(interval string, withdrawsOnly bool) (int64, error) {
+		var queryClause string
+		if withdrawsOnly {
+			queryClause = ` AND type = 'btc'`
+		}
+		var total int64
+		err := tx.GetContext(ctx, &total, `
+			SELECT COALESCE(SUM(value + network_fee + processing_fee), 0) FROM ledger
+			WHERE account_id = $1 AND direction = 'out' AND status IN ('pending', 'completed', 'held') AND type <> 'adjustment' AND created_at > NOW() - $2::interval`+queryClause,
+			lr.AccountId, interval)
+		if err != nil {
+			return 0, fmt.Errorf("Could not get sent total: %v", err)
+		}
+		return total, nil
+	}
+
+	if limits.DailySend > 0 {
+		total, err := sent("1 day", false)
+		if err != nil {
+			return err
+		}
+		if total+value > limits.DailySend {
+			return tdrpc.ErrDailySendLimitExceeded
+		}
+	}
+
+	if limits.WeeklySend > 0 {
+		total, err := sent("7 days", false)
+		if err != nil {
+			return err
+		}
+		if total+value > limits.WeeklySend {
+			return tdrpc.ErrWeeklySendLimitExceeded
+		}
+	}
+
+	if limits.DailyWithdraw > 0 && lr.Type == tdrpc.BTC {
+		total, err := sent("1 day", true)
+		if err != nil {
+			return err
+		}
+		if total+value > limits.DailyWithdraw {
+			return tdrpc.ErrDailyWithdrawLimitExceeded
+		}
+	}
+
+	return nil
+
+}
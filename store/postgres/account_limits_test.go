@@ -0,0 +1,157 @@
+package postgres
+
+import (
+	"git.coinninja.net/backend/thunderdome/store"
+	"git.coinninja.net/backend/thunderdome/tdrpc"
+)
+
+func (suite *DBTestSuite) TestAccountLimits() {
+
+	a1 := suite.newTestAccount("testuser1", 10000)
+
+	// No limits set
+	_, err := suite.client.GetAccountLimits(suite.ctx, a1.Id)
+	suite.Equal(store.ErrNotFound, err)
+
+	// Set and update limits
+	al, err := suite.client.SaveAccountLimits(suite.ctx, &tdrpc.AccountLimits{AccountId: a1.Id, MaxPayment: 500})
+	suite.Nil(err)
+	suite.Equal(int64(500), al.MaxPayment)
+	al.DailySend = 1000
+	al.DailyWithdraw = 300
+	_, err = suite.client.SaveAccountLimits(suite.ctx, al)
+	suite.Nil(err)
+	al, err = suite.client.GetAccountLimits(suite.ctx, a1.Id)
+	suite.Nil(err)
+	suite.Equal(int64(500), al.MaxPayment)
+	suite.Equal(int64(1000), al.DailySend)
+	suite.Equal(int64(300), al.DailyWithdraw)
+
+	// Larger than the max payment
+	err = suite.client.ProcessLedgerRecord(suite.ctx, &tdrpc.LedgerRecord{
+		Id:        "tr1",
+		AccountId: a1.Id,
+		Status:    tdrpc.PENDING,
+		Type:      tdrpc.LIGHTNING,
+		Direction: tdrpc.OUT,
+		Value:     501,
+	})
+	suite.Equal(tdrpc.ErrMaxPaymentExceeded, err)
+
+	// Pending payments count against the daily limit
+	for _, id := range []string{"tr2", "tr3"} {
+		err = suite.client.ProcessLedgerRecord(suite.ctx, &tdrpc.LedgerRecord{
+			Id:        id,
+			AccountId: a1.Id,
+			Status:    tdrpc.PENDING,
+			Type:      tdrpc.LIGHTNING,
+			Direction: tdrpc.OUT,
+			Value:     400,
+		})
+		suite.Nil(err)
+	}
+
+	// The withdraw is within the withdraw limit but not the daily send limit
+	lr := &tdrpc.LedgerRecord{
+		Id:         "tr4",
+		AccountId:  a1.Id,
+		Status:     tdrpc.PENDING,
+		Type:       tdrpc.BTC,
+		Direction:  tdrpc.OUT,
+		Value:      250,
+		NetworkFee: 10,
+	}
+	err = suite.client.ProcessLedgerRecord(suite.ctx, lr)
+	suite.Equal(tdrpc.ErrDailySendLimitExceeded, err)
+
+	// Failed payments no longer count
+	err = suite.client.ProcessLedgerRecord(suite.ctx, &tdrpc.LedgerRecord{
+		Id:        "tr3",
+		AccountId: a1.Id,
+		Status:    tdrpc.FAILED,
+		Type:      tdrpc.LIGHTNING,
+		Direction: tdrpc.OUT,
+		Value:     400,
+	})
+	suite.Nil(err)
+	err = suite.client.ProcessLedgerRecord(suite.ctx, lr)
+	suite.Nil(err)
+
+	// Over the daily withdraw limit
+	lr.Id = "tr5"
+	lr.Value = 50
+	err = suite.client.ProcessLedgerRecord(suite.ctx, lr)
+	suite.Equal(tdrpc.ErrDailyWithdrawLimitExceeded, err)
+
+	// Unlimited overrides
+	al.DailySend = tdrpc.LimitUnlimited
+	al.DailyWithdraw = tdrpc.LimitUnlimited
+	_, err = suite.client.SaveAccountLimits(suite.ctx, al)
+	suite.Nil(err)
+	err = suite.client.ProcessLedgerRecord(suite.ctx, lr)
+	suite.Nil(err)
+
+}
+
+func (suite *DBTestSuite) TestAccountLimitsPreAuth() {
+
+	a1 := suite.newTestAccount("testuser1", 10000)
+
+	_, err := suite.client.SaveAccountLimits(suite.ctx, &tdrpc.AccountLimits{AccountId: a1.Id, DailySend: 1000, MaxPayment: 800})
+	suite.Nil(err)
+
+	// Pre-authorize funds within the limits
+	preAuth := &tdrpc.LedgerRecord{
+		Id:        "preauth1",
+		AccountId: a1.Id,
+		Status:    tdrpc.PENDING,
+		Type:      tdrpc.LIGHTNING,
+		Direction: tdrpc.OUT,
+		Value:     500,
+		Request:   tdrpc.PreAuthRequest,
+	}
+	err = suite.client.ProcessLedgerRecord(suite.ctx, preAuth)
+	suite.Nil(err)
+	err = suite.client.UpdateLedgerRecordID(suite.ctx, preAuth.Id, "pay1", tdrpc.OUT)
+	suite.Nil(err)
+
+	// Paying more than the max payment with the pre-authorized funds
+	pay := &tdrpc.LedgerRecord{
+		Id:        "pay1",
+		AccountId: a1.Id,
+		Status:    tdrpc.PENDING,
+		Type:      tdrpc.LIGHTNING,
+		Direction: tdrpc.OUT,
+		Value:     900,
+	}
+	err = suite.client.ProcessLedgerRecord(suite.ctx, pay)
+	suite.Equal(tdrpc.ErrMaxPaymentExceeded, err)
+
+	// Another payment uses up most of the daily limit, the pre-authorized value still fits
+	err = suite.client.ProcessLedgerRecord(suite.ctx, &tdrpc.LedgerRecord{
+		Id:        "pay2",
+		AccountId: a1.Id,
+		Status:    tdrpc.COMPLETED,
+		Type:      tdrpc.LIGHTNING,
+		Direction: tdrpc.OUT,
+		Value:     400,
+	})
+	suite.Nil(err)
+
+	// Growing the payment past the daily limit on completion
+	pay.Status = tdrpc.COMPLETED
+	pay.Value = 700
+	err = suite.client.ProcessLedgerRecord(suite.ctx, pay)
+	suite.Equal(tdrpc.ErrDailySendLimitExceeded, err)
+
+	// Completing it for the pre-authorized value only counts it once
+	pay.Value = 500
+	err = suite.client.ProcessLedgerRecord(suite.ctx, pay)
+	suite.Nil(err)
+
+	a1, err = suite.client.GetAccountByID(suite.ctx, a1.Id)
+	suite.Nil(err)
+	suite.Equal(int64(9100), a1.Balance)
+	suite.Equal(int64(0), a1.PendingOut)
+
+}
@@ -0,0 +1,69 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"git.coinninja.net/backend/thunderdome/tdrpc"
+)
+
+// CreateAdminAudit records a change made through the admin API
+func (c *Client) CreateAdminAudit(ctx context.Context, audit *tdrpc.AdminAudit) (*tdrpc.AdminAudit, error) {
+
+	ret := new(tdrpc.AdminAudit)
+	err := c.db.GetContext(ctx, ret, `
+		INSERT INTO admin_audit (created_at, operator, method, request, code, error)
+		VALUES(NOW(), $1, $2, $3, $4, $5)
+		RETURNING *
+	`, audit.Operator, audit.Method, audit.Request, audit.Code, audit.Error)
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+
+}
+
+// GetAdminAuditLog fetches the audit log, newest first, with filter and pagination
+func (c *Client) GetAdminAuditLog(ctx context.Context, filter map[string]string, after time.Time, offset int, limit int) ([]*tdrpc.AdminAudit, error) {
+
+	var queryClause string
+	var queryParams = []interface{}{}
+
+	// Validate the filters
+	for filter, value := range filter {
+		switch filter {
+		case "operator", "method", "code":
+			if value == "" {
+				return nil, fmt.Errorf("Invalid value for %s", filter)
+			}
+			queryParams = append(queryParams, value)
+			queryClause += fmt.Sprintf(" AND %s = $%d", filter, len(queryParams))
+		default:
+			return nil, fmt.Errorf("Unsupported filter %s", filter)
+		}
+	}
+
+	if !after.IsZero() {
+		queryParams = append(queryParams, after)
+		queryClause += fmt.Sprintf(" AND created_at > $%d", len(queryParams))
+	}
+
+	queryClause += " ORDER BY created_at DESC, id DESC"
+
+	if limit > 0 {
+		queryClause += fmt.Sprintf(" LIMIT %d", limit)
+	}
+	if offset > 0 {
+		queryClause += fmt.Sprintf(" OFFSET %d", offset)
+	}
+
+	var auditLog = make([]*tdrpc.AdminAudit, 0)
+	err := c.db.SelectContext(ctx, &auditLog, `SELECT * FROM admin_audit WHERE 1=1`+queryClause, queryParams...)
+	if err != nil {
+		return auditLog, err
+	}
+
+	return auditLog, nil
+}
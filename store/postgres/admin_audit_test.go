@@ -0,0 +1,53 @@
+package postgres
+
+import (
+	"time"
+
+	"git.coinninja.net/backend/thunderdome/tdrpc"
+)
+
+func (suite *DBTestSuite) TestAdminAudit() {
+
+	start := time.Now().Add(-time.Minute)
+
+	a1, err := suite.client.CreateAdminAudit(suite.ctx, &tdrpc.AdminAudit{
+		Operator: "op1",
+		Method:   "/tdrpc.AdminRPC/UpdateAccount",
+		Request:  `{"id":"testuser1","locked":true}`,
+		Code:     "OK",
+	})
+	suite.Nil(err)
+	suite.NotZero(a1.Id)
+	suite.NotNil(a1.CreatedAt)
+
+	_, err = suite.client.CreateAdminAudit(suite.ctx, &tdrpc.AdminAudit{
+		Operator: "op2",
+		Method:   "/tdrpc.AdminRPC/DeleteAgentKey",
+		Request:  `{"id":"missing"}`,
+		Code:     "NotFound",
+		Error:    "agent key not found",
+	})
+	suite.Nil(err)
+
+	// Newest first
+	auditLog, err := suite.client.GetAdminAuditLog(suite.ctx, nil, start, 0, 0)
+	suite.Nil(err)
+	suite.Len(auditLog, 2)
+	suite.Equal("op2", auditLog[0].Operator)
+	suite.Equal("agent key not found", auditLog[0].Error)
+
+	auditLog, err = suite.client.GetAdminAuditLog(suite.ctx, map[string]string{"operator": "op1"}, time.Time{}, 0, 0)
+	suite.Nil(err)
+	suite.Len(auditLog, 1)
+	suite.Equal(a1.Id, auditLog[0].Id)
+	suite.Equal(a1.Request, auditLog[0].Request)
+
+	auditLog, err = suite.client.GetAdminAuditLog(suite.ctx, map[string]string{"code": "OK"}, time.Time{}, 0, 1)
+	suite.Nil(err)
+	suite.Len(auditLog, 1)
+
+	// Invalid filter
+	_, err = suite.client.GetAdminAuditLog(suite.ctx, map[string]string{"bad": "value"}, time.Time{}, 0, 0)
+	suite.NotNil(err)
+
+}
@@ -0,0 +1,157 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/lib/pq"
+
+	"git.coinninja.net/backend/thunderdome/store"
+	"git.coinninja.net/backend/thunderdome/tdrpc"
+)
+
+// The agent key columns (the secret_hash is never returned)
+const agentKeyColumns = `id, created_at, updated_at, name, scopes, account_ids, value_limit, expires_at, revoked_at`
+
+// agentKey handles scanning the array columns of an agent key
+type agentKey struct {
+	*tdrpc.AgentKey
+	Scopes     pq.StringArray `db:"scopes"`
+	AccountIds pq.StringArray `db:"account_ids"`
+}
+
+func newAgentKey() *agentKey {
+	return &agentKey{AgentKey: new(tdrpc.AgentKey)}
+}
+
+// get returns the AgentKey with the array columns populated
+func (ak *agentKey) get() *tdrpc.AgentKey {
+	ak.AgentKey.Scopes = []string(ak.Scopes)
+	ak.AgentKey.AccountIds = []string(ak.AccountIds)
+	return ak.AgentKey
+}
+
+// GetAgentKeys fetches agent keys with pagination
+func (c *Client) GetAgentKeys(ctx context.Context, offset int, limit int) ([]*tdrpc.AgentKey, error) {
+
+	var queryClause string
+
+	if limit > 0 {
+		queryClause += fmt.Sprintf(" LIMIT %d", limit)
+	}
+	if offset > 0 {
+		queryClause += fmt.Sprintf(" OFFSET %d", offset)
+	}
+
+	rows, err := c.db.QueryxContext(ctx, `SELECT `+agentKeyColumns+` FROM agent_key ORDER BY created_at`+queryClause)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var aks = make([]*tdrpc.AgentKey, 0)
+	for rows.Next() {
+		ak := newAgentKey()
+		if err = rows.StructScan(ak); err != nil {
+			return nil, err
+		}
+		aks = append(aks, ak.get())
+	}
+
+	return aks, rows.Err()
+}
+
+// GetAgentKey fetches an agent key by ID
+func (c *Client) GetAgentKey(ctx context.Context, id string) (*tdrpc.AgentKey, error) {
+
+	ak := newAgentKey()
+	err := c.db.GetContext(ctx, ak, `SELECT `+agentKeyColumns+` FROM agent_key WHERE id = $1`, id)
+	if err == sql.ErrNoRows {
+		return nil, store.ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return ak.get(), nil
+}
+
+// GetAgentKeyBySecretHash fetches an agent key by the hash of its secret
+func (c *Client) GetAgentKeyBySecretHash(ctx context.Context, secretHash string) (*tdrpc.AgentKey, error) {
+
+	ak := newAgentKey()
+	err := c.db.GetContext(ctx, ak, `SELECT `+agentKeyColumns+` FROM agent_key WHERE secret_hash = $1`, secretHash)
+	if err == sql.ErrNoRows {
+		return nil, store.ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return ak.get(), nil
+}
+
+// CreateAgentKey creates a new agent key with the hash of its secret
+func (c *Client) CreateAgentKey(ctx context.Context, key *tdrpc.AgentKey, secretHash string) (*tdrpc.AgentKey, error) {
+
+	if key.Id == "" {
+		key.Id = c.newID()
+	}
+
+	ak := newAgentKey()
+	err := c.db.GetContext(ctx, ak, `
+		INSERT INTO agent_key (id, created_at, updated_at, name, secret_hash, scopes, account_ids, value_limit, expires_at)
+		VALUES($1, NOW(), NOW(), $2, $3, $4, $5, $6, $7)
+		RETURNING `+agentKeyColumns,
+		key.Id, key.Name, secretHash, pq.StringArray(key.Scopes), pq.StringArray(key.AccountIds), key.ValueLimit, key.ExpiresAt)
+	if pqErr, ok := err.(*pq.Error); ok && pqErr.Code.Name() == "unique_violation" {
+		return nil, store.ErrAlreadyExists
+	} else if err != nil {
+		return nil, err
+	}
+
+	return ak.get(), nil
+
+}
+
+// SaveAgentKey updates an existing agent key
+func (c *Client) SaveAgentKey(ctx context.Context, key *tdrpc.AgentKey) (*tdrpc.AgentKey, error) {
+
+	ak := newAgentKey()
+	err := c.db.GetContext(ctx, ak, `
+		UPDATE agent_key SET
+		updated_at = NOW(),
+		name = $2,
+		scopes = $3,
+		account_ids = $4,
+		value_limit = $5,
+		expires_at = $6,
+		revoked_at = $7
+		WHERE id = $1
+		RETURNING `+agentKeyColumns,
+		key.Id, key.Name, pq.StringArray(key.Scopes), pq.StringArray(key.AccountIds), key.ValueLimit, key.ExpiresAt, key.RevokedAt)
+	if err == sql.ErrNoRows {
+		return nil, store.ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return ak.get(), nil
+
+}
+
+// DeleteAgentKey removes an agent key
+func (c *Client) DeleteAgentKey(ctx context.Context, id string) error {
+
+	result, err := c.db.ExecContext(ctx, `DELETE FROM agent_key WHERE id = $1`, id)
+	if err != nil {
+		return err
+	}
+	if count, err := result.RowsAffected(); err != nil {
+		return err
+	} else if count == 0 {
+		return store.ErrNotFound
+	}
+
+	return nil
+
+}
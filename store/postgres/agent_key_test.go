@@ -0,0 +1,63 @@
+package postgres
+
+import (
+	"time"
+
+	"git.coinninja.net/backend/thunderdome/store"
+	"git.coinninja.net/backend/thunderdome/tdrpc"
+)
+
+func (suite *DBTestSuite) TestAgentKey() {
+
+	secretHash := tdrpc.HashAgentKeySecret("secret")
+
+	// Create a key
+	ak1, err := suite.client.CreateAgentKey(suite.ctx, &tdrpc.AgentKey{
+		Name:       "agent1",
+		Scopes:     []string{"Pay", "CreatePreAuth"},
+		AccountIds: []string{"pubkey:*"},
+		ValueLimit: 1000,
+	}, secretHash)
+	suite.Nil(err)
+	suite.NotEmpty(ak1.Id)
+	suite.Equal([]string{"Pay", "CreatePreAuth"}, ak1.Scopes)
+	suite.Equal([]string{"pubkey:*"}, ak1.AccountIds)
+	suite.Nil(ak1.RevokedAt)
+
+	// Duplicate secret
+	_, err = suite.client.CreateAgentKey(suite.ctx, &tdrpc.AgentKey{Name: "agent2"}, secretHash)
+	suite.Equal(store.ErrAlreadyExists, err)
+
+	// Fetch it by id and hash
+	ak2, err := suite.client.GetAgentKey(suite.ctx, ak1.Id)
+	suite.Nil(err)
+	suite.Equal(ak1, ak2)
+	ak2, err = suite.client.GetAgentKeyBySecretHash(suite.ctx, secretHash)
+	suite.Nil(err)
+	suite.Equal(ak1, ak2)
+	_, err = suite.client.GetAgentKeyBySecretHash(suite.ctx, tdrpc.HashAgentKeySecret("other"))
+	suite.Equal(store.ErrNotFound, err)
+
+	// Update and revoke it
+	revokedAt := time.Now().UTC()
+	ak2.AccountIds = []string{"pubkey:abc", "pubkey:def"}
+	ak2.ValueLimit = 2000
+	ak2.RevokedAt = &revokedAt
+	ak2, err = suite.client.SaveAgentKey(suite.ctx, ak2)
+	suite.Nil(err)
+	suite.Equal([]string{"pubkey:abc", "pubkey:def"}, ak2.AccountIds)
+	suite.Equal(int64(2000), ak2.ValueLimit)
+	suite.NotNil(ak2.RevokedAt)
+
+	// List
+	aks, err := suite.client.GetAgentKeys(suite.ctx, 0, 0)
+	suite.Nil(err)
+	suite.Len(aks, 1)
+
+	// Delete
+	suite.Nil(suite.client.DeleteAgentKey(suite.ctx, ak1.Id))
+	suite.Equal(store.ErrNotFound, suite.client.DeleteAgentKey(suite.ctx, ak1.Id))
+	_, err = suite.client.GetAgentKey(suite.ctx, ak1.Id)
+	suite.Equal(store.ErrNotFound, err)
+
+}
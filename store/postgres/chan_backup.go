@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"time"
 
 	"git.coinninja.net/backend/thunderdome/store"
 	"git.coinninja.net/backend/thunderdome/tdrpc"
@@ -19,6 +20,7 @@ func (c *Client) GetLastChanBackup(ctx context.Context) (*tdrpc.ChanBackup, erro
 	} else if err != nil {
 		return nil, err
 	}
+	cb.Size = int64(len(cb.Data))
 
 	return &cb, nil
 
@@ -32,6 +34,73 @@ func (c *Client) StoreChanBackup(ctx context.Context, fundingTXIDs string, data
 	if err != nil {
 		return nil, fmt.Errorf("Could not store backup: %v", err)
 	}
+	cb.Size = int64(len(cb.Data))
 	return &cb, nil
 
 }
+
+// GetChanBackup gets a channel backup by ID
+func (c *Client) GetChanBackup(ctx context.Context, id int64) (*tdrpc.ChanBackup, error) {
+
+	var cb tdrpc.ChanBackup
+	err := c.db.GetContext(ctx, &cb, `SELECT * FROM chan_backup WHERE id = $1`, id)
+	if err == sql.ErrNoRows {
+		return nil, store.ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+	cb.Size = int64(len(cb.Data))
+
+	return &cb, nil
+
+}
+
+// GetChanBackups fetches channel backups without their data, newest first. If chanPoint
+// is not blank it only returns the backups that include that channel (txid:index)
+func (c *Client) GetChanBackups(ctx context.Context, chanPoint string, offset int, limit int) ([]*tdrpc.ChanBackup, error) {
+
+	var queryClause string
+	var queryParams = []interface{}{}
+
+	// funding_txids is a sorted comma separated list of channel points
+	if chanPoint != "" {
+		queryParams = append(queryParams, chanPoint)
+		queryClause += fmt.Sprintf(" AND $%d = ANY(string_to_array(funding_txids, ','))", len(queryParams))
+	}
+
+	queryClause += " ORDER BY timestamp DESC, id DESC"
+
+	if limit > 0 {
+		queryClause += fmt.Sprintf(" LIMIT %d", limit)
+	}
+	if offset > 0 {
+		queryClause += fmt.Sprintf(" OFFSET %d", offset)
+	}
+
+	var cbs = make([]*tdrpc.ChanBackup, 0)
+	err := c.db.SelectContext(ctx, &cbs, `SELECT id, timestamp, funding_txids, length(decode(data, 'base64')) AS size FROM chan_backup WHERE 1=1`+queryClause, queryParams...)
+	if err != nil {
+		return cbs, err
+	}
+
+	return cbs, nil
+
+}
+
+// PruneChanBackups deletes the channel backups beyond the newest count (if count > 0) and those older than before (if not zero).
+// The newest backup is always kept. It returns the number deleted.
+func (c *Client) PruneChanBackups(ctx context.Context, count int, before time.Time) (int64, error) {
+
+	result, err := c.db.ExecContext(ctx, `
+		DELETE FROM chan_backup WHERE id IN (
+			SELECT id FROM (
+				SELECT id, timestamp, row_number() OVER (ORDER BY timestamp DESC, id DESC) AS rank FROM chan_backup
+			) AS cb WHERE rank > 1 AND (($1 > 0 AND rank > $1) OR timestamp < $2)
+		)`, count, before)
+	if err != nil {
+		return 0, fmt.Errorf("Could not prune backups: %v", err)
+	}
+
+	return result.RowsAffected()
+
+}
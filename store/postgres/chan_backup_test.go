@@ -0,0 +1,97 @@
+package postgres
+
+import (
+	"time"
+
+	"git.coinninja.net/backend/thunderdome/store"
+	"git.coinninja.net/backend/thunderdome/tdrpc"
+)
+
+func (suite *DBTestSuite) TestChanBackup() {
+
+	_, err := suite.client.GetLastChanBackup(suite.ctx)
+	suite.Equal(store.ErrNotFound, err)
+
+	cb1, err := suite.client.StoreChanBackup(suite.ctx, "aaaa:0", tdrpc.ChanBackupData("backup1"))
+	suite.Nil(err)
+	suite.Equal(int64(7), cb1.Size)
+
+	cb2, err := suite.client.StoreChanBackup(suite.ctx, "aaaa:0,bbbb:1", tdrpc.ChanBackupData("backup22"))
+	suite.Nil(err)
+
+	last, err := suite.client.GetLastChanBackup(suite.ctx)
+	suite.Nil(err)
+	suite.Equal(cb2.Id, last.Id)
+	suite.Equal(tdrpc.ChanBackupData("backup22"), last.Data)
+
+	cb, err := suite.client.GetChanBackup(suite.ctx, cb1.Id)
+	suite.Nil(err)
+	suite.Equal("aaaa:0", cb.FundingTXIDs)
+	suite.Equal(tdrpc.ChanBackupData("backup1"), cb.Data)
+
+	_, err = suite.client.GetChanBackup(suite.ctx, cb2.Id+1)
+	suite.Equal(store.ErrNotFound, err)
+
+	// Newest first without the data
+	cbs, err := suite.client.GetChanBackups(suite.ctx, "", 0, 0)
+	suite.Nil(err)
+	suite.Len(cbs, 2)
+	suite.Equal(cb2.Id, cbs[0].Id)
+	suite.Equal(int64(8), cbs[0].Size)
+	suite.Nil(cbs[0].Data)
+
+	// Only backups including the channel point
+	cbs, err = suite.client.GetChanBackups(suite.ctx, "bbbb:1", 0, 0)
+	suite.Nil(err)
+	suite.Len(cbs, 1)
+	suite.Equal(cb2.Id, cbs[0].Id)
+
+	// Does not match a partial channel point
+	cbs, err = suite.client.GetChanBackups(suite.ctx, "bbbb:", 0, 0)
+	suite.Nil(err)
+	suite.Len(cbs, 0)
+
+	// Pagination
+	cbs, err = suite.client.GetChanBackups(suite.ctx, "aaaa:0", 1, 1)
+	suite.Nil(err)
+	suite.Len(cbs, 1)
+	suite.Equal(cb1.Id, cbs[0].Id)
+
+}
+
+func (suite *DBTestSuite) TestPruneChanBackups() {
+
+	for x := 0; x < 4; x++ {
+		_, err := suite.client.StoreChanBackup(suite.ctx, "aaaa:0", tdrpc.ChanBackupData("backup"))
+		suite.Nil(err)
+	}
+	_, err := suite.client.db.Exec(`UPDATE chan_backup SET timestamp = NOW() - INTERVAL '10 days'`)
+	suite.Nil(err)
+	last, err := suite.client.StoreChanBackup(suite.ctx, "aaaa:0", tdrpc.ChanBackupData("backup"))
+	suite.Nil(err)
+
+	// Nothing to prune
+	deleted, err := suite.client.PruneChanBackups(suite.ctx, 0, time.Time{})
+	suite.Nil(err)
+	suite.Equal(int64(0), deleted)
+
+	// Keep the newest 3
+	deleted, err = suite.client.PruneChanBackups(suite.ctx, 3, time.Time{})
+	suite.Nil(err)
+	suite.Equal(int64(2), deleted)
+
+	// Older than a day
+	deleted, err = suite.client.PruneChanBackups(suite.ctx, 0, time.Now().Add(-24*time.Hour))
+	suite.Nil(err)
+	suite.Equal(int64(2), deleted)
+
+	// Always keeps the newest
+	deleted, err = suite.client.PruneChanBackups(suite.ctx, 0, time.Now().Add(time.Hour))
+	suite.Nil(err)
+	suite.Equal(int64(0), deleted)
+
+	cb, err := suite.client.GetLastChanBackup(suite.ctx)
+	suite.Nil(err)
+	suite.Equal(last.Id, cb.Id)
+
+}
@@ -0,0 +1,200 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+
+	"git.coinninja.net/backend/thunderdome/store"
+	"git.coinninja.net/backend/thunderdome/tdrpc"
+)
+
+// GetChannelProposals fetches channel proposals with filter and pagination, newest first
+func (c *Client) GetChannelProposals(ctx context.Context, filter map[string]string, after time.Time, offset int, limit int) ([]*tdrpc.ChannelProposal, error) {
+
+	var queryClause string
+	var queryParams = []interface{}{}
+
+	// Validate the filters
+	for filter, value := range filter {
+		switch filter {
+		case "action", "node_pubkey", "status", "operator":
+			if value == "" {
+				return nil, fmt.Errorf("Invalid value for %s", filter)
+			}
+			queryParams = append(queryParams, value)
+			queryClause += fmt.Sprintf(" AND %s = $%d", filter, len(queryParams))
+		default:
+			return nil, fmt.Errorf("Unsupported filter %s", filter)
+		}
+	}
+
+	if !after.IsZero() {
+		queryParams = append(queryParams, after)
+		queryClause += fmt.Sprintf(" AND updated_at > $%d", len(queryParams))
+	}
+
+	queryClause += " ORDER BY created_at DESC, id DESC"
+
+	if limit > 0 {
+		queryClause += fmt.Sprintf(" LIMIT %d", limit)
+	}
+	if offset > 0 {
+		queryClause += fmt.Sprintf(" OFFSET %d", offset)
+	}
+
+	var proposals = make([]*tdrpc.ChannelProposal, 0)
+	err := c.db.SelectContext(ctx, &proposals, `SELECT * FROM channel_proposal WHERE 1=1`+queryClause, queryParams...)
+	if err != nil {
+		return proposals, err
+	}
+
+	return proposals, nil
+}
+
+// GetChannelProposal fetches a channel proposal by ID
+func (c *Client) GetChannelProposal(ctx context.Context, id string) (*tdrpc.ChannelProposal, error) {
+
+	proposal := new(tdrpc.ChannelProposal)
+	err := c.db.GetContext(ctx, proposal, `SELECT * FROM channel_proposal WHERE id = $1`, id)
+	if err == sql.ErrNoRows {
+		return nil, store.ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return proposal, nil
+}
+
+// CreateChannelProposal creates a proposal, it returns store.ErrAlreadyExists if the same one is already outstanding
+func (c *Client) CreateChannelProposal(ctx context.Context, proposal *tdrpc.ChannelProposal) (*tdrpc.ChannelProposal, error) {
+
+	if proposal.Id == "" {
+		proposal.Id = c.newID()
+	}
+
+	var ret = new(tdrpc.ChannelProposal)
+	err := c.db.GetContext(ctx, ret, `
+		INSERT INTO channel_proposal (id, created_at, updated_at, action, node_pubkey, chan_point, value, reason, status, operator)
+		VALUES($1, NOW(), NOW(), $2, $3, $4, $5, $6, $7, $8)
+		RETURNING *`,
+		proposal.Id, proposal.Action, proposal.NodePubkey, proposal.ChanPoint, proposal.Value, proposal.Reason, proposal.Status, proposal.Operator)
+	if pqErr, ok := err.(*pq.Error); ok && pqErr.Code.Name() == "unique_violation" {
+		return nil, store.ErrAlreadyExists
+	} else if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+
+}
+
+// ReviewChannelProposal approves or rejects a proposal, this can only happen once for each proposal
+func (c *Client) ReviewChannelProposal(ctx context.Context, id string, status string, operator string) (*tdrpc.ChannelProposal, error) {
+
+	if status != tdrpc.ChannelProposalStatusApproved && status != tdrpc.ChannelProposalStatusRejected {
+		return nil, fmt.Errorf("Invalid review status %s", status)
+	}
+
+	var ret = new(tdrpc.ChannelProposal)
+	err := c.db.GetContext(ctx, ret, `
+		UPDATE channel_proposal SET
+		updated_at = NOW(),
+		status = $2,
+		operator = $3
+		WHERE id = $1 AND status = $4
+		RETURNING *`,
+		id, status, operator, tdrpc.ChannelProposalStatusProposed)
+	if err == sql.ErrNoRows {
+		// Determine if it doesn't exist or was already reviewed
+		if _, err = c.GetChannelProposal(ctx, id); err != nil {
+			return nil, err
+		}
+		return nil, tdrpc.ErrNotProposed
+	} else if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+
+}
+
+// CompleteChannelProposal records the outcome of opening or closing the channel for an approved proposal
+func (c *Client) CompleteChannelProposal(ctx context.Context, id string, status string, txid string, errorMessage string) (*tdrpc.ChannelProposal, error) {
+
+	if status != tdrpc.ChannelProposalStatusCompleted && status != tdrpc.ChannelProposalStatusFailed {
+		return nil, fmt.Errorf("Invalid completed status %s", status)
+	}
+
+	var ret = new(tdrpc.ChannelProposal)
+	err := c.db.GetContext(ctx, ret, `
+		UPDATE channel_proposal SET
+		updated_at = NOW(),
+		status = $2,
+		txid = $3,
+		error = $4
+		WHERE id = $1 AND status = $5
+		RETURNING *`,
+		id, status, txid, errorMessage, tdrpc.ChannelProposalStatusApproved)
+	if err == sql.ErrNoRows {
+		return nil, store.ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+
+}
+
+// CreateRouteFailure records a payment to destination that could not be routed
+func (c *Client) CreateRouteFailure(ctx context.Context, destination string, value int64) error {
+
+	_, err := c.db.ExecContext(ctx, `INSERT INTO route_failure (created_at, destination, value) VALUES(NOW(), $1, $2)`, destination, value)
+	return err
+
+}
+
+// GetRouteFailureStats returns the number and value of payments that could not be routed to each destination
+func (c *Client) GetRouteFailureStats(ctx context.Context, after time.Time) ([]*tdrpc.RouteFailureStats, error) {
+
+	var stats = make([]*tdrpc.RouteFailureStats, 0)
+	err := c.db.SelectContext(ctx, &stats, `
+		SELECT
+		destination,
+		COUNT(id) as count,
+		COALESCE(SUM(value),0) as value
+		FROM route_failure
+		WHERE created_at > $1
+		GROUP BY destination
+	`, after)
+	if err != nil {
+		return stats, err
+	}
+
+	return stats, nil
+
+}
+
+// SaveChannelActivity saves the number of updates to a channel, the channel was active when the number changes
+func (c *Client) SaveChannelActivity(ctx context.Context, chanPoint string, numUpdates uint64) (*tdrpc.ChannelActivity, error) {
+
+	var ret = new(tdrpc.ChannelActivity)
+	err := c.db.GetContext(ctx, ret, `
+		INSERT INTO channel_activity (chan_point, num_updates, active_at)
+		VALUES($1, $2, NOW())
+		ON CONFLICT (chan_point) DO UPDATE
+		SET
+		num_updates = $2,
+		active_at = CASE WHEN channel_activity.num_updates <> $2 THEN NOW() ELSE channel_activity.active_at END
+		RETURNING *
+	`, chanPoint, numUpdates)
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+
+}
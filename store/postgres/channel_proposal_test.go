@@ -0,0 +1,125 @@
+package postgres
+
+import (
+	"time"
+
+	"git.coinninja.net/backend/thunderdome/store"
+	"git.coinninja.net/backend/thunderdome/tdrpc"
+)
+
+func (suite *DBTestSuite) TestChannelProposal() {
+
+	// Create a proposal
+	p1, err := suite.client.CreateChannelProposal(suite.ctx, &tdrpc.ChannelProposal{
+		Action:     tdrpc.ChannelProposalActionOpen,
+		NodePubkey: "node1",
+		Value:      1000000,
+		Reason:     "frequent destination",
+		Status:     tdrpc.ChannelProposalStatusProposed,
+	})
+	suite.Nil(err)
+	suite.NotEmpty(p1.Id)
+	suite.NotNil(p1.CreatedAt)
+
+	// Only one can be outstanding
+	_, err = suite.client.CreateChannelProposal(suite.ctx, &tdrpc.ChannelProposal{
+		Action:     tdrpc.ChannelProposalActionOpen,
+		NodePubkey: "node1",
+		Value:      2000000,
+		Status:     tdrpc.ChannelProposalStatusProposed,
+	})
+	suite.Equal(store.ErrAlreadyExists, err)
+
+	// Cannot complete before it's approved
+	_, err = suite.client.CompleteChannelProposal(suite.ctx, p1.Id, tdrpc.ChannelProposalStatusCompleted, "txid1", "")
+	suite.Equal(store.ErrNotFound, err)
+
+	// Approve
+	p1, err = suite.client.ReviewChannelProposal(suite.ctx, p1.Id, tdrpc.ChannelProposalStatusApproved, "operator1")
+	suite.Nil(err)
+	suite.Equal(tdrpc.ChannelProposalStatusApproved, p1.Status)
+	suite.Equal("operator1", p1.Operator)
+
+	// Can only be reviewed once
+	_, err = suite.client.ReviewChannelProposal(suite.ctx, p1.Id, tdrpc.ChannelProposalStatusRejected, "operator2")
+	suite.Equal(tdrpc.ErrNotProposed, err)
+	_, err = suite.client.ReviewChannelProposal(suite.ctx, "missing", tdrpc.ChannelProposalStatusRejected, "operator2")
+	suite.Equal(store.ErrNotFound, err)
+
+	// Complete
+	p1, err = suite.client.CompleteChannelProposal(suite.ctx, p1.Id, tdrpc.ChannelProposalStatusCompleted, "txid1", "")
+	suite.Nil(err)
+	suite.Equal(tdrpc.ChannelProposalStatusCompleted, p1.Status)
+	suite.Equal("txid1", p1.Txid)
+
+	// Once completed the node can be proposed again
+	p2, err := suite.client.CreateChannelProposal(suite.ctx, &tdrpc.ChannelProposal{
+		Action:     tdrpc.ChannelProposalActionOpen,
+		NodePubkey: "node1",
+		Value:      2000000,
+		Status:     tdrpc.ChannelProposalStatusProposed,
+	})
+	suite.Nil(err)
+
+	// List with filter
+	proposals, err := suite.client.GetChannelProposals(suite.ctx, map[string]string{"node_pubkey": "node1"}, time.Time{}, 0, 0)
+	suite.Nil(err)
+	suite.Len(proposals, 2)
+	proposals, err = suite.client.GetChannelProposals(suite.ctx, map[string]string{"status": tdrpc.ChannelProposalStatusCompleted}, time.Time{}, 0, 0)
+	suite.Nil(err)
+	suite.Len(proposals, 1)
+	suite.Equal(p1.Id, proposals[0].Id)
+	_, err = suite.client.GetChannelProposals(suite.ctx, map[string]string{"bad": "filter"}, time.Time{}, 0, 0)
+	suite.NotNil(err)
+
+	// Reject
+	p2, err = suite.client.ReviewChannelProposal(suite.ctx, p2.Id, tdrpc.ChannelProposalStatusRejected, "operator2")
+	suite.Nil(err)
+	suite.Equal(tdrpc.ChannelProposalStatusRejected, p2.Status)
+
+}
+
+func (suite *DBTestSuite) TestRouteFailure() {
+
+	suite.Nil(suite.client.CreateRouteFailure(suite.ctx, "node1", 100))
+	suite.Nil(suite.client.CreateRouteFailure(suite.ctx, "node1", 200))
+	suite.Nil(suite.client.CreateRouteFailure(suite.ctx, "node2", 300))
+
+	stats, err := suite.client.GetRouteFailureStats(suite.ctx, time.Now().Add(-time.Hour))
+	suite.Nil(err)
+	suite.Len(stats, 2)
+	for _, s := range stats {
+		switch s.Destination {
+		case "node1":
+			suite.Equal(int64(2), s.Count)
+			suite.Equal(int64(300), s.Value)
+		case "node2":
+			suite.Equal(int64(1), s.Count)
+			suite.Equal(int64(300), s.Value)
+		}
+	}
+
+	stats, err = suite.client.GetRouteFailureStats(suite.ctx, time.Now().Add(time.Hour))
+	suite.Nil(err)
+	suite.Len(stats, 0)
+
+}
+
+func (suite *DBTestSuite) TestChannelActivity() {
+
+	a1, err := suite.client.SaveChannelActivity(suite.ctx, "txid:0", 5)
+	suite.Nil(err)
+	suite.Equal(uint64(5), a1.NumUpdates)
+
+	// No new updates, stays the same
+	time.Sleep(10 * time.Millisecond)
+	a2, err := suite.client.SaveChannelActivity(suite.ctx, "txid:0", 5)
+	suite.Nil(err)
+	suite.True(a1.ActiveAt.Equal(a2.ActiveAt))
+
+	// New updates, it's active
+	a3, err := suite.client.SaveChannelActivity(suite.ctx, "txid:0", 6)
+	suite.Nil(err)
+	suite.True(a3.ActiveAt.After(a1.ActiveAt))
+
+}
@@ -0,0 +1,63 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"git.coinninja.net/backend/thunderdome/store"
+	"git.coinninja.net/backend/thunderdome/tdrpc"
+)
+
+// AddDelegationSpend adds value to the amount spent by a delegation on day returning the new total
+// If the total would exceed limit, nothing is added and tdrpc.ErrDelegationLimitExceeded is returned
+func (c *Client) AddDelegationSpend(ctx context.Context, delegationID string, accountID string, day time.Time, value int64, limit int64) (int64, error) {
+
+	if value > limit {
+		return 0, tdrpc.ErrDelegationLimitExceeded
+	}
+
+	var total int64
+	err := c.db.GetContext(ctx, &total, `
+		INSERT INTO delegation_spend (delegation_id, day, account_id, updated_at, value)
+		VALUES($1, $2, $3, NOW(), $4)
+		ON CONFLICT (delegation_id, day) DO UPDATE
+		SET
+		updated_at = NOW(),
+		value = delegation_spend.value + EXCLUDED.value
+		WHERE delegation_spend.value + EXCLUDED.value <= $5
+		RETURNING value
+	`, delegationID, day.UTC().Format("2006-01-02"), accountID, value, limit)
+	if err == sql.ErrNoRows {
+		return 0, tdrpc.ErrDelegationLimitExceeded
+	} else if err != nil {
+		return 0, err
+	}
+
+	return total, nil
+
+}
+
+// SavePreAuthDelegation records the delegation that created a pre-auth
+func (c *Client) SavePreAuthDelegation(ctx context.Context, ledgerID string, delegationID string, accountID string) error {
+
+	_, err := c.db.ExecContext(ctx, `INSERT INTO preauth_delegation (ledger_id, delegation_id, account_id) VALUES($1, $2, $3)`, ledgerID, delegationID, accountID)
+	return err
+
+}
+
+// GetPreAuthDelegation returns the id of the delegation that created a pre-auth
+// It returns store.ErrNotFound if it was not created by a delegate
+func (c *Client) GetPreAuthDelegation(ctx context.Context, ledgerID string) (string, error) {
+
+	var delegationID string
+	err := c.db.GetContext(ctx, &delegationID, `SELECT delegation_id FROM preauth_delegation WHERE ledger_id = $1`, ledgerID)
+	if err == sql.ErrNoRows {
+		return "", store.ErrNotFound
+	} else if err != nil {
+		return "", err
+	}
+
+	return delegationID, nil
+
+}
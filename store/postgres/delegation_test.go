@@ -0,0 +1,63 @@
+package postgres
+
+import (
+	"time"
+
+	"git.coinninja.net/backend/thunderdome/store"
+	"git.coinninja.net/backend/thunderdome/tdrpc"
+)
+
+func (suite *DBTestSuite) TestAddDelegationSpend() {
+
+	a1 := suite.newTestAccount("testuser1", 0)
+	today := time.Now().UTC()
+	tomorrow := today.Add(24 * time.Hour)
+
+	// Spend up to the limit
+	total, err := suite.client.AddDelegationSpend(suite.ctx, "delegation1", a1.Id, today, 600, 1000)
+	suite.Nil(err)
+	suite.Equal(int64(600), total)
+	total, err = suite.client.AddDelegationSpend(suite.ctx, "delegation1", a1.Id, today, 400, 1000)
+	suite.Nil(err)
+	suite.Equal(int64(1000), total)
+
+	// Over the limit
+	_, err = suite.client.AddDelegationSpend(suite.ctx, "delegation1", a1.Id, today, 1, 1000)
+	suite.Equal(tdrpc.ErrDelegationLimitExceeded, err)
+	_, err = suite.client.AddDelegationSpend(suite.ctx, "delegation1", a1.Id, tomorrow, 1001, 1000)
+	suite.Equal(tdrpc.ErrDelegationLimitExceeded, err)
+
+	// Refund
+	total, err = suite.client.AddDelegationSpend(suite.ctx, "delegation1", a1.Id, today, -400, 1000)
+	suite.Nil(err)
+	suite.Equal(int64(600), total)
+
+	// The next day and other delegations are tracked separately
+	total, err = suite.client.AddDelegationSpend(suite.ctx, "delegation1", a1.Id, tomorrow, 1000, 1000)
+	suite.Nil(err)
+	suite.Equal(int64(1000), total)
+	total, err = suite.client.AddDelegationSpend(suite.ctx, "delegation2", a1.Id, today, 1000, 1000)
+	suite.Nil(err)
+	suite.Equal(int64(1000), total)
+
+}
+
+func (suite *DBTestSuite) TestPreAuthDelegation() {
+
+	a1 := suite.newTestAccount("testuser1", 0)
+
+	_, err := suite.client.GetPreAuthDelegation(suite.ctx, "preauth:1")
+	suite.Equal(store.ErrNotFound, err)
+
+	err = suite.client.SavePreAuthDelegation(suite.ctx, "preauth:1", "delegation1", a1.Id)
+	suite.Nil(err)
+
+	delegationID, err := suite.client.GetPreAuthDelegation(suite.ctx, "preauth:1")
+	suite.Nil(err)
+	suite.Equal("delegation1", delegationID)
+
+	// A pre-auth is only created once
+	err = suite.client.SavePreAuthDelegation(suite.ctx, "preauth:1", "delegation2", a1.Id)
+	suite.NotNil(err)
+
+}
@@ -0,0 +1,88 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"git.coinninja.net/backend/thunderdome/store"
+	"git.coinninja.net/backend/thunderdome/tdrpc"
+)
+
+// GetIdempotencyKey fetches an idempotency key that has not expired
+func (c *Client) GetIdempotencyKey(ctx context.Context, accountID string, key string) (*tdrpc.IdempotencyKey, error) {
+
+	ik := new(tdrpc.IdempotencyKey)
+	err := c.db.GetContext(ctx, ik, `SELECT * FROM idempotency_key WHERE account_id = $1 AND key = $2 AND expires_at > NOW()`, accountID, key)
+	if err == sql.ErrNoRows {
+		return nil, store.ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return ik, nil
+
+}
+
+// CreateIdempotencyKey claims an idempotency key, an expired key can be claimed again
+// It will return store.ErrAlreadyExists if the key is already claimed
+func (c *Client) CreateIdempotencyKey(ctx context.Context, ik *tdrpc.IdempotencyKey) error {
+
+	var claimed bool
+	err := c.db.GetContext(ctx, &claimed, `
+		INSERT INTO idempotency_key (account_id, key, endpoint, request_hash, response, created_at, expires_at)
+		VALUES($1, $2, $3, $4, NULL, NOW(), $5)
+		ON CONFLICT (account_id, key) DO UPDATE
+		SET
+		endpoint = $3,
+		request_hash = $4,
+		response = NULL,
+		created_at = NOW(),
+		expires_at = $5
+		WHERE idempotency_key.expires_at <= NOW()
+		RETURNING true
+	`, ik.AccountId, ik.Key, ik.Endpoint, ik.RequestHash, ik.ExpiresAt)
+	if err == sql.ErrNoRows {
+		return store.ErrAlreadyExists
+	} else if err != nil {
+		return err
+	}
+
+	return nil
+
+}
+
+// SaveIdempotencyKeyResponse stores the response to replay for a claimed idempotency key
+func (c *Client) SaveIdempotencyKeyResponse(ctx context.Context, accountID string, key string, response []byte) error {
+
+	result, err := c.db.ExecContext(ctx, `UPDATE idempotency_key SET response = $1 WHERE account_id = $2 AND key = $3`, response, accountID, key)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return store.ErrNotFound
+	}
+
+	return nil
+
+}
+
+// DeleteIdempotencyKey releases an idempotency key so the request can be retried
+func (c *Client) DeleteIdempotencyKey(ctx context.Context, accountID string, key string) error {
+
+	_, err := c.db.ExecContext(ctx, `DELETE FROM idempotency_key WHERE account_id = $1 AND key = $2`, accountID, key)
+	return err
+
+}
+
+// ExpireIdempotencyKeys removes any expired idempotency keys
+func (c *Client) ExpireIdempotencyKeys(ctx context.Context) error {
+
+	_, err := c.db.ExecContext(ctx, `DELETE FROM idempotency_key WHERE expires_at <= NOW()`)
+	return err
+
+}
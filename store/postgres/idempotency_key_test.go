@@ -0,0 +1,77 @@
+package postgres
+
+import (
+	"time"
+
+	"git.coinninja.net/backend/thunderdome/store"
+	"git.coinninja.net/backend/thunderdome/tdrpc"
+)
+
+func (suite *DBTestSuite) TestIdempotencyKey() {
+
+	// Create a test account
+	a1 := suite.newTestAccount("testuser1", 0)
+
+	ik := &tdrpc.IdempotencyKey{
+		AccountId:   a1.Id,
+		Key:         "key1",
+		Endpoint:    "/test",
+		RequestHash: "hash1",
+		ExpiresAt:   time.Now().Add(time.Hour),
+	}
+
+	_, err := suite.client.GetIdempotencyKey(suite.ctx, a1.Id, ik.Key)
+	suite.Equal(store.ErrNotFound, err)
+
+	// Claim it
+	err = suite.client.CreateIdempotencyKey(suite.ctx, ik)
+	suite.Nil(err)
+
+	// It can only be claimed once
+	err = suite.client.CreateIdempotencyKey(suite.ctx, ik)
+	suite.Equal(store.ErrAlreadyExists, err)
+
+	ik2, err := suite.client.GetIdempotencyKey(suite.ctx, a1.Id, ik.Key)
+	suite.Nil(err)
+	suite.Equal("hash1", ik2.RequestHash)
+	suite.Nil(ik2.Response)
+
+	// Save the response
+	err = suite.client.SaveIdempotencyKeyResponse(suite.ctx, a1.Id, ik.Key, []byte("response"))
+	suite.Nil(err)
+	ik2, err = suite.client.GetIdempotencyKey(suite.ctx, a1.Id, ik.Key)
+	suite.Nil(err)
+	suite.Equal([]byte("response"), ik2.Response)
+
+	err = suite.client.SaveIdempotencyKeyResponse(suite.ctx, a1.Id, "missing", []byte("response"))
+	suite.Equal(store.ErrNotFound, err)
+
+	// Released keys can be claimed again
+	err = suite.client.DeleteIdempotencyKey(suite.ctx, a1.Id, ik.Key)
+	suite.Nil(err)
+	err = suite.client.CreateIdempotencyKey(suite.ctx, ik)
+	suite.Nil(err)
+
+	// Expired keys are not found and can be claimed again
+	expired := &tdrpc.IdempotencyKey{
+		AccountId:   a1.Id,
+		Key:         "key2",
+		Endpoint:    "/test",
+		RequestHash: "hash2",
+		ExpiresAt:   time.Now().Add(-time.Hour),
+	}
+	err = suite.client.CreateIdempotencyKey(suite.ctx, expired)
+	suite.Nil(err)
+	_, err = suite.client.GetIdempotencyKey(suite.ctx, a1.Id, expired.Key)
+	suite.Equal(store.ErrNotFound, err)
+	expired.ExpiresAt = time.Now().Add(time.Hour)
+	err = suite.client.CreateIdempotencyKey(suite.ctx, expired)
+	suite.Nil(err)
+
+	// Expire removes only expired keys
+	err = suite.client.ExpireIdempotencyKeys(suite.ctx)
+	suite.Nil(err)
+	_, err = suite.client.GetIdempotencyKey(suite.ctx, a1.Id, ik.Key)
+	suite.Nil(err)
+
+}
@@ -239,6 +239,7 @@ func (c *Client) updateLedgerRecordID(ctx context.Context, tx *sqlx.Tx, oldID st
 }
 
 // ExpireLedgerRequests finds any inbound LedgerRequests that have expired and expires them
+// Accepted hold invoices are only expired by the monitor once they are canceled in lnd
 func (c *Client) ExpireLedgerRequests(ctx context.Context) error {
 
 	var lrs = make([]*tdrpc.LedgerRecord, 0)
@@ -0,0 +1,117 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"runtime/debug"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"git.coinninja.net/backend/thunderdome/tdrpc"
+)
+
+// AdjustBalance processes an adjustment ledger record and records the adjustment in the same transaction
+func (c *Client) AdjustBalance(ctx context.Context, lr *tdrpc.LedgerRecord, adjustment *tdrpc.LedgerAdjustment) (*tdrpc.LedgerAdjustment, error) {
+
+	if lr.Type != tdrpc.ADJUSTMENT || lr.Status != tdrpc.COMPLETED {
+		return nil, fmt.Errorf("Invalid adjustment ledger record %v:%v", lr.Type, lr.Status)
+	}
+
+	for retries := 10; retries > 0; retries-- {
+
+		// Start a transaction
+		tx, err := c.db.BeginTxx(ctx, &sql.TxOptions{
+			Isolation: sql.LevelSerializable,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("Could not start transaction: %v", err)
+		}
+
+		// If we panic, roll the transaction back
+		defer func() {
+			if r := recover(); r != nil {
+				_ = tx.Rollback()
+				c.logger.Panic(string(debug.Stack()))
+			}
+		}()
+
+		ret, err := c.adjustBalance(ctx, tx, lr, adjustment)
+		if err != nil {
+			_ = tx.Rollback()
+			if IsTransactionError(err) {
+				c.logger.Warnf("AdjustBalance TX Fail: %v - Retries Left %d", err, retries)
+				time.Sleep(time.Duration(retries) * time.Second)
+				continue
+			}
+			return nil, err
+		}
+
+		// Commit the transaction
+		err = tx.Commit()
+		if err != nil {
+			_ = tx.Rollback()
+			if IsTransactionError(err) {
+				c.logger.Warnf("AdjustBalance TX Fail: %v - Retries Left %d", err, retries)
+				time.Sleep(time.Duration(retries) * time.Second)
+				continue
+			}
+			return nil, fmt.Errorf("Commit Error: %v", err)
+		}
+
+		return ret, nil
+	}
+
+	return nil, fmt.Errorf("Transaction failed, out of retries")
+
+}
+
+func (c *Client) adjustBalance(ctx context.Context, tx *sqlx.Tx, lr *tdrpc.LedgerRecord, adjustment *tdrpc.LedgerAdjustment) (*tdrpc.LedgerAdjustment, error) {
+
+	if err := c.processLedgerRecord(ctx, tx, lr); err != nil {
+		return nil, err
+	}
+
+	ret := new(tdrpc.LedgerAdjustment)
+	err := tx.GetContext(ctx, ret, `
+		INSERT INTO ledger_adjustment (ledger_id, account_id, created_at, value, operator, reason, ticket_id)
+		VALUES($1, $2, NOW(), $3, $4, $5, $6)
+		RETURNING *
+	`, lr.Id, lr.AccountId, adjustment.Value, adjustment.Operator, adjustment.Reason, adjustment.TicketId)
+	if err != nil {
+		return nil, fmt.Errorf("Could not insert adjustment: %v", err)
+	}
+
+	return ret, nil
+
+}
+
+// GetLedgerAdjustments fetches the adjustments, newest first, optionally for a single account
+func (c *Client) GetLedgerAdjustments(ctx context.Context, accountID string, offset int, limit int) ([]*tdrpc.LedgerAdjustment, error) {
+
+	var queryClause string
+	var queryParams = []interface{}{}
+
+	if accountID != "" {
+		queryParams = append(queryParams, accountID)
+		queryClause += fmt.Sprintf(" AND account_id = $%d", len(queryParams))
+	}
+
+	queryClause += " ORDER BY created_at DESC"
+
+	if limit > 0 {
+		queryClause += fmt.Sprintf(" LIMIT %d", limit)
+	}
+	if offset > 0 {
+		queryClause += fmt.Sprintf(" OFFSET %d", offset)
+	}
+
+	var adjustments = make([]*tdrpc.LedgerAdjustment, 0)
+	err := c.db.SelectContext(ctx, &adjustments, `SELECT * FROM ledger_adjustment WHERE 1=1`+queryClause, queryParams...)
+	if err != nil {
+		return adjustments, err
+	}
+
+	return adjustments, nil
+}
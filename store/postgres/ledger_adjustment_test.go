@@ -0,0 +1,75 @@
+package postgres
+
+import (
+	"git.coinninja.net/backend/thunderdome/tdrpc"
+)
+
+func (suite *DBTestSuite) TestAdjustBalance() {
+
+	// Create a test account, it starts empty so the database stays consistent
+	a1 := suite.newTestAccount("testuser1", 0)
+
+	// Only completed adjustments are valid
+	_, err := suite.client.AdjustBalance(suite.ctx, &tdrpc.LedgerRecord{
+		Id:        "adj0",
+		AccountId: a1.Id,
+		Status:    tdrpc.PENDING,
+		Type:      tdrpc.ADJUSTMENT,
+		Direction: tdrpc.IN,
+		Value:     100,
+	}, &tdrpc.LedgerAdjustment{Value: 100, Reason: "test"})
+	suite.NotNil(err)
+
+	// Credit
+	adj, err := suite.client.AdjustBalance(suite.ctx, &tdrpc.LedgerRecord{
+		Id:        "adj1",
+		AccountId: a1.Id,
+		Status:    tdrpc.COMPLETED,
+		Type:      tdrpc.ADJUSTMENT,
+		Direction: tdrpc.IN,
+		Value:     100,
+	}, &tdrpc.LedgerAdjustment{Value: 100, Operator: "op1", Reason: "refund", TicketId: "T-1"})
+	suite.Nil(err)
+	suite.Equal("adj1", adj.LedgerId)
+	suite.Equal(a1.Id, adj.AccountId)
+	suite.Equal("op1", adj.Operator)
+	suite.Equal("T-1", adj.TicketId)
+
+	// Debit
+	_, err = suite.client.AdjustBalance(suite.ctx, &tdrpc.LedgerRecord{
+		Id:        "adj2",
+		AccountId: a1.Id,
+		Status:    tdrpc.COMPLETED,
+		Type:      tdrpc.ADJUSTMENT,
+		Direction: tdrpc.OUT,
+		Value:     30,
+	}, &tdrpc.LedgerAdjustment{Value: -30, Operator: "op1", Reason: "correction"})
+	suite.Nil(err)
+
+	// Cannot debit more than the balance
+	_, err = suite.client.AdjustBalance(suite.ctx, &tdrpc.LedgerRecord{
+		Id:        "adj3",
+		AccountId: a1.Id,
+		Status:    tdrpc.COMPLETED,
+		Type:      tdrpc.ADJUSTMENT,
+		Direction: tdrpc.OUT,
+		Value:     1000,
+	}, &tdrpc.LedgerAdjustment{Value: -1000, Reason: "too much"})
+	suite.Equal(tdrpc.ErrInsufficientFunds, err)
+
+	a1, err = suite.client.GetAccountByID(suite.ctx, a1.Id)
+	suite.Nil(err)
+	suite.Equal(int64(70), a1.Balance)
+
+	// The ledger still balances
+	suite.Nil(suite.client.CheckDatabaseConsistency(suite.ctx))
+
+	adjustments, err := suite.client.GetLedgerAdjustments(suite.ctx, a1.Id, 0, 0)
+	suite.Nil(err)
+	suite.Len(adjustments, 2)
+
+	adjustments, err = suite.client.GetLedgerAdjustments(suite.ctx, "missing", 0, 0)
+	suite.Nil(err)
+	suite.Len(adjustments, 0)
+
+}
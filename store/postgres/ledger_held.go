@@ -0,0 +1,53 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+
+	"git.coinninja.net/backend/thunderdome/store"
+	"git.coinninja.net/backend/thunderdome/tdrpc"
+)
+
+// ReviewHeldLedgerRecord moves a held outbound ledger record to status (pending when approved, failed when rejected)
+// It will return tdrpc.ErrNotHeld if the record is no longer held so a record can only be reviewed once
+func (c *Client) ReviewHeldLedgerRecord(ctx context.Context, id string, status tdrpc.LedgerRecord_Status, reason string) (*tdrpc.LedgerRecord, error) {
+
+	if status != tdrpc.PENDING && status != tdrpc.FAILED {
+		return nil, fmt.Errorf("Invalid review status %v", status)
+	}
+
+	return c.ledgerTx(ctx, "ReviewHeldLedgerRecord", func(tx *sqlx.Tx) (*tdrpc.LedgerRecord, error) {
+		return c.reviewHeldLedgerRecord(ctx, tx, id, status, reason)
+	})
+
+}
+
+func (c *Client) reviewHeldLedgerRecord(ctx context.Context, tx *sqlx.Tx, id string, status tdrpc.LedgerRecord_Status, reason string) (*tdrpc.LedgerRecord, error) {
+
+	lr := new(tdrpc.LedgerRecord)
+	err := tx.GetContext(ctx, lr, `SELECT * FROM ledger WHERE id = $1 AND direction = $2`, id, tdrpc.OUT)
+	if err == sql.ErrNoRows {
+		return nil, store.ErrNotFound
+	} else if err != nil {
+		return nil, fmt.Errorf("Could not fetch existing LedgerRecord: %v", err)
+	}
+
+	if lr.Status != tdrpc.HELD {
+		return nil, tdrpc.ErrNotHeld
+	}
+
+	lr.Status = status
+	if status == tdrpc.FAILED {
+		lr.Error = reason
+	}
+
+	if err = c.processLedgerRecord(ctx, tx, lr); err != nil {
+		return nil, err
+	}
+
+	return lr, nil
+
+}
@@ -0,0 +1,72 @@
+package postgres
+
+import (
+	"git.coinninja.net/backend/thunderdome/store"
+	"git.coinninja.net/backend/thunderdome/tdrpc"
+)
+
+func (suite *DBTestSuite) TestReviewHeldLedgerRecord() {
+
+	// Create a test account
+	a1 := suite.newTestAccount("testuser1", 100)
+
+	lr1 := &tdrpc.LedgerRecord{
+		Id:        "tr1",
+		AccountId: a1.Id,
+		Status:    tdrpc.HELD,
+		Type:      tdrpc.LIGHTNING,
+		Direction: tdrpc.OUT,
+		Value:     30,
+	}
+	err := suite.client.ProcessLedgerRecord(suite.ctx, lr1)
+	suite.Nil(err)
+
+	lr2 := &tdrpc.LedgerRecord{
+		Id:         "tr2",
+		AccountId:  a1.Id,
+		Status:     tdrpc.HELD,
+		Type:       tdrpc.BTC,
+		Direction:  tdrpc.OUT,
+		Value:      20,
+		SatPerByte: 12,
+	}
+	err = suite.client.ProcessLedgerRecord(suite.ctx, lr2)
+	suite.Nil(err)
+
+	// Only pending or failed are valid
+	_, err = suite.client.ReviewHeldLedgerRecord(suite.ctx, lr1.Id, tdrpc.COMPLETED, "")
+	suite.NotNil(err)
+
+	// Not found
+	_, err = suite.client.ReviewHeldLedgerRecord(suite.ctx, "missing", tdrpc.PENDING, "")
+	suite.Equal(store.ErrNotFound, err)
+
+	// Approve keeps the funds reserved
+	lr, err := suite.client.ReviewHeldLedgerRecord(suite.ctx, lr1.Id, tdrpc.PENDING, "")
+	suite.Nil(err)
+	suite.Equal(tdrpc.PENDING, lr.Status)
+
+	a1, err = suite.client.GetAccountByID(suite.ctx, a1.Id)
+	suite.Nil(err)
+	suite.Equal(int64(50), a1.Balance)
+	suite.Equal(int64(50), a1.PendingOut)
+
+	// Cannot be reviewed twice
+	_, err = suite.client.ReviewHeldLedgerRecord(suite.ctx, lr1.Id, tdrpc.FAILED, "again")
+	suite.Equal(tdrpc.ErrNotHeld, err)
+
+	// Reject returns the funds and records the reason
+	lr, err = suite.client.ReviewHeldLedgerRecord(suite.ctx, lr2.Id, tdrpc.FAILED, "suspicious")
+	suite.Nil(err)
+	suite.Equal(tdrpc.FAILED, lr.Status)
+	suite.Equal("suspicious", lr.Error)
+
+	// The quoted fee rate is kept on the record
+	suite.Equal(int64(12), lr.SatPerByte)
+
+	a1, err = suite.client.GetAccountByID(suite.ctx, a1.Id)
+	suite.Nil(err)
+	suite.Equal(int64(70), a1.Balance)
+	suite.Equal(int64(30), a1.PendingOut)
+
+}
@@ -9,12 +9,24 @@ import (
 
 	"github.com/jmoiron/sqlx"
 
+	"git.coinninja.net/backend/thunderdome/metrics"
 	"git.coinninja.net/backend/thunderdome/tdrpc"
 )
 
 // ProcessLedgerRecord handles any balance transfer and changes to the ledger based on the status of the LedgerRecord
 func (c *Client) ProcessLedgerRecord(ctx context.Context, lr *tdrpc.LedgerRecord) error {
 
+	_, err := c.ledgerTx(ctx, "ProcessLedgerRecord", func(tx *sqlx.Tx) (*tdrpc.LedgerRecord, error) {
+		return lr, c.processLedgerRecord(ctx, tx, lr)
+	})
+	return err
+
+}
+
+// ledgerTx runs fn in a serializable transaction retrying it if it conflicts with another one
+// The retries are counted in ledger.tx_retries by function and the committed record in ledger.transitions
+func (c *Client) ledgerTx(ctx context.Context, function string, fn func(tx *sqlx.Tx) (*tdrpc.LedgerRecord, error)) (*tdrpc.LedgerRecord, error) {
+
 	for retries := 10; retries > 0; retries-- {
 
 		// Start a transaction
@@ -22,7 +34,7 @@ func (c *Client) ProcessLedgerRecord(ctx context.Context, lr *tdrpc.LedgerRecord
 			Isolation: sql.LevelSerializable,
 		})
 		if err != nil {
-			return fmt.Errorf("Could not start transaction: %v", err)
+			return nil, fmt.Errorf("Could not start transaction: %v", err)
 		}
 
 		// If we panic, roll the transaction back
@@ -33,15 +45,16 @@ func (c *Client) ProcessLedgerRecord(ctx context.Context, lr *tdrpc.LedgerRecord
 			}
 		}()
 
-		err = c.processLedgerRecord(ctx, tx, lr)
+		lr, err := fn(tx)
 		if err != nil {
 			_ = tx.Rollback()
 			if IsTransactionError(err) {
-				c.logger.Warnf("ProcessLedgerRecord TX Fail: %v - Retries Left %d", err, retries)
+				c.logger.Warnf("%s TX Fail: %v - Retries Left %d", function, err, retries)
+				metrics.Incr("ledger.tx_retries", metrics.Labels{"function": function})
 				time.Sleep(time.Duration(retries) * time.Second)
 				continue
 			}
-			return err
+			return nil, err
 		}
 
 		// Commit the transaction
@@ -49,23 +62,46 @@ func (c *Client) ProcessLedgerRecord(ctx context.Context, lr *tdrpc.LedgerRecord
 		if err != nil {
 			_ = tx.Rollback()
 			if IsTransactionError(err) {
-				c.logger.Warnf("ProcessLedgerRecord TX Fail: %v - Retries Left %d", err, retries)
+				c.logger.Warnf("%s TX Fail: %v - Retries Left %d", function, err, retries)
+				metrics.Incr("ledger.tx_retries", metrics.Labels{"function": function})
 				time.Sleep(time.Duration(retries) * time.Second)
 				continue
 			}
-			return fmt.Errorf("Commit Error: %v", err)
+			return nil, fmt.Errorf("Commit Error: %v", err)
 		}
 
-		return nil
+		metrics.Incr("ledger.transitions", metrics.Labels{"status": lr.Status.String(), "type": lr.Type.String(), "direction": lr.Direction.String()})
+
+		return lr, nil
 	}
 
-	return fmt.Errorf("Transaction failed, out of retries")
+	return nil, fmt.Errorf("Transaction failed, out of retries")
 
 }
 
 // This does the actual work but it allows you to nest it inside of another transaction
 func (c *Client) processLedgerRecord(ctx context.Context, tx *sqlx.Tx, lr *tdrpc.LedgerRecord) error {
 
+	// Only outbound records can be held for review
+	if lr.Status == tdrpc.HELD && lr.Direction != tdrpc.OUT {
+		return fmt.Errorf("Invalid Status %v for direction %v", lr.Status, lr.Direction)
+	}
+
+	// Only inbound hold invoices can be accepted before they are settled
+	if lr.Status == tdrpc.ACCEPTED && (lr.Direction != tdrpc.IN || lr.Type != tdrpc.LIGHTNING) {
+		return fmt.Errorf("Invalid Status %v for direction %v", lr.Status, lr.Direction)
+	}
+
+	// Adjustments are made by an admin and take effect immediately
+	if lr.Type == tdrpc.ADJUSTMENT && lr.Status != tdrpc.COMPLETED {
+		return fmt.Errorf("Invalid Status %v for type %v", lr.Status, lr.Type)
+	}
+
+	// Rebalance costs are recorded once paid and only ever charged to the house account
+	if lr.Type == tdrpc.REBALANCE && (lr.Status != tdrpc.COMPLETED || lr.Direction != tdrpc.OUT || lr.AccountId != tdrpc.HouseAccountId) {
+		return fmt.Errorf("Invalid rebalance ledger record %v:%v:%v", lr.Status, lr.Direction, lr.AccountId)
+	}
+
 	// See if the ledger entry already exists
 	prevlr := new(tdrpc.LedgerRecord)
 	err := tx.GetContext(ctx, prevlr, `SELECT * FROM ledger WHERE id = $1 AND direction = $2`, lr.Id, lr.Direction)
@@ -87,7 +123,7 @@ func (c *Client) processLedgerRecord(ctx context.Context, tx *sqlx.Tx, lr *tdrpc
 		}
 
 		// Invalid status transitions
-		if ((prevlr.Status == tdrpc.EXPIRED || prevlr.Status == tdrpc.COMPLETED) && (lr.Status == tdrpc.PENDING || lr.Status == tdrpc.FAILED)) ||
+		if ((prevlr.Status == tdrpc.EXPIRED || prevlr.Status == tdrpc.COMPLETED) && (lr.Status == tdrpc.PENDING || lr.Status == tdrpc.FAILED || lr.Status == tdrpc.HELD || lr.Status == tdrpc.ACCEPTED)) ||
 			// Completed is a final state always
 			(prevlr.Status == tdrpc.COMPLETED && lr.Status != tdrpc.COMPLETED) {
 			if prevlr.Status == tdrpc.COMPLETED {
@@ -135,16 +171,28 @@ func (c *Client) processLedgerRecord(ctx context.Context, tx *sqlx.Tx, lr *tdrpc
 		// There is a previous LedgerRecord and the status has changed
 		if prevlr != nil {
 
-			// It was previously pending, pull the reserved funds from pending_out
-			if prevlr.Status == tdrpc.PENDING {
+			// It was previously pending or held, pull the reserved funds from pending_out
+			if prevlr.Status == tdrpc.PENDING || prevlr.Status == tdrpc.HELD {
 
 				// If the current value is greater than the previous
-				// And we are trying to complete the transaction
+				// And we are trying to complete the transaction or keep the funds reserved
 				// ensure there is still sufficient funds to cover the transaction
-				if lr.ValueTotal()-prevlr.ValueTotal() > balance && lr.Status == tdrpc.COMPLETED {
+				if lr.ValueTotal()-prevlr.ValueTotal() > balance && (lr.Status == tdrpc.COMPLETED || lr.Status == tdrpc.PENDING || lr.Status == tdrpc.HELD) {
 					return tdrpc.ErrInsufficientFunds
 				}
 
+				// A pre-authorized or held record that grows or completes must still be within the limits of the account
+				// The previous value is already counted against the limits so only the added value is checked
+				if (lr.Status == tdrpc.COMPLETED || ((lr.Status == tdrpc.PENDING || lr.Status == tdrpc.HELD) && lr.ValueTotal() > prevlr.ValueTotal())) && lr.Type != tdrpc.ADJUSTMENT && lr.Type != tdrpc.REBALANCE {
+					added := lr.ValueTotal() - prevlr.ValueTotal()
+					if added < 0 {
+						added = 0
+					}
+					if err = c.checkAccountLimits(ctx, tx, lr, added); err != nil {
+						return err
+					}
+				}
+
 				_, err = tx.ExecContext(ctx, `UPDATE account SET pending_out = pending_out - $1 WHERE id = $2`, prevlr.ValueTotal(), prevlr.AccountId)
 				if err != nil {
 					return fmt.Errorf("Could not process out existing pending pending_out: %v", err)
@@ -157,6 +205,12 @@ func (c *Client) processLedgerRecord(ctx context.Context, tx *sqlx.Tx, lr *tdrpc
 				if err != nil {
 					return fmt.Errorf("Could not process out existing failed/expired balance: %v", err)
 				}
+			} else if lr.Status == tdrpc.PENDING || lr.Status == tdrpc.HELD {
+				// A record was held or released, the funds remain reserved in pending_out at the current value
+				_, err = tx.ExecContext(ctx, `UPDATE account SET balance = balance + $1 - $2, pending_out = pending_out + $2 WHERE id = $3`, prevlr.ValueTotal(), lr.ValueTotal(), prevlr.AccountId)
+				if err != nil {
+					return fmt.Errorf("Could not process out pending_out: %v", err)
+				}
 			} else if lr.Status == tdrpc.COMPLETED && prevlr.ValueTotal() != lr.ValueTotal() {
 				// If for some reason the settled balance was different from the pending balance, adjust to the completed value
 				_, err = tx.ExecContext(ctx, `UPDATE account SET balance = balance + $1 - $2 WHERE id = $3`, prevlr.ValueTotal(), lr.ValueTotal(), prevlr.AccountId)
@@ -167,13 +221,20 @@ func (c *Client) processLedgerRecord(ctx context.Context, tx *sqlx.Tx, lr *tdrpc
 
 		} else { // No previous record/status
 
-			// Check to make sure we have enough funds for this transaction
-			if balance < lr.ValueTotal() {
+			// Check to make sure we have enough funds for this transaction (the house account pays costs already incurred)
+			if balance < lr.ValueTotal() && lr.Type != tdrpc.REBALANCE {
 				return tdrpc.ErrInsufficientFunds
 			}
 
-			// We've started a new transaction
-			if lr.Status == tdrpc.PENDING {
+			// Ensure the new transaction is within the limits of the account (adjustments and rebalances are not subject to limits)
+			if (lr.Status == tdrpc.PENDING || lr.Status == tdrpc.COMPLETED || lr.Status == tdrpc.HELD) && lr.Type != tdrpc.ADJUSTMENT && lr.Type != tdrpc.REBALANCE {
+				if err = c.checkAccountLimits(ctx, tx, lr, lr.ValueTotal()); err != nil {
+					return err
+				}
+			}
+
+			// We've started a new transaction (or it's being held for review)
+			if lr.Status == tdrpc.PENDING || lr.Status == tdrpc.HELD {
 
 				// Put it in pending_out
 				_, err = tx.ExecContext(ctx, `UPDATE account SET balance = balance - $1, pending_out = pending_out + $1 WHERE id = $2`, lr.ValueTotal(), lr.AccountId)
@@ -197,8 +258,8 @@ func (c *Client) processLedgerRecord(ctx context.Context, tx *sqlx.Tx, lr *tdrpc
 
 		// There is a previous LedgerRecord
 		if prevlr != nil {
-			// It was previously pending, pull the reserved funds from pending_in (but only for BTC)
-			if prevlr.Status == tdrpc.PENDING && lr.Type == tdrpc.BTC {
+			// It was previously pending (but only for BTC) or accepted, pull the reserved funds from pending_in
+			if (prevlr.Status == tdrpc.PENDING && lr.Type == tdrpc.BTC) || prevlr.Status == tdrpc.ACCEPTED {
 				_, err = tx.ExecContext(ctx, `UPDATE account SET pending_in = pending_in - $1 WHERE id = $2`, prevlr.Value, prevlr.AccountId)
 				if err != nil {
 					return fmt.Errorf("Could not process in new pending balance: %v", err)
@@ -206,8 +267,8 @@ func (c *Client) processLedgerRecord(ctx context.Context, tx *sqlx.Tx, lr *tdrpc
 			}
 		}
 
-		// Pending incoming transactions (but only for BTC) add balance to pending
-		if lr.Status == tdrpc.PENDING && lr.Type == tdrpc.BTC {
+		// Pending incoming transactions (but only for BTC) and accepted hold invoices add balance to pending
+		if (lr.Status == tdrpc.PENDING && lr.Type == tdrpc.BTC) || lr.Status == tdrpc.ACCEPTED {
 			_, err = tx.ExecContext(ctx, `UPDATE account SET pending_in = pending_in + $1 WHERE id = $2`, lr.Value, lr.AccountId)
 			if err != nil {
 				return fmt.Errorf("Could not process in new completed balance: %v", err)
@@ -235,8 +296,8 @@ func (c *Client) processLedgerRecord(ctx context.Context, tx *sqlx.Tx, lr *tdrpc
 	// Upsert the data, capture the result
 	var ret tdrpc.LedgerRecord
 	err = tx.GetContext(ctx, &ret, `
-		INSERT INTO ledger (id, account_id, created_at, updated_at, expires_at, status, type, direction, generated, value, network_fee, processing_fee, add_index, memo, request, error, hidden)
-		VALUES($1, $2, NOW(), NOW(), $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
+		INSERT INTO ledger (id, account_id, created_at, updated_at, expires_at, status, type, direction, generated, value, network_fee, processing_fee, add_index, memo, request, error, hidden, hold, cancel_height, sat_per_byte)
+		VALUES($1, $2, NOW(), NOW(), $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18)
 		ON CONFLICT (id, direction) DO UPDATE
 		SET
 		updated_at = NOW(),
@@ -248,9 +309,10 @@ func (c *Client) processLedgerRecord(ctx context.Context, tx *sqlx.Tx, lr *tdrpc
 		memo = $12,
 		request = $13,
 		error = $14,
-		hidden = $15
+		hidden = $15,
+		cancel_height = $17
 		RETURNING *
-	`, lr.Id, lr.AccountId, lr.ExpiresAt, lr.Status, lr.Type, lr.Direction, lr.Generated, lr.Value, lr.NetworkFee, lr.ProcessingFee, lr.AddIndex, lr.Memo, lr.Request, lr.Error, lr.Hidden)
+	`, lr.Id, lr.AccountId, lr.ExpiresAt, lr.Status, lr.Type, lr.Direction, lr.Generated, lr.Value, lr.NetworkFee, lr.ProcessingFee, lr.AddIndex, lr.Memo, lr.Request, lr.Error, lr.Hidden, lr.Hold, lr.CancelHeight, lr.SatPerByte)
 	if err != nil {
 		return fmt.Errorf("Could not process ledger: %v", err)
 	}
@@ -1,6 +1,8 @@
 package postgres
 
 import (
+	"time"
+
 	"git.coinninja.net/backend/thunderdome/store"
 	"git.coinninja.net/backend/thunderdome/tdrpc"
 )
@@ -304,3 +306,234 @@ func (suite *DBTestSuite) TestProcessLedgerPreAuth() {
 	suite.Equal(lr2, lr3)
 
 }
+
+func (suite *DBTestSuite) TestProcessLedgerRecordHeld() {
+
+	// Create a test account
+	a1 := suite.newTestAccount("testuser1", 100)
+
+	// Held records reserve the funds
+	lr1 := &tdrpc.LedgerRecord{
+		Id:            "tr1",
+		AccountId:     a1.Id,
+		Status:        tdrpc.HELD,
+		Type:          tdrpc.BTC,
+		Direction:     tdrpc.OUT,
+		Value:         50,
+		NetworkFee:    5,
+		ProcessingFee: 5,
+	}
+	err := suite.client.ProcessLedgerRecord(suite.ctx, lr1)
+	suite.Nil(err)
+
+	a1, err = suite.client.GetAccountByID(suite.ctx, a1.Id)
+	suite.Nil(err)
+	suite.Equal(int64(40), a1.Balance)
+	suite.Equal(int64(60), a1.PendingOut)
+
+	// Released to pending, the funds stay reserved
+	lr1.Status = tdrpc.PENDING
+	err = suite.client.ProcessLedgerRecord(suite.ctx, lr1)
+	suite.Nil(err)
+
+	a1, err = suite.client.GetAccountByID(suite.ctx, a1.Id)
+	suite.Nil(err)
+	suite.Equal(int64(40), a1.Balance)
+	suite.Equal(int64(60), a1.PendingOut)
+
+	// Held then failed returns the funds
+	lr2 := &tdrpc.LedgerRecord{
+		Id:        "tr2",
+		AccountId: a1.Id,
+		Status:    tdrpc.HELD,
+		Type:      tdrpc.LIGHTNING,
+		Direction: tdrpc.OUT,
+		Value:     40,
+	}
+	err = suite.client.ProcessLedgerRecord(suite.ctx, lr2)
+	suite.Nil(err)
+	lr2.Status = tdrpc.FAILED
+	err = suite.client.ProcessLedgerRecord(suite.ctx, lr2)
+	suite.Nil(err)
+
+	a1, err = suite.client.GetAccountByID(suite.ctx, a1.Id)
+	suite.Nil(err)
+	suite.Equal(int64(40), a1.Balance)
+	suite.Equal(int64(60), a1.PendingOut)
+
+	// Completed records cannot be held
+	lr1.Status = tdrpc.COMPLETED
+	err = suite.client.ProcessLedgerRecord(suite.ctx, lr1)
+	suite.Nil(err)
+	lr1.Status = tdrpc.HELD
+	err = suite.client.ProcessLedgerRecord(suite.ctx, lr1)
+	suite.NotNil(err)
+
+	// Inbound records cannot be held
+	err = suite.client.ProcessLedgerRecord(suite.ctx, &tdrpc.LedgerRecord{
+		Id:        "tr3",
+		AccountId: a1.Id,
+		Status:    tdrpc.HELD,
+		Type:      tdrpc.LIGHTNING,
+		Direction: tdrpc.IN,
+		Value:     10,
+	})
+	suite.NotNil(err)
+
+}
+
+func (suite *DBTestSuite) TestProcessLedgerRecordAccepted() {
+
+	// Create a test account
+	a1 := suite.newTestAccount("testuser1", 0)
+
+	// A hold invoice
+	lr1 := &tdrpc.LedgerRecord{
+		Id:        "tr1",
+		AccountId: a1.Id,
+		Status:    tdrpc.PENDING,
+		Type:      tdrpc.LIGHTNING,
+		Direction: tdrpc.IN,
+		Value:     10,
+		Memo:      "memo-tr1",
+		Request:   "request-tr1",
+		Hold:      true,
+	}
+
+	err := suite.client.ProcessLedgerRecord(suite.ctx, lr1)
+	suite.Nil(err)
+	suite.True(lr1.Hold)
+
+	// Accepted funds are visible in pending_in but not spendable
+	lr1.Status = tdrpc.ACCEPTED
+	lr1.Value = 12
+	lr1.CancelHeight = 600100
+	err = suite.client.ProcessLedgerRecord(suite.ctx, lr1)
+	suite.Nil(err)
+	suite.Equal(int64(600100), lr1.CancelHeight)
+
+	// The cancel height is stored on the record
+	lr, err := suite.client.GetLedgerRecord(suite.ctx, lr1.Id, tdrpc.IN)
+	suite.Nil(err)
+	suite.Equal(int64(600100), lr.CancelHeight)
+
+	a1, err = suite.client.GetAccountByID(suite.ctx, a1.Id)
+	suite.Nil(err)
+	suite.Equal(int64(0), a1.Balance)
+	suite.Equal(int64(12), a1.PendingIn)
+
+	// Settled funds are credited
+	lr1.Status = tdrpc.COMPLETED
+	err = suite.client.ProcessLedgerRecord(suite.ctx, lr1)
+	suite.Nil(err)
+
+	a1, err = suite.client.GetAccountByID(suite.ctx, a1.Id)
+	suite.Nil(err)
+	suite.Equal(int64(12), a1.Balance)
+	suite.Equal(int64(0), a1.PendingIn)
+
+	// Cannot go back to accepted
+	lr1.Status = tdrpc.ACCEPTED
+	err = suite.client.ProcessLedgerRecord(suite.ctx, lr1)
+	suite.NotNil(err)
+
+	// A canceled hold invoice removes the accepted funds
+	lr2 := &tdrpc.LedgerRecord{
+		Id:        "tr2",
+		AccountId: a1.Id,
+		Status:    tdrpc.ACCEPTED,
+		Type:      tdrpc.LIGHTNING,
+		Direction: tdrpc.IN,
+		Value:     5,
+		Hold:      true,
+	}
+	err = suite.client.ProcessLedgerRecord(suite.ctx, lr2)
+	suite.Nil(err)
+
+	lr2.Status = tdrpc.EXPIRED
+	err = suite.client.ProcessLedgerRecord(suite.ctx, lr2)
+	suite.Nil(err)
+
+	a1, err = suite.client.GetAccountByID(suite.ctx, a1.Id)
+	suite.Nil(err)
+	suite.Equal(int64(12), a1.Balance)
+	suite.Equal(int64(0), a1.PendingIn)
+
+	// Only inbound lightning can be accepted
+	lr3 := &tdrpc.LedgerRecord{
+		Id:        "tr3",
+		AccountId: a1.Id,
+		Status:    tdrpc.ACCEPTED,
+		Type:      tdrpc.LIGHTNING,
+		Direction: tdrpc.OUT,
+		Value:     5,
+	}
+	err = suite.client.ProcessLedgerRecord(suite.ctx, lr3)
+	suite.NotNil(err)
+
+	suite.Nil(suite.client.CheckDatabaseConsistency(suite.ctx))
+
+}
+
+func (suite *DBTestSuite) TestProcessLedgerRecordRebalance() {
+
+	// The house account is created by the migrations but the tests remove every account
+	house := suite.newTestAccount(tdrpc.HouseAccountId, 0)
+	a1 := suite.newTestAccount("testuser1", 100)
+
+	// Rebalances can only be charged to the house account
+	err := suite.client.ProcessLedgerRecord(suite.ctx, &tdrpc.LedgerRecord{
+		Id:         tdrpc.RebalanceLedgerRecordIdPrefix + "tr0",
+		AccountId:  a1.Id,
+		Status:     tdrpc.COMPLETED,
+		Type:       tdrpc.REBALANCE,
+		Direction:  tdrpc.OUT,
+		NetworkFee: 5,
+	})
+	suite.NotNil(err)
+
+	// They are only recorded once paid
+	err = suite.client.ProcessLedgerRecord(suite.ctx, &tdrpc.LedgerRecord{
+		Id:         tdrpc.RebalanceLedgerRecordIdPrefix + "tr1",
+		AccountId:  house.Id,
+		Status:     tdrpc.PENDING,
+		Type:       tdrpc.REBALANCE,
+		Direction:  tdrpc.OUT,
+		NetworkFee: 5,
+	})
+	suite.NotNil(err)
+
+	// The house account pays the cost even though it has no balance
+	err = suite.client.ProcessLedgerRecord(suite.ctx, &tdrpc.LedgerRecord{
+		Id:         tdrpc.RebalanceLedgerRecordIdPrefix + "tr2",
+		AccountId:  house.Id,
+		Status:     tdrpc.COMPLETED,
+		Type:       tdrpc.REBALANCE,
+		Direction:  tdrpc.OUT,
+		NetworkFee: 5,
+	})
+	suite.Nil(err)
+
+	house, err = suite.client.GetAccountByID(suite.ctx, house.Id)
+	suite.Nil(err)
+	suite.Equal(int64(-5), house.Balance)
+
+	// The cost shows up in the fee stats
+	lrStats, err := suite.client.GetLedgerRecordStats(suite.ctx, map[string]string{
+		"account_id": tdrpc.HouseAccountId,
+		"type":       tdrpc.REBALANCE.String(),
+	}, time.Time{})
+	suite.Nil(err)
+	suite.Equal(int64(1), lrStats.Count)
+	suite.Equal(int64(5), lrStats.NetworkFee)
+
+	// The house account is not included in the user stats
+	aStats, err := suite.client.GetAccountStats(suite.ctx)
+	suite.Nil(err)
+	suite.Equal(int64(1), aStats.Count)
+	suite.Equal(int64(100), aStats.Balance)
+
+	// The ledger still balances
+	suite.Nil(suite.client.CheckDatabaseConsistency(suite.ctx))
+
+}
@@ -0,0 +1,16 @@
+-- agent key table
+CREATE TABLE public.agent_key (
+  id TEXT PRIMARY KEY,
+  created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+  updated_at TIMESTAMP WITH TIME ZONE,
+  name TEXT NOT NULL DEFAULT '',
+  secret_hash TEXT NOT NULL,
+  scopes TEXT[] NOT NULL DEFAULT '{}',
+  account_ids TEXT[] NOT NULL DEFAULT '{}',
+  value_limit BIGINT NOT NULL DEFAULT 0,
+  expires_at TIMESTAMP WITH TIME ZONE,
+  revoked_at TIMESTAMP WITH TIME ZONE
+);
+
+-- Keys are looked up by the hash of the secret
+CREATE UNIQUE INDEX ix_agent_key_secret_hash ON public.agent_key USING btree(secret_hash);
@@ -0,0 +1 @@
+DROP TABLE public.delegation_spend;
@@ -0,0 +1,12 @@
+-- delegation spend tracking table
+CREATE TABLE public.delegation_spend (
+  delegation_id TEXT NOT NULL,
+  day DATE NOT NULL,
+  account_id TEXT NOT NULL,
+  updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+  value BIGINT NOT NULL DEFAULT 0,
+  PRIMARY KEY (delegation_id, day)
+);
+
+ALTER TABLE ONLY public.delegation_spend
+  ADD CONSTRAINT fkey_delegation_spend_account_id FOREIGN KEY (account_id) REFERENCES public.account(id) ON DELETE CASCADE;
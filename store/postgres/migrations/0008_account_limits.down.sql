@@ -0,0 +1,2 @@
+DROP INDEX public.ix_ledger_account_id_direction_created_at;
+DROP TABLE public.account_limits;
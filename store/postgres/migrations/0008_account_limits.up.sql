@@ -0,0 +1,16 @@
+-- per account limit tier and overrides
+CREATE TABLE public.account_limits (
+  account_id TEXT PRIMARY KEY,
+  created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+  updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+  tier TEXT NOT NULL DEFAULT '',
+  daily_send BIGINT NOT NULL DEFAULT 0,
+  weekly_send BIGINT NOT NULL DEFAULT 0,
+  max_payment BIGINT NOT NULL DEFAULT 0,
+  daily_withdraw BIGINT NOT NULL DEFAULT 0
+);
+
+ALTER TABLE ONLY public.account_limits
+  ADD CONSTRAINT fkey_account_limits_account_id FOREIGN KEY (account_id) REFERENCES public.account(id) ON DELETE CASCADE;
+
+CREATE INDEX ix_ledger_account_id_direction_created_at ON public.ledger USING btree(account_id, direction, created_at);
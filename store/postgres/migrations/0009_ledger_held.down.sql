@@ -0,0 +1,6 @@
+-- Postgres cannot drop an enum value, recreate the type without held (held funds are already in pending_out)
+UPDATE ledger SET status = 'pending' WHERE status = 'held';
+ALTER TYPE ledger_status RENAME TO ledger_status_old;
+CREATE TYPE ledger_status AS ENUM ('pending', 'completed', 'expired','failed');
+ALTER TABLE ledger ALTER COLUMN status TYPE ledger_status USING status::text::ledger_status;
+DROP TYPE ledger_status_old;
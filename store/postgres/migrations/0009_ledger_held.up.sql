@@ -0,0 +1,2 @@
+-- held ledger status for records awaiting manual review
+ALTER TYPE ledger_status ADD VALUE 'held';
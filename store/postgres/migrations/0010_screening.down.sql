@@ -0,0 +1,2 @@
+DROP TABLE public.screening_history;
+DROP TABLE public.screening_entry;
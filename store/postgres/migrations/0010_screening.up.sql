@@ -0,0 +1,30 @@
+-- destination screening lists
+CREATE TABLE public.screening_entry (
+  id TEXT PRIMARY KEY,
+  created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+  updated_at TIMESTAMP WITH TIME ZONE,
+  list TEXT NOT NULL,
+  type TEXT NOT NULL,
+  value TEXT NOT NULL,
+  action TEXT NOT NULL DEFAULT '',
+  note TEXT NOT NULL DEFAULT ''
+);
+
+-- Destinations are looked up by type and value
+CREATE UNIQUE INDEX ix_screening_entry_type_value_list ON public.screening_entry USING btree(type, value, list);
+
+-- every change to the screening lists
+CREATE TABLE public.screening_history (
+  id BIGSERIAL PRIMARY KEY,
+  entry_id TEXT NOT NULL,
+  created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+  operation TEXT NOT NULL,
+  operator TEXT NOT NULL DEFAULT '',
+  list TEXT NOT NULL,
+  type TEXT NOT NULL,
+  value TEXT NOT NULL,
+  action TEXT NOT NULL DEFAULT '',
+  note TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX ix_screening_history_entry_id_created_at ON public.screening_history USING btree(entry_id, created_at);
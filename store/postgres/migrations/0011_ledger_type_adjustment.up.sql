@@ -0,0 +1,2 @@
+-- manual adjustments made by an admin (ADD VALUE must be the only statement in the migration)
+ALTER TYPE ledger_type ADD VALUE 'adjustment';
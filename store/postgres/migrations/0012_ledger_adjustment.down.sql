@@ -0,0 +1 @@
+DROP TABLE public.ledger_adjustment;
@@ -0,0 +1,15 @@
+-- who made each adjustment and why
+CREATE TABLE public.ledger_adjustment (
+  ledger_id TEXT PRIMARY KEY,
+  account_id TEXT NOT NULL,
+  created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+  value BIGINT NOT NULL,
+  operator TEXT NOT NULL DEFAULT '',
+  reason TEXT NOT NULL,
+  ticket_id TEXT NOT NULL DEFAULT ''
+);
+
+ALTER TABLE ONLY public.ledger_adjustment
+  ADD CONSTRAINT fkey_ledger_adjustment_account_id FOREIGN KEY (account_id) REFERENCES public.account(id) ON DELETE CASCADE;
+
+CREATE INDEX ix_ledger_adjustment_account_id_created_at ON public.ledger_adjustment USING btree(account_id, created_at);
@@ -0,0 +1 @@
+DROP TABLE public.admin_audit;
@@ -0,0 +1,13 @@
+-- every change made through the admin API
+CREATE TABLE public.admin_audit (
+  id BIGSERIAL PRIMARY KEY,
+  created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+  operator TEXT NOT NULL DEFAULT '',
+  method TEXT NOT NULL,
+  request TEXT NOT NULL DEFAULT '',
+  code TEXT NOT NULL,
+  error TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX ix_admin_audit_created_at ON public.admin_audit USING btree(created_at);
+CREATE INDEX ix_admin_audit_operator_created_at ON public.admin_audit USING btree(operator, created_at);
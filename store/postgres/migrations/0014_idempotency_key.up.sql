@@ -0,0 +1,16 @@
+-- client supplied idempotency keys and the response to replay
+CREATE TABLE public.idempotency_key (
+  account_id TEXT NOT NULL,
+  key TEXT NOT NULL,
+  endpoint TEXT NOT NULL,
+  request_hash TEXT NOT NULL,
+  response BYTEA,
+  created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+  expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+  PRIMARY KEY (account_id, key)
+);
+
+ALTER TABLE ONLY public.idempotency_key
+  ADD CONSTRAINT fkey_idempotency_key_account_id FOREIGN KEY (account_id) REFERENCES public.account(id) ON DELETE CASCADE;
+
+CREATE INDEX ix_idempotency_key_expires_at ON public.idempotency_key USING btree(expires_at);
@@ -0,0 +1,7 @@
+-- Postgres cannot drop an enum value, recreate the type without accepted (accepted funds are only in pending_in)
+UPDATE account SET pending_in = pending_in - COALESCE((SELECT SUM(value) FROM ledger WHERE ledger.account_id = account.id AND ledger.status = 'accepted'), 0);
+UPDATE ledger SET status = 'expired' WHERE status = 'accepted';
+ALTER TYPE ledger_status RENAME TO ledger_status_old;
+CREATE TYPE ledger_status AS ENUM ('pending', 'completed', 'expired','failed', 'held');
+ALTER TABLE ledger ALTER COLUMN status TYPE ledger_status USING status::text::ledger_status;
+DROP TYPE ledger_status_old;
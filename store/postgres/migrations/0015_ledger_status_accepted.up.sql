@@ -0,0 +1,2 @@
+-- accepted ledger status for hold invoices that are paid but not settled (ADD VALUE must be the only statement in the migration)
+ALTER TYPE ledger_status ADD VALUE 'accepted';
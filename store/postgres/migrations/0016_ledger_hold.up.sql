@@ -0,0 +1,3 @@
+-- hold invoices are paid but not settled until released
+ALTER TABLE ledger
+    ADD COLUMN hold BOOLEAN NOT NULL DEFAULT false;
@@ -0,0 +1,6 @@
+-- where the monitors resume processing from after a restart
+CREATE TABLE public.monitor_checkpoint (
+  name TEXT PRIMARY KEY,
+  value BIGINT NOT NULL,
+  updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
@@ -0,0 +1,6 @@
+-- Postgres cannot drop an enum value, recreate the type without rebalance (rebalance costs can no longer be represented)
+DELETE FROM ledger WHERE type = 'rebalance';
+ALTER TYPE ledger_type RENAME TO ledger_type_old;
+CREATE TYPE ledger_type AS ENUM ('btc', 'lightning', 'adjustment');
+ALTER TABLE ledger ALTER COLUMN type TYPE ledger_type USING type::text::ledger_type;
+DROP TYPE ledger_type_old;
@@ -0,0 +1,2 @@
+-- the cost of channel rebalances (ADD VALUE must be the only statement in the migration)
+ALTER TYPE ledger_type ADD VALUE 'rebalance';
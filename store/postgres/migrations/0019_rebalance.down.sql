@@ -0,0 +1 @@
+DELETE FROM account WHERE id = 'internal:house';
@@ -0,0 +1,2 @@
+-- Create the house account that pays for operating the node, its balance goes negative as costs are recorded
+INSERT INTO account (id, updated_at, address) VALUES ('internal:house', NOW(), 'house');
@@ -0,0 +1,3 @@
+DROP TABLE public.channel_activity;
+DROP TABLE public.route_failure;
+DROP TABLE public.channel_proposal;
@@ -0,0 +1,37 @@
+-- channel opens and closes proposed by the channel manager
+CREATE TABLE public.channel_proposal (
+  id TEXT PRIMARY KEY,
+  created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+  updated_at TIMESTAMP WITH TIME ZONE,
+  action TEXT NOT NULL,
+  node_pubkey TEXT NOT NULL,
+  chan_point TEXT NOT NULL DEFAULT '',
+  value BIGINT NOT NULL DEFAULT 0,
+  reason TEXT NOT NULL DEFAULT '',
+  status TEXT NOT NULL,
+  operator TEXT NOT NULL DEFAULT '',
+  txid TEXT NOT NULL DEFAULT '',
+  error TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX ix_channel_proposal_status_created_at ON public.channel_proposal USING btree(status, created_at);
+
+-- Only one proposal for the same node and channel can be outstanding at a time
+CREATE UNIQUE INDEX ix_channel_proposal_action_node_pubkey_chan_point ON public.channel_proposal USING btree(action, node_pubkey, chan_point) WHERE status IN ('proposed', 'approved');
+
+-- payments that could not be routed to a destination
+CREATE TABLE public.route_failure (
+  id BIGSERIAL PRIMARY KEY,
+  created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+  destination TEXT NOT NULL,
+  value BIGINT NOT NULL DEFAULT 0
+);
+
+CREATE INDEX ix_route_failure_created_at ON public.route_failure USING btree(created_at);
+
+-- the last time each channel was used
+CREATE TABLE public.channel_activity (
+  chan_point TEXT PRIMARY KEY,
+  num_updates BIGINT NOT NULL,
+  active_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
@@ -0,0 +1 @@
+DROP INDEX public.chan_backup_timestamp_idx;
@@ -0,0 +1,2 @@
+-- channel backups are listed and pruned by timestamp
+CREATE INDEX chan_backup_timestamp_idx ON public.chan_backup (timestamp);
@@ -0,0 +1,10 @@
+-- the delegation that created each pre-auth, a delegate can only pay with its own pre-auths
+CREATE TABLE public.preauth_delegation (
+  ledger_id TEXT PRIMARY KEY,
+  delegation_id TEXT NOT NULL,
+  account_id TEXT NOT NULL,
+  created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+ALTER TABLE ONLY public.preauth_delegation
+  ADD CONSTRAINT fkey_preauth_delegation_account_id FOREIGN KEY (account_id) REFERENCES public.account(id) ON DELETE CASCADE;
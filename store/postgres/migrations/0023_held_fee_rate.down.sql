@@ -0,0 +1 @@
+DROP TABLE public.held_fee_rate;
@@ -0,0 +1,6 @@
+-- the fee rate quoted for a held withdraw, it is sent at this rate when approved
+CREATE TABLE public.held_fee_rate (
+  ledger_id TEXT PRIMARY KEY,
+  sat_per_byte BIGINT NOT NULL,
+  created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
@@ -0,0 +1 @@
+DROP TABLE public.hold_cancel_height;
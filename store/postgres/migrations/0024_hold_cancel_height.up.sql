@@ -0,0 +1,6 @@
+-- the block height an accepted hold invoice is canceled at, before its htlcs expire
+CREATE TABLE public.hold_cancel_height (
+  ledger_id TEXT PRIMARY KEY,
+  height BIGINT NOT NULL,
+  created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
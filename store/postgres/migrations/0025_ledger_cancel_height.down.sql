@@ -0,0 +1,8 @@
+CREATE TABLE public.hold_cancel_height (
+  ledger_id TEXT PRIMARY KEY,
+  height BIGINT NOT NULL,
+  created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+INSERT INTO hold_cancel_height (ledger_id, height)
+    SELECT id, cancel_height FROM ledger WHERE direction = 'in' AND cancel_height <> 0;
+ALTER TABLE ledger DROP COLUMN cancel_height;
@@ -0,0 +1,7 @@
+-- the block height an accepted hold invoice is canceled at, moved onto the ledger record
+ALTER TABLE ledger
+    ADD COLUMN cancel_height BIGINT NOT NULL DEFAULT 0;
+UPDATE ledger SET cancel_height = hold_cancel_height.height
+    FROM hold_cancel_height
+    WHERE ledger.id = hold_cancel_height.ledger_id AND ledger.direction = 'in';
+DROP TABLE public.hold_cancel_height;
@@ -0,0 +1,8 @@
+CREATE TABLE public.held_fee_rate (
+  ledger_id TEXT PRIMARY KEY,
+  sat_per_byte BIGINT NOT NULL,
+  created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+INSERT INTO held_fee_rate (ledger_id, sat_per_byte)
+    SELECT id, sat_per_byte FROM ledger WHERE direction = 'out' AND sat_per_byte <> 0;
+ALTER TABLE ledger DROP COLUMN sat_per_byte;
@@ -0,0 +1,7 @@
+-- the fee rate quoted for a held withdraw, moved onto the ledger record
+ALTER TABLE ledger
+    ADD COLUMN sat_per_byte BIGINT NOT NULL DEFAULT 0;
+UPDATE ledger SET sat_per_byte = held_fee_rate.sat_per_byte
+    FROM held_fee_rate
+    WHERE ledger.id = held_fee_rate.ledger_id AND ledger.direction = 'out';
+DROP TABLE public.held_fee_rate;
@@ -0,0 +1,57 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"git.coinninja.net/backend/thunderdome/store"
+)
+
+// GetMonitorCheckpoint returns the saved value of a monitor checkpoint
+func (c *Client) GetMonitorCheckpoint(ctx context.Context, name string) (int64, error) {
+
+	var value int64
+	err := c.db.GetContext(ctx, &value, `SELECT value FROM monitor_checkpoint WHERE name = $1`, name)
+	if err == sql.ErrNoRows {
+		return 0, store.ErrNotFound
+	} else if err != nil {
+		return 0, err
+	}
+
+	return value, nil
+
+}
+
+// SaveMonitorCheckpoint creates or updates a monitor checkpoint
+func (c *Client) SaveMonitorCheckpoint(ctx context.Context, name string, value int64) error {
+
+	_, err := c.db.ExecContext(ctx, `
+		INSERT INTO monitor_checkpoint (name, value, updated_at)
+		VALUES($1, $2, NOW())
+		ON CONFLICT (name) DO UPDATE
+		SET
+		value = $2,
+		updated_at = NOW()
+	`, name, value)
+
+	return err
+
+}
+
+// IncrMonitorCheckpoint adds one to a monitor checkpoint, creating it at one, and returns the new value
+func (c *Client) IncrMonitorCheckpoint(ctx context.Context, name string) (int64, error) {
+
+	var value int64
+	err := c.db.GetContext(ctx, &value, `
+		INSERT INTO monitor_checkpoint (name, value, updated_at)
+		VALUES($1, 1, NOW())
+		ON CONFLICT (name) DO UPDATE
+		SET
+		value = monitor_checkpoint.value + 1,
+		updated_at = NOW()
+		RETURNING value
+	`, name)
+
+	return value, err
+
+}
@@ -0,0 +1,42 @@
+package postgres
+
+import (
+	"git.coinninja.net/backend/thunderdome/store"
+)
+
+func (suite *DBTestSuite) TestMonitorCheckpoint() {
+
+	_, err := suite.client.GetMonitorCheckpoint(suite.ctx, "test")
+	suite.Equal(store.ErrNotFound, err)
+
+	err = suite.client.SaveMonitorCheckpoint(suite.ctx, "test", 10)
+	suite.Nil(err)
+
+	value, err := suite.client.GetMonitorCheckpoint(suite.ctx, "test")
+	suite.Nil(err)
+	suite.Equal(int64(10), value)
+
+	err = suite.client.SaveMonitorCheckpoint(suite.ctx, "test", 20)
+	suite.Nil(err)
+
+	value, err = suite.client.GetMonitorCheckpoint(suite.ctx, "test")
+	suite.Nil(err)
+	suite.Equal(int64(20), value)
+
+}
+
+func (suite *DBTestSuite) TestIncrMonitorCheckpoint() {
+
+	value, err := suite.client.IncrMonitorCheckpoint(suite.ctx, "test")
+	suite.Nil(err)
+	suite.Equal(int64(1), value)
+
+	value, err = suite.client.IncrMonitorCheckpoint(suite.ctx, "test")
+	suite.Nil(err)
+	suite.Equal(int64(2), value)
+
+	value, err = suite.client.GetMonitorCheckpoint(suite.ctx, "test")
+	suite.Nil(err)
+	suite.Equal(int64(2), value)
+
+}
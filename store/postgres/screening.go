@@ -0,0 +1,228 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	"git.coinninja.net/backend/thunderdome/store"
+	"git.coinninja.net/backend/thunderdome/tdrpc"
+)
+
+// Screening history operations
+const (
+	screeningOperationCreate = "create"
+	screeningOperationUpdate = "update"
+	screeningOperationDelete = "delete"
+)
+
+// GetScreeningEntries fetches screening entries with filter and pagination
+func (c *Client) GetScreeningEntries(ctx context.Context, filter map[string]string, offset int, limit int) ([]*tdrpc.ScreeningEntry, error) {
+
+	var queryClause string
+	var queryParams = []interface{}{}
+
+	// Validate the filters
+	for filter, value := range filter {
+		switch filter {
+		case "list", "type", "value":
+			if value == "" {
+				return nil, fmt.Errorf("Invalid value for %s", filter)
+			}
+			queryParams = append(queryParams, value)
+			queryClause += fmt.Sprintf(" AND %s = $%d", filter, len(queryParams))
+		default:
+			return nil, fmt.Errorf("Unsupported filter %s", filter)
+		}
+	}
+
+	queryClause += " ORDER BY created_at"
+
+	if limit > 0 {
+		queryClause += fmt.Sprintf(" LIMIT %d", limit)
+	}
+	if offset > 0 {
+		queryClause += fmt.Sprintf(" OFFSET %d", offset)
+	}
+
+	var entries = make([]*tdrpc.ScreeningEntry, 0)
+	err := c.db.SelectContext(ctx, &entries, `SELECT * FROM screening_entry WHERE 1=1`+queryClause, queryParams...)
+	if err != nil {
+		return entries, err
+	}
+
+	return entries, nil
+}
+
+// GetScreeningEntry fetches a screening entry by ID
+func (c *Client) GetScreeningEntry(ctx context.Context, id string) (*tdrpc.ScreeningEntry, error) {
+
+	entry := new(tdrpc.ScreeningEntry)
+	err := c.db.GetContext(ctx, entry, `SELECT * FROM screening_entry WHERE id = $1`, id)
+	if err == sql.ErrNoRows {
+		return nil, store.ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+// GetScreeningEntriesByValue fetches the allow and deny list entries for a destination
+func (c *Client) GetScreeningEntriesByValue(ctx context.Context, screeningType string, value string) ([]*tdrpc.ScreeningEntry, error) {
+
+	var entries = make([]*tdrpc.ScreeningEntry, 0)
+	err := c.db.SelectContext(ctx, &entries, `SELECT * FROM screening_entry WHERE type = $1 AND value = $2`, screeningType, value)
+	if err != nil {
+		return entries, err
+	}
+
+	return entries, nil
+}
+
+// CreateScreeningEntry creates a new screening entry and records the change in the history
+func (c *Client) CreateScreeningEntry(ctx context.Context, entry *tdrpc.ScreeningEntry, operator string) (*tdrpc.ScreeningEntry, error) {
+
+	if entry.Id == "" {
+		entry.Id = c.newID()
+	}
+
+	var ret = new(tdrpc.ScreeningEntry)
+	err := c.screeningTx(ctx, func(tx *sqlx.Tx) error {
+		err := tx.GetContext(ctx, ret, `
+			INSERT INTO screening_entry (id, created_at, updated_at, list, type, value, action, note)
+			VALUES($1, NOW(), NOW(), $2, $3, $4, $5, $6)
+			RETURNING *`,
+			entry.Id, entry.List, entry.Type, entry.Value, entry.Action, entry.Note)
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code.Name() == "unique_violation" {
+			return store.ErrAlreadyExists
+		} else if err != nil {
+			return err
+		}
+		return addScreeningHistory(ctx, tx, ret, screeningOperationCreate, operator)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+
+}
+
+// SaveScreeningEntry updates an existing screening entry and records the change in the history
+func (c *Client) SaveScreeningEntry(ctx context.Context, entry *tdrpc.ScreeningEntry, operator string) (*tdrpc.ScreeningEntry, error) {
+
+	var ret = new(tdrpc.ScreeningEntry)
+	err := c.screeningTx(ctx, func(tx *sqlx.Tx) error {
+		err := tx.GetContext(ctx, ret, `
+			UPDATE screening_entry SET
+			updated_at = NOW(),
+			list = $2,
+			type = $3,
+			value = $4,
+			action = $5,
+			note = $6
+			WHERE id = $1
+			RETURNING *`,
+			entry.Id, entry.List, entry.Type, entry.Value, entry.Action, entry.Note)
+		if err == sql.ErrNoRows {
+			return store.ErrNotFound
+		} else if pqErr, ok := err.(*pq.Error); ok && pqErr.Code.Name() == "unique_violation" {
+			return store.ErrAlreadyExists
+		} else if err != nil {
+			return err
+		}
+		return addScreeningHistory(ctx, tx, ret, screeningOperationUpdate, operator)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+
+}
+
+// DeleteScreeningEntry removes a screening entry and records the change in the history
+func (c *Client) DeleteScreeningEntry(ctx context.Context, id string, operator string) error {
+
+	return c.screeningTx(ctx, func(tx *sqlx.Tx) error {
+		var entry = new(tdrpc.ScreeningEntry)
+		err := tx.GetContext(ctx, entry, `DELETE FROM screening_entry WHERE id = $1 RETURNING *`, id)
+		if err == sql.ErrNoRows {
+			return store.ErrNotFound
+		} else if err != nil {
+			return err
+		}
+		return addScreeningHistory(ctx, tx, entry, screeningOperationDelete, operator)
+	})
+
+}
+
+// GetScreeningHistory fetches the history of changes to the screening lists, newest first
+func (c *Client) GetScreeningHistory(ctx context.Context, entryID string, offset int, limit int) ([]*tdrpc.ScreeningHistory, error) {
+
+	var queryClause string
+	var queryParams = []interface{}{}
+
+	if entryID != "" {
+		queryParams = append(queryParams, entryID)
+		queryClause += fmt.Sprintf(" AND entry_id = $%d", len(queryParams))
+	}
+
+	queryClause += " ORDER BY created_at DESC, id DESC"
+
+	if limit > 0 {
+		queryClause += fmt.Sprintf(" LIMIT %d", limit)
+	}
+	if offset > 0 {
+		queryClause += fmt.Sprintf(" OFFSET %d", offset)
+	}
+
+	var history = make([]*tdrpc.ScreeningHistory, 0)
+	err := c.db.SelectContext(ctx, &history, `SELECT * FROM screening_history WHERE 1=1`+queryClause, queryParams...)
+	if err != nil {
+		return history, err
+	}
+
+	return history, nil
+}
+
+// screeningTx runs f in a transaction so the history is always recorded with the change
+func (c *Client) screeningTx(ctx context.Context, f func(tx *sqlx.Tx) error) error {
+
+	tx, err := c.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("Could not start transaction: %v", err)
+	}
+
+	if err = f(tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("Commit Error: %v", err)
+	}
+
+	return nil
+
+}
+
+// addScreeningHistory records a change to a screening entry
+func addScreeningHistory(ctx context.Context, tx *sqlx.Tx, entry *tdrpc.ScreeningEntry, operation string, operator string) error {
+
+	_, err := tx.ExecContext(ctx, `
+		INSERT INTO screening_history (entry_id, created_at, operation, operator, list, type, value, action, note)
+		VALUES($1, NOW(), $2, $3, $4, $5, $6, $7, $8)`,
+		entry.Id, operation, operator, entry.List, entry.Type, entry.Value, entry.Action, entry.Note)
+	if err != nil {
+		return fmt.Errorf("Could not record screening history: %v", err)
+	}
+
+	return nil
+
+}
@@ -0,0 +1,72 @@
+package postgres
+
+import (
+	"git.coinninja.net/backend/thunderdome/store"
+	"git.coinninja.net/backend/thunderdome/tdrpc"
+)
+
+func (suite *DBTestSuite) TestScreening() {
+
+	// Create an entry
+	e1, err := suite.client.CreateScreeningEntry(suite.ctx, &tdrpc.ScreeningEntry{
+		List:   tdrpc.ScreeningListDeny,
+		Type:   tdrpc.ScreeningTypeAddress,
+		Value:  "bc1qtest",
+		Action: tdrpc.ScreeningActionBlock,
+		Note:   "sanctioned",
+	}, "operator1")
+	suite.Nil(err)
+	suite.NotEmpty(e1.Id)
+
+	// Duplicate
+	_, err = suite.client.CreateScreeningEntry(suite.ctx, &tdrpc.ScreeningEntry{
+		List:   tdrpc.ScreeningListDeny,
+		Type:   tdrpc.ScreeningTypeAddress,
+		Value:  "bc1qtest",
+		Action: tdrpc.ScreeningActionHold,
+	}, "operator1")
+	suite.Equal(store.ErrAlreadyExists, err)
+
+	// Lookup by value
+	entries, err := suite.client.GetScreeningEntriesByValue(suite.ctx, tdrpc.ScreeningTypeAddress, "bc1qtest")
+	suite.Nil(err)
+	suite.Len(entries, 1)
+	suite.Equal(e1, entries[0])
+	entries, err = suite.client.GetScreeningEntriesByValue(suite.ctx, tdrpc.ScreeningTypeNode, "bc1qtest")
+	suite.Nil(err)
+	suite.Len(entries, 0)
+
+	// Update
+	e1.Action = tdrpc.ScreeningActionHold
+	e1, err = suite.client.SaveScreeningEntry(suite.ctx, e1, "operator2")
+	suite.Nil(err)
+	suite.Equal(tdrpc.ScreeningActionHold, e1.Action)
+
+	// List with filter
+	entries, err = suite.client.GetScreeningEntries(suite.ctx, map[string]string{"list": tdrpc.ScreeningListDeny}, 0, 0)
+	suite.Nil(err)
+	suite.Len(entries, 1)
+	entries, err = suite.client.GetScreeningEntries(suite.ctx, map[string]string{"list": tdrpc.ScreeningListAllow}, 0, 0)
+	suite.Nil(err)
+	suite.Len(entries, 0)
+
+	// Delete
+	err = suite.client.DeleteScreeningEntry(suite.ctx, e1.Id, "operator3")
+	suite.Nil(err)
+	_, err = suite.client.GetScreeningEntry(suite.ctx, e1.Id)
+	suite.Equal(store.ErrNotFound, err)
+	err = suite.client.DeleteScreeningEntry(suite.ctx, e1.Id, "operator3")
+	suite.Equal(store.ErrNotFound, err)
+
+	// Every change is in the history, newest first
+	history, err := suite.client.GetScreeningHistory(suite.ctx, e1.Id, 0, 0)
+	suite.Nil(err)
+	suite.Len(history, 3)
+	suite.Equal("delete", history[0].Operation)
+	suite.Equal("operator3", history[0].Operator)
+	suite.Equal("update", history[1].Operation)
+	suite.Equal(tdrpc.ScreeningActionHold, history[1].Action)
+	suite.Equal("create", history[2].Operation)
+	suite.Equal("operator1", history[2].Operator)
+
+}
@@ -52,6 +52,33 @@ func (suite *DBTestSuite) SetupTest() {
 	_, err = suite.client.db.Exec(`DELETE FROM account`)
 	assert.Nil(suite.T(), err)
 
+	_, err = suite.client.db.Exec(`DELETE FROM agent_key`)
+	assert.Nil(suite.T(), err)
+
+	_, err = suite.client.db.Exec(`DELETE FROM screening_entry`)
+	assert.Nil(suite.T(), err)
+
+	_, err = suite.client.db.Exec(`DELETE FROM screening_history`)
+	assert.Nil(suite.T(), err)
+
+	_, err = suite.client.db.Exec(`DELETE FROM admin_audit`)
+	assert.Nil(suite.T(), err)
+
+	_, err = suite.client.db.Exec(`DELETE FROM monitor_checkpoint`)
+	assert.Nil(suite.T(), err)
+
+	_, err = suite.client.db.Exec(`DELETE FROM channel_proposal`)
+	assert.Nil(suite.T(), err)
+
+	_, err = suite.client.db.Exec(`DELETE FROM route_failure`)
+	assert.Nil(suite.T(), err)
+
+	_, err = suite.client.db.Exec(`DELETE FROM channel_activity`)
+	assert.Nil(suite.T(), err)
+
+	_, err = suite.client.db.Exec(`DELETE FROM chan_backup`)
+	assert.Nil(suite.T(), err)
+
 }
 
 // Run the test suite
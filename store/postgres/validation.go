@@ -3,6 +3,10 @@ package postgres
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
+
+	"git.coinninja.net/backend/thunderdome/store/postgres/migrations"
 )
 
 // CheckDatabaseConsistency will balance all deposits, withdraws, sent and received invoices with the current balance to ensure no discrepancies
@@ -13,7 +17,7 @@ func (c *Client) CheckDatabaseConsistency(ctx context.Context) error {
 	SELECT
 		(SELECT COALESCE(SUM(value), 0) AS total FROM ledger WHERE direction = 'in' AND status = 'completed')
 	-
-		(SELECT COALESCE(SUM(value), 0) + COALESCE(SUM(network_fee), 0) + COALESCE(SUM(processing_fee), 0) AS total FROM ledger WHERE direction = 'out' AND (status = 'completed' OR status = 'pending'))
+		(SELECT COALESCE(SUM(value), 0) + COALESCE(SUM(network_fee), 0) + COALESCE(SUM(processing_fee), 0) AS total FROM ledger WHERE direction = 'out' AND (status = 'completed' OR status = 'pending' OR status = 'held'))
 	-
 		(SELECT COALESCE(SUM(balance), 0) FROM account)
 	AS delta
@@ -28,3 +32,38 @@ func (c *Client) CheckDatabaseConsistency(ctx context.Context) error {
 
 	return nil
 }
+
+// CheckSchema returns the database migration version and an error if it's dirty or not the latest version
+func (c *Client) CheckSchema(ctx context.Context) (int64, error) {
+
+	var schema struct {
+		Version int64 `db:"version"`
+		Dirty   bool  `db:"dirty"`
+	}
+	err := c.db.GetContext(ctx, &schema, `SELECT version, dirty FROM schema_migrations LIMIT 1`)
+	if err != nil {
+		return 0, fmt.Errorf("Could not get schema version: %v", err)
+	}
+
+	if schema.Dirty {
+		return schema.Version, fmt.Errorf("Schema version %d is dirty", schema.Version)
+	}
+
+	if latest := latestSchemaVersion(); schema.Version != latest {
+		return schema.Version, fmt.Errorf("Schema version %d is not the latest version %d", schema.Version, latest)
+	}
+
+	return schema.Version, nil
+
+}
+
+// latestSchemaVersion returns the version of the newest migration
+func latestSchemaVersion() int64 {
+	var latest int64
+	for _, name := range migrations.AssetNames() {
+		if version, err := strconv.ParseInt(strings.SplitN(name, "_", 2)[0], 10, 64); err == nil && version > latest {
+			latest = version
+		}
+	}
+	return latest
+}
@@ -0,0 +1,10 @@
+package postgres
+
+func (suite *DBTestSuite) TestCheckSchema() {
+
+	version, err := suite.client.CheckSchema(suite.ctx)
+	suite.Nil(err)
+	suite.Equal(latestSchemaVersion(), version)
+	suite.NotZero(version)
+
+}
@@ -60,3 +60,37 @@ func (c *client) Del(bucket string, key string) error {
 func (c *client) Clear(bucket string) error {
 	return c.DelPattern(c.prefix + bucket + Delimeter + "*")
 }
+
+// Ping checks the connection to redis
+func (c *client) Ping() error {
+	return c.client.Ping().Err()
+}
+
+// lockScript acquires or refreshes a lock held by ARGV[1], it returns 1 if the lock is held
+const lockScript = `local v = redis.call('GET', KEYS[1])
+if v == ARGV[1] then redis.call('PEXPIRE', KEYS[1], ARGV[2]) return 1 end
+if v then return 0 end
+redis.call('SET', KEYS[1], ARGV[1], 'PX', ARGV[2])
+return 1`
+
+// unlockScript releases a lock only if it is held by ARGV[1]
+const unlockScript = `if redis.call('GET', KEYS[1]) == ARGV[1] then return redis.call('DEL', KEYS[1]) end
+return 0`
+
+// Lock acquires a lock for value or refreshes it if value already holds it. It returns false if someone else holds the lock.
+func (c *client) Lock(bucket string, key string, value string, expires time.Duration) (bool, error) {
+	held, err := c.client.Eval(lockScript, []string{c.prefix + bucket + Delimeter + key}, value, expires.Nanoseconds()/int64(time.Millisecond)).Int64()
+	if err != nil {
+		return false, err
+	}
+	return held == 1, nil
+}
+
+// Unlock releases a lock if it is held by value
+func (c *client) Unlock(bucket string, key string, value string) error {
+	err := c.client.Eval(unlockScript, []string{c.prefix + bucket + Delimeter + key}, value).Err()
+	if err == redis.Nil {
+		return nil
+	}
+	return err
+}
@@ -115,3 +115,45 @@ func TestClear(t *testing.T) {
 	r.AssertExpectations(t)
 
 }
+
+func TestLock(t *testing.T) {
+
+	r := new(mocks.UniversalClient)
+	c := &client{
+		logger: zap.S().With("package", "cache.redis"),
+		prefix: "test",
+		client: r,
+	}
+
+	r.On("Eval", lockScript, []string{c.prefix + "bucket" + Delimeter + "key"}, "me", int64(15000)).Once().Return(redis.NewCmdResult(int64(1), nil))
+	held, err := c.Lock("bucket", "key", "me", 15*time.Second)
+	assert.Nil(t, err)
+	assert.True(t, held)
+
+	r.On("Eval", lockScript, []string{c.prefix + "bucket" + Delimeter + "key"}, "you", int64(15000)).Once().Return(redis.NewCmdResult(int64(0), nil))
+	held, err = c.Lock("bucket", "key", "you", 15*time.Second)
+	assert.Nil(t, err)
+	assert.False(t, held)
+
+	r.On("Eval", unlockScript, []string{c.prefix + "bucket" + Delimeter + "key"}, "me").Once().Return(redis.NewCmdResult(int64(1), nil))
+	assert.Nil(t, c.Unlock("bucket", "key", "me"))
+
+	r.AssertExpectations(t)
+
+}
+
+func TestPing(t *testing.T) {
+
+	r := new(mocks.UniversalClient)
+	c := &client{
+		logger: zap.S().With("package", "cache.redis"),
+		prefix: "test",
+		client: r,
+	}
+
+	r.On("Ping").Once().Return(redis.NewStatusResult("PONG", nil))
+	assert.Nil(t, c.Ping())
+
+	r.AssertExpectations(t)
+
+}
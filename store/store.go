@@ -18,4 +18,7 @@ type DistCache interface {
 	GetBytes(bucket string, key string) ([]byte, error)
 	Del(bucket string, key string) error
 	Clear(bucket string) error
+	Lock(bucket string, key string, value string, expires time.Duration) (bool, error)
+	Unlock(bucket string, key string, value string) error
+	Ping() error
 }
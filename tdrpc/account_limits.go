@@ -0,0 +1,41 @@
+package tdrpc
+
+import (
+	config "github.com/spf13/viper"
+)
+
+// LimitUnlimited can be used to override a tier default with no limit
+const LimitUnlimited int64 = -1
+
+// Effective returns the limits that apply after filling in the tier defaults. A limit of 0 is unlimited.
+func (al *AccountLimits) Effective() *AccountLimits {
+
+	tier := al.Tier
+	if tier == "" {
+		tier = config.GetString("tdome.default_limit_tier")
+	}
+
+	limit := func(override int64, name string) int64 {
+		if override == LimitUnlimited {
+			return 0
+		} else if override > 0 {
+			return override
+		}
+		return config.GetInt64("tdome.limit_tiers." + tier + "." + name)
+	}
+
+	return &AccountLimits{
+		AccountId:     al.AccountId,
+		Tier:          tier,
+		DailySend:     limit(al.DailySend, "daily_send"),
+		WeeklySend:    limit(al.WeeklySend, "weekly_send"),
+		MaxPayment:    limit(al.MaxPayment, "max_payment"),
+		DailyWithdraw: limit(al.DailyWithdraw, "daily_withdraw"),
+	}
+
+}
+
+// ValidLimitTier returns true if the tier is configured
+func ValidLimitTier(tier string) bool {
+	return config.IsSet("tdome.limit_tiers." + tier)
+}
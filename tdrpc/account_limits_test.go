@@ -0,0 +1,46 @@
+package tdrpc
+
+import (
+	"testing"
+
+	config "github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccountLimitsEffective(t *testing.T) {
+
+	config.Set("tdome.default_limit_tier", "basic")
+	config.Set("tdome.limit_tiers", map[string]interface{}{
+		"basic": map[string]interface{}{
+			"daily_send":     1000,
+			"weekly_send":    5000,
+			"max_payment":    500,
+			"daily_withdraw": 0,
+		},
+		"verified": map[string]interface{}{
+			"daily_send": 100000,
+		},
+	})
+
+	assert.True(t, ValidLimitTier("basic"))
+	assert.True(t, ValidLimitTier("verified"))
+	assert.False(t, ValidLimitTier("other"))
+
+	// Tier defaults
+	al := (&AccountLimits{AccountId: "pubkey:abc"}).Effective()
+	assert.Equal(t, "pubkey:abc", al.AccountId)
+	assert.Equal(t, "basic", al.Tier)
+	assert.Equal(t, int64(1000), al.DailySend)
+	assert.Equal(t, int64(5000), al.WeeklySend)
+	assert.Equal(t, int64(500), al.MaxPayment)
+	assert.Equal(t, int64(0), al.DailyWithdraw)
+
+	// Overrides
+	al = (&AccountLimits{Tier: "verified", WeeklySend: 200000, MaxPayment: LimitUnlimited}).Effective()
+	assert.Equal(t, "verified", al.Tier)
+	assert.Equal(t, int64(100000), al.DailySend)
+	assert.Equal(t, int64(200000), al.WeeklySend)
+	assert.Equal(t, int64(0), al.MaxPayment)
+	assert.Equal(t, int64(0), al.DailyWithdraw)
+
+}
@@ -4,11 +4,15 @@
 package tdrpc
 
 import (
+	bytes "bytes"
 	context "context"
 	fmt "fmt"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	empty "github.com/golang/protobuf/ptypes/empty"
 	_ "github.com/grpc-ecosystem/grpc-gateway/protoc-gen-swagger/options"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
@@ -16,12 +20,14 @@ import (
 	math "math"
 	reflect "reflect"
 	strings "strings"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -242,715 +248,16454 @@ func (m *AdminUpdateAccountRequest) GetLocked() bool {
 	return false
 }
 
-func init() {
-	proto.RegisterType((*AdminAccountsRequest)(nil), "tdrpc.AdminAccountsRequest")
-	proto.RegisterMapType((map[string]string)(nil), "tdrpc.AdminAccountsRequest.FilterEntry")
-	proto.RegisterType((*AdminAccountsResponse)(nil), "tdrpc.AdminAccountsResponse")
-	proto.RegisterType((*AdminGetAccountRequest)(nil), "tdrpc.AdminGetAccountRequest")
-	proto.RegisterType((*AdminUpdateAccountRequest)(nil), "tdrpc.AdminUpdateAccountRequest")
+// AdminResolveLedgerRecordRequest is used to resolve a stuck pending ledger record
+type AdminResolveLedgerRecordRequest struct {
+	// The id of the ledger record
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The direction of the ledger record
+	Direction LedgerRecord_Direction `protobuf:"varint,2,opt,name=direction,proto3,enum=tdrpc.LedgerRecord_Direction" json:"direction,omitempty"`
+	// Apply the proposed transition, otherwise it is only returned
+	Confirm bool `protobuf:"varint,3,opt,name=confirm,proto3" json:"confirm,omitempty"`
 }
 
-func init() { proto.RegisterFile("tdrpc/adminrpc.proto", fileDescriptor_5f27b1318c7cd0a2) }
-
-var fileDescriptor_5f27b1318c7cd0a2 = []byte{
-	// 572 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0x41, 0x6f, 0xd3, 0x3e,
-	0x18, 0xc6, 0xe3, 0xec, 0xbf, 0xfe, 0x87, 0xc7, 0x36, 0x66, 0x75, 0x25, 0x2b, 0xc3, 0x54, 0xb9,
-	0x30, 0x0d, 0x96, 0x48, 0xe3, 0x02, 0xbb, 0xa0, 0x6d, 0x02, 0x2e, 0x3d, 0xa0, 0x48, 0x1c, 0xe0,
-	0xe6, 0xc4, 0x6e, 0x66, 0x9a, 0xda, 0x21, 0x71, 0x40, 0x13, 0x42, 0x42, 0x7c, 0x02, 0x24, 0xbe,
-	0x04, 0x1f, 0x83, 0x23, 0xc7, 0x4a, 0x5c, 0x76, 0xa4, 0x29, 0x07, 0x8e, 0xbb, 0x73, 0x41, 0x75,
-	0x1c, 0x28, 0x6d, 0x05, 0x97, 0xd6, 0xcf, 0xfb, 0xd8, 0x3f, 0xbf, 0xef, 0xd3, 0x1a, 0x36, 0x15,
-	0xcd, 0xd2, 0xc8, 0x27, 0x74, 0xc0, 0x45, 0x96, 0x46, 0x5e, 0x9a, 0x49, 0x25, 0xd1, 0xb2, 0xae,
-	0xb6, 0x37, 0x2b, 0x53, 0x7f, 0x56, 0x4e, 0x7b, 0x27, 0x96, 0x32, 0x4e, 0x98, 0x4f, 0x52, 0xee,
-	0x13, 0x21, 0xa4, 0x22, 0x8a, 0x4b, 0x91, 0x1b, 0x77, 0x3f, 0xe6, 0xea, 0xb4, 0x08, 0xbd, 0x48,
-	0x0e, 0xfc, 0x58, 0xc6, 0xd2, 0xd7, 0xe5, 0xb0, 0xe8, 0x69, 0xa5, 0x85, 0x5e, 0x99, 0xed, 0xb7,
-	0xf5, 0x57, 0xb4, 0x1f, 0x33, 0xb1, 0x9f, 0xbf, 0x22, 0x71, 0xcc, 0x32, 0x5f, 0xa6, 0x1a, 0x38,
-	0x0f, 0x77, 0x3f, 0x01, 0xd8, 0x3c, 0x9a, 0xf4, 0x79, 0x14, 0x45, 0xb2, 0x10, 0x2a, 0x0f, 0xd8,
-	0x8b, 0x82, 0xe5, 0x0a, 0xdd, 0x87, 0x8d, 0x1e, 0x4f, 0x14, 0xcb, 0x1c, 0xd0, 0x59, 0xda, 0x5d,
-	0x3d, 0xb8, 0xe9, 0x55, 0x1d, 0x2f, 0xda, 0xec, 0x3d, 0xd4, 0x3b, 0x1f, 0x08, 0x95, 0x9d, 0x05,
-	0xe6, 0x18, 0x6a, 0xc1, 0x86, 0xec, 0xf5, 0x72, 0xa6, 0x9c, 0xa5, 0x0e, 0xd8, 0x5d, 0x0e, 0x8c,
-	0x42, 0x4d, 0xb8, 0x9c, 0xf0, 0x01, 0x57, 0xce, 0x7f, 0xba, 0x5c, 0x89, 0xf6, 0x3d, 0xb8, 0x3a,
-	0x05, 0x41, 0x57, 0xe0, 0x52, 0x9f, 0x9d, 0x39, 0xa0, 0x03, 0x76, 0x2f, 0x05, 0x93, 0xe5, 0xe4,
-	0xd8, 0x4b, 0x92, 0x14, 0xcc, 0xb1, 0x75, 0xad, 0x12, 0x87, 0xf6, 0x5d, 0xe0, 0x9e, 0xc0, 0xad,
-	0x99, 0xa6, 0xf2, 0x54, 0x8a, 0x9c, 0xa1, 0x3d, 0xb8, 0x42, 0x4c, 0xcd, 0x0c, 0xb1, 0x5e, 0x0f,
-	0x51, 0x95, 0x83, 0x5f, 0xbe, 0x7b, 0x0c, 0x5b, 0x1a, 0xf2, 0x88, 0xa9, 0xda, 0x34, 0x41, 0xac,
-	0x43, 0x9b, 0x53, 0xd3, 0x89, 0xcd, 0x29, 0x72, 0xe0, 0xff, 0x84, 0xd2, 0x8c, 0xe5, 0xb9, 0x69,
-	0xa5, 0x96, 0xee, 0x09, 0xdc, 0xd6, 0x8c, 0x27, 0x29, 0x25, 0x8a, 0xfd, 0x03, 0xd3, 0x82, 0x8d,
-	0x44, 0x46, 0x7d, 0x46, 0x35, 0x65, 0x25, 0x30, 0xea, 0xe0, 0x87, 0x0d, 0x57, 0x34, 0x25, 0x78,
-	0x7c, 0x82, 0x42, 0x78, 0xb9, 0xcb, 0xf3, 0xba, 0xa3, 0x1c, 0x5d, 0xfb, 0xcb, 0x8f, 0xd0, 0xde,
-	0x59, 0x6c, 0x56, 0x61, 0xb8, 0x57, 0xdf, 0x7d, 0xf9, 0xf6, 0xc1, 0xde, 0x44, 0x1b, 0xd5, 0xdf,
-	0xd2, 0xaf, 0x27, 0x47, 0x4f, 0x21, 0xfc, 0x3d, 0x34, 0xba, 0x3e, 0x0d, 0x99, 0x0b, 0xa3, 0x3d,
-	0x13, 0xa0, 0xbb, 0xa3, 0xa9, 0x2d, 0xd4, 0x9c, 0xa1, 0xfa, 0xaf, 0x39, 0x7d, 0x83, 0x42, 0xb8,
-	0xf6, 0x47, 0x16, 0xa8, 0x33, 0x4d, 0x5f, 0x14, 0xd3, 0xdc, 0x05, 0x37, 0xf4, 0x05, 0xdb, 0x07,
-	0x0b, 0x2f, 0x38, 0x04, 0x7b, 0xa8, 0x0b, 0x1b, 0x5d, 0x46, 0x63, 0x96, 0xa1, 0xa6, 0x39, 0x5a,
-	0xc9, 0x1a, 0xb8, 0x35, 0x53, 0x35, 0x71, 0x6c, 0x69, 0xee, 0x06, 0x5a, 0x33, 0xdc, 0x44, 0xdb,
-	0xc7, 0x64, 0x38, 0xc2, 0xd6, 0xf9, 0x08, 0x5b, 0x17, 0x23, 0x0c, 0xde, 0x96, 0x18, 0x7c, 0x2c,
-	0x31, 0xf8, 0x5c, 0x62, 0x30, 0x2c, 0x31, 0xf8, 0x5a, 0x62, 0xf0, 0xbd, 0xc4, 0xd6, 0x45, 0x89,
-	0xad, 0xf7, 0x63, 0x6c, 0x0d, 0xc7, 0xd8, 0x3a, 0x1f, 0x63, 0xeb, 0xd9, 0xad, 0x98, 0x2b, 0x2f,
-	0x92, 0x5c, 0x08, 0x2e, 0x9e, 0x13, 0x4f, 0x30, 0xe5, 0x87, 0x24, 0xea, 0x33, 0x41, 0x7d, 0x75,
-	0x5a, 0x08, 0xca, 0x32, 0x2a, 0x07, 0xac, 0x7a, 0xf2, 0x61, 0x43, 0x3f, 0xbc, 0x3b, 0x3f, 0x03,
-	0x00, 0x00, 0xff, 0xff, 0x77, 0xb9, 0x33, 0x70, 0x25, 0x04, 0x00, 0x00,
+func (m *AdminResolveLedgerRecordRequest) Reset()      { *m = AdminResolveLedgerRecordRequest{} }
+func (*AdminResolveLedgerRecordRequest) ProtoMessage() {}
+func (*AdminResolveLedgerRecordRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f27b1318c7cd0a2, []int{4}
 }
-
-func (this *AdminAccountsRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *AdminResolveLedgerRecordRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminResolveLedgerRecordRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminResolveLedgerRecordRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
+}
+func (m *AdminResolveLedgerRecordRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminResolveLedgerRecordRequest.Merge(m, src)
+}
+func (m *AdminResolveLedgerRecordRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminResolveLedgerRecordRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminResolveLedgerRecordRequest.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*AdminAccountsRequest)
-	if !ok {
-		that2, ok := that.(AdminAccountsRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+var xxx_messageInfo_AdminResolveLedgerRecordRequest proto.InternalMessageInfo
+
+func (m *AdminResolveLedgerRecordRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return ""
+}
+
+func (m *AdminResolveLedgerRecordRequest) GetDirection() LedgerRecord_Direction {
+	if m != nil {
+		return m.Direction
 	}
-	if len(this.Filter) != len(that1.Filter) {
-		return false
+	return IN
+}
+
+func (m *AdminResolveLedgerRecordRequest) GetConfirm() bool {
+	if m != nil {
+		return m.Confirm
 	}
-	for i := range this.Filter {
-		if this.Filter[i] != that1.Filter[i] {
-			return false
+	return false
+}
+
+type AdminResolveLedgerRecordResponse struct {
+	// The ledger record as it is now
+	Current *LedgerRecord `protobuf:"bytes,1,opt,name=current,proto3" json:"current,omitempty"`
+	// The ledger record after the proposed transition, empty if there is nothing to change
+	Proposed *LedgerRecord `protobuf:"bytes,2,opt,name=proposed,proto3" json:"proposed,omitempty"`
+	// Why the transition was proposed
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// The proposed transition was applied
+	Applied bool `protobuf:"varint,4,opt,name=applied,proto3" json:"applied,omitempty"`
+}
+
+func (m *AdminResolveLedgerRecordResponse) Reset()      { *m = AdminResolveLedgerRecordResponse{} }
+func (*AdminResolveLedgerRecordResponse) ProtoMessage() {}
+func (*AdminResolveLedgerRecordResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f27b1318c7cd0a2, []int{5}
+}
+func (m *AdminResolveLedgerRecordResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminResolveLedgerRecordResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminResolveLedgerRecordResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if this.Offset != that1.Offset {
-		return false
+}
+func (m *AdminResolveLedgerRecordResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminResolveLedgerRecordResponse.Merge(m, src)
+}
+func (m *AdminResolveLedgerRecordResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminResolveLedgerRecordResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminResolveLedgerRecordResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminResolveLedgerRecordResponse proto.InternalMessageInfo
+
+func (m *AdminResolveLedgerRecordResponse) GetCurrent() *LedgerRecord {
+	if m != nil {
+		return m.Current
 	}
-	if this.Limit != that1.Limit {
-		return false
+	return nil
+}
+
+func (m *AdminResolveLedgerRecordResponse) GetProposed() *LedgerRecord {
+	if m != nil {
+		return m.Proposed
 	}
-	return true
+	return nil
 }
-func (this *AdminAccountsResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *AdminResolveLedgerRecordResponse) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *AdminResolveLedgerRecordResponse) GetApplied() bool {
+	if m != nil {
+		return m.Applied
 	}
+	return false
+}
 
-	that1, ok := that.(*AdminAccountsResponse)
-	if !ok {
-		that2, ok := that.(AdminAccountsResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// AdminAdjustBalanceRequest is used to credit or debit an account
+type AdminAdjustBalanceRequest struct {
+	// The id of the account
+	AccountId string `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// The value of the adjustment, positive credits the account and negative debits it
+	Value int64 `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+	// The reason for the adjustment
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// The support ticket that requested the adjustment
+	TicketId string `protobuf:"bytes,4,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+}
+
+func (m *AdminAdjustBalanceRequest) Reset()      { *m = AdminAdjustBalanceRequest{} }
+func (*AdminAdjustBalanceRequest) ProtoMessage() {}
+func (*AdminAdjustBalanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f27b1318c7cd0a2, []int{6}
+}
+func (m *AdminAdjustBalanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminAdjustBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminAdjustBalanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *AdminAdjustBalanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminAdjustBalanceRequest.Merge(m, src)
+}
+func (m *AdminAdjustBalanceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminAdjustBalanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminAdjustBalanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminAdjustBalanceRequest proto.InternalMessageInfo
+
+func (m *AdminAdjustBalanceRequest) GetAccountId() string {
+	if m != nil {
+		return m.AccountId
 	}
-	if len(this.Accounts) != len(that1.Accounts) {
-		return false
+	return ""
+}
+
+func (m *AdminAdjustBalanceRequest) GetValue() int64 {
+	if m != nil {
+		return m.Value
 	}
-	for i := range this.Accounts {
-		if !this.Accounts[i].Equal(that1.Accounts[i]) {
-			return false
-		}
+	return 0
+}
+
+func (m *AdminAdjustBalanceRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return true
+	return ""
 }
-func (this *AdminGetAccountRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *AdminAdjustBalanceRequest) GetTicketId() string {
+	if m != nil {
+		return m.TicketId
 	}
+	return ""
+}
 
-	that1, ok := that.(*AdminGetAccountRequest)
-	if !ok {
-		that2, ok := that.(AdminGetAccountRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type AdminAdjustBalanceResponse struct {
+	// The ledger record that changed the balance
+	Result *LedgerRecord `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	// The adjustment audit record
+	Adjustment *LedgerAdjustment `protobuf:"bytes,2,opt,name=adjustment,proto3" json:"adjustment,omitempty"`
+}
+
+func (m *AdminAdjustBalanceResponse) Reset()      { *m = AdminAdjustBalanceResponse{} }
+func (*AdminAdjustBalanceResponse) ProtoMessage() {}
+func (*AdminAdjustBalanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f27b1318c7cd0a2, []int{7}
+}
+func (m *AdminAdjustBalanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminAdjustBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminAdjustBalanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Id != that1.Id {
-		return false
-	}
-	if this.Address != that1.Address {
-		return false
+}
+func (m *AdminAdjustBalanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminAdjustBalanceResponse.Merge(m, src)
+}
+func (m *AdminAdjustBalanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminAdjustBalanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminAdjustBalanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminAdjustBalanceResponse proto.InternalMessageInfo
+
+func (m *AdminAdjustBalanceResponse) GetResult() *LedgerRecord {
+	if m != nil {
+		return m.Result
 	}
-	return true
+	return nil
 }
-func (this *AdminUpdateAccountRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *AdminAdjustBalanceResponse) GetAdjustment() *LedgerAdjustment {
+	if m != nil {
+		return m.Adjustment
 	}
+	return nil
+}
 
-	that1, ok := that.(*AdminUpdateAccountRequest)
-	if !ok {
-		that2, ok := that.(AdminUpdateAccountRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// LedgerAdjustment records who made a manual adjustment and why
+type LedgerAdjustment struct {
+	// The id of the adjustment ledger record
+	LedgerId string `protobuf:"bytes,1,opt,name=ledger_id,json=ledgerId,proto3" json:"ledger_id,omitempty" db:"ledger_id"`
+	// The id of the account
+	AccountId string `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty" db:"account_id"`
+	// Created at timestamp
+	CreatedAt *time.Time `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at,omitempty" db:"created_at"`
+	// The value of the adjustment, positive is a credit and negative is a debit
+	Value int64 `protobuf:"varint,4,opt,name=value,proto3" json:"value,omitempty"`
+	// The admin user that made the adjustment
+	Operator string `protobuf:"bytes,5,opt,name=operator,proto3" json:"operator,omitempty"`
+	// The reason for the adjustment
+	Reason string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	// The support ticket that requested the adjustment
+	TicketId string `protobuf:"bytes,7,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty" db:"ticket_id"`
+}
+
+func (m *LedgerAdjustment) Reset()      { *m = LedgerAdjustment{} }
+func (*LedgerAdjustment) ProtoMessage() {}
+func (*LedgerAdjustment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f27b1318c7cd0a2, []int{8}
+}
+func (m *LedgerAdjustment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LedgerAdjustment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LedgerAdjustment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *LedgerAdjustment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LedgerAdjustment.Merge(m, src)
+}
+func (m *LedgerAdjustment) XXX_Size() int {
+	return m.Size()
+}
+func (m *LedgerAdjustment) XXX_DiscardUnknown() {
+	xxx_messageInfo_LedgerAdjustment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LedgerAdjustment proto.InternalMessageInfo
+
+func (m *LedgerAdjustment) GetLedgerId() string {
+	if m != nil {
+		return m.LedgerId
 	}
-	if this.Id != that1.Id {
-		return false
+	return ""
+}
+
+func (m *LedgerAdjustment) GetAccountId() string {
+	if m != nil {
+		return m.AccountId
 	}
-	if this.Locked != that1.Locked {
-		return false
+	return ""
+}
+
+func (m *LedgerAdjustment) GetCreatedAt() *time.Time {
+	if m != nil {
+		return m.CreatedAt
 	}
-	return true
+	return nil
 }
-func (this *AdminAccountsRequest) GoString() string {
-	if this == nil {
-		return "nil"
+
+func (m *LedgerAdjustment) GetValue() int64 {
+	if m != nil {
+		return m.Value
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&tdrpc.AdminAccountsRequest{")
-	keysForFilter := make([]string, 0, len(this.Filter))
-	for k, _ := range this.Filter {
-		keysForFilter = append(keysForFilter, k)
-	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForFilter)
-	mapStringForFilter := "map[string]string{"
-	for _, k := range keysForFilter {
-		mapStringForFilter += fmt.Sprintf("%#v: %#v,", k, this.Filter[k])
-	}
-	mapStringForFilter += "}"
-	if this.Filter != nil {
-		s = append(s, "Filter: "+mapStringForFilter+",\n")
+	return 0
+}
+
+func (m *LedgerAdjustment) GetOperator() string {
+	if m != nil {
+		return m.Operator
 	}
-	s = append(s, "Offset: "+fmt.Sprintf("%#v", this.Offset)+",\n")
-	s = append(s, "Limit: "+fmt.Sprintf("%#v", this.Limit)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return ""
 }
-func (this *AdminAccountsResponse) GoString() string {
-	if this == nil {
-		return "nil"
+
+func (m *LedgerAdjustment) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&tdrpc.AdminAccountsResponse{")
-	if this.Accounts != nil {
-		s = append(s, "Accounts: "+fmt.Sprintf("%#v", this.Accounts)+",\n")
+	return ""
+}
+
+func (m *LedgerAdjustment) GetTicketId() string {
+	if m != nil {
+		return m.TicketId
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return ""
 }
-func (this *AdminGetAccountRequest) GoString() string {
-	if this == nil {
-		return "nil"
+
+// AdminAdjustmentsRequest is used to list manual adjustments
+type AdminAdjustmentsRequest struct {
+	// Only show adjustments for this account
+	AccountId string `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// Offset, Limit for pagination
+	Offset int32 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit  int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *AdminAdjustmentsRequest) Reset()      { *m = AdminAdjustmentsRequest{} }
+func (*AdminAdjustmentsRequest) ProtoMessage() {}
+func (*AdminAdjustmentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f27b1318c7cd0a2, []int{9}
+}
+func (m *AdminAdjustmentsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminAdjustmentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminAdjustmentsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&tdrpc.AdminGetAccountRequest{")
-	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
-	s = append(s, "Address: "+fmt.Sprintf("%#v", this.Address)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
 }
-func (this *AdminUpdateAccountRequest) GoString() string {
-	if this == nil {
-		return "nil"
+func (m *AdminAdjustmentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminAdjustmentsRequest.Merge(m, src)
+}
+func (m *AdminAdjustmentsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminAdjustmentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminAdjustmentsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminAdjustmentsRequest proto.InternalMessageInfo
+
+func (m *AdminAdjustmentsRequest) GetAccountId() string {
+	if m != nil {
+		return m.AccountId
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&tdrpc.AdminUpdateAccountRequest{")
-	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
-	s = append(s, "Locked: "+fmt.Sprintf("%#v", this.Locked)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return ""
 }
-func valueToGoStringAdminrpc(v interface{}, typ string) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+
+func (m *AdminAdjustmentsRequest) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+	return 0
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *AdminAdjustmentsRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+type AdminAdjustmentsResponse struct {
+	// The list of adjustments, newest first
+	Adjustments []*LedgerAdjustment `protobuf:"bytes,1,rep,name=adjustments,proto3" json:"adjustments,omitempty"`
+}
 
-// AdminRPCClient is the client API for AdminRPC service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type AdminRPCClient interface {
-	// List Accounts
-	ListAccounts(ctx context.Context, in *AdminAccountsRequest, opts ...grpc.CallOption) (*AdminAccountsResponse, error)
-	// Get Account
-	GetAccount(ctx context.Context, in *AdminGetAccountRequest, opts ...grpc.CallOption) (*Account, error)
-	// Update Account
-	UpdateAccount(ctx context.Context, in *AdminUpdateAccountRequest, opts ...grpc.CallOption) (*Account, error)
-	// Decode a payment request
-	Ledger(ctx context.Context, in *LedgerRequest, opts ...grpc.CallOption) (*LedgerResponse, error)
+func (m *AdminAdjustmentsResponse) Reset()      { *m = AdminAdjustmentsResponse{} }
+func (*AdminAdjustmentsResponse) ProtoMessage() {}
+func (*AdminAdjustmentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f27b1318c7cd0a2, []int{10}
+}
+func (m *AdminAdjustmentsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminAdjustmentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminAdjustmentsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AdminAdjustmentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminAdjustmentsResponse.Merge(m, src)
+}
+func (m *AdminAdjustmentsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminAdjustmentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminAdjustmentsResponse.DiscardUnknown(m)
 }
 
-type adminRPCClient struct {
-	cc *grpc.ClientConn
+var xxx_messageInfo_AdminAdjustmentsResponse proto.InternalMessageInfo
+
+func (m *AdminAdjustmentsResponse) GetAdjustments() []*LedgerAdjustment {
+	if m != nil {
+		return m.Adjustments
+	}
+	return nil
 }
 
-func NewAdminRPCClient(cc *grpc.ClientConn) AdminRPCClient {
-	return &adminRPCClient{cc}
+// AdminHeldRequest is used to list held ledger records
+type AdminHeldRequest struct {
+	// Filter values (account_id, type)
+	Filter map[string]string `protobuf:"bytes,1,rep,name=filter,proto3" json:"filter,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Offset, Limit for pagination
+	Offset int32 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit  int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func (c *adminRPCClient) ListAccounts(ctx context.Context, in *AdminAccountsRequest, opts ...grpc.CallOption) (*AdminAccountsResponse, error) {
-	out := new(AdminAccountsResponse)
-	err := c.cc.Invoke(ctx, "/tdrpc.AdminRPC/ListAccounts", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *AdminHeldRequest) Reset()      { *m = AdminHeldRequest{} }
+func (*AdminHeldRequest) ProtoMessage() {}
+func (*AdminHeldRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f27b1318c7cd0a2, []int{11}
+}
+func (m *AdminHeldRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminHeldRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminHeldRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *AdminHeldRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminHeldRequest.Merge(m, src)
+}
+func (m *AdminHeldRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminHeldRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminHeldRequest.DiscardUnknown(m)
 }
 
-func (c *adminRPCClient) GetAccount(ctx context.Context, in *AdminGetAccountRequest, opts ...grpc.CallOption) (*Account, error) {
-	out := new(Account)
-	err := c.cc.Invoke(ctx, "/tdrpc.AdminRPC/GetAccount", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_AdminHeldRequest proto.InternalMessageInfo
+
+func (m *AdminHeldRequest) GetFilter() map[string]string {
+	if m != nil {
+		return m.Filter
 	}
-	return out, nil
+	return nil
 }
 
-func (c *adminRPCClient) UpdateAccount(ctx context.Context, in *AdminUpdateAccountRequest, opts ...grpc.CallOption) (*Account, error) {
-	out := new(Account)
-	err := c.cc.Invoke(ctx, "/tdrpc.AdminRPC/UpdateAccount", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *AdminHeldRequest) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
 	}
-	return out, nil
+	return 0
 }
 
-func (c *adminRPCClient) Ledger(ctx context.Context, in *LedgerRequest, opts ...grpc.CallOption) (*LedgerResponse, error) {
-	out := new(LedgerResponse)
-	err := c.cc.Invoke(ctx, "/tdrpc.AdminRPC/Ledger", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *AdminHeldRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
 	}
-	return out, nil
+	return 0
 }
 
-// AdminRPCServer is the server API for AdminRPC service.
-type AdminRPCServer interface {
-	// List Accounts
-	ListAccounts(context.Context, *AdminAccountsRequest) (*AdminAccountsResponse, error)
-	// Get Account
-	GetAccount(context.Context, *AdminGetAccountRequest) (*Account, error)
-	// Update Account
-	UpdateAccount(context.Context, *AdminUpdateAccountRequest) (*Account, error)
-	// Decode a payment request
-	Ledger(context.Context, *LedgerRequest) (*LedgerResponse, error)
+// AdminReviewHeldRequest is used to approve or reject a held ledger record
+type AdminReviewHeldRequest struct {
+	// The id of the held ledger record
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The reason for the decision, stored as the error when rejected
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func RegisterAdminRPCServer(s *grpc.Server, srv AdminRPCServer) {
-	s.RegisterService(&_AdminRPC_serviceDesc, srv)
+func (m *AdminReviewHeldRequest) Reset()      { *m = AdminReviewHeldRequest{} }
+func (*AdminReviewHeldRequest) ProtoMessage() {}
+func (*AdminReviewHeldRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f27b1318c7cd0a2, []int{12}
 }
-
-func _AdminRPC_ListAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AdminAccountsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AdminRPCServer).ListAccounts(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tdrpc.AdminRPC/ListAccounts",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminRPCServer).ListAccounts(ctx, req.(*AdminAccountsRequest))
+func (m *AdminReviewHeldRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminReviewHeldRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminReviewHeldRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *AdminReviewHeldRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminReviewHeldRequest.Merge(m, src)
+}
+func (m *AdminReviewHeldRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminReviewHeldRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminReviewHeldRequest.DiscardUnknown(m)
 }
 
-func _AdminRPC_GetAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AdminGetAccountRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AdminRPCServer).GetAccount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tdrpc.AdminRPC/GetAccount",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminRPCServer).GetAccount(ctx, req.(*AdminGetAccountRequest))
+var xxx_messageInfo_AdminReviewHeldRequest proto.InternalMessageInfo
+
+func (m *AdminReviewHeldRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _AdminRPC_UpdateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AdminUpdateAccountRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AdminRPCServer).UpdateAccount(ctx, in)
+func (m *AdminReviewHeldRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tdrpc.AdminRPC/UpdateAccount",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminRPCServer).UpdateAccount(ctx, req.(*AdminUpdateAccountRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _AdminRPC_Ledger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LedgerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+// AgentKey is an API key that allows an agent to act on behalf of accounts
+type AgentKey struct {
+	// The id of the agent key
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Created at timestamp
+	CreatedAt *time.Time `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at,omitempty" db:"created_at"`
+	// Update at timestamp
+	UpdatedAt *time.Time `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3,stdtime" json:"updated_at,omitempty" db:"updated_at"`
+	// A descriptive name for the agent
+	Name string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	// The endpoints the agent may call (CreateGenerated, Pay, CreatePreAuth, GetPreAuth)
+	Scopes []string `protobuf:"bytes,5,rep,name=scopes,proto3" json:"scopes,omitempty" db:"-"`
+	// The account ids the agent may act on, an id ending in * is a prefix match
+	AccountIds []string `protobuf:"bytes,6,rep,name=account_ids,json=accountIds,proto3" json:"account_ids,omitempty" db:"-"`
+	// The max value the agent can pay including fees or pre-authorize
+	ValueLimit int64 `protobuf:"varint,7,opt,name=value_limit,json=valueLimit,proto3" json:"value_limit" db:"value_limit"`
+	// When the key expires, never if not set
+	ExpiresAt *time.Time `protobuf:"bytes,8,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at,omitempty" db:"expires_at"`
+	// When the key was revoked, not revoked if not set
+	RevokedAt *time.Time `protobuf:"bytes,9,opt,name=revoked_at,json=revokedAt,proto3,stdtime" json:"revoked_at,omitempty" db:"revoked_at"`
+}
+
+func (m *AgentKey) Reset()      { *m = AgentKey{} }
+func (*AgentKey) ProtoMessage() {}
+func (*AgentKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f27b1318c7cd0a2, []int{13}
+}
+func (m *AgentKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AgentKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AgentKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(AdminRPCServer).Ledger(ctx, in)
+}
+func (m *AgentKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AgentKey.Merge(m, src)
+}
+func (m *AgentKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *AgentKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_AgentKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AgentKey proto.InternalMessageInfo
+
+func (m *AgentKey) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tdrpc.AdminRPC/Ledger",
+	return ""
+}
+
+func (m *AgentKey) GetCreatedAt() *time.Time {
+	if m != nil {
+		return m.CreatedAt
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminRPCServer).Ledger(ctx, req.(*LedgerRequest))
+	return nil
+}
+
+func (m *AgentKey) GetUpdatedAt() *time.Time {
+	if m != nil {
+		return m.UpdatedAt
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _AdminRPC_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "tdrpc.AdminRPC",
-	HandlerType: (*AdminRPCServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ListAccounts",
-			Handler:    _AdminRPC_ListAccounts_Handler,
-		},
-		{
-			MethodName: "GetAccount",
-			Handler:    _AdminRPC_GetAccount_Handler,
-		},
-		{
-			MethodName: "UpdateAccount",
-			Handler:    _AdminRPC_UpdateAccount_Handler,
-		},
-		{
-			MethodName: "Ledger",
-			Handler:    _AdminRPC_Ledger_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "tdrpc/adminrpc.proto",
+func (m *AgentKey) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-func (m *AdminAccountsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *AgentKey) GetScopes() []string {
+	if m != nil {
+		return m.Scopes
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *AdminAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Filter) > 0 {
-		for k, _ := range m.Filter {
-			dAtA[i] = 0xa
-			i++
-			v := m.Filter[k]
-			mapSize := 1 + len(k) + sovAdminrpc(uint64(len(k))) + 1 + len(v) + sovAdminrpc(uint64(len(v)))
-			i = encodeVarintAdminrpc(dAtA, i, uint64(mapSize))
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintAdminrpc(dAtA, i, uint64(len(k)))
-			i += copy(dAtA[i:], k)
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintAdminrpc(dAtA, i, uint64(len(v)))
-			i += copy(dAtA[i:], v)
-		}
+func (m *AgentKey) GetAccountIds() []string {
+	if m != nil {
+		return m.AccountIds
 	}
-	if m.Offset != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintAdminrpc(dAtA, i, uint64(m.Offset))
+	return nil
+}
+
+func (m *AgentKey) GetValueLimit() int64 {
+	if m != nil {
+		return m.ValueLimit
 	}
-	if m.Limit != 0 {
-		dAtA[i] = 0x20
-		i++
-		i = encodeVarintAdminrpc(dAtA, i, uint64(m.Limit))
+	return 0
+}
+
+func (m *AgentKey) GetExpiresAt() *time.Time {
+	if m != nil {
+		return m.ExpiresAt
 	}
-	return i, nil
+	return nil
 }
 
-func (m *AdminAccountsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *AgentKey) GetRevokedAt() *time.Time {
+	if m != nil {
+		return m.RevokedAt
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *AdminAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Accounts) > 0 {
-		for _, msg := range m.Accounts {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintAdminrpc(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+// AdminAgentKeysRequest is used to list agent keys
+type AdminAgentKeysRequest struct {
+	// Offset, Limit for pagination
+	Offset int32 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit  int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *AdminAgentKeysRequest) Reset()      { *m = AdminAgentKeysRequest{} }
+func (*AdminAgentKeysRequest) ProtoMessage() {}
+func (*AdminAgentKeysRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f27b1318c7cd0a2, []int{14}
+}
+func (m *AdminAgentKeysRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminAgentKeysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminAgentKeysRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (m *AdminAgentKeysRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminAgentKeysRequest.Merge(m, src)
+}
+func (m *AdminAgentKeysRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminAgentKeysRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminAgentKeysRequest.DiscardUnknown(m)
 }
 
-func (m *AdminGetAccountRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_AdminAgentKeysRequest proto.InternalMessageInfo
+
+func (m *AdminAgentKeysRequest) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *AdminGetAccountRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintAdminrpc(dAtA, i, uint64(len(m.Id)))
-		i += copy(dAtA[i:], m.Id)
-	}
-	if len(m.Address) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintAdminrpc(dAtA, i, uint64(len(m.Address)))
-		i += copy(dAtA[i:], m.Address)
+func (m *AdminAgentKeysRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
 	}
-	return i, nil
+	return 0
 }
 
-func (m *AdminUpdateAccountRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type AdminAgentKeysResponse struct {
+	// The list of agent keys
+	AgentKeys []*AgentKey `protobuf:"bytes,1,rep,name=agent_keys,json=agentKeys,proto3" json:"agent_keys,omitempty"`
 }
 
-func (m *AdminUpdateAccountRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintAdminrpc(dAtA, i, uint64(len(m.Id)))
-		i += copy(dAtA[i:], m.Id)
-	}
-	if m.Locked {
-		dAtA[i] = 0x10
-		i++
-		if m.Locked {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *AdminAgentKeysResponse) Reset()      { *m = AdminAgentKeysResponse{} }
+func (*AdminAgentKeysResponse) ProtoMessage() {}
+func (*AdminAgentKeysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f27b1318c7cd0a2, []int{15}
+}
+func (m *AdminAgentKeysResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminAgentKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminAgentKeysResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
-		i++
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (m *AdminAgentKeysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminAgentKeysResponse.Merge(m, src)
+}
+func (m *AdminAgentKeysResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminAgentKeysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminAgentKeysResponse.DiscardUnknown(m)
 }
 
-func encodeVarintAdminrpc(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_AdminAgentKeysResponse proto.InternalMessageInfo
+
+func (m *AdminAgentKeysResponse) GetAgentKeys() []*AgentKey {
+	if m != nil {
+		return m.AgentKeys
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	return nil
 }
-func (m *AdminAccountsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+// Used to get, revoke or delete an agent key
+type AdminAgentKeyRequest struct {
+	// The id of the agent key
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *AdminAgentKeyRequest) Reset()      { *m = AdminAgentKeyRequest{} }
+func (*AdminAgentKeyRequest) ProtoMessage() {}
+func (*AdminAgentKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f27b1318c7cd0a2, []int{16}
+}
+func (m *AdminAgentKeyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminAgentKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminAgentKeyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if len(m.Filter) > 0 {
-		for k, v := range m.Filter {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovAdminrpc(uint64(len(k))) + 1 + len(v) + sovAdminrpc(uint64(len(v)))
-			n += mapEntrySize + 1 + sovAdminrpc(uint64(mapEntrySize))
+}
+func (m *AdminAgentKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminAgentKeyRequest.Merge(m, src)
+}
+func (m *AdminAgentKeyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminAgentKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminAgentKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminAgentKeyRequest proto.InternalMessageInfo
+
+func (m *AdminAgentKeyRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+// Used to create or update an agent key
+type AdminSaveAgentKeyRequest struct {
+	// The id of the agent key (update only)
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// A descriptive name for the agent
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The endpoints the agent may call
+	Scopes []string `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	// The account ids the agent may act on, an id ending in * is a prefix match
+	AccountIds []string `protobuf:"bytes,4,rep,name=account_ids,json=accountIds,proto3" json:"account_ids,omitempty"`
+	// The max value the agent can pay including fees or pre-authorize
+	ValueLimit int64 `protobuf:"varint,5,opt,name=value_limit,json=valueLimit,proto3" json:"value_limit,omitempty"`
+	// When the key expires, never if not set
+	ExpiresAt *time.Time `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at,omitempty"`
+}
+
+func (m *AdminSaveAgentKeyRequest) Reset()      { *m = AdminSaveAgentKeyRequest{} }
+func (*AdminSaveAgentKeyRequest) ProtoMessage() {}
+func (*AdminSaveAgentKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f27b1318c7cd0a2, []int{17}
+}
+func (m *AdminSaveAgentKeyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminSaveAgentKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminSaveAgentKeyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Offset != 0 {
-		n += 1 + sovAdminrpc(uint64(m.Offset))
+}
+func (m *AdminSaveAgentKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminSaveAgentKeyRequest.Merge(m, src)
+}
+func (m *AdminSaveAgentKeyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminSaveAgentKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminSaveAgentKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminSaveAgentKeyRequest proto.InternalMessageInfo
+
+func (m *AdminSaveAgentKeyRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	if m.Limit != 0 {
-		n += 1 + sovAdminrpc(uint64(m.Limit))
+	return ""
+}
+
+func (m *AdminSaveAgentKeyRequest) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return n
+	return ""
 }
 
-func (m *AdminAccountsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AdminSaveAgentKeyRequest) GetScopes() []string {
+	if m != nil {
+		return m.Scopes
 	}
-	var l int
-	_ = l
-	if len(m.Accounts) > 0 {
-		for _, e := range m.Accounts {
-			l = e.Size()
-			n += 1 + l + sovAdminrpc(uint64(l))
+	return nil
+}
+
+func (m *AdminSaveAgentKeyRequest) GetAccountIds() []string {
+	if m != nil {
+		return m.AccountIds
+	}
+	return nil
+}
+
+func (m *AdminSaveAgentKeyRequest) GetValueLimit() int64 {
+	if m != nil {
+		return m.ValueLimit
+	}
+	return 0
+}
+
+func (m *AdminSaveAgentKeyRequest) GetExpiresAt() *time.Time {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
+}
+
+type AdminCreateAgentKeyResponse struct {
+	// The agent key
+	AgentKey *AgentKey `protobuf:"bytes,1,opt,name=agent_key,json=agentKey,proto3" json:"agent_key,omitempty"`
+	// The secret to use in the signature header, it cannot be retrieved again
+	Secret string `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+}
+
+func (m *AdminCreateAgentKeyResponse) Reset()      { *m = AdminCreateAgentKeyResponse{} }
+func (*AdminCreateAgentKeyResponse) ProtoMessage() {}
+func (*AdminCreateAgentKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f27b1318c7cd0a2, []int{18}
+}
+func (m *AdminCreateAgentKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminCreateAgentKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminCreateAgentKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *AdminCreateAgentKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminCreateAgentKeyResponse.Merge(m, src)
+}
+func (m *AdminCreateAgentKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminCreateAgentKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminCreateAgentKeyResponse.DiscardUnknown(m)
 }
 
-func (m *AdminGetAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_AdminCreateAgentKeyResponse proto.InternalMessageInfo
+
+func (m *AdminCreateAgentKeyResponse) GetAgentKey() *AgentKey {
+	if m != nil {
+		return m.AgentKey
 	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovAdminrpc(uint64(l))
+	return nil
+}
+
+func (m *AdminCreateAgentKeyResponse) GetSecret() string {
+	if m != nil {
+		return m.Secret
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovAdminrpc(uint64(l))
+	return ""
+}
+
+// AccountLimits are the spending limits of an account. For overrides a limit of 0 uses the tier default
+// and -1 is unlimited. For effective limits, a limit of 0 is unlimited.
+type AccountLimits struct {
+	// The id of the account
+	AccountId string `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty" db:"account_id"`
+	// Created at timestamp
+	CreatedAt *time.Time `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at,omitempty" db:"created_at"`
+	// Update at timestamp
+	UpdatedAt *time.Time `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3,stdtime" json:"updated_at,omitempty" db:"updated_at"`
+	// The limit tier, the default tier if not set
+	Tier string `protobuf:"bytes,4,opt,name=tier,proto3" json:"tier,omitempty"`
+	// The max total value sent in the last 24 hours
+	DailySend int64 `protobuf:"varint,5,opt,name=daily_send,json=dailySend,proto3" json:"daily_send" db:"daily_send"`
+	// The max total value sent in the last 7 days
+	WeeklySend int64 `protobuf:"varint,6,opt,name=weekly_send,json=weeklySend,proto3" json:"weekly_send" db:"weekly_send"`
+	// The max value of a single payment or pre-auth
+	MaxPayment int64 `protobuf:"varint,7,opt,name=max_payment,json=maxPayment,proto3" json:"max_payment" db:"max_payment"`
+	// The max total value withdrawn in the last 24 hours
+	DailyWithdraw int64 `protobuf:"varint,8,opt,name=daily_withdraw,json=dailyWithdraw,proto3" json:"daily_withdraw" db:"daily_withdraw"`
+}
+
+func (m *AccountLimits) Reset()      { *m = AccountLimits{} }
+func (*AccountLimits) ProtoMessage() {}
+func (*AccountLimits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f27b1318c7cd0a2, []int{19}
+}
+func (m *AccountLimits) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountLimits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountLimits.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *AccountLimits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountLimits.Merge(m, src)
+}
+func (m *AccountLimits) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountLimits) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountLimits.DiscardUnknown(m)
 }
 
-func (m *AdminUpdateAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_AccountLimits proto.InternalMessageInfo
+
+func (m *AccountLimits) GetAccountId() string {
+	if m != nil {
+		return m.AccountId
 	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovAdminrpc(uint64(l))
+	return ""
+}
+
+func (m *AccountLimits) GetCreatedAt() *time.Time {
+	if m != nil {
+		return m.CreatedAt
 	}
-	if m.Locked {
-		n += 2
+	return nil
+}
+
+func (m *AccountLimits) GetUpdatedAt() *time.Time {
+	if m != nil {
+		return m.UpdatedAt
 	}
-	return n
+	return nil
 }
 
-func sovAdminrpc(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
+func (m *AccountLimits) GetTier() string {
+	if m != nil {
+		return m.Tier
+	}
+	return ""
+}
+
+func (m *AccountLimits) GetDailySend() int64 {
+	if m != nil {
+		return m.DailySend
+	}
+	return 0
+}
+
+func (m *AccountLimits) GetWeeklySend() int64 {
+	if m != nil {
+		return m.WeeklySend
+	}
+	return 0
+}
+
+func (m *AccountLimits) GetMaxPayment() int64 {
+	if m != nil {
+		return m.MaxPayment
+	}
+	return 0
+}
+
+func (m *AccountLimits) GetDailyWithdraw() int64 {
+	if m != nil {
+		return m.DailyWithdraw
+	}
+	return 0
+}
+
+// AdminAccountLimitsRequest is used to get the limits of an account
+type AdminAccountLimitsRequest struct {
+	// The id of the account
+	AccountId string `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+}
+
+func (m *AdminAccountLimitsRequest) Reset()      { *m = AdminAccountLimitsRequest{} }
+func (*AdminAccountLimitsRequest) ProtoMessage() {}
+func (*AdminAccountLimitsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f27b1318c7cd0a2, []int{20}
+}
+func (m *AdminAccountLimitsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminAccountLimitsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminAccountLimitsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AdminAccountLimitsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminAccountLimitsRequest.Merge(m, src)
+}
+func (m *AdminAccountLimitsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminAccountLimitsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminAccountLimitsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminAccountLimitsRequest proto.InternalMessageInfo
+
+func (m *AdminAccountLimitsRequest) GetAccountId() string {
+	if m != nil {
+		return m.AccountId
+	}
+	return ""
+}
+
+type AdminAccountLimitsResponse struct {
+	// The tier and overrides set for the account
+	Limits *AccountLimits `protobuf:"bytes,1,opt,name=limits,proto3" json:"limits,omitempty"`
+	// The limits that are enforced after applying the tier defaults
+	Effective *AccountLimits `protobuf:"bytes,2,opt,name=effective,proto3" json:"effective,omitempty"`
+}
+
+func (m *AdminAccountLimitsResponse) Reset()      { *m = AdminAccountLimitsResponse{} }
+func (*AdminAccountLimitsResponse) ProtoMessage() {}
+func (*AdminAccountLimitsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f27b1318c7cd0a2, []int{21}
+}
+func (m *AdminAccountLimitsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminAccountLimitsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminAccountLimitsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AdminAccountLimitsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminAccountLimitsResponse.Merge(m, src)
+}
+func (m *AdminAccountLimitsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminAccountLimitsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminAccountLimitsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminAccountLimitsResponse proto.InternalMessageInfo
+
+func (m *AdminAccountLimitsResponse) GetLimits() *AccountLimits {
+	if m != nil {
+		return m.Limits
+	}
+	return nil
+}
+
+func (m *AdminAccountLimitsResponse) GetEffective() *AccountLimits {
+	if m != nil {
+		return m.Effective
+	}
+	return nil
+}
+
+// ScreeningEntry is a destination on the allow or deny list
+type ScreeningEntry struct {
+	// The id of the entry
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Created at timestamp
+	CreatedAt *time.Time `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at,omitempty" db:"created_at"`
+	// Update at timestamp
+	UpdatedAt *time.Time `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3,stdtime" json:"updated_at,omitempty" db:"updated_at"`
+	// The list (deny, allow)
+	List string `protobuf:"bytes,4,opt,name=list,proto3" json:"list,omitempty"`
+	// The destination type (address, node)
+	Type string `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`
+	// The bitcoin address or node public key
+	Value string `protobuf:"bytes,6,opt,name=value,proto3" json:"value,omitempty"`
+	// What happens when a deny list entry matches (block, hold)
+	Action string `protobuf:"bytes,7,opt,name=action,proto3" json:"action,omitempty"`
+	// A note describing why the entry exists
+	Note string `protobuf:"bytes,8,opt,name=note,proto3" json:"note,omitempty"`
+}
+
+func (m *ScreeningEntry) Reset()      { *m = ScreeningEntry{} }
+func (*ScreeningEntry) ProtoMessage() {}
+func (*ScreeningEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f27b1318c7cd0a2, []int{22}
+}
+func (m *ScreeningEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScreeningEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScreeningEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScreeningEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScreeningEntry.Merge(m, src)
+}
+func (m *ScreeningEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScreeningEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScreeningEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScreeningEntry proto.InternalMessageInfo
+
+func (m *ScreeningEntry) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ScreeningEntry) GetCreatedAt() *time.Time {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *ScreeningEntry) GetUpdatedAt() *time.Time {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return nil
+}
+
+func (m *ScreeningEntry) GetList() string {
+	if m != nil {
+		return m.List
+	}
+	return ""
+}
+
+func (m *ScreeningEntry) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *ScreeningEntry) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *ScreeningEntry) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *ScreeningEntry) GetNote() string {
+	if m != nil {
+		return m.Note
+	}
+	return ""
+}
+
+// AdminScreeningEntriesRequest is used to list screening entries
+type AdminScreeningEntriesRequest struct {
+	// Filter values (list, type, value)
+	Filter map[string]string `protobuf:"bytes,1,rep,name=filter,proto3" json:"filter,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Offset, Limit for pagination
+	Offset int32 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit  int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *AdminScreeningEntriesRequest) Reset()      { *m = AdminScreeningEntriesRequest{} }
+func (*AdminScreeningEntriesRequest) ProtoMessage() {}
+func (*AdminScreeningEntriesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f27b1318c7cd0a2, []int{23}
+}
+func (m *AdminScreeningEntriesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminScreeningEntriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminScreeningEntriesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AdminScreeningEntriesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminScreeningEntriesRequest.Merge(m, src)
+}
+func (m *AdminScreeningEntriesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminScreeningEntriesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminScreeningEntriesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminScreeningEntriesRequest proto.InternalMessageInfo
+
+func (m *AdminScreeningEntriesRequest) GetFilter() map[string]string {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (m *AdminScreeningEntriesRequest) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *AdminScreeningEntriesRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type AdminScreeningEntriesResponse struct {
+	// The list of screening entries
+	ScreeningEntries []*ScreeningEntry `protobuf:"bytes,1,rep,name=screening_entries,json=screeningEntries,proto3" json:"screening_entries,omitempty"`
+}
+
+func (m *AdminScreeningEntriesResponse) Reset()      { *m = AdminScreeningEntriesResponse{} }
+func (*AdminScreeningEntriesResponse) ProtoMessage() {}
+func (*AdminScreeningEntriesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f27b1318c7cd0a2, []int{24}
+}
+func (m *AdminScreeningEntriesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminScreeningEntriesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminScreeningEntriesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AdminScreeningEntriesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminScreeningEntriesResponse.Merge(m, src)
+}
+func (m *AdminScreeningEntriesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminScreeningEntriesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminScreeningEntriesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminScreeningEntriesResponse proto.InternalMessageInfo
+
+func (m *AdminScreeningEntriesResponse) GetScreeningEntries() []*ScreeningEntry {
+	if m != nil {
+		return m.ScreeningEntries
+	}
+	return nil
+}
+
+// Used to delete a screening entry
+type AdminScreeningEntryRequest struct {
+	// The id of the screening entry
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *AdminScreeningEntryRequest) Reset()      { *m = AdminScreeningEntryRequest{} }
+func (*AdminScreeningEntryRequest) ProtoMessage() {}
+func (*AdminScreeningEntryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f27b1318c7cd0a2, []int{25}
+}
+func (m *AdminScreeningEntryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminScreeningEntryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminScreeningEntryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AdminScreeningEntryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminScreeningEntryRequest.Merge(m, src)
+}
+func (m *AdminScreeningEntryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminScreeningEntryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminScreeningEntryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminScreeningEntryRequest proto.InternalMessageInfo
+
+func (m *AdminScreeningEntryRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+// ScreeningHistory is a change to a screening entry
+type ScreeningHistory struct {
+	// The id of the change
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The id of the screening entry
+	EntryId string `protobuf:"bytes,2,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty" db:"entry_id"`
+	// Created at timestamp
+	CreatedAt *time.Time `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at,omitempty" db:"created_at"`
+	// The change made (create, update, delete)
+	Operation string `protobuf:"bytes,4,opt,name=operation,proto3" json:"operation,omitempty"`
+	// The admin user that made the change
+	Operator string `protobuf:"bytes,5,opt,name=operator,proto3" json:"operator,omitempty"`
+	// The entry after the change
+	List   string `protobuf:"bytes,6,opt,name=list,proto3" json:"list,omitempty"`
+	Type   string `protobuf:"bytes,7,opt,name=type,proto3" json:"type,omitempty"`
+	Value  string `protobuf:"bytes,8,opt,name=value,proto3" json:"value,omitempty"`
+	Action string `protobuf:"bytes,9,opt,name=action,proto3" json:"action,omitempty"`
+	Note   string `protobuf:"bytes,10,opt,name=note,proto3" json:"note,omitempty"`
+}
+
+func (m *ScreeningHistory) Reset()      { *m = ScreeningHistory{} }
+func (*ScreeningHistory) ProtoMessage() {}
+func (*ScreeningHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f27b1318c7cd0a2, []int{26}
+}
+func (m *ScreeningHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScreeningHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScreeningHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScreeningHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScreeningHistory.Merge(m, src)
+}
+func (m *ScreeningHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScreeningHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScreeningHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScreeningHistory proto.InternalMessageInfo
+
+func (m *ScreeningHistory) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ScreeningHistory) GetEntryId() string {
+	if m != nil {
+		return m.EntryId
+	}
+	return ""
+}
+
+func (m *ScreeningHistory) GetCreatedAt() *time.Time {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *ScreeningHistory) GetOperation() string {
+	if m != nil {
+		return m.Operation
+	}
+	return ""
+}
+
+func (m *ScreeningHistory) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *ScreeningHistory) GetList() string {
+	if m != nil {
+		return m.List
+	}
+	return ""
+}
+
+func (m *ScreeningHistory) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *ScreeningHistory) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *ScreeningHistory) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *ScreeningHistory) GetNote() string {
+	if m != nil {
+		return m.Note
+	}
+	return ""
+}
+
+// AdminScreeningHistoryRequest is used to list the history of screening entries
+type AdminScreeningHistoryRequest struct {
+	// Only show the history of this entry
+	EntryId string `protobuf:"bytes,1,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	// Offset, Limit for pagination
+	Offset int32 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit  int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *AdminScreeningHistoryRequest) Reset()      { *m = AdminScreeningHistoryRequest{} }
+func (*AdminScreeningHistoryRequest) ProtoMessage() {}
+func (*AdminScreeningHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f27b1318c7cd0a2, []int{27}
+}
+func (m *AdminScreeningHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminScreeningHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminScreeningHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AdminScreeningHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminScreeningHistoryRequest.Merge(m, src)
+}
+func (m *AdminScreeningHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminScreeningHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminScreeningHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminScreeningHistoryRequest proto.InternalMessageInfo
+
+func (m *AdminScreeningHistoryRequest) GetEntryId() string {
+	if m != nil {
+		return m.EntryId
+	}
+	return ""
+}
+
+func (m *AdminScreeningHistoryRequest) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *AdminScreeningHistoryRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type AdminScreeningHistoryResponse struct {
+	// The list of changes, newest first
+	ScreeningHistory []*ScreeningHistory `protobuf:"bytes,1,rep,name=screening_history,json=screeningHistory,proto3" json:"screening_history,omitempty"`
+}
+
+func (m *AdminScreeningHistoryResponse) Reset()      { *m = AdminScreeningHistoryResponse{} }
+func (*AdminScreeningHistoryResponse) ProtoMessage() {}
+func (*AdminScreeningHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f27b1318c7cd0a2, []int{28}
+}
+func (m *AdminScreeningHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminScreeningHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminScreeningHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AdminScreeningHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminScreeningHistoryResponse.Merge(m, src)
+}
+func (m *AdminScreeningHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminScreeningHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminScreeningHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminScreeningHistoryResponse proto.InternalMessageInfo
+
+func (m *AdminScreeningHistoryResponse) GetScreeningHistory() []*ScreeningHistory {
+	if m != nil {
+		return m.ScreeningHistory
+	}
+	return nil
+}
+
+// AdminAudit is a record of a change made through the admin API
+type AdminAudit struct {
+	// The id of the audit record
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Created at timestamp
+	CreatedAt *time.Time `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at,omitempty" db:"created_at"`
+	// The admin user that made the request
+	Operator string `protobuf:"bytes,3,opt,name=operator,proto3" json:"operator,omitempty"`
+	// The full RPC method name
+	Method string `protobuf:"bytes,4,opt,name=method,proto3" json:"method,omitempty"`
+	// The request as JSON with any secrets redacted
+	Request string `protobuf:"bytes,5,opt,name=request,proto3" json:"request,omitempty"`
+	// The resulting status code
+	Code string `protobuf:"bytes,6,opt,name=code,proto3" json:"code,omitempty"`
+	// The error message if the request failed
+	Error string `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *AdminAudit) Reset()      { *m = AdminAudit{} }
+func (*AdminAudit) ProtoMessage() {}
+func (*AdminAudit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f27b1318c7cd0a2, []int{29}
+}
+func (m *AdminAudit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminAudit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminAudit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AdminAudit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminAudit.Merge(m, src)
+}
+func (m *AdminAudit) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminAudit) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminAudit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminAudit proto.InternalMessageInfo
+
+func (m *AdminAudit) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *AdminAudit) GetCreatedAt() *time.Time {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *AdminAudit) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *AdminAudit) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *AdminAudit) GetRequest() string {
+	if m != nil {
+		return m.Request
+	}
+	return ""
+}
+
+func (m *AdminAudit) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+func (m *AdminAudit) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+// AdminAuditLogRequest is used to list the audit log
+type AdminAuditLogRequest struct {
+	// Filter values (operator, method, code)
+	Filter map[string]string `protobuf:"bytes,1,rep,name=filter,proto3" json:"filter,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// After filtering for creation time
+	After *time.Time `protobuf:"bytes,2,opt,name=after,proto3,stdtime" json:"after,omitempty"`
+	// Offset, Limit for pagination
+	Offset int32 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit  int32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *AdminAuditLogRequest) Reset()      { *m = AdminAuditLogRequest{} }
+func (*AdminAuditLogRequest) ProtoMessage() {}
+func (*AdminAuditLogRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f27b1318c7cd0a2, []int{30}
+}
+func (m *AdminAuditLogRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminAuditLogRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminAuditLogRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AdminAuditLogRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminAuditLogRequest.Merge(m, src)
+}
+func (m *AdminAuditLogRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminAuditLogRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminAuditLogRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminAuditLogRequest proto.InternalMessageInfo
+
+func (m *AdminAuditLogRequest) GetFilter() map[string]string {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (m *AdminAuditLogRequest) GetAfter() *time.Time {
+	if m != nil {
+		return m.After
+	}
+	return nil
+}
+
+func (m *AdminAuditLogRequest) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *AdminAuditLogRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type AdminAuditLogResponse struct {
+	// The audit records, newest first
+	AuditLog []*AdminAudit `protobuf:"bytes,1,rep,name=audit_log,json=auditLog,proto3" json:"audit_log,omitempty"`
+}
+
+func (m *AdminAuditLogResponse) Reset()      { *m = AdminAuditLogResponse{} }
+func (*AdminAuditLogResponse) ProtoMessage() {}
+func (*AdminAuditLogResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f27b1318c7cd0a2, []int{31}
+}
+func (m *AdminAuditLogResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminAuditLogResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminAuditLogResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AdminAuditLogResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminAuditLogResponse.Merge(m, src)
+}
+func (m *AdminAuditLogResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminAuditLogResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminAuditLogResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminAuditLogResponse proto.InternalMessageInfo
+
+func (m *AdminAuditLogResponse) GetAuditLog() []*AdminAudit {
+	if m != nil {
+		return m.AuditLog
+	}
+	return nil
+}
+
+// ChannelProposal is a channel open or close proposed by the channel manager
+type ChannelProposal struct {
+	// The id of the proposal
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Created at timestamp
+	CreatedAt *time.Time `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at,omitempty" db:"created_at"`
+	// Update at timestamp
+	UpdatedAt *time.Time `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3,stdtime" json:"updated_at,omitempty" db:"updated_at"`
+	// The action (open, close)
+	Action string `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	// The public key of the peer node
+	NodePubkey string `protobuf:"bytes,5,opt,name=node_pubkey,json=nodePubkey,proto3" json:"node_pubkey,omitempty" db:"node_pubkey"`
+	// The channel point of the channel to close
+	ChanPoint string `protobuf:"bytes,6,opt,name=chan_point,json=chanPoint,proto3" json:"chan_point,omitempty" db:"chan_point"`
+	// The value of the channel to open in satoshis
+	Value int64 `protobuf:"varint,7,opt,name=value,proto3" json:"value,omitempty"`
+	// Why the channel manager proposed it
+	Reason string `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason,omitempty"`
+	// The status (proposed, approved, rejected, completed, failed)
+	Status string `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
+	// The admin user that reviewed it or autopilot if it was automatic
+	Operator string `protobuf:"bytes,10,opt,name=operator,proto3" json:"operator,omitempty"`
+	// The funding or closing transaction id
+	Txid string `protobuf:"bytes,11,opt,name=txid,proto3" json:"txid,omitempty"`
+	// The error if opening or closing the channel failed
+	Error string `protobuf:"bytes,12,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *ChannelProposal) Reset()      { *m = ChannelProposal{} }
+func (*ChannelProposal) ProtoMessage() {}
+func (*ChannelProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f27b1318c7cd0a2, []int{32}
+}
+func (m *ChannelProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelProposal.Merge(m, src)
+}
+func (m *ChannelProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelProposal proto.InternalMessageInfo
+
+func (m *ChannelProposal) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ChannelProposal) GetCreatedAt() *time.Time {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *ChannelProposal) GetUpdatedAt() *time.Time {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return nil
+}
+
+func (m *ChannelProposal) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *ChannelProposal) GetNodePubkey() string {
+	if m != nil {
+		return m.NodePubkey
+	}
+	return ""
+}
+
+func (m *ChannelProposal) GetChanPoint() string {
+	if m != nil {
+		return m.ChanPoint
+	}
+	return ""
+}
+
+func (m *ChannelProposal) GetValue() int64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+func (m *ChannelProposal) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *ChannelProposal) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *ChannelProposal) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *ChannelProposal) GetTxid() string {
+	if m != nil {
+		return m.Txid
+	}
+	return ""
+}
+
+func (m *ChannelProposal) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+// AdminChannelProposalsRequest is used to list channel proposals
+type AdminChannelProposalsRequest struct {
+	// Filter values (action, node_pubkey, status)
+	Filter map[string]string `protobuf:"bytes,1,rep,name=filter,proto3" json:"filter,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Offset, Limit for pagination
+	Offset int32 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit  int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *AdminChannelProposalsRequest) Reset()      { *m = AdminChannelProposalsRequest{} }
+func (*AdminChannelProposalsRequest) ProtoMessage() {}
+func (*AdminChannelProposalsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f27b1318c7cd0a2, []int{33}
+}
+func (m *AdminChannelProposalsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminChannelProposalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminChannelProposalsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AdminChannelProposalsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminChannelProposalsRequest.Merge(m, src)
+}
+func (m *AdminChannelProposalsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminChannelProposalsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminChannelProposalsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminChannelProposalsRequest proto.InternalMessageInfo
+
+func (m *AdminChannelProposalsRequest) GetFilter() map[string]string {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (m *AdminChannelProposalsRequest) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *AdminChannelProposalsRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type AdminChannelProposalsResponse struct {
+	// The channel proposals, newest first
+	ChannelProposals []*ChannelProposal `protobuf:"bytes,1,rep,name=channel_proposals,json=channelProposals,proto3" json:"channel_proposals,omitempty"`
+}
+
+func (m *AdminChannelProposalsResponse) Reset()      { *m = AdminChannelProposalsResponse{} }
+func (*AdminChannelProposalsResponse) ProtoMessage() {}
+func (*AdminChannelProposalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f27b1318c7cd0a2, []int{34}
+}
+func (m *AdminChannelProposalsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminChannelProposalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminChannelProposalsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AdminChannelProposalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminChannelProposalsResponse.Merge(m, src)
+}
+func (m *AdminChannelProposalsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminChannelProposalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminChannelProposalsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminChannelProposalsResponse proto.InternalMessageInfo
+
+func (m *AdminChannelProposalsResponse) GetChannelProposals() []*ChannelProposal {
+	if m != nil {
+		return m.ChannelProposals
+	}
+	return nil
+}
+
+// Used to review a channel proposal
+type AdminChannelProposalRequest struct {
+	// The id of the channel proposal
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *AdminChannelProposalRequest) Reset()      { *m = AdminChannelProposalRequest{} }
+func (*AdminChannelProposalRequest) ProtoMessage() {}
+func (*AdminChannelProposalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f27b1318c7cd0a2, []int{35}
+}
+func (m *AdminChannelProposalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminChannelProposalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminChannelProposalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AdminChannelProposalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminChannelProposalRequest.Merge(m, src)
+}
+func (m *AdminChannelProposalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminChannelProposalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminChannelProposalRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminChannelProposalRequest proto.InternalMessageInfo
+
+func (m *AdminChannelProposalRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+// AdminChannelHistoryRequest is used to list the channels opened and closed
+type AdminChannelHistoryRequest struct {
+	// Only show the history of this peer node
+	NodePubkey string `protobuf:"bytes,1,opt,name=node_pubkey,json=nodePubkey,proto3" json:"node_pubkey,omitempty"`
+	// Offset, Limit for pagination
+	Offset int32 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit  int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *AdminChannelHistoryRequest) Reset()      { *m = AdminChannelHistoryRequest{} }
+func (*AdminChannelHistoryRequest) ProtoMessage() {}
+func (*AdminChannelHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f27b1318c7cd0a2, []int{36}
+}
+func (m *AdminChannelHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminChannelHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminChannelHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AdminChannelHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminChannelHistoryRequest.Merge(m, src)
+}
+func (m *AdminChannelHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminChannelHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminChannelHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminChannelHistoryRequest proto.InternalMessageInfo
+
+func (m *AdminChannelHistoryRequest) GetNodePubkey() string {
+	if m != nil {
+		return m.NodePubkey
+	}
+	return ""
+}
+
+func (m *AdminChannelHistoryRequest) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *AdminChannelHistoryRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+// AdminChanBackup is a stored channel backup
+type AdminChanBackup struct {
+	// The id of the backup
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// When it was stored
+	Timestamp *time.Time `protobuf:"bytes,2,opt,name=timestamp,proto3,stdtime" json:"timestamp,omitempty"`
+	// The channel points (txid:index) of the channels in the backup
+	ChanPoints []string `protobuf:"bytes,3,rep,name=chan_points,json=chanPoints,proto3" json:"chan_points,omitempty"`
+	// The size of the backup in bytes
+	DataSize int64 `protobuf:"varint,4,opt,name=data_size,json=dataSize,proto3" json:"data_size,omitempty"`
+}
+
+func (m *AdminChanBackup) Reset()      { *m = AdminChanBackup{} }
+func (*AdminChanBackup) ProtoMessage() {}
+func (*AdminChanBackup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f27b1318c7cd0a2, []int{37}
+}
+func (m *AdminChanBackup) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminChanBackup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminChanBackup.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AdminChanBackup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminChanBackup.Merge(m, src)
+}
+func (m *AdminChanBackup) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminChanBackup) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminChanBackup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminChanBackup proto.InternalMessageInfo
+
+func (m *AdminChanBackup) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *AdminChanBackup) GetTimestamp() *time.Time {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *AdminChanBackup) GetChanPoints() []string {
+	if m != nil {
+		return m.ChanPoints
+	}
+	return nil
+}
+
+func (m *AdminChanBackup) GetDataSize() int64 {
+	if m != nil {
+		return m.DataSize
+	}
+	return 0
+}
+
+// AdminChanBackupsRequest is used to list channel backups
+type AdminChanBackupsRequest struct {
+	// Only show backups including this channel point (txid:index)
+	ChanPoint string `protobuf:"bytes,1,opt,name=chan_point,json=chanPoint,proto3" json:"chan_point,omitempty"`
+	// Offset, Limit for pagination
+	Offset int32 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit  int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *AdminChanBackupsRequest) Reset()      { *m = AdminChanBackupsRequest{} }
+func (*AdminChanBackupsRequest) ProtoMessage() {}
+func (*AdminChanBackupsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f27b1318c7cd0a2, []int{38}
+}
+func (m *AdminChanBackupsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminChanBackupsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminChanBackupsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AdminChanBackupsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminChanBackupsRequest.Merge(m, src)
+}
+func (m *AdminChanBackupsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminChanBackupsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminChanBackupsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminChanBackupsRequest proto.InternalMessageInfo
+
+func (m *AdminChanBackupsRequest) GetChanPoint() string {
+	if m != nil {
+		return m.ChanPoint
+	}
+	return ""
+}
+
+func (m *AdminChanBackupsRequest) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *AdminChanBackupsRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type AdminChanBackupsResponse struct {
+	// The channel backups, newest first
+	ChanBackups []*AdminChanBackup `protobuf:"bytes,1,rep,name=chan_backups,json=chanBackups,proto3" json:"chan_backups,omitempty"`
+}
+
+func (m *AdminChanBackupsResponse) Reset()      { *m = AdminChanBackupsResponse{} }
+func (*AdminChanBackupsResponse) ProtoMessage() {}
+func (*AdminChanBackupsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f27b1318c7cd0a2, []int{39}
+}
+func (m *AdminChanBackupsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminChanBackupsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminChanBackupsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AdminChanBackupsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminChanBackupsResponse.Merge(m, src)
+}
+func (m *AdminChanBackupsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminChanBackupsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminChanBackupsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminChanBackupsResponse proto.InternalMessageInfo
+
+func (m *AdminChanBackupsResponse) GetChanBackups() []*AdminChanBackup {
+	if m != nil {
+		return m.ChanBackups
+	}
+	return nil
+}
+
+// AdminChanBackupRequest is used to request a channel backup
+type AdminChanBackupRequest struct {
+	// The id of the backup
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *AdminChanBackupRequest) Reset()      { *m = AdminChanBackupRequest{} }
+func (*AdminChanBackupRequest) ProtoMessage() {}
+func (*AdminChanBackupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f27b1318c7cd0a2, []int{40}
+}
+func (m *AdminChanBackupRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminChanBackupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminChanBackupRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AdminChanBackupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminChanBackupRequest.Merge(m, src)
+}
+func (m *AdminChanBackupRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminChanBackupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminChanBackupRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminChanBackupRequest proto.InternalMessageInfo
+
+func (m *AdminChanBackupRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// AdminDiffChanBackupsRequest is used to compare two channel backups
+type AdminDiffChanBackupsRequest struct {
+	// The id of the older backup
+	FromId int64 `protobuf:"varint,1,opt,name=from_id,json=fromId,proto3" json:"from_id,omitempty"`
+	// The id of the newer backup
+	ToId int64 `protobuf:"varint,2,opt,name=to_id,json=toId,proto3" json:"to_id,omitempty"`
+}
+
+func (m *AdminDiffChanBackupsRequest) Reset()      { *m = AdminDiffChanBackupsRequest{} }
+func (*AdminDiffChanBackupsRequest) ProtoMessage() {}
+func (*AdminDiffChanBackupsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f27b1318c7cd0a2, []int{41}
+}
+func (m *AdminDiffChanBackupsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminDiffChanBackupsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminDiffChanBackupsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AdminDiffChanBackupsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminDiffChanBackupsRequest.Merge(m, src)
+}
+func (m *AdminDiffChanBackupsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminDiffChanBackupsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminDiffChanBackupsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminDiffChanBackupsRequest proto.InternalMessageInfo
+
+func (m *AdminDiffChanBackupsRequest) GetFromId() int64 {
+	if m != nil {
+		return m.FromId
+	}
+	return 0
+}
+
+func (m *AdminDiffChanBackupsRequest) GetToId() int64 {
+	if m != nil {
+		return m.ToId
+	}
+	return 0
+}
+
+type AdminDiffChanBackupsResponse struct {
+	From *AdminChanBackup `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To   *AdminChanBackup `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	// The channel points only in the to backup
+	Added []string `protobuf:"bytes,3,rep,name=added,proto3" json:"added,omitempty"`
+	// The channel points only in the from backup
+	Removed []string `protobuf:"bytes,4,rep,name=removed,proto3" json:"removed,omitempty"`
+	// The channel points in both backups
+	Unchanged []string `protobuf:"bytes,5,rep,name=unchanged,proto3" json:"unchanged,omitempty"`
+}
+
+func (m *AdminDiffChanBackupsResponse) Reset()      { *m = AdminDiffChanBackupsResponse{} }
+func (*AdminDiffChanBackupsResponse) ProtoMessage() {}
+func (*AdminDiffChanBackupsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f27b1318c7cd0a2, []int{42}
+}
+func (m *AdminDiffChanBackupsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminDiffChanBackupsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminDiffChanBackupsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AdminDiffChanBackupsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminDiffChanBackupsResponse.Merge(m, src)
+}
+func (m *AdminDiffChanBackupsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminDiffChanBackupsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminDiffChanBackupsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminDiffChanBackupsResponse proto.InternalMessageInfo
+
+func (m *AdminDiffChanBackupsResponse) GetFrom() *AdminChanBackup {
+	if m != nil {
+		return m.From
+	}
+	return nil
+}
+
+func (m *AdminDiffChanBackupsResponse) GetTo() *AdminChanBackup {
+	if m != nil {
+		return m.To
+	}
+	return nil
+}
+
+func (m *AdminDiffChanBackupsResponse) GetAdded() []string {
+	if m != nil {
+		return m.Added
+	}
+	return nil
+}
+
+func (m *AdminDiffChanBackupsResponse) GetRemoved() []string {
+	if m != nil {
+		return m.Removed
+	}
+	return nil
+}
+
+func (m *AdminDiffChanBackupsResponse) GetUnchanged() []string {
+	if m != nil {
+		return m.Unchanged
+	}
+	return nil
+}
+
+type AdminVerifyChanBackupResponse struct {
+	ChanBackup *AdminChanBackup `protobuf:"bytes,1,opt,name=chan_backup,json=chanBackup,proto3" json:"chan_backup,omitempty"`
+	// If lnd accepted the backup
+	Valid bool `protobuf:"varint,2,opt,name=valid,proto3" json:"valid,omitempty"`
+	// Why lnd rejected the backup
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *AdminVerifyChanBackupResponse) Reset()      { *m = AdminVerifyChanBackupResponse{} }
+func (*AdminVerifyChanBackupResponse) ProtoMessage() {}
+func (*AdminVerifyChanBackupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f27b1318c7cd0a2, []int{43}
+}
+func (m *AdminVerifyChanBackupResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminVerifyChanBackupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminVerifyChanBackupResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AdminVerifyChanBackupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminVerifyChanBackupResponse.Merge(m, src)
+}
+func (m *AdminVerifyChanBackupResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminVerifyChanBackupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminVerifyChanBackupResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminVerifyChanBackupResponse proto.InternalMessageInfo
+
+func (m *AdminVerifyChanBackupResponse) GetChanBackup() *AdminChanBackup {
+	if m != nil {
+		return m.ChanBackup
+	}
+	return nil
+}
+
+func (m *AdminVerifyChanBackupResponse) GetValid() bool {
+	if m != nil {
+		return m.Valid
+	}
+	return false
+}
+
+func (m *AdminVerifyChanBackupResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type AdminDownloadChanBackupResponse struct {
+	ChanBackup *AdminChanBackup `protobuf:"bytes,1,opt,name=chan_backup,json=chanBackup,proto3" json:"chan_backup,omitempty"`
+	// The multi channel backup as exported by lnd
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *AdminDownloadChanBackupResponse) Reset()      { *m = AdminDownloadChanBackupResponse{} }
+func (*AdminDownloadChanBackupResponse) ProtoMessage() {}
+func (*AdminDownloadChanBackupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f27b1318c7cd0a2, []int{44}
+}
+func (m *AdminDownloadChanBackupResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminDownloadChanBackupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminDownloadChanBackupResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AdminDownloadChanBackupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminDownloadChanBackupResponse.Merge(m, src)
+}
+func (m *AdminDownloadChanBackupResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminDownloadChanBackupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminDownloadChanBackupResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminDownloadChanBackupResponse proto.InternalMessageInfo
+
+func (m *AdminDownloadChanBackupResponse) GetChanBackup() *AdminChanBackup {
+	if m != nil {
+		return m.ChanBackup
+	}
+	return nil
+}
+
+func (m *AdminDownloadChanBackupResponse) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*AdminAccountsRequest)(nil), "tdrpc.AdminAccountsRequest")
+	proto.RegisterMapType((map[string]string)(nil), "tdrpc.AdminAccountsRequest.FilterEntry")
+	proto.RegisterType((*AdminAccountsResponse)(nil), "tdrpc.AdminAccountsResponse")
+	proto.RegisterType((*AdminGetAccountRequest)(nil), "tdrpc.AdminGetAccountRequest")
+	proto.RegisterType((*AdminUpdateAccountRequest)(nil), "tdrpc.AdminUpdateAccountRequest")
+	proto.RegisterType((*AdminResolveLedgerRecordRequest)(nil), "tdrpc.AdminResolveLedgerRecordRequest")
+	proto.RegisterType((*AdminResolveLedgerRecordResponse)(nil), "tdrpc.AdminResolveLedgerRecordResponse")
+	proto.RegisterType((*AdminAdjustBalanceRequest)(nil), "tdrpc.AdminAdjustBalanceRequest")
+	proto.RegisterType((*AdminAdjustBalanceResponse)(nil), "tdrpc.AdminAdjustBalanceResponse")
+	proto.RegisterType((*LedgerAdjustment)(nil), "tdrpc.LedgerAdjustment")
+	proto.RegisterType((*AdminAdjustmentsRequest)(nil), "tdrpc.AdminAdjustmentsRequest")
+	proto.RegisterType((*AdminAdjustmentsResponse)(nil), "tdrpc.AdminAdjustmentsResponse")
+	proto.RegisterType((*AdminHeldRequest)(nil), "tdrpc.AdminHeldRequest")
+	proto.RegisterMapType((map[string]string)(nil), "tdrpc.AdminHeldRequest.FilterEntry")
+	proto.RegisterType((*AdminReviewHeldRequest)(nil), "tdrpc.AdminReviewHeldRequest")
+	proto.RegisterType((*AgentKey)(nil), "tdrpc.AgentKey")
+	proto.RegisterType((*AdminAgentKeysRequest)(nil), "tdrpc.AdminAgentKeysRequest")
+	proto.RegisterType((*AdminAgentKeysResponse)(nil), "tdrpc.AdminAgentKeysResponse")
+	proto.RegisterType((*AdminAgentKeyRequest)(nil), "tdrpc.AdminAgentKeyRequest")
+	proto.RegisterType((*AdminSaveAgentKeyRequest)(nil), "tdrpc.AdminSaveAgentKeyRequest")
+	proto.RegisterType((*AdminCreateAgentKeyResponse)(nil), "tdrpc.AdminCreateAgentKeyResponse")
+	proto.RegisterType((*AccountLimits)(nil), "tdrpc.AccountLimits")
+	proto.RegisterType((*AdminAccountLimitsRequest)(nil), "tdrpc.AdminAccountLimitsRequest")
+	proto.RegisterType((*AdminAccountLimitsResponse)(nil), "tdrpc.AdminAccountLimitsResponse")
+	proto.RegisterType((*ScreeningEntry)(nil), "tdrpc.ScreeningEntry")
+	proto.RegisterType((*AdminScreeningEntriesRequest)(nil), "tdrpc.AdminScreeningEntriesRequest")
+	proto.RegisterMapType((map[string]string)(nil), "tdrpc.AdminScreeningEntriesRequest.FilterEntry")
+	proto.RegisterType((*AdminScreeningEntriesResponse)(nil), "tdrpc.AdminScreeningEntriesResponse")
+	proto.RegisterType((*AdminScreeningEntryRequest)(nil), "tdrpc.AdminScreeningEntryRequest")
+	proto.RegisterType((*ScreeningHistory)(nil), "tdrpc.ScreeningHistory")
+	proto.RegisterType((*AdminScreeningHistoryRequest)(nil), "tdrpc.AdminScreeningHistoryRequest")
+	proto.RegisterType((*AdminScreeningHistoryResponse)(nil), "tdrpc.AdminScreeningHistoryResponse")
+	proto.RegisterType((*AdminAudit)(nil), "tdrpc.AdminAudit")
+	proto.RegisterType((*AdminAuditLogRequest)(nil), "tdrpc.AdminAuditLogRequest")
+	proto.RegisterMapType((map[string]string)(nil), "tdrpc.AdminAuditLogRequest.FilterEntry")
+	proto.RegisterType((*AdminAuditLogResponse)(nil), "tdrpc.AdminAuditLogResponse")
+	proto.RegisterType((*ChannelProposal)(nil), "tdrpc.ChannelProposal")
+	proto.RegisterType((*AdminChannelProposalsRequest)(nil), "tdrpc.AdminChannelProposalsRequest")
+	proto.RegisterMapType((map[string]string)(nil), "tdrpc.AdminChannelProposalsRequest.FilterEntry")
+	proto.RegisterType((*AdminChannelProposalsResponse)(nil), "tdrpc.AdminChannelProposalsResponse")
+	proto.RegisterType((*AdminChannelProposalRequest)(nil), "tdrpc.AdminChannelProposalRequest")
+	proto.RegisterType((*AdminChannelHistoryRequest)(nil), "tdrpc.AdminChannelHistoryRequest")
+	proto.RegisterType((*AdminChanBackup)(nil), "tdrpc.AdminChanBackup")
+	proto.RegisterType((*AdminChanBackupsRequest)(nil), "tdrpc.AdminChanBackupsRequest")
+	proto.RegisterType((*AdminChanBackupsResponse)(nil), "tdrpc.AdminChanBackupsResponse")
+	proto.RegisterType((*AdminChanBackupRequest)(nil), "tdrpc.AdminChanBackupRequest")
+	proto.RegisterType((*AdminDiffChanBackupsRequest)(nil), "tdrpc.AdminDiffChanBackupsRequest")
+	proto.RegisterType((*AdminDiffChanBackupsResponse)(nil), "tdrpc.AdminDiffChanBackupsResponse")
+	proto.RegisterType((*AdminVerifyChanBackupResponse)(nil), "tdrpc.AdminVerifyChanBackupResponse")
+	proto.RegisterType((*AdminDownloadChanBackupResponse)(nil), "tdrpc.AdminDownloadChanBackupResponse")
+}
+
+func init() { proto.RegisterFile("tdrpc/adminrpc.proto", fileDescriptor_5f27b1318c7cd0a2) }
+
+var fileDescriptor_5f27b1318c7cd0a2 = []byte{
+	// 3031 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0xcd, 0x73, 0x1c, 0x47,
+	0x15, 0xf7, 0xac, 0xa4, 0xd5, 0xee, 0x93, 0xf5, 0xe1, 0xb6, 0x3e, 0xd6, 0x63, 0x69, 0x57, 0x6e,
+	0x3b, 0x8e, 0xca, 0x1f, 0xda, 0x44, 0x14, 0x84, 0x38, 0x55, 0x04, 0xc9, 0x0e, 0x8e, 0x2b, 0xae,
+	0xc2, 0x35, 0x26, 0xa4, 0x48, 0x15, 0xb5, 0x35, 0xda, 0xe9, 0x5d, 0x4d, 0xb4, 0x3b, 0xb3, 0xcc,
+	0xcc, 0xca, 0x56, 0x52, 0x09, 0x54, 0x30, 0x05, 0x17, 0xa8, 0x14, 0xdc, 0x28, 0xfe, 0x00, 0xae,
+	0x50, 0x1c, 0xa8, 0xe2, 0x92, 0x23, 0x37, 0x0c, 0x5c, 0x72, 0x12, 0x58, 0xe1, 0x40, 0x71, 0x0a,
+	0x3e, 0x71, 0xa4, 0xba, 0xfb, 0xf5, 0x7c, 0xcf, 0x4a, 0xce, 0x07, 0x21, 0xa7, 0x9d, 0xee, 0xf7,
+	0xfa, 0xbd, 0xd7, 0xef, 0xfd, 0xfa, 0x75, 0xf7, 0xeb, 0x85, 0xf9, 0xc0, 0xf2, 0x06, 0xed, 0xa6,
+	0x69, 0xf5, 0x6d, 0xc7, 0x1b, 0xb4, 0xd7, 0x07, 0x9e, 0x1b, 0xb8, 0x64, 0x42, 0xf4, 0xea, 0xa7,
+	0x24, 0x31, 0xb0, 0x42, 0x8a, 0xbe, 0xdc, 0x75, 0xdd, 0x6e, 0x8f, 0x35, 0xcd, 0x81, 0xdd, 0x34,
+	0x1d, 0xc7, 0x0d, 0xcc, 0xc0, 0x76, 0x1d, 0x1f, 0xa9, 0x67, 0x91, 0x2a, 0x5a, 0xdb, 0xc3, 0x4e,
+	0x93, 0xf5, 0x07, 0xc1, 0x3e, 0x12, 0x1b, 0x69, 0x62, 0x60, 0xf7, 0x99, 0x1f, 0x98, 0xfd, 0x01,
+	0x32, 0x5c, 0xed, 0xda, 0xc1, 0xce, 0x70, 0x7b, 0xbd, 0xed, 0xf6, 0x9b, 0x5d, 0xb7, 0xeb, 0x46,
+	0x9c, 0xbc, 0x25, 0x1a, 0xe2, 0x0b, 0xd9, 0xaf, 0x88, 0x9f, 0xf6, 0xd5, 0x2e, 0x73, 0xae, 0xfa,
+	0xf7, 0xcc, 0x6e, 0x97, 0x79, 0x4d, 0x77, 0x20, 0xcc, 0xc9, 0x9a, 0x46, 0xdf, 0xd7, 0x60, 0x7e,
+	0x93, 0xcf, 0x72, 0xb3, 0xdd, 0x76, 0x87, 0x4e, 0xe0, 0x1b, 0xec, 0x7b, 0x43, 0xe6, 0x07, 0xe4,
+	0x45, 0x28, 0x77, 0xec, 0x5e, 0xc0, 0xbc, 0x9a, 0xb6, 0x3a, 0xb6, 0x36, 0xb5, 0xf1, 0xf4, 0xba,
+	0x9c, 0x6f, 0x1e, 0xf3, 0xfa, 0x37, 0x04, 0xe7, 0x4b, 0x4e, 0xe0, 0xed, 0x1b, 0x38, 0x8c, 0x2c,
+	0x42, 0xd9, 0xed, 0x74, 0x7c, 0x16, 0xd4, 0xc6, 0x56, 0xb5, 0xb5, 0x09, 0x03, 0x5b, 0x64, 0x1e,
+	0x26, 0x7a, 0x76, 0xdf, 0x0e, 0x6a, 0xe3, 0xa2, 0x5b, 0x36, 0xf4, 0xe7, 0x61, 0x2a, 0x26, 0x84,
+	0xcc, 0xc1, 0xd8, 0x2e, 0xdb, 0xaf, 0x69, 0xab, 0xda, 0x5a, 0xd5, 0xe0, 0x9f, 0x7c, 0xd8, 0x9e,
+	0xd9, 0x1b, 0xb2, 0x5a, 0x49, 0xf4, 0xc9, 0xc6, 0xb5, 0xd2, 0x57, 0x35, 0x7a, 0x1d, 0x16, 0x52,
+	0x46, 0xf9, 0x03, 0xd7, 0xf1, 0x19, 0xb9, 0x04, 0x15, 0x13, 0xfb, 0x70, 0x12, 0x33, 0x6a, 0x12,
+	0xb2, 0xdb, 0x08, 0xe9, 0x74, 0x0b, 0x16, 0x85, 0x90, 0x9b, 0x2c, 0x50, 0x44, 0x74, 0xc4, 0x0c,
+	0x94, 0x6c, 0x0b, 0x2d, 0x29, 0xd9, 0x16, 0xa9, 0xc1, 0xa4, 0x69, 0x59, 0x1e, 0xf3, 0x7d, 0x34,
+	0x45, 0x35, 0xe9, 0x75, 0x38, 0x23, 0x64, 0xbc, 0x3a, 0xb0, 0xcc, 0x80, 0x1d, 0x21, 0x66, 0x11,
+	0xca, 0x3d, 0xb7, 0xbd, 0xcb, 0x2c, 0x21, 0xa5, 0x62, 0x60, 0x8b, 0xfe, 0x44, 0x83, 0x86, 0x90,
+	0x62, 0x30, 0xdf, 0xed, 0xed, 0xb1, 0xdb, 0xcc, 0xea, 0x32, 0xcf, 0x60, 0x6d, 0xd7, 0xb3, 0x8a,
+	0x64, 0xbd, 0x00, 0x55, 0xcb, 0xf6, 0x58, 0x9b, 0x07, 0x56, 0x88, 0x9b, 0xd9, 0x58, 0xc1, 0x99,
+	0xc6, 0x87, 0xaf, 0xdf, 0x50, 0x4c, 0x46, 0xc4, 0xcf, 0xe7, 0xd3, 0x76, 0x9d, 0x8e, 0xed, 0xf5,
+	0x45, 0xa0, 0x2a, 0x86, 0x6a, 0xd2, 0xdf, 0x69, 0xb0, 0x5a, 0x6c, 0x0a, 0x3a, 0xf9, 0x2a, 0x4c,
+	0xb6, 0x87, 0x9e, 0xc7, 0x9c, 0x40, 0x18, 0x34, 0xb5, 0x71, 0x3a, 0x47, 0xb3, 0xa1, 0x78, 0x48,
+	0x13, 0x2a, 0x03, 0xcf, 0x1d, 0xb8, 0x3e, 0x4e, 0xbc, 0x80, 0x3f, 0x64, 0xe2, 0x7e, 0xf2, 0x98,
+	0xe9, 0xbb, 0x8e, 0xb0, 0xae, 0x6a, 0x60, 0x4b, 0x84, 0x61, 0x30, 0xe8, 0xd9, 0xcc, 0x12, 0x40,
+	0xaa, 0x18, 0xaa, 0x49, 0x7f, 0xa6, 0x61, 0x1c, 0x36, 0xad, 0x37, 0x86, 0x7e, 0xb0, 0x65, 0xf6,
+	0x4c, 0xa7, 0xcd, 0x94, 0xef, 0x56, 0x00, 0x30, 0xe8, 0xad, 0xd0, 0x87, 0x55, 0xec, 0xb9, 0x65,
+	0x91, 0x95, 0x38, 0xcc, 0xc6, 0xb6, 0x26, 0x7f, 0xbe, 0x39, 0xfe, 0xcb, 0x92, 0x36, 0x86, 0x78,
+	0x2b, 0xb4, 0xe6, 0x2c, 0x54, 0x03, 0xbb, 0xbd, 0xcb, 0x84, 0xd0, 0x71, 0x41, 0xaa, 0xc8, 0x8e,
+	0x5b, 0x16, 0x7d, 0x57, 0x03, 0x3d, 0xcf, 0x20, 0xf4, 0xe0, 0x65, 0x2e, 0xd3, 0x1f, 0xf6, 0x46,
+	0x3a, 0x10, 0x59, 0xc8, 0x73, 0x00, 0xa6, 0x90, 0xd2, 0xe7, 0x1e, 0x97, 0x1e, 0x5c, 0x4a, 0x0c,
+	0xd8, 0x0c, 0xc9, 0x46, 0x8c, 0x95, 0xfe, 0xa9, 0x04, 0x73, 0x69, 0x06, 0xd2, 0x84, 0x6a, 0x4f,
+	0xf4, 0x85, 0xbe, 0xd8, 0x22, 0x8f, 0x0f, 0x1a, 0x33, 0xd6, 0xf6, 0x35, 0x1a, 0x12, 0xa8, 0x51,
+	0x91, 0xdf, 0xb7, 0x2c, 0xb2, 0x91, 0xf0, 0x9e, 0xc0, 0xff, 0xd6, 0xe9, 0xc7, 0x07, 0x8d, 0x59,
+	0x3e, 0x22, 0xa2, 0xd0, 0xb8, 0x4b, 0x0d, 0x80, 0xb6, 0xc7, 0xcc, 0x80, 0x59, 0x2d, 0x53, 0x26,
+	0x83, 0xa9, 0x0d, 0x7d, 0x5d, 0x66, 0xbd, 0x75, 0x95, 0xcb, 0xd6, 0xbf, 0xa5, 0xb2, 0xde, 0xd6,
+	0x92, 0x92, 0x17, 0x8d, 0xa2, 0xef, 0xfd, 0xad, 0xa1, 0x19, 0x55, 0xec, 0xd8, 0x0c, 0xa2, 0x30,
+	0x8d, 0xe7, 0x86, 0x49, 0x87, 0x8a, 0x3b, 0x60, 0x9e, 0x19, 0xb8, 0x5e, 0x6d, 0x42, 0x46, 0x43,
+	0xb5, 0x63, 0x21, 0x2c, 0x27, 0x42, 0xd8, 0x8c, 0x87, 0x70, 0x32, 0xe9, 0x8b, 0x90, 0x40, 0x63,
+	0x61, 0xed, 0xc0, 0x52, 0x2c, 0xaa, 0x7d, 0x16, 0xe5, 0xc3, 0xa3, 0x40, 0x16, 0xa5, 0xc6, 0x52,
+	0x7e, 0x6a, 0x1c, 0x8b, 0xa5, 0x46, 0xfa, 0x2a, 0xd4, 0xb2, 0x7a, 0x10, 0x3b, 0xcf, 0xc3, 0x54,
+	0x14, 0x63, 0x95, 0xe5, 0x0a, 0xf1, 0x10, 0xe7, 0xa5, 0xbf, 0xd7, 0x60, 0x4e, 0xc8, 0x7d, 0x99,
+	0xf5, 0xc2, 0xcc, 0xf2, 0x42, 0x2a, 0xeb, 0x9f, 0x8f, 0x67, 0xfd, 0x18, 0xe3, 0x11, 0x19, 0xff,
+	0x18, 0xd3, 0xfa, 0x24, 0x19, 0xff, 0xeb, 0x98, 0xac, 0x0d, 0xb6, 0x67, 0xb3, 0x7b, 0x71, 0xfb,
+	0x73, 0xb2, 0x2c, 0x06, 0xbb, 0x14, 0x0f, 0x36, 0xfd, 0xd5, 0x38, 0x54, 0x36, 0xbb, 0xcc, 0x09,
+	0x5e, 0x61, 0xfb, 0x99, 0x41, 0x49, 0xc0, 0x96, 0x3e, 0x15, 0xc0, 0x1a, 0x00, 0xc3, 0x81, 0x85,
+	0xd4, 0x27, 0x59, 0x04, 0xd1, 0x28, 0x94, 0x89, 0x1d, 0x9b, 0x01, 0x21, 0x30, 0xee, 0x98, 0x7d,
+	0x86, 0xf9, 0x46, 0x7c, 0x13, 0x0a, 0x65, 0xbf, 0xed, 0x0e, 0x98, 0x5f, 0x9b, 0x58, 0x1d, 0x5b,
+	0xab, 0x6e, 0xc1, 0xe3, 0x83, 0x46, 0x99, 0xcb, 0xb9, 0x4a, 0x0d, 0xa4, 0x90, 0xcb, 0x30, 0x15,
+	0xa1, 0xd3, 0xaf, 0x95, 0x33, 0x8c, 0x10, 0x42, 0xd5, 0x27, 0xaf, 0xc0, 0x94, 0x70, 0x7c, 0x4b,
+	0x86, 0x70, 0x52, 0xac, 0xb7, 0x4b, 0xb8, 0xde, 0xfe, 0x75, 0xd0, 0x88, 0x53, 0x1f, 0x1f, 0x34,
+	0xe6, 0xb8, 0x88, 0x58, 0x17, 0x35, 0x40, 0xb4, 0x6e, 0xf3, 0x06, 0xf7, 0x02, 0xbb, 0x3f, 0xb0,
+	0x3d, 0xe6, 0x73, 0x2f, 0x54, 0x8e, 0xef, 0x85, 0x68, 0x14, 0x7a, 0x01, 0x3b, 0xa4, 0x67, 0x3d,
+	0xb6, 0xe7, 0xee, 0x4a, 0xcf, 0x56, 0x8f, 0x2f, 0x33, 0x1a, 0x85, 0x32, 0xb1, 0x63, 0x33, 0xa0,
+	0x2f, 0xa9, 0x23, 0x05, 0x42, 0x24, 0x5c, 0xd8, 0x11, 0xc4, 0xb5, 0x7c, 0x88, 0x97, 0xe2, 0x2b,
+	0xf7, 0x65, 0x58, 0x4c, 0x8b, 0xc1, 0x75, 0xbb, 0x0e, 0x60, 0xf2, 0xce, 0xd6, 0x2e, 0xdb, 0x57,
+	0xcb, 0x76, 0x56, 0xad, 0x35, 0xe4, 0x36, 0xaa, 0xa6, 0x1a, 0x47, 0x2f, 0xaa, 0x53, 0x9a, 0xa2,
+	0xe5, 0xe3, 0x9d, 0x1e, 0x6a, 0x98, 0x2c, 0xee, 0x9a, 0x7b, 0xec, 0x08, 0xe6, 0x10, 0x3f, 0xa5,
+	0x18, 0x7e, 0x16, 0x43, 0xfc, 0x8c, 0x71, 0x58, 0x84, 0x98, 0x69, 0x24, 0x31, 0x33, 0x2e, 0x88,
+	0x71, 0x9c, 0xac, 0x25, 0x71, 0x32, 0x91, 0xcc, 0xcb, 0x71, 0x10, 0xbc, 0x98, 0x00, 0x41, 0xf9,
+	0xc8, 0x80, 0x8d, 0xa7, 0x22, 0x4e, 0xdb, 0x70, 0x56, 0xcc, 0xf1, 0xba, 0x58, 0x5d, 0xd1, 0x2c,
+	0xd1, 0xb7, 0x57, 0xa0, 0x1a, 0xfa, 0x16, 0xb7, 0xd4, 0x8c, 0x6b, 0x2b, 0xca, 0xb5, 0x62, 0xc2,
+	0xac, 0xed, 0x61, 0xd2, 0xaa, 0x1a, 0xd8, 0xa2, 0x7f, 0x18, 0x87, 0x69, 0x3c, 0xc2, 0x09, 0xb3,
+	0xfd, 0xd4, 0xde, 0xa7, 0x7d, 0x8c, 0xbd, 0xef, 0xff, 0x3a, 0x95, 0x04, 0x36, 0xf3, 0x54, 0x2a,
+	0xe1, 0xdf, 0xe4, 0x26, 0x80, 0x65, 0xda, 0xbd, 0xfd, 0x96, 0xcf, 0x1c, 0x0b, 0x03, 0xba, 0x16,
+	0x2d, 0xfc, 0x18, 0x51, 0x29, 0x88, 0x7a, 0xa8, 0x51, 0x15, 0x8d, 0xbb, 0xcc, 0xb1, 0x78, 0x0a,
+	0xb9, 0xc7, 0xd8, 0xae, 0x92, 0x54, 0xce, 0xa4, 0x90, 0x18, 0x55, 0xa5, 0x90, 0x58, 0x17, 0x35,
+	0x40, 0xb6, 0x94, 0xb0, 0xbe, 0x79, 0xbf, 0x35, 0x30, 0xf7, 0xc5, 0x09, 0x28, 0x9b, 0x8f, 0x62,
+	0x54, 0x25, 0x2c, 0xd6, 0x45, 0x0d, 0xe8, 0x9b, 0xf7, 0xef, 0xc8, 0x06, 0x79, 0x0d, 0x66, 0xa4,
+	0xcd, 0xf7, 0xec, 0x60, 0xc7, 0xf2, 0xcc, 0x7b, 0x22, 0x27, 0x8d, 0x6d, 0x3d, 0x13, 0xc9, 0x4b,
+	0x31, 0x3c, 0x3e, 0x68, 0x9c, 0x8e, 0xa6, 0xaa, 0x7a, 0xa9, 0x31, 0x2d, 0x3a, 0x5e, 0x53, 0xed,
+	0x6b, 0xea, 0x08, 0x1a, 0x47, 0xd0, 0xf1, 0x4e, 0x07, 0xf4, 0x1d, 0x75, 0x5a, 0x4c, 0x8e, 0x0d,
+	0xd1, 0x5d, 0x16, 0x2b, 0xcc, 0x47, 0x68, 0xcf, 0x27, 0xaf, 0x34, 0xc8, 0x8d, 0x3c, 0x64, 0x03,
+	0xaa, 0xac, 0xd3, 0xe1, 0x27, 0xfd, 0x3d, 0x56, 0x2b, 0x8d, 0x18, 0x10, 0xb1, 0xd1, 0xdf, 0x96,
+	0x60, 0xe6, 0x6e, 0xdb, 0x63, 0xcc, 0xb1, 0x9d, 0xae, 0xdc, 0x9c, 0xbf, 0xc0, 0x3b, 0x64, 0xcf,
+	0xf6, 0x03, 0x05, 0x6b, 0xfe, 0xcd, 0xfb, 0x82, 0xfd, 0x01, 0xc3, 0x73, 0xa1, 0xf8, 0x8e, 0x8e,
+	0x1a, 0xe5, 0xd8, 0x51, 0x83, 0xa7, 0x06, 0x53, 0xde, 0xa9, 0x26, 0x65, 0x6a, 0x90, 0x2d, 0x2e,
+	0xc1, 0x71, 0x03, 0x56, 0xab, 0x60, 0xde, 0x74, 0x03, 0x46, 0x1f, 0x6a, 0xb0, 0x2c, 0x13, 0x6f,
+	0xdc, 0x73, 0x36, 0x0b, 0x83, 0x7e, 0x33, 0x75, 0xb2, 0x6a, 0xc6, 0x4f, 0x56, 0x05, 0x83, 0x3e,
+	0xdf, 0x53, 0x56, 0x1b, 0x56, 0x0a, 0x8c, 0x43, 0x28, 0x6e, 0xc1, 0x29, 0x5f, 0xd1, 0x5a, 0x4c,
+	0x12, 0x71, 0x76, 0x0b, 0x38, 0xbb, 0x24, 0x8e, 0x8c, 0x39, 0x3f, 0x25, 0x8b, 0x5e, 0x41, 0xb0,
+	0xa7, 0x18, 0x0b, 0xb6, 0xb7, 0x3f, 0x97, 0x60, 0x2e, 0xe4, 0x7c, 0xd9, 0xf6, 0x03, 0xd7, 0xdb,
+	0x27, 0x4b, 0x21, 0x53, 0x6c, 0xc3, 0xe1, 0x28, 0xbd, 0x02, 0x15, 0x6e, 0xd5, 0x7e, 0x74, 0x55,
+	0x39, 0xf5, 0xf8, 0xa0, 0x31, 0x2d, 0xce, 0x13, 0xd8, 0x4f, 0x8d, 0x49, 0xf1, 0xf9, 0x19, 0x5d,
+	0x53, 0x96, 0xa1, 0x2a, 0xef, 0x1d, 0x1c, 0x44, 0x12, 0x84, 0x51, 0xc7, 0xc8, 0x5b, 0x8a, 0x42,
+	0x6e, 0x39, 0x07, 0xb9, 0x93, 0x79, 0xc8, 0xad, 0xe4, 0x23, 0xb7, 0x9a, 0x8b, 0x5c, 0x88, 0x21,
+	0xb7, 0x9b, 0x06, 0x2e, 0xfa, 0x55, 0xc5, 0xe0, 0x4c, 0xcc, 0x8b, 0x32, 0x12, 0xa1, 0xcb, 0x9e,
+	0xec, 0x1e, 0xc3, 0x60, 0xa5, 0x40, 0x11, 0xe2, 0xe9, 0x46, 0x1c, 0x4f, 0x3b, 0x92, 0x98, 0xba,
+	0xd2, 0x64, 0xc6, 0xce, 0xf9, 0xa9, 0x1e, 0xfa, 0x6f, 0x0d, 0x40, 0xe6, 0xcf, 0xa1, 0x65, 0x07,
+	0xc5, 0xe8, 0xf8, 0x2c, 0x72, 0x58, 0x3c, 0xa2, 0x63, 0xd9, 0x7b, 0x67, 0x9f, 0x05, 0x3b, 0xae,
+	0xaa, 0x0f, 0x60, 0x8b, 0x17, 0x32, 0x3c, 0xe9, 0x6a, 0x04, 0x81, 0x6a, 0xf2, 0x68, 0xb5, 0x5d,
+	0x4b, 0x25, 0x25, 0xf1, 0xcd, 0x5d, 0xcb, 0x3c, 0xcf, 0xf5, 0x10, 0x04, 0xb2, 0x41, 0xff, 0x13,
+	0x56, 0xf1, 0xf8, 0x9c, 0x6f, 0xbb, 0xdd, 0xe3, 0x55, 0xf1, 0x92, 0xcc, 0xb9, 0xd9, 0xe6, 0x2b,
+	0x30, 0x61, 0x76, 0xf8, 0xf8, 0xd2, 0x31, 0xcf, 0x69, 0x92, 0xfd, 0x7f, 0x57, 0xfd, 0xbb, 0x09,
+	0x0b, 0xa9, 0xc9, 0x84, 0x47, 0xec, 0xaa, 0xc9, 0xfb, 0x5a, 0x3d, 0xb7, 0x8b, 0xb3, 0x3f, 0x95,
+	0x99, 0xbd, 0x51, 0x31, 0x71, 0x1c, 0x7d, 0x34, 0x06, 0xb3, 0xd7, 0x77, 0x4c, 0xc7, 0x61, 0xbd,
+	0x3b, 0xa2, 0xf8, 0x64, 0xf6, 0xbe, 0xb0, 0xfb, 0x5e, 0xb4, 0xfe, 0xc7, 0x13, 0xeb, 0xff, 0xcb,
+	0x30, 0xe5, 0xb8, 0x16, 0x6b, 0x0d, 0x86, 0xdb, 0xdc, 0xc1, 0x02, 0x6f, 0x5b, 0xf3, 0xea, 0x98,
+	0x14, 0x23, 0x51, 0x03, 0x78, 0xeb, 0x8e, 0x68, 0xf0, 0x93, 0x6f, 0x7b, 0xc7, 0x74, 0x5a, 0x03,
+	0xd7, 0x76, 0x30, 0x25, 0x45, 0x27, 0xdf, 0x88, 0x42, 0x8d, 0x2a, 0x6f, 0xdc, 0xe1, 0xdf, 0x51,
+	0x85, 0x66, 0xf2, 0x88, 0x42, 0x5a, 0x25, 0x51, 0x85, 0xe1, 0xc7, 0xf1, 0xc0, 0x0c, 0x86, 0xbe,
+	0xca, 0x5c, 0xb2, 0x95, 0x58, 0x59, 0x90, 0xcd, 0x95, 0xc1, 0x7d, 0xdb, 0xaa, 0x4d, 0x61, 0x5e,
+	0xbc, 0x6f, 0x5b, 0xd1, 0x3a, 0x39, 0x19, 0x5f, 0x27, 0xe1, 0x2e, 0x9d, 0x0a, 0xf4, 0xf1, 0x76,
+	0xe9, 0x82, 0x41, 0x9f, 0xef, 0x2e, 0x6d, 0xc1, 0x4a, 0x81, 0x71, 0xb8, 0x0e, 0xae, 0xc3, 0xa9,
+	0xb6, 0xa4, 0xb5, 0x06, 0x8a, 0x88, 0xb3, 0x5b, 0xc4, 0xd9, 0xa5, 0xc6, 0x1a, 0x73, 0xed, 0x94,
+	0x30, 0x7a, 0x55, 0x5d, 0xb9, 0x52, 0x9c, 0x05, 0xfb, 0xf4, 0x2e, 0xe8, 0x71, 0xf6, 0xd4, 0x8e,
+	0xd2, 0x48, 0xa2, 0x50, 0x0e, 0x8b, 0xe3, 0xed, 0xc9, 0xf6, 0x95, 0xdf, 0x68, 0x30, 0x1b, 0x6a,
+	0xdb, 0x32, 0xdb, 0xbb, 0xc3, 0x41, 0x71, 0xd6, 0xff, 0x1a, 0xaf, 0xf2, 0xe1, 0x52, 0x3a, 0x76,
+	0x4e, 0x8b, 0x86, 0x70, 0xdb, 0x23, 0xc0, 0xab, 0x4b, 0x32, 0x84, 0xb0, 0xf7, 0xc9, 0x05, 0xa8,
+	0x5a, 0x66, 0x60, 0xb6, 0x7c, 0xfb, 0xcd, 0x4c, 0x75, 0xb2, 0xc2, 0x29, 0x77, 0xed, 0x37, 0x59,
+	0x58, 0x3b, 0x8c, 0x4c, 0x8e, 0xdf, 0x0e, 0x62, 0x8b, 0x0d, 0x6f, 0x07, 0xd1, 0xba, 0xfa, 0x78,
+	0xb5, 0xc3, 0x84, 0x9e, 0xb0, 0x76, 0x78, 0x52, 0x28, 0xda, 0x96, 0xfd, 0x29, 0x4c, 0xa4, 0x86,
+	0x19, 0x53, 0xed, 0xf0, 0xdb, 0xa7, 0xcf, 0xc2, 0x62, 0x9a, 0x8e, 0xd6, 0x17, 0x39, 0x9e, 0x7e,
+	0x17, 0x11, 0x74, 0xc3, 0xee, 0x74, 0x72, 0x66, 0xbd, 0x0a, 0x93, 0x1d, 0xcf, 0xed, 0xb7, 0xb2,
+	0x83, 0xcb, 0xbc, 0xff, 0x96, 0x45, 0x96, 0x61, 0x22, 0x70, 0xd5, 0x51, 0x2e, 0x46, 0x1f, 0x0f,
+	0xdc, 0x5b, 0x16, 0x7d, 0x5f, 0xad, 0xec, 0x8c, 0xfc, 0xf0, 0x31, 0x68, 0x9c, 0x0b, 0xc2, 0x5b,
+	0x53, 0xd1, 0x2c, 0x05, 0x0f, 0xb9, 0x08, 0xa5, 0xc0, 0xad, 0x95, 0x46, 0x72, 0x96, 0x02, 0x97,
+	0xfb, 0xdc, 0xb4, 0x2c, 0x66, 0x21, 0x0c, 0x64, 0x43, 0x6e, 0xe8, 0x7d, 0x77, 0x4f, 0xbc, 0x4c,
+	0x8c, 0xc9, 0x0d, 0x5d, 0x34, 0xf9, 0x71, 0x70, 0xe8, 0x70, 0x3f, 0x76, 0x99, 0x25, 0xeb, 0x73,
+	0x46, 0xd4, 0x41, 0x1f, 0x68, 0xb8, 0x94, 0xbf, 0xcd, 0x3c, 0xbb, 0xb3, 0x1f, 0xf7, 0x2d, 0xce,
+	0xe1, 0x39, 0x98, 0x8a, 0x45, 0xec, 0x88, 0xa9, 0x40, 0x14, 0x30, 0x4c, 0x1f, 0xb6, 0x7a, 0x6b,
+	0x92, 0x8d, 0x28, 0x47, 0x8e, 0xc5, 0x73, 0xa4, 0x83, 0xef, 0x4f, 0x37, 0xdc, 0x7b, 0x4e, 0xcf,
+	0x35, 0xad, 0x4f, 0xd3, 0x0e, 0x02, 0xe3, 0x7c, 0x09, 0x08, 0x33, 0x4e, 0x1a, 0xe2, 0x7b, 0xe3,
+	0x2f, 0xcb, 0x50, 0x11, 0x63, 0x8c, 0x3b, 0xd7, 0xc9, 0x36, 0x9c, 0xbc, 0x6d, 0xfb, 0xea, 0x09,
+	0xce, 0x27, 0x67, 0x47, 0xbc, 0x3a, 0xea, 0xcb, 0xf9, 0x44, 0x69, 0x24, 0x5d, 0x7a, 0xf7, 0xaf,
+	0xff, 0xf8, 0x45, 0xe9, 0x14, 0x99, 0x95, 0xaf, 0xb8, 0x4d, 0xf5, 0xd4, 0x47, 0xbe, 0x03, 0x10,
+	0xbd, 0xf2, 0x91, 0x95, 0xb8, 0x90, 0xcc, 0xeb, 0x9f, 0x9e, 0x7a, 0x31, 0xa4, 0xcb, 0x42, 0xea,
+	0x22, 0x99, 0x4f, 0x49, 0x6d, 0xbe, 0x65, 0x5b, 0x6f, 0x93, 0x6d, 0x98, 0x4e, 0x3c, 0xfe, 0x91,
+	0xd5, 0xb8, 0xf4, 0xbc, 0x77, 0xc1, 0x8c, 0x82, 0x86, 0x50, 0x70, 0x66, 0x23, 0x57, 0xc1, 0x35,
+	0xed, 0x12, 0xb9, 0x0d, 0x65, 0x59, 0xd8, 0x27, 0xf3, 0xa9, 0x87, 0x22, 0x29, 0x70, 0x21, 0xd5,
+	0x8b, 0xee, 0x58, 0x10, 0x72, 0x67, 0xc9, 0x34, 0xca, 0x95, 0x4f, 0x3a, 0xe4, 0xa7, 0x1a, 0x9c,
+	0xce, 0x79, 0xde, 0x23, 0x17, 0xe3, 0x86, 0x17, 0x3f, 0x45, 0xea, 0x4f, 0x1f, 0xc9, 0x87, 0xfa,
+	0x9f, 0x12, 0xfa, 0x1b, 0x54, 0x4f, 0xe8, 0x17, 0xb3, 0x6a, 0x7a, 0x72, 0x1c, 0x9f, 0xdd, 0x03,
+	0x0d, 0xa6, 0x13, 0xcf, 0x64, 0x49, 0x17, 0xe6, 0x3d, 0xe9, 0xe9, 0xe7, 0x46, 0x70, 0xa0, 0xf6,
+	0x75, 0xa1, 0x7d, 0x8d, 0x9e, 0xcf, 0x78, 0x35, 0xaa, 0xc4, 0xbc, 0xdd, 0x94, 0xcf, 0x23, 0xdc,
+	0x0c, 0x07, 0x66, 0x05, 0x0e, 0xa3, 0xf7, 0x12, 0x52, 0xcf, 0x6a, 0x89, 0xbf, 0xf9, 0xe8, 0x8d,
+	0x42, 0x3a, 0xda, 0xa0, 0x0b, 0x1b, 0xe6, 0x09, 0x51, 0x36, 0xc4, 0x84, 0x7f, 0x13, 0x2a, 0x5c,
+	0x1f, 0x7f, 0xca, 0x20, 0x4b, 0x05, 0x6f, 0x2e, 0x45, 0x91, 0x3d, 0x2d, 0xe4, 0x4e, 0x93, 0x29,
+	0x94, 0xbb, 0xc3, 0x85, 0x38, 0x30, 0xb5, 0x39, 0x18, 0x78, 0xee, 0x1e, 0x13, 0x32, 0x57, 0x92,
+	0x61, 0x4a, 0x3d, 0x9b, 0xe8, 0x67, 0xf3, 0x9e, 0x1c, 0x95, 0xfc, 0xf3, 0x42, 0xfe, 0x0a, 0xad,
+	0xc5, 0xe4, 0xcb, 0xb8, 0x99, 0x52, 0x03, 0x77, 0x58, 0x0f, 0xc0, 0x60, 0x6f, 0xb0, 0x76, 0xf0,
+	0x89, 0xd5, 0x51, 0xa1, 0x6e, 0x99, 0x2e, 0x65, 0xd4, 0x79, 0x42, 0x01, 0xd7, 0xd6, 0x81, 0x69,
+	0x11, 0x1e, 0x55, 0x1f, 0x27, 0xc9, 0x54, 0x90, 0xaa, 0xda, 0xeb, 0x2b, 0x05, 0x54, 0xd4, 0x58,
+	0x13, 0x1a, 0x09, 0x99, 0x53, 0x81, 0xe1, 0x1c, 0xbc, 0x30, 0x4f, 0x5e, 0x87, 0x29, 0x9e, 0x12,
+	0x70, 0x44, 0x2a, 0x1b, 0x25, 0xab, 0xeb, 0x7a, 0xba, 0xc6, 0x4c, 0x57, 0x84, 0xd8, 0x25, 0xb2,
+	0x90, 0x16, 0x2b, 0x73, 0x85, 0x07, 0x33, 0xc9, 0x02, 0x36, 0x49, 0x20, 0x28, 0xa7, 0x80, 0xaf,
+	0xd3, 0x44, 0x8a, 0xcd, 0xad, 0x7e, 0xd3, 0xb3, 0x42, 0xeb, 0x02, 0xcd, 0x4c, 0x86, 0xfb, 0xcd,
+	0x82, 0x19, 0x4c, 0x42, 0xc7, 0xd6, 0x99, 0x99, 0xd6, 0xaa, 0x50, 0xa0, 0x6f, 0xe4, 0x4f, 0x0b,
+	0xb5, 0x18, 0xe2, 0x29, 0xe5, 0x63, 0x3a, 0xee, 0x82, 0xd0, 0x50, 0xa7, 0xcb, 0xb9, 0x1a, 0x9a,
+	0xf2, 0x99, 0x86, 0x6b, 0xb9, 0xc1, 0x7a, 0x2c, 0x38, 0xa6, 0x96, 0xc5, 0xcc, 0x29, 0xef, 0x25,
+	0xfe, 0x27, 0x1c, 0x15, 0xa5, 0x4b, 0x05, 0x51, 0x7a, 0xa0, 0xc1, 0x5c, 0xb4, 0x2b, 0xe0, 0x4b,
+	0xc0, 0x6a, 0xce, 0xc6, 0x93, 0x28, 0xf1, 0xea, 0xe7, 0x46, 0x70, 0x60, 0xa0, 0x2e, 0x0b, 0xc5,
+	0x4f, 0x91, 0xd1, 0x29, 0x09, 0xeb, 0xb8, 0xef, 0xc0, 0xe9, 0xc4, 0xee, 0x81, 0x86, 0xe4, 0xd6,
+	0x72, 0x8f, 0xa3, 0x1c, 0xf3, 0xa1, 0x7e, 0x1c, 0xe5, 0x3c, 0xa4, 0x6f, 0xc1, 0x3c, 0x5f, 0x70,
+	0xe9, 0x52, 0x20, 0x39, 0x7f, 0x8c, 0x2a, 0xa6, 0x7e, 0x61, 0x34, 0x53, 0xc1, 0x2a, 0x0c, 0x0b,
+	0x3b, 0xc4, 0x84, 0x79, 0x09, 0xf6, 0x54, 0x55, 0x3a, 0xbf, 0xc8, 0xa8, 0xe7, 0x77, 0x67, 0x16,
+	0x46, 0x28, 0x5f, 0x26, 0x94, 0x79, 0xe9, 0xdf, 0x4f, 0xa4, 0x22, 0xbd, 0x34, 0x42, 0x15, 0xe1,
+	0xd2, 0x18, 0xc0, 0xbc, 0x04, 0x6d, 0x4a, 0xcf, 0xb9, 0x42, 0x17, 0x3d, 0x31, 0x80, 0x93, 0x4a,
+	0xc9, 0x0f, 0xb5, 0x54, 0xe8, 0x54, 0xd9, 0x34, 0x3f, 0x74, 0xc9, 0xab, 0x9a, 0x7e, 0x61, 0x34,
+	0x13, 0x86, 0x0e, 0xe7, 0x4d, 0x6a, 0x19, 0x13, 0xb0, 0x8a, 0x47, 0x5a, 0x78, 0xae, 0xc3, 0x62,
+	0x4b, 0x6a, 0xa9, 0x26, 0xeb, 0x50, 0xfa, 0x72, 0x3e, 0x11, 0x95, 0xcd, 0x0b, 0x65, 0x33, 0xe4,
+	0xa4, 0x82, 0x2e, 0x67, 0x20, 0x0f, 0x70, 0x9a, 0xe9, 0x6b, 0x70, 0x72, 0x9a, 0x05, 0x37, 0x78,
+	0xfd, 0xc2, 0x68, 0x26, 0xd4, 0x7c, 0x4e, 0x68, 0x3e, 0x4b, 0xce, 0xa0, 0x66, 0xbc, 0x25, 0xfb,
+	0xcd, 0xf0, 0x5e, 0x4d, 0x7e, 0xac, 0xc1, 0x22, 0xee, 0xbb, 0x29, 0x31, 0x84, 0x8e, 0xd0, 0x11,
+	0xc5, 0x38, 0xf7, 0x42, 0x4e, 0x9f, 0x15, 0x9a, 0x2f, 0xd3, 0x8b, 0x85, 0x9a, 0x33, 0x1b, 0xf2,
+	0x8f, 0x34, 0x58, 0x90, 0x3b, 0xf2, 0xa7, 0x69, 0xc8, 0x33, 0xc2, 0x90, 0x4b, 0xf4, 0xa9, 0x23,
+	0x0c, 0x89, 0xb6, 0xea, 0x77, 0x80, 0xc4, 0xe2, 0xa2, 0xc0, 0x77, 0x2e, 0xc7, 0x86, 0x51, 0xd0,
+	0x2b, 0x8c, 0x09, 0x1e, 0x97, 0xc9, 0x52, 0xda, 0x20, 0x85, 0x3c, 0x3c, 0xc9, 0xc5, 0xae, 0x84,
+	0xc9, 0x93, 0x5c, 0xf6, 0x2e, 0xaa, 0x37, 0x0a, 0xe9, 0x05, 0x27, 0x39, 0xae, 0x14, 0x6f, 0xd1,
+	0xe4, 0x2d, 0x98, 0x4d, 0x5d, 0x41, 0x93, 0x0e, 0xcf, 0xbf, 0xff, 0xea, 0xe7, 0x47, 0xf2, 0x8c,
+	0x98, 0x2c, 0xea, 0x6d, 0x5a, 0x76, 0xa7, 0x43, 0xbe, 0x0f, 0x73, 0xe9, 0xcb, 0x63, 0xf2, 0x2c,
+	0x96, 0xb9, 0xb0, 0x27, 0xdd, 0x5c, 0x74, 0xf3, 0xa4, 0x17, 0x85, 0xe6, 0x55, 0x52, 0xcf, 0xd1,
+	0x2c, 0x02, 0xbe, 0x27, 0x46, 0x72, 0xd4, 0x91, 0xec, 0xc5, 0xf1, 0x28, 0x1b, 0x12, 0x97, 0x8d,
+	0xe2, 0x7b, 0x27, 0x5d, 0x13, 0x56, 0x50, 0xb2, 0x5a, 0x64, 0x85, 0x85, 0x63, 0xb7, 0xcc, 0x87,
+	0x8f, 0xea, 0x27, 0x3e, 0x78, 0x54, 0x3f, 0xf1, 0xd1, 0xa3, 0xba, 0xf6, 0x83, 0xc3, 0xba, 0xf6,
+	0xeb, 0xc3, 0xba, 0xf6, 0xc7, 0xc3, 0xba, 0xf6, 0xf0, 0xb0, 0xae, 0xfd, 0xfd, 0xb0, 0xae, 0xfd,
+	0xf3, 0xb0, 0x7e, 0xe2, 0xa3, 0xc3, 0xfa, 0x89, 0xf7, 0x3e, 0xac, 0x9f, 0x78, 0xf8, 0x61, 0xfd,
+	0xc4, 0x07, 0x1f, 0xd6, 0x4f, 0xbc, 0x7e, 0xb9, 0x6b, 0x07, 0xeb, 0x6d, 0xd7, 0x76, 0x1c, 0xdb,
+	0x79, 0xc3, 0x5c, 0x77, 0x58, 0xd0, 0xe4, 0xe2, 0x99, 0x63, 0x35, 0x83, 0x9d, 0xa1, 0x63, 0x31,
+	0xcf, 0x72, 0xfb, 0x4c, 0xfe, 0xf1, 0x77, 0xbb, 0x2c, 0xf2, 0xf0, 0x97, 0xfe, 0x3b, 0x00, 0x35,
+	0xc8, 0x84, 0x9a, 0x2b, 0x2c, 0x00, 0x00,
+}
+
+func (this *AdminAccountsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AdminAccountsRequest)
+	if !ok {
+		that2, ok := that.(AdminAccountsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Filter) != len(that1.Filter) {
+		return false
+	}
+	for i := range this.Filter {
+		if this.Filter[i] != that1.Filter[i] {
+			return false
+		}
+	}
+	if this.Offset != that1.Offset {
+		return false
+	}
+	if this.Limit != that1.Limit {
+		return false
+	}
+	return true
+}
+func (this *AdminAccountsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AdminAccountsResponse)
+	if !ok {
+		that2, ok := that.(AdminAccountsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Accounts) != len(that1.Accounts) {
+		return false
+	}
+	for i := range this.Accounts {
+		if !this.Accounts[i].Equal(that1.Accounts[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *AdminGetAccountRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AdminGetAccountRequest)
+	if !ok {
+		that2, ok := that.(AdminGetAccountRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	return true
+}
+func (this *AdminUpdateAccountRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AdminUpdateAccountRequest)
+	if !ok {
+		that2, ok := that.(AdminUpdateAccountRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Locked != that1.Locked {
+		return false
+	}
+	return true
+}
+func (this *AdminResolveLedgerRecordRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AdminResolveLedgerRecordRequest)
+	if !ok {
+		that2, ok := that.(AdminResolveLedgerRecordRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Direction != that1.Direction {
+		return false
+	}
+	if this.Confirm != that1.Confirm {
+		return false
+	}
+	return true
+}
+func (this *AdminResolveLedgerRecordResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AdminResolveLedgerRecordResponse)
+	if !ok {
+		that2, ok := that.(AdminResolveLedgerRecordResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Current.Equal(that1.Current) {
+		return false
+	}
+	if !this.Proposed.Equal(that1.Proposed) {
+		return false
+	}
+	if this.Reason != that1.Reason {
+		return false
+	}
+	if this.Applied != that1.Applied {
+		return false
+	}
+	return true
+}
+func (this *AdminAdjustBalanceRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AdminAdjustBalanceRequest)
+	if !ok {
+		that2, ok := that.(AdminAdjustBalanceRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.AccountId != that1.AccountId {
+		return false
+	}
+	if this.Value != that1.Value {
+		return false
+	}
+	if this.Reason != that1.Reason {
+		return false
+	}
+	if this.TicketId != that1.TicketId {
+		return false
+	}
+	return true
+}
+func (this *AdminAdjustBalanceResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AdminAdjustBalanceResponse)
+	if !ok {
+		that2, ok := that.(AdminAdjustBalanceResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Result.Equal(that1.Result) {
+		return false
+	}
+	if !this.Adjustment.Equal(that1.Adjustment) {
+		return false
+	}
+	return true
+}
+func (this *LedgerAdjustment) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LedgerAdjustment)
+	if !ok {
+		that2, ok := that.(LedgerAdjustment)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.LedgerId != that1.LedgerId {
+		return false
+	}
+	if this.AccountId != that1.AccountId {
+		return false
+	}
+	if that1.CreatedAt == nil {
+		if this.CreatedAt != nil {
+			return false
+		}
+	} else if !this.CreatedAt.Equal(*that1.CreatedAt) {
+		return false
+	}
+	if this.Value != that1.Value {
+		return false
+	}
+	if this.Operator != that1.Operator {
+		return false
+	}
+	if this.Reason != that1.Reason {
+		return false
+	}
+	if this.TicketId != that1.TicketId {
+		return false
+	}
+	return true
+}
+func (this *AdminAdjustmentsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AdminAdjustmentsRequest)
+	if !ok {
+		that2, ok := that.(AdminAdjustmentsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.AccountId != that1.AccountId {
+		return false
+	}
+	if this.Offset != that1.Offset {
+		return false
+	}
+	if this.Limit != that1.Limit {
+		return false
+	}
+	return true
+}
+func (this *AdminAdjustmentsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AdminAdjustmentsResponse)
+	if !ok {
+		that2, ok := that.(AdminAdjustmentsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Adjustments) != len(that1.Adjustments) {
+		return false
+	}
+	for i := range this.Adjustments {
+		if !this.Adjustments[i].Equal(that1.Adjustments[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *AdminHeldRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AdminHeldRequest)
+	if !ok {
+		that2, ok := that.(AdminHeldRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Filter) != len(that1.Filter) {
+		return false
+	}
+	for i := range this.Filter {
+		if this.Filter[i] != that1.Filter[i] {
+			return false
+		}
+	}
+	if this.Offset != that1.Offset {
+		return false
+	}
+	if this.Limit != that1.Limit {
+		return false
+	}
+	return true
+}
+func (this *AdminReviewHeldRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AdminReviewHeldRequest)
+	if !ok {
+		that2, ok := that.(AdminReviewHeldRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Reason != that1.Reason {
+		return false
+	}
+	return true
+}
+func (this *AgentKey) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AgentKey)
+	if !ok {
+		that2, ok := that.(AgentKey)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if that1.CreatedAt == nil {
+		if this.CreatedAt != nil {
+			return false
+		}
+	} else if !this.CreatedAt.Equal(*that1.CreatedAt) {
+		return false
+	}
+	if that1.UpdatedAt == nil {
+		if this.UpdatedAt != nil {
+			return false
+		}
+	} else if !this.UpdatedAt.Equal(*that1.UpdatedAt) {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if len(this.Scopes) != len(that1.Scopes) {
+		return false
+	}
+	for i := range this.Scopes {
+		if this.Scopes[i] != that1.Scopes[i] {
+			return false
+		}
+	}
+	if len(this.AccountIds) != len(that1.AccountIds) {
+		return false
+	}
+	for i := range this.AccountIds {
+		if this.AccountIds[i] != that1.AccountIds[i] {
+			return false
+		}
+	}
+	if this.ValueLimit != that1.ValueLimit {
+		return false
+	}
+	if that1.ExpiresAt == nil {
+		if this.ExpiresAt != nil {
+			return false
+		}
+	} else if !this.ExpiresAt.Equal(*that1.ExpiresAt) {
+		return false
+	}
+	if that1.RevokedAt == nil {
+		if this.RevokedAt != nil {
+			return false
+		}
+	} else if !this.RevokedAt.Equal(*that1.RevokedAt) {
+		return false
+	}
+	return true
+}
+func (this *AdminAgentKeysRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AdminAgentKeysRequest)
+	if !ok {
+		that2, ok := that.(AdminAgentKeysRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Offset != that1.Offset {
+		return false
+	}
+	if this.Limit != that1.Limit {
+		return false
+	}
+	return true
+}
+func (this *AdminAgentKeysResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AdminAgentKeysResponse)
+	if !ok {
+		that2, ok := that.(AdminAgentKeysResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.AgentKeys) != len(that1.AgentKeys) {
+		return false
+	}
+	for i := range this.AgentKeys {
+		if !this.AgentKeys[i].Equal(that1.AgentKeys[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *AdminAgentKeyRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AdminAgentKeyRequest)
+	if !ok {
+		that2, ok := that.(AdminAgentKeyRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	return true
+}
+func (this *AdminSaveAgentKeyRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AdminSaveAgentKeyRequest)
+	if !ok {
+		that2, ok := that.(AdminSaveAgentKeyRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if len(this.Scopes) != len(that1.Scopes) {
+		return false
+	}
+	for i := range this.Scopes {
+		if this.Scopes[i] != that1.Scopes[i] {
+			return false
+		}
+	}
+	if len(this.AccountIds) != len(that1.AccountIds) {
+		return false
+	}
+	for i := range this.AccountIds {
+		if this.AccountIds[i] != that1.AccountIds[i] {
+			return false
+		}
+	}
+	if this.ValueLimit != that1.ValueLimit {
+		return false
+	}
+	if that1.ExpiresAt == nil {
+		if this.ExpiresAt != nil {
+			return false
+		}
+	} else if !this.ExpiresAt.Equal(*that1.ExpiresAt) {
+		return false
+	}
+	return true
+}
+func (this *AdminCreateAgentKeyResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AdminCreateAgentKeyResponse)
+	if !ok {
+		that2, ok := that.(AdminCreateAgentKeyResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.AgentKey.Equal(that1.AgentKey) {
+		return false
+	}
+	if this.Secret != that1.Secret {
+		return false
+	}
+	return true
+}
+func (this *AccountLimits) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AccountLimits)
+	if !ok {
+		that2, ok := that.(AccountLimits)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.AccountId != that1.AccountId {
+		return false
+	}
+	if that1.CreatedAt == nil {
+		if this.CreatedAt != nil {
+			return false
+		}
+	} else if !this.CreatedAt.Equal(*that1.CreatedAt) {
+		return false
+	}
+	if that1.UpdatedAt == nil {
+		if this.UpdatedAt != nil {
+			return false
+		}
+	} else if !this.UpdatedAt.Equal(*that1.UpdatedAt) {
+		return false
+	}
+	if this.Tier != that1.Tier {
+		return false
+	}
+	if this.DailySend != that1.DailySend {
+		return false
+	}
+	if this.WeeklySend != that1.WeeklySend {
+		return false
+	}
+	if this.MaxPayment != that1.MaxPayment {
+		return false
+	}
+	if this.DailyWithdraw != that1.DailyWithdraw {
+		return false
+	}
+	return true
+}
+func (this *AdminAccountLimitsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AdminAccountLimitsRequest)
+	if !ok {
+		that2, ok := that.(AdminAccountLimitsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.AccountId != that1.AccountId {
+		return false
+	}
+	return true
+}
+func (this *AdminAccountLimitsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AdminAccountLimitsResponse)
+	if !ok {
+		that2, ok := that.(AdminAccountLimitsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Limits.Equal(that1.Limits) {
+		return false
+	}
+	if !this.Effective.Equal(that1.Effective) {
+		return false
+	}
+	return true
+}
+func (this *ScreeningEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ScreeningEntry)
+	if !ok {
+		that2, ok := that.(ScreeningEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if that1.CreatedAt == nil {
+		if this.CreatedAt != nil {
+			return false
+		}
+	} else if !this.CreatedAt.Equal(*that1.CreatedAt) {
+		return false
+	}
+	if that1.UpdatedAt == nil {
+		if this.UpdatedAt != nil {
+			return false
+		}
+	} else if !this.UpdatedAt.Equal(*that1.UpdatedAt) {
+		return false
+	}
+	if this.List != that1.List {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if this.Value != that1.Value {
+		return false
+	}
+	if this.Action != that1.Action {
+		return false
+	}
+	if this.Note != that1.Note {
+		return false
+	}
+	return true
+}
+func (this *AdminScreeningEntriesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AdminScreeningEntriesRequest)
+	if !ok {
+		that2, ok := that.(AdminScreeningEntriesRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Filter) != len(that1.Filter) {
+		return false
+	}
+	for i := range this.Filter {
+		if this.Filter[i] != that1.Filter[i] {
+			return false
+		}
+	}
+	if this.Offset != that1.Offset {
+		return false
+	}
+	if this.Limit != that1.Limit {
+		return false
+	}
+	return true
+}
+func (this *AdminScreeningEntriesResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AdminScreeningEntriesResponse)
+	if !ok {
+		that2, ok := that.(AdminScreeningEntriesResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.ScreeningEntries) != len(that1.ScreeningEntries) {
+		return false
+	}
+	for i := range this.ScreeningEntries {
+		if !this.ScreeningEntries[i].Equal(that1.ScreeningEntries[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *AdminScreeningEntryRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AdminScreeningEntryRequest)
+	if !ok {
+		that2, ok := that.(AdminScreeningEntryRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	return true
+}
+func (this *ScreeningHistory) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ScreeningHistory)
+	if !ok {
+		that2, ok := that.(ScreeningHistory)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.EntryId != that1.EntryId {
+		return false
+	}
+	if that1.CreatedAt == nil {
+		if this.CreatedAt != nil {
+			return false
+		}
+	} else if !this.CreatedAt.Equal(*that1.CreatedAt) {
+		return false
+	}
+	if this.Operation != that1.Operation {
+		return false
+	}
+	if this.Operator != that1.Operator {
+		return false
+	}
+	if this.List != that1.List {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if this.Value != that1.Value {
+		return false
+	}
+	if this.Action != that1.Action {
+		return false
+	}
+	if this.Note != that1.Note {
+		return false
+	}
+	return true
+}
+func (this *AdminScreeningHistoryRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AdminScreeningHistoryRequest)
+	if !ok {
+		that2, ok := that.(AdminScreeningHistoryRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.EntryId != that1.EntryId {
+		return false
+	}
+	if this.Offset != that1.Offset {
+		return false
+	}
+	if this.Limit != that1.Limit {
+		return false
+	}
+	return true
+}
+func (this *AdminScreeningHistoryResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AdminScreeningHistoryResponse)
+	if !ok {
+		that2, ok := that.(AdminScreeningHistoryResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.ScreeningHistory) != len(that1.ScreeningHistory) {
+		return false
+	}
+	for i := range this.ScreeningHistory {
+		if !this.ScreeningHistory[i].Equal(that1.ScreeningHistory[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *AdminAudit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AdminAudit)
+	if !ok {
+		that2, ok := that.(AdminAudit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if that1.CreatedAt == nil {
+		if this.CreatedAt != nil {
+			return false
+		}
+	} else if !this.CreatedAt.Equal(*that1.CreatedAt) {
+		return false
+	}
+	if this.Operator != that1.Operator {
+		return false
+	}
+	if this.Method != that1.Method {
+		return false
+	}
+	if this.Request != that1.Request {
+		return false
+	}
+	if this.Code != that1.Code {
+		return false
+	}
+	if this.Error != that1.Error {
+		return false
+	}
+	return true
+}
+func (this *AdminAuditLogRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AdminAuditLogRequest)
+	if !ok {
+		that2, ok := that.(AdminAuditLogRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Filter) != len(that1.Filter) {
+		return false
+	}
+	for i := range this.Filter {
+		if this.Filter[i] != that1.Filter[i] {
+			return false
+		}
+	}
+	if that1.After == nil {
+		if this.After != nil {
+			return false
+		}
+	} else if !this.After.Equal(*that1.After) {
+		return false
+	}
+	if this.Offset != that1.Offset {
+		return false
+	}
+	if this.Limit != that1.Limit {
+		return false
+	}
+	return true
+}
+func (this *AdminAuditLogResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AdminAuditLogResponse)
+	if !ok {
+		that2, ok := that.(AdminAuditLogResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.AuditLog) != len(that1.AuditLog) {
+		return false
+	}
+	for i := range this.AuditLog {
+		if !this.AuditLog[i].Equal(that1.AuditLog[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *ChannelProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ChannelProposal)
+	if !ok {
+		that2, ok := that.(ChannelProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if that1.CreatedAt == nil {
+		if this.CreatedAt != nil {
+			return false
+		}
+	} else if !this.CreatedAt.Equal(*that1.CreatedAt) {
+		return false
+	}
+	if that1.UpdatedAt == nil {
+		if this.UpdatedAt != nil {
+			return false
+		}
+	} else if !this.UpdatedAt.Equal(*that1.UpdatedAt) {
+		return false
+	}
+	if this.Action != that1.Action {
+		return false
+	}
+	if this.NodePubkey != that1.NodePubkey {
+		return false
+	}
+	if this.ChanPoint != that1.ChanPoint {
+		return false
+	}
+	if this.Value != that1.Value {
+		return false
+	}
+	if this.Reason != that1.Reason {
+		return false
+	}
+	if this.Status != that1.Status {
+		return false
+	}
+	if this.Operator != that1.Operator {
+		return false
+	}
+	if this.Txid != that1.Txid {
+		return false
+	}
+	if this.Error != that1.Error {
+		return false
+	}
+	return true
+}
+func (this *AdminChannelProposalsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AdminChannelProposalsRequest)
+	if !ok {
+		that2, ok := that.(AdminChannelProposalsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Filter) != len(that1.Filter) {
+		return false
+	}
+	for i := range this.Filter {
+		if this.Filter[i] != that1.Filter[i] {
+			return false
+		}
+	}
+	if this.Offset != that1.Offset {
+		return false
+	}
+	if this.Limit != that1.Limit {
+		return false
+	}
+	return true
+}
+func (this *AdminChannelProposalsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AdminChannelProposalsResponse)
+	if !ok {
+		that2, ok := that.(AdminChannelProposalsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.ChannelProposals) != len(that1.ChannelProposals) {
+		return false
+	}
+	for i := range this.ChannelProposals {
+		if !this.ChannelProposals[i].Equal(that1.ChannelProposals[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *AdminChannelProposalRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AdminChannelProposalRequest)
+	if !ok {
+		that2, ok := that.(AdminChannelProposalRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	return true
+}
+func (this *AdminChannelHistoryRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AdminChannelHistoryRequest)
+	if !ok {
+		that2, ok := that.(AdminChannelHistoryRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NodePubkey != that1.NodePubkey {
+		return false
+	}
+	if this.Offset != that1.Offset {
+		return false
+	}
+	if this.Limit != that1.Limit {
+		return false
+	}
+	return true
+}
+func (this *AdminChanBackup) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AdminChanBackup)
+	if !ok {
+		that2, ok := that.(AdminChanBackup)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if that1.Timestamp == nil {
+		if this.Timestamp != nil {
+			return false
+		}
+	} else if !this.Timestamp.Equal(*that1.Timestamp) {
+		return false
+	}
+	if len(this.ChanPoints) != len(that1.ChanPoints) {
+		return false
+	}
+	for i := range this.ChanPoints {
+		if this.ChanPoints[i] != that1.ChanPoints[i] {
+			return false
+		}
+	}
+	if this.DataSize != that1.DataSize {
+		return false
+	}
+	return true
+}
+func (this *AdminChanBackupsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AdminChanBackupsRequest)
+	if !ok {
+		that2, ok := that.(AdminChanBackupsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ChanPoint != that1.ChanPoint {
+		return false
+	}
+	if this.Offset != that1.Offset {
+		return false
+	}
+	if this.Limit != that1.Limit {
+		return false
+	}
+	return true
+}
+func (this *AdminChanBackupsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AdminChanBackupsResponse)
+	if !ok {
+		that2, ok := that.(AdminChanBackupsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.ChanBackups) != len(that1.ChanBackups) {
+		return false
+	}
+	for i := range this.ChanBackups {
+		if !this.ChanBackups[i].Equal(that1.ChanBackups[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *AdminChanBackupRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AdminChanBackupRequest)
+	if !ok {
+		that2, ok := that.(AdminChanBackupRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	return true
+}
+func (this *AdminDiffChanBackupsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AdminDiffChanBackupsRequest)
+	if !ok {
+		that2, ok := that.(AdminDiffChanBackupsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.FromId != that1.FromId {
+		return false
+	}
+	if this.ToId != that1.ToId {
+		return false
+	}
+	return true
+}
+func (this *AdminDiffChanBackupsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AdminDiffChanBackupsResponse)
+	if !ok {
+		that2, ok := that.(AdminDiffChanBackupsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.From.Equal(that1.From) {
+		return false
+	}
+	if !this.To.Equal(that1.To) {
+		return false
+	}
+	if len(this.Added) != len(that1.Added) {
+		return false
+	}
+	for i := range this.Added {
+		if this.Added[i] != that1.Added[i] {
+			return false
+		}
+	}
+	if len(this.Removed) != len(that1.Removed) {
+		return false
+	}
+	for i := range this.Removed {
+		if this.Removed[i] != that1.Removed[i] {
+			return false
+		}
+	}
+	if len(this.Unchanged) != len(that1.Unchanged) {
+		return false
+	}
+	for i := range this.Unchanged {
+		if this.Unchanged[i] != that1.Unchanged[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *AdminVerifyChanBackupResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AdminVerifyChanBackupResponse)
+	if !ok {
+		that2, ok := that.(AdminVerifyChanBackupResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ChanBackup.Equal(that1.ChanBackup) {
+		return false
+	}
+	if this.Valid != that1.Valid {
+		return false
+	}
+	if this.Error != that1.Error {
+		return false
+	}
+	return true
+}
+func (this *AdminDownloadChanBackupResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AdminDownloadChanBackupResponse)
+	if !ok {
+		that2, ok := that.(AdminDownloadChanBackupResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ChanBackup.Equal(that1.ChanBackup) {
+		return false
+	}
+	if !bytes.Equal(this.Data, that1.Data) {
+		return false
+	}
+	return true
+}
+func (this *AdminAccountsRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&tdrpc.AdminAccountsRequest{")
+	keysForFilter := make([]string, 0, len(this.Filter))
+	for k, _ := range this.Filter {
+		keysForFilter = append(keysForFilter, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForFilter)
+	mapStringForFilter := "map[string]string{"
+	for _, k := range keysForFilter {
+		mapStringForFilter += fmt.Sprintf("%#v: %#v,", k, this.Filter[k])
+	}
+	mapStringForFilter += "}"
+	if this.Filter != nil {
+		s = append(s, "Filter: "+mapStringForFilter+",\n")
+	}
+	s = append(s, "Offset: "+fmt.Sprintf("%#v", this.Offset)+",\n")
+	s = append(s, "Limit: "+fmt.Sprintf("%#v", this.Limit)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AdminAccountsResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&tdrpc.AdminAccountsResponse{")
+	if this.Accounts != nil {
+		s = append(s, "Accounts: "+fmt.Sprintf("%#v", this.Accounts)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AdminGetAccountRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&tdrpc.AdminGetAccountRequest{")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "Address: "+fmt.Sprintf("%#v", this.Address)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AdminUpdateAccountRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&tdrpc.AdminUpdateAccountRequest{")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "Locked: "+fmt.Sprintf("%#v", this.Locked)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AdminResolveLedgerRecordRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&tdrpc.AdminResolveLedgerRecordRequest{")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "Direction: "+fmt.Sprintf("%#v", this.Direction)+",\n")
+	s = append(s, "Confirm: "+fmt.Sprintf("%#v", this.Confirm)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AdminResolveLedgerRecordResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&tdrpc.AdminResolveLedgerRecordResponse{")
+	if this.Current != nil {
+		s = append(s, "Current: "+fmt.Sprintf("%#v", this.Current)+",\n")
+	}
+	if this.Proposed != nil {
+		s = append(s, "Proposed: "+fmt.Sprintf("%#v", this.Proposed)+",\n")
+	}
+	s = append(s, "Reason: "+fmt.Sprintf("%#v", this.Reason)+",\n")
+	s = append(s, "Applied: "+fmt.Sprintf("%#v", this.Applied)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AdminAdjustBalanceRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&tdrpc.AdminAdjustBalanceRequest{")
+	s = append(s, "AccountId: "+fmt.Sprintf("%#v", this.AccountId)+",\n")
+	s = append(s, "Value: "+fmt.Sprintf("%#v", this.Value)+",\n")
+	s = append(s, "Reason: "+fmt.Sprintf("%#v", this.Reason)+",\n")
+	s = append(s, "TicketId: "+fmt.Sprintf("%#v", this.TicketId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AdminAdjustBalanceResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&tdrpc.AdminAdjustBalanceResponse{")
+	if this.Result != nil {
+		s = append(s, "Result: "+fmt.Sprintf("%#v", this.Result)+",\n")
+	}
+	if this.Adjustment != nil {
+		s = append(s, "Adjustment: "+fmt.Sprintf("%#v", this.Adjustment)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *LedgerAdjustment) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 11)
+	s = append(s, "&tdrpc.LedgerAdjustment{")
+	s = append(s, "LedgerId: "+fmt.Sprintf("%#v", this.LedgerId)+",\n")
+	s = append(s, "AccountId: "+fmt.Sprintf("%#v", this.AccountId)+",\n")
+	s = append(s, "CreatedAt: "+fmt.Sprintf("%#v", this.CreatedAt)+",\n")
+	s = append(s, "Value: "+fmt.Sprintf("%#v", this.Value)+",\n")
+	s = append(s, "Operator: "+fmt.Sprintf("%#v", this.Operator)+",\n")
+	s = append(s, "Reason: "+fmt.Sprintf("%#v", this.Reason)+",\n")
+	s = append(s, "TicketId: "+fmt.Sprintf("%#v", this.TicketId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AdminAdjustmentsRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&tdrpc.AdminAdjustmentsRequest{")
+	s = append(s, "AccountId: "+fmt.Sprintf("%#v", this.AccountId)+",\n")
+	s = append(s, "Offset: "+fmt.Sprintf("%#v", this.Offset)+",\n")
+	s = append(s, "Limit: "+fmt.Sprintf("%#v", this.Limit)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AdminAdjustmentsResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&tdrpc.AdminAdjustmentsResponse{")
+	if this.Adjustments != nil {
+		s = append(s, "Adjustments: "+fmt.Sprintf("%#v", this.Adjustments)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AdminHeldRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&tdrpc.AdminHeldRequest{")
+	keysForFilter := make([]string, 0, len(this.Filter))
+	for k, _ := range this.Filter {
+		keysForFilter = append(keysForFilter, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForFilter)
+	mapStringForFilter := "map[string]string{"
+	for _, k := range keysForFilter {
+		mapStringForFilter += fmt.Sprintf("%#v: %#v,", k, this.Filter[k])
+	}
+	mapStringForFilter += "}"
+	if this.Filter != nil {
+		s = append(s, "Filter: "+mapStringForFilter+",\n")
+	}
+	s = append(s, "Offset: "+fmt.Sprintf("%#v", this.Offset)+",\n")
+	s = append(s, "Limit: "+fmt.Sprintf("%#v", this.Limit)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AdminReviewHeldRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&tdrpc.AdminReviewHeldRequest{")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "Reason: "+fmt.Sprintf("%#v", this.Reason)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AgentKey) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 13)
+	s = append(s, "&tdrpc.AgentKey{")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "CreatedAt: "+fmt.Sprintf("%#v", this.CreatedAt)+",\n")
+	s = append(s, "UpdatedAt: "+fmt.Sprintf("%#v", this.UpdatedAt)+",\n")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	s = append(s, "Scopes: "+fmt.Sprintf("%#v", this.Scopes)+",\n")
+	s = append(s, "AccountIds: "+fmt.Sprintf("%#v", this.AccountIds)+",\n")
+	s = append(s, "ValueLimit: "+fmt.Sprintf("%#v", this.ValueLimit)+",\n")
+	s = append(s, "ExpiresAt: "+fmt.Sprintf("%#v", this.ExpiresAt)+",\n")
+	s = append(s, "RevokedAt: "+fmt.Sprintf("%#v", this.RevokedAt)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AdminAgentKeysRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&tdrpc.AdminAgentKeysRequest{")
+	s = append(s, "Offset: "+fmt.Sprintf("%#v", this.Offset)+",\n")
+	s = append(s, "Limit: "+fmt.Sprintf("%#v", this.Limit)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AdminAgentKeysResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&tdrpc.AdminAgentKeysResponse{")
+	if this.AgentKeys != nil {
+		s = append(s, "AgentKeys: "+fmt.Sprintf("%#v", this.AgentKeys)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AdminAgentKeyRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&tdrpc.AdminAgentKeyRequest{")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AdminSaveAgentKeyRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&tdrpc.AdminSaveAgentKeyRequest{")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	s = append(s, "Scopes: "+fmt.Sprintf("%#v", this.Scopes)+",\n")
+	s = append(s, "AccountIds: "+fmt.Sprintf("%#v", this.AccountIds)+",\n")
+	s = append(s, "ValueLimit: "+fmt.Sprintf("%#v", this.ValueLimit)+",\n")
+	s = append(s, "ExpiresAt: "+fmt.Sprintf("%#v", this.ExpiresAt)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AdminCreateAgentKeyResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&tdrpc.AdminCreateAgentKeyResponse{")
+	if this.AgentKey != nil {
+		s = append(s, "AgentKey: "+fmt.Sprintf("%#v", this.AgentKey)+",\n")
+	}
+	s = append(s, "Secret: "+fmt.Sprintf("%#v", this.Secret)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AccountLimits) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 12)
+	s = append(s, "&tdrpc.AccountLimits{")
+	s = append(s, "AccountId: "+fmt.Sprintf("%#v", this.AccountId)+",\n")
+	s = append(s, "CreatedAt: "+fmt.Sprintf("%#v", this.CreatedAt)+",\n")
+	s = append(s, "UpdatedAt: "+fmt.Sprintf("%#v", this.UpdatedAt)+",\n")
+	s = append(s, "Tier: "+fmt.Sprintf("%#v", this.Tier)+",\n")
+	s = append(s, "DailySend: "+fmt.Sprintf("%#v", this.DailySend)+",\n")
+	s = append(s, "WeeklySend: "+fmt.Sprintf("%#v", this.WeeklySend)+",\n")
+	s = append(s, "MaxPayment: "+fmt.Sprintf("%#v", this.MaxPayment)+",\n")
+	s = append(s, "DailyWithdraw: "+fmt.Sprintf("%#v", this.DailyWithdraw)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AdminAccountLimitsRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&tdrpc.AdminAccountLimitsRequest{")
+	s = append(s, "AccountId: "+fmt.Sprintf("%#v", this.AccountId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AdminAccountLimitsResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&tdrpc.AdminAccountLimitsResponse{")
+	if this.Limits != nil {
+		s = append(s, "Limits: "+fmt.Sprintf("%#v", this.Limits)+",\n")
+	}
+	if this.Effective != nil {
+		s = append(s, "Effective: "+fmt.Sprintf("%#v", this.Effective)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ScreeningEntry) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 12)
+	s = append(s, "&tdrpc.ScreeningEntry{")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "CreatedAt: "+fmt.Sprintf("%#v", this.CreatedAt)+",\n")
+	s = append(s, "UpdatedAt: "+fmt.Sprintf("%#v", this.UpdatedAt)+",\n")
+	s = append(s, "List: "+fmt.Sprintf("%#v", this.List)+",\n")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	s = append(s, "Value: "+fmt.Sprintf("%#v", this.Value)+",\n")
+	s = append(s, "Action: "+fmt.Sprintf("%#v", this.Action)+",\n")
+	s = append(s, "Note: "+fmt.Sprintf("%#v", this.Note)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AdminScreeningEntriesRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&tdrpc.AdminScreeningEntriesRequest{")
+	keysForFilter := make([]string, 0, len(this.Filter))
+	for k, _ := range this.Filter {
+		keysForFilter = append(keysForFilter, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForFilter)
+	mapStringForFilter := "map[string]string{"
+	for _, k := range keysForFilter {
+		mapStringForFilter += fmt.Sprintf("%#v: %#v,", k, this.Filter[k])
+	}
+	mapStringForFilter += "}"
+	if this.Filter != nil {
+		s = append(s, "Filter: "+mapStringForFilter+",\n")
+	}
+	s = append(s, "Offset: "+fmt.Sprintf("%#v", this.Offset)+",\n")
+	s = append(s, "Limit: "+fmt.Sprintf("%#v", this.Limit)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AdminScreeningEntriesResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&tdrpc.AdminScreeningEntriesResponse{")
+	if this.ScreeningEntries != nil {
+		s = append(s, "ScreeningEntries: "+fmt.Sprintf("%#v", this.ScreeningEntries)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AdminScreeningEntryRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&tdrpc.AdminScreeningEntryRequest{")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ScreeningHistory) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 14)
+	s = append(s, "&tdrpc.ScreeningHistory{")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "EntryId: "+fmt.Sprintf("%#v", this.EntryId)+",\n")
+	s = append(s, "CreatedAt: "+fmt.Sprintf("%#v", this.CreatedAt)+",\n")
+	s = append(s, "Operation: "+fmt.Sprintf("%#v", this.Operation)+",\n")
+	s = append(s, "Operator: "+fmt.Sprintf("%#v", this.Operator)+",\n")
+	s = append(s, "List: "+fmt.Sprintf("%#v", this.List)+",\n")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	s = append(s, "Value: "+fmt.Sprintf("%#v", this.Value)+",\n")
+	s = append(s, "Action: "+fmt.Sprintf("%#v", this.Action)+",\n")
+	s = append(s, "Note: "+fmt.Sprintf("%#v", this.Note)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AdminScreeningHistoryRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&tdrpc.AdminScreeningHistoryRequest{")
+	s = append(s, "EntryId: "+fmt.Sprintf("%#v", this.EntryId)+",\n")
+	s = append(s, "Offset: "+fmt.Sprintf("%#v", this.Offset)+",\n")
+	s = append(s, "Limit: "+fmt.Sprintf("%#v", this.Limit)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AdminScreeningHistoryResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&tdrpc.AdminScreeningHistoryResponse{")
+	if this.ScreeningHistory != nil {
+		s = append(s, "ScreeningHistory: "+fmt.Sprintf("%#v", this.ScreeningHistory)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AdminAudit) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 11)
+	s = append(s, "&tdrpc.AdminAudit{")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "CreatedAt: "+fmt.Sprintf("%#v", this.CreatedAt)+",\n")
+	s = append(s, "Operator: "+fmt.Sprintf("%#v", this.Operator)+",\n")
+	s = append(s, "Method: "+fmt.Sprintf("%#v", this.Method)+",\n")
+	s = append(s, "Request: "+fmt.Sprintf("%#v", this.Request)+",\n")
+	s = append(s, "Code: "+fmt.Sprintf("%#v", this.Code)+",\n")
+	s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AdminAuditLogRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&tdrpc.AdminAuditLogRequest{")
+	keysForFilter := make([]string, 0, len(this.Filter))
+	for k, _ := range this.Filter {
+		keysForFilter = append(keysForFilter, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForFilter)
+	mapStringForFilter := "map[string]string{"
+	for _, k := range keysForFilter {
+		mapStringForFilter += fmt.Sprintf("%#v: %#v,", k, this.Filter[k])
+	}
+	mapStringForFilter += "}"
+	if this.Filter != nil {
+		s = append(s, "Filter: "+mapStringForFilter+",\n")
+	}
+	s = append(s, "After: "+fmt.Sprintf("%#v", this.After)+",\n")
+	s = append(s, "Offset: "+fmt.Sprintf("%#v", this.Offset)+",\n")
+	s = append(s, "Limit: "+fmt.Sprintf("%#v", this.Limit)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AdminAuditLogResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&tdrpc.AdminAuditLogResponse{")
+	if this.AuditLog != nil {
+		s = append(s, "AuditLog: "+fmt.Sprintf("%#v", this.AuditLog)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ChannelProposal) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 16)
+	s = append(s, "&tdrpc.ChannelProposal{")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "CreatedAt: "+fmt.Sprintf("%#v", this.CreatedAt)+",\n")
+	s = append(s, "UpdatedAt: "+fmt.Sprintf("%#v", this.UpdatedAt)+",\n")
+	s = append(s, "Action: "+fmt.Sprintf("%#v", this.Action)+",\n")
+	s = append(s, "NodePubkey: "+fmt.Sprintf("%#v", this.NodePubkey)+",\n")
+	s = append(s, "ChanPoint: "+fmt.Sprintf("%#v", this.ChanPoint)+",\n")
+	s = append(s, "Value: "+fmt.Sprintf("%#v", this.Value)+",\n")
+	s = append(s, "Reason: "+fmt.Sprintf("%#v", this.Reason)+",\n")
+	s = append(s, "Status: "+fmt.Sprintf("%#v", this.Status)+",\n")
+	s = append(s, "Operator: "+fmt.Sprintf("%#v", this.Operator)+",\n")
+	s = append(s, "Txid: "+fmt.Sprintf("%#v", this.Txid)+",\n")
+	s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AdminChannelProposalsRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&tdrpc.AdminChannelProposalsRequest{")
+	keysForFilter := make([]string, 0, len(this.Filter))
+	for k, _ := range this.Filter {
+		keysForFilter = append(keysForFilter, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForFilter)
+	mapStringForFilter := "map[string]string{"
+	for _, k := range keysForFilter {
+		mapStringForFilter += fmt.Sprintf("%#v: %#v,", k, this.Filter[k])
+	}
+	mapStringForFilter += "}"
+	if this.Filter != nil {
+		s = append(s, "Filter: "+mapStringForFilter+",\n")
+	}
+	s = append(s, "Offset: "+fmt.Sprintf("%#v", this.Offset)+",\n")
+	s = append(s, "Limit: "+fmt.Sprintf("%#v", this.Limit)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AdminChannelProposalsResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&tdrpc.AdminChannelProposalsResponse{")
+	if this.ChannelProposals != nil {
+		s = append(s, "ChannelProposals: "+fmt.Sprintf("%#v", this.ChannelProposals)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AdminChannelProposalRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&tdrpc.AdminChannelProposalRequest{")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AdminChannelHistoryRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&tdrpc.AdminChannelHistoryRequest{")
+	s = append(s, "NodePubkey: "+fmt.Sprintf("%#v", this.NodePubkey)+",\n")
+	s = append(s, "Offset: "+fmt.Sprintf("%#v", this.Offset)+",\n")
+	s = append(s, "Limit: "+fmt.Sprintf("%#v", this.Limit)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AdminChanBackup) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&tdrpc.AdminChanBackup{")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "Timestamp: "+fmt.Sprintf("%#v", this.Timestamp)+",\n")
+	s = append(s, "ChanPoints: "+fmt.Sprintf("%#v", this.ChanPoints)+",\n")
+	s = append(s, "DataSize: "+fmt.Sprintf("%#v", this.DataSize)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AdminChanBackupsRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&tdrpc.AdminChanBackupsRequest{")
+	s = append(s, "ChanPoint: "+fmt.Sprintf("%#v", this.ChanPoint)+",\n")
+	s = append(s, "Offset: "+fmt.Sprintf("%#v", this.Offset)+",\n")
+	s = append(s, "Limit: "+fmt.Sprintf("%#v", this.Limit)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AdminChanBackupsResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&tdrpc.AdminChanBackupsResponse{")
+	if this.ChanBackups != nil {
+		s = append(s, "ChanBackups: "+fmt.Sprintf("%#v", this.ChanBackups)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AdminChanBackupRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&tdrpc.AdminChanBackupRequest{")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AdminDiffChanBackupsRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&tdrpc.AdminDiffChanBackupsRequest{")
+	s = append(s, "FromId: "+fmt.Sprintf("%#v", this.FromId)+",\n")
+	s = append(s, "ToId: "+fmt.Sprintf("%#v", this.ToId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AdminDiffChanBackupsResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&tdrpc.AdminDiffChanBackupsResponse{")
+	if this.From != nil {
+		s = append(s, "From: "+fmt.Sprintf("%#v", this.From)+",\n")
+	}
+	if this.To != nil {
+		s = append(s, "To: "+fmt.Sprintf("%#v", this.To)+",\n")
+	}
+	s = append(s, "Added: "+fmt.Sprintf("%#v", this.Added)+",\n")
+	s = append(s, "Removed: "+fmt.Sprintf("%#v", this.Removed)+",\n")
+	s = append(s, "Unchanged: "+fmt.Sprintf("%#v", this.Unchanged)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AdminVerifyChanBackupResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&tdrpc.AdminVerifyChanBackupResponse{")
+	if this.ChanBackup != nil {
+		s = append(s, "ChanBackup: "+fmt.Sprintf("%#v", this.ChanBackup)+",\n")
+	}
+	s = append(s, "Valid: "+fmt.Sprintf("%#v", this.Valid)+",\n")
+	s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AdminDownloadChanBackupResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&tdrpc.AdminDownloadChanBackupResponse{")
+	if this.ChanBackup != nil {
+		s = append(s, "ChanBackup: "+fmt.Sprintf("%#v", this.ChanBackup)+",\n")
+	}
+	s = append(s, "Data: "+fmt.Sprintf("%#v", this.Data)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringAdminrpc(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// AdminRPCClient is the client API for AdminRPC service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AdminRPCClient interface {
+	// List Accounts
+	ListAccounts(ctx context.Context, in *AdminAccountsRequest, opts ...grpc.CallOption) (*AdminAccountsResponse, error)
+	// Get Account
+	GetAccount(ctx context.Context, in *AdminGetAccountRequest, opts ...grpc.CallOption) (*Account, error)
+	// Update Account
+	UpdateAccount(ctx context.Context, in *AdminUpdateAccountRequest, opts ...grpc.CallOption) (*Account, error)
+	// Decode a payment request
+	Ledger(ctx context.Context, in *LedgerRequest, opts ...grpc.CallOption) (*LedgerResponse, error)
+	// Look up the real outcome of a stuck pending ledger record and apply it when confirmed
+	ResolveLedgerRecord(ctx context.Context, in *AdminResolveLedgerRecordRequest, opts ...grpc.CallOption) (*AdminResolveLedgerRecordResponse, error)
+	// Credit or debit an account with a manual adjustment
+	AdjustBalance(ctx context.Context, in *AdminAdjustBalanceRequest, opts ...grpc.CallOption) (*AdminAdjustBalanceResponse, error)
+	// List manual adjustments
+	ListAdjustments(ctx context.Context, in *AdminAdjustmentsRequest, opts ...grpc.CallOption) (*AdminAdjustmentsResponse, error)
+	// List outbound ledger records held for review
+	ListHeld(ctx context.Context, in *AdminHeldRequest, opts ...grpc.CallOption) (*LedgerResponse, error)
+	// Approve a held ledger record, resuming the original send
+	ApproveHeld(ctx context.Context, in *AdminReviewHeldRequest, opts ...grpc.CallOption) (*LedgerRecordResponse, error)
+	// Reject a held ledger record, returning the funds to the account
+	RejectHeld(ctx context.Context, in *AdminReviewHeldRequest, opts ...grpc.CallOption) (*LedgerRecordResponse, error)
+	// List Agent Keys
+	ListAgentKeys(ctx context.Context, in *AdminAgentKeysRequest, opts ...grpc.CallOption) (*AdminAgentKeysResponse, error)
+	// Get Agent Key
+	GetAgentKey(ctx context.Context, in *AdminAgentKeyRequest, opts ...grpc.CallOption) (*AgentKey, error)
+	// Create Agent Key - The secret is only returned once
+	CreateAgentKey(ctx context.Context, in *AdminSaveAgentKeyRequest, opts ...grpc.CallOption) (*AdminCreateAgentKeyResponse, error)
+	// Update Agent Key
+	UpdateAgentKey(ctx context.Context, in *AdminSaveAgentKeyRequest, opts ...grpc.CallOption) (*AgentKey, error)
+	// Revoke Agent Key
+	RevokeAgentKey(ctx context.Context, in *AdminAgentKeyRequest, opts ...grpc.CallOption) (*AgentKey, error)
+	// Delete Agent Key
+	DeleteAgentKey(ctx context.Context, in *AdminAgentKeyRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Get the spending limits of an account
+	GetAccountLimits(ctx context.Context, in *AdminAccountLimitsRequest, opts ...grpc.CallOption) (*AdminAccountLimitsResponse, error)
+	// Update the spending limit tier and overrides of an account
+	UpdateAccountLimits(ctx context.Context, in *AccountLimits, opts ...grpc.CallOption) (*AdminAccountLimitsResponse, error)
+	// List Screening Entries
+	ListScreeningEntries(ctx context.Context, in *AdminScreeningEntriesRequest, opts ...grpc.CallOption) (*AdminScreeningEntriesResponse, error)
+	// Create Screening Entry
+	CreateScreeningEntry(ctx context.Context, in *ScreeningEntry, opts ...grpc.CallOption) (*ScreeningEntry, error)
+	// Update Screening Entry
+	UpdateScreeningEntry(ctx context.Context, in *ScreeningEntry, opts ...grpc.CallOption) (*ScreeningEntry, error)
+	// Delete Screening Entry
+	DeleteScreeningEntry(ctx context.Context, in *AdminScreeningEntryRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// List the history of changes to the screening lists
+	ListScreeningHistory(ctx context.Context, in *AdminScreeningHistoryRequest, opts ...grpc.CallOption) (*AdminScreeningHistoryResponse, error)
+	// List the audit log of changes made through the admin API
+	ListAuditLog(ctx context.Context, in *AdminAuditLogRequest, opts ...grpc.CallOption) (*AdminAuditLogResponse, error)
+	// List the channel opens and closes proposed by the channel manager
+	ListChannelProposals(ctx context.Context, in *AdminChannelProposalsRequest, opts ...grpc.CallOption) (*AdminChannelProposalsResponse, error)
+	// Approve a channel proposal and open or close the channel
+	ApproveChannelProposal(ctx context.Context, in *AdminChannelProposalRequest, opts ...grpc.CallOption) (*ChannelProposal, error)
+	// Reject a channel proposal
+	RejectChannelProposal(ctx context.Context, in *AdminChannelProposalRequest, opts ...grpc.CallOption) (*ChannelProposal, error)
+	// List the channels opened and closed by the channel manager
+	ListChannelHistory(ctx context.Context, in *AdminChannelHistoryRequest, opts ...grpc.CallOption) (*AdminChannelProposalsResponse, error)
+	// List the stored channel backups, optionally only those including a channel
+	ListChanBackups(ctx context.Context, in *AdminChanBackupsRequest, opts ...grpc.CallOption) (*AdminChanBackupsResponse, error)
+	// Compare the channels in two channel backups
+	DiffChanBackups(ctx context.Context, in *AdminDiffChanBackupsRequest, opts ...grpc.CallOption) (*AdminDiffChanBackupsResponse, error)
+	// Verify a channel backup with lnd
+	VerifyChanBackup(ctx context.Context, in *AdminChanBackupRequest, opts ...grpc.CallOption) (*AdminVerifyChanBackupResponse, error)
+	// Download a channel backup
+	DownloadChanBackup(ctx context.Context, in *AdminChanBackupRequest, opts ...grpc.CallOption) (*AdminDownloadChanBackupResponse, error)
+}
+
+type adminRPCClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAdminRPCClient(cc *grpc.ClientConn) AdminRPCClient {
+	return &adminRPCClient{cc}
+}
+
+func (c *adminRPCClient) ListAccounts(ctx context.Context, in *AdminAccountsRequest, opts ...grpc.CallOption) (*AdminAccountsResponse, error) {
+	out := new(AdminAccountsResponse)
+	err := c.cc.Invoke(ctx, "/tdrpc.AdminRPC/ListAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminRPCClient) GetAccount(ctx context.Context, in *AdminGetAccountRequest, opts ...grpc.CallOption) (*Account, error) {
+	out := new(Account)
+	err := c.cc.Invoke(ctx, "/tdrpc.AdminRPC/GetAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminRPCClient) UpdateAccount(ctx context.Context, in *AdminUpdateAccountRequest, opts ...grpc.CallOption) (*Account, error) {
+	out := new(Account)
+	err := c.cc.Invoke(ctx, "/tdrpc.AdminRPC/UpdateAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminRPCClient) Ledger(ctx context.Context, in *LedgerRequest, opts ...grpc.CallOption) (*LedgerResponse, error) {
+	out := new(LedgerResponse)
+	err := c.cc.Invoke(ctx, "/tdrpc.AdminRPC/Ledger", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminRPCClient) ResolveLedgerRecord(ctx context.Context, in *AdminResolveLedgerRecordRequest, opts ...grpc.CallOption) (*AdminResolveLedgerRecordResponse, error) {
+	out := new(AdminResolveLedgerRecordResponse)
+	err := c.cc.Invoke(ctx, "/tdrpc.AdminRPC/ResolveLedgerRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminRPCClient) AdjustBalance(ctx context.Context, in *AdminAdjustBalanceRequest, opts ...grpc.CallOption) (*AdminAdjustBalanceResponse, error) {
+	out := new(AdminAdjustBalanceResponse)
+	err := c.cc.Invoke(ctx, "/tdrpc.AdminRPC/AdjustBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminRPCClient) ListAdjustments(ctx context.Context, in *AdminAdjustmentsRequest, opts ...grpc.CallOption) (*AdminAdjustmentsResponse, error) {
+	out := new(AdminAdjustmentsResponse)
+	err := c.cc.Invoke(ctx, "/tdrpc.AdminRPC/ListAdjustments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminRPCClient) ListHeld(ctx context.Context, in *AdminHeldRequest, opts ...grpc.CallOption) (*LedgerResponse, error) {
+	out := new(LedgerResponse)
+	err := c.cc.Invoke(ctx, "/tdrpc.AdminRPC/ListHeld", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminRPCClient) ApproveHeld(ctx context.Context, in *AdminReviewHeldRequest, opts ...grpc.CallOption) (*LedgerRecordResponse, error) {
+	out := new(LedgerRecordResponse)
+	err := c.cc.Invoke(ctx, "/tdrpc.AdminRPC/ApproveHeld", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminRPCClient) RejectHeld(ctx context.Context, in *AdminReviewHeldRequest, opts ...grpc.CallOption) (*LedgerRecordResponse, error) {
+	out := new(LedgerRecordResponse)
+	err := c.cc.Invoke(ctx, "/tdrpc.AdminRPC/RejectHeld", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminRPCClient) ListAgentKeys(ctx context.Context, in *AdminAgentKeysRequest, opts ...grpc.CallOption) (*AdminAgentKeysResponse, error) {
+	out := new(AdminAgentKeysResponse)
+	err := c.cc.Invoke(ctx, "/tdrpc.AdminRPC/ListAgentKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminRPCClient) GetAgentKey(ctx context.Context, in *AdminAgentKeyRequest, opts ...grpc.CallOption) (*AgentKey, error) {
+	out := new(AgentKey)
+	err := c.cc.Invoke(ctx, "/tdrpc.AdminRPC/GetAgentKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminRPCClient) CreateAgentKey(ctx context.Context, in *AdminSaveAgentKeyRequest, opts ...grpc.CallOption) (*AdminCreateAgentKeyResponse, error) {
+	out := new(AdminCreateAgentKeyResponse)
+	err := c.cc.Invoke(ctx, "/tdrpc.AdminRPC/CreateAgentKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminRPCClient) UpdateAgentKey(ctx context.Context, in *AdminSaveAgentKeyRequest, opts ...grpc.CallOption) (*AgentKey, error) {
+	out := new(AgentKey)
+	err := c.cc.Invoke(ctx, "/tdrpc.AdminRPC/UpdateAgentKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminRPCClient) RevokeAgentKey(ctx context.Context, in *AdminAgentKeyRequest, opts ...grpc.CallOption) (*AgentKey, error) {
+	out := new(AgentKey)
+	err := c.cc.Invoke(ctx, "/tdrpc.AdminRPC/RevokeAgentKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminRPCClient) DeleteAgentKey(ctx context.Context, in *AdminAgentKeyRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/tdrpc.AdminRPC/DeleteAgentKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminRPCClient) GetAccountLimits(ctx context.Context, in *AdminAccountLimitsRequest, opts ...grpc.CallOption) (*AdminAccountLimitsResponse, error) {
+	out := new(AdminAccountLimitsResponse)
+	err := c.cc.Invoke(ctx, "/tdrpc.AdminRPC/GetAccountLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminRPCClient) UpdateAccountLimits(ctx context.Context, in *AccountLimits, opts ...grpc.CallOption) (*AdminAccountLimitsResponse, error) {
+	out := new(AdminAccountLimitsResponse)
+	err := c.cc.Invoke(ctx, "/tdrpc.AdminRPC/UpdateAccountLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminRPCClient) ListScreeningEntries(ctx context.Context, in *AdminScreeningEntriesRequest, opts ...grpc.CallOption) (*AdminScreeningEntriesResponse, error) {
+	out := new(AdminScreeningEntriesResponse)
+	err := c.cc.Invoke(ctx, "/tdrpc.AdminRPC/ListScreeningEntries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminRPCClient) CreateScreeningEntry(ctx context.Context, in *ScreeningEntry, opts ...grpc.CallOption) (*ScreeningEntry, error) {
+	out := new(ScreeningEntry)
+	err := c.cc.Invoke(ctx, "/tdrpc.AdminRPC/CreateScreeningEntry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminRPCClient) UpdateScreeningEntry(ctx context.Context, in *ScreeningEntry, opts ...grpc.CallOption) (*ScreeningEntry, error) {
+	out := new(ScreeningEntry)
+	err := c.cc.Invoke(ctx, "/tdrpc.AdminRPC/UpdateScreeningEntry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminRPCClient) DeleteScreeningEntry(ctx context.Context, in *AdminScreeningEntryRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/tdrpc.AdminRPC/DeleteScreeningEntry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminRPCClient) ListScreeningHistory(ctx context.Context, in *AdminScreeningHistoryRequest, opts ...grpc.CallOption) (*AdminScreeningHistoryResponse, error) {
+	out := new(AdminScreeningHistoryResponse)
+	err := c.cc.Invoke(ctx, "/tdrpc.AdminRPC/ListScreeningHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminRPCClient) ListAuditLog(ctx context.Context, in *AdminAuditLogRequest, opts ...grpc.CallOption) (*AdminAuditLogResponse, error) {
+	out := new(AdminAuditLogResponse)
+	err := c.cc.Invoke(ctx, "/tdrpc.AdminRPC/ListAuditLog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminRPCClient) ListChannelProposals(ctx context.Context, in *AdminChannelProposalsRequest, opts ...grpc.CallOption) (*AdminChannelProposalsResponse, error) {
+	out := new(AdminChannelProposalsResponse)
+	err := c.cc.Invoke(ctx, "/tdrpc.AdminRPC/ListChannelProposals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminRPCClient) ApproveChannelProposal(ctx context.Context, in *AdminChannelProposalRequest, opts ...grpc.CallOption) (*ChannelProposal, error) {
+	out := new(ChannelProposal)
+	err := c.cc.Invoke(ctx, "/tdrpc.AdminRPC/ApproveChannelProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminRPCClient) RejectChannelProposal(ctx context.Context, in *AdminChannelProposalRequest, opts ...grpc.CallOption) (*ChannelProposal, error) {
+	out := new(ChannelProposal)
+	err := c.cc.Invoke(ctx, "/tdrpc.AdminRPC/RejectChannelProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminRPCClient) ListChannelHistory(ctx context.Context, in *AdminChannelHistoryRequest, opts ...grpc.CallOption) (*AdminChannelProposalsResponse, error) {
+	out := new(AdminChannelProposalsResponse)
+	err := c.cc.Invoke(ctx, "/tdrpc.AdminRPC/ListChannelHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminRPCClient) ListChanBackups(ctx context.Context, in *AdminChanBackupsRequest, opts ...grpc.CallOption) (*AdminChanBackupsResponse, error) {
+	out := new(AdminChanBackupsResponse)
+	err := c.cc.Invoke(ctx, "/tdrpc.AdminRPC/ListChanBackups", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminRPCClient) DiffChanBackups(ctx context.Context, in *AdminDiffChanBackupsRequest, opts ...grpc.CallOption) (*AdminDiffChanBackupsResponse, error) {
+	out := new(AdminDiffChanBackupsResponse)
+	err := c.cc.Invoke(ctx, "/tdrpc.AdminRPC/DiffChanBackups", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminRPCClient) VerifyChanBackup(ctx context.Context, in *AdminChanBackupRequest, opts ...grpc.CallOption) (*AdminVerifyChanBackupResponse, error) {
+	out := new(AdminVerifyChanBackupResponse)
+	err := c.cc.Invoke(ctx, "/tdrpc.AdminRPC/VerifyChanBackup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminRPCClient) DownloadChanBackup(ctx context.Context, in *AdminChanBackupRequest, opts ...grpc.CallOption) (*AdminDownloadChanBackupResponse, error) {
+	out := new(AdminDownloadChanBackupResponse)
+	err := c.cc.Invoke(ctx, "/tdrpc.AdminRPC/DownloadChanBackup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminRPCServer is the server API for AdminRPC service.
+type AdminRPCServer interface {
+	// List Accounts
+	ListAccounts(context.Context, *AdminAccountsRequest) (*AdminAccountsResponse, error)
+	// Get Account
+	GetAccount(context.Context, *AdminGetAccountRequest) (*Account, error)
+	// Update Account
+	UpdateAccount(context.Context, *AdminUpdateAccountRequest) (*Account, error)
+	// Decode a payment request
+	Ledger(context.Context, *LedgerRequest) (*LedgerResponse, error)
+	// Look up the real outcome of a stuck pending ledger record and apply it when confirmed
+	ResolveLedgerRecord(context.Context, *AdminResolveLedgerRecordRequest) (*AdminResolveLedgerRecordResponse, error)
+	// Credit or debit an account with a manual adjustment
+	AdjustBalance(context.Context, *AdminAdjustBalanceRequest) (*AdminAdjustBalanceResponse, error)
+	// List manual adjustments
+	ListAdjustments(context.Context, *AdminAdjustmentsRequest) (*AdminAdjustmentsResponse, error)
+	// List outbound ledger records held for review
+	ListHeld(context.Context, *AdminHeldRequest) (*LedgerResponse, error)
+	// Approve a held ledger record, resuming the original send
+	ApproveHeld(context.Context, *AdminReviewHeldRequest) (*LedgerRecordResponse, error)
+	// Reject a held ledger record, returning the funds to the account
+	RejectHeld(context.Context, *AdminReviewHeldRequest) (*LedgerRecordResponse, error)
+	// List Agent Keys
+	ListAgentKeys(context.Context, *AdminAgentKeysRequest) (*AdminAgentKeysResponse, error)
+	// Get Agent Key
+	GetAgentKey(context.Context, *AdminAgentKeyRequest) (*AgentKey, error)
+	// Create Agent Key - The secret is only returned once
+	CreateAgentKey(context.Context, *AdminSaveAgentKeyRequest) (*AdminCreateAgentKeyResponse, error)
+	// Update Agent Key
+	UpdateAgentKey(context.Context, *AdminSaveAgentKeyRequest) (*AgentKey, error)
+	// Revoke Agent Key
+	RevokeAgentKey(context.Context, *AdminAgentKeyRequest) (*AgentKey, error)
+	// Delete Agent Key
+	DeleteAgentKey(context.Context, *AdminAgentKeyRequest) (*empty.Empty, error)
+	// Get the spending limits of an account
+	GetAccountLimits(context.Context, *AdminAccountLimitsRequest) (*AdminAccountLimitsResponse, error)
+	// Update the spending limit tier and overrides of an account
+	UpdateAccountLimits(context.Context, *AccountLimits) (*AdminAccountLimitsResponse, error)
+	// List Screening Entries
+	ListScreeningEntries(context.Context, *AdminScreeningEntriesRequest) (*AdminScreeningEntriesResponse, error)
+	// Create Screening Entry
+	CreateScreeningEntry(context.Context, *ScreeningEntry) (*ScreeningEntry, error)
+	// Update Screening Entry
+	UpdateScreeningEntry(context.Context, *ScreeningEntry) (*ScreeningEntry, error)
+	// Delete Screening Entry
+	DeleteScreeningEntry(context.Context, *AdminScreeningEntryRequest) (*empty.Empty, error)
+	// List the history of changes to the screening lists
+	ListScreeningHistory(context.Context, *AdminScreeningHistoryRequest) (*AdminScreeningHistoryResponse, error)
+	// List the audit log of changes made through the admin API
+	ListAuditLog(context.Context, *AdminAuditLogRequest) (*AdminAuditLogResponse, error)
+	// List the channel opens and closes proposed by the channel manager
+	ListChannelProposals(context.Context, *AdminChannelProposalsRequest) (*AdminChannelProposalsResponse, error)
+	// Approve a channel proposal and open or close the channel
+	ApproveChannelProposal(context.Context, *AdminChannelProposalRequest) (*ChannelProposal, error)
+	// Reject a channel proposal
+	RejectChannelProposal(context.Context, *AdminChannelProposalRequest) (*ChannelProposal, error)
+	// List the channels opened and closed by the channel manager
+	ListChannelHistory(context.Context, *AdminChannelHistoryRequest) (*AdminChannelProposalsResponse, error)
+	// List the stored channel backups, optionally only those including a channel
+	ListChanBackups(context.Context, *AdminChanBackupsRequest) (*AdminChanBackupsResponse, error)
+	// Compare the channels in two channel backups
+	DiffChanBackups(context.Context, *AdminDiffChanBackupsRequest) (*AdminDiffChanBackupsResponse, error)
+	// Verify a channel backup with lnd
+	VerifyChanBackup(context.Context, *AdminChanBackupRequest) (*AdminVerifyChanBackupResponse, error)
+	// Download a channel backup
+	DownloadChanBackup(context.Context, *AdminChanBackupRequest) (*AdminDownloadChanBackupResponse, error)
+}
+
+func RegisterAdminRPCServer(s *grpc.Server, srv AdminRPCServer) {
+	s.RegisterService(&_AdminRPC_serviceDesc, srv)
+}
+
+func _AdminRPC_ListAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminRPCServer).ListAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tdrpc.AdminRPC/ListAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminRPCServer).ListAccounts(ctx, req.(*AdminAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminRPC_GetAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminGetAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminRPCServer).GetAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tdrpc.AdminRPC/GetAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminRPCServer).GetAccount(ctx, req.(*AdminGetAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminRPC_UpdateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminUpdateAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminRPCServer).UpdateAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tdrpc.AdminRPC/UpdateAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminRPCServer).UpdateAccount(ctx, req.(*AdminUpdateAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminRPC_Ledger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LedgerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminRPCServer).Ledger(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tdrpc.AdminRPC/Ledger",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminRPCServer).Ledger(ctx, req.(*LedgerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminRPC_ResolveLedgerRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminResolveLedgerRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminRPCServer).ResolveLedgerRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tdrpc.AdminRPC/ResolveLedgerRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminRPCServer).ResolveLedgerRecord(ctx, req.(*AdminResolveLedgerRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminRPC_AdjustBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminAdjustBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminRPCServer).AdjustBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tdrpc.AdminRPC/AdjustBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminRPCServer).AdjustBalance(ctx, req.(*AdminAdjustBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminRPC_ListAdjustments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminAdjustmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminRPCServer).ListAdjustments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tdrpc.AdminRPC/ListAdjustments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminRPCServer).ListAdjustments(ctx, req.(*AdminAdjustmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminRPC_ListHeld_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminHeldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminRPCServer).ListHeld(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tdrpc.AdminRPC/ListHeld",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminRPCServer).ListHeld(ctx, req.(*AdminHeldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminRPC_ApproveHeld_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminReviewHeldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminRPCServer).ApproveHeld(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tdrpc.AdminRPC/ApproveHeld",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminRPCServer).ApproveHeld(ctx, req.(*AdminReviewHeldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminRPC_RejectHeld_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminReviewHeldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminRPCServer).RejectHeld(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tdrpc.AdminRPC/RejectHeld",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminRPCServer).RejectHeld(ctx, req.(*AdminReviewHeldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminRPC_ListAgentKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminAgentKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminRPCServer).ListAgentKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tdrpc.AdminRPC/ListAgentKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminRPCServer).ListAgentKeys(ctx, req.(*AdminAgentKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminRPC_GetAgentKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminAgentKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminRPCServer).GetAgentKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tdrpc.AdminRPC/GetAgentKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminRPCServer).GetAgentKey(ctx, req.(*AdminAgentKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminRPC_CreateAgentKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminSaveAgentKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminRPCServer).CreateAgentKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tdrpc.AdminRPC/CreateAgentKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminRPCServer).CreateAgentKey(ctx, req.(*AdminSaveAgentKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminRPC_UpdateAgentKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminSaveAgentKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminRPCServer).UpdateAgentKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tdrpc.AdminRPC/UpdateAgentKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminRPCServer).UpdateAgentKey(ctx, req.(*AdminSaveAgentKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminRPC_RevokeAgentKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminAgentKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminRPCServer).RevokeAgentKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tdrpc.AdminRPC/RevokeAgentKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminRPCServer).RevokeAgentKey(ctx, req.(*AdminAgentKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminRPC_DeleteAgentKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminAgentKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminRPCServer).DeleteAgentKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tdrpc.AdminRPC/DeleteAgentKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminRPCServer).DeleteAgentKey(ctx, req.(*AdminAgentKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminRPC_GetAccountLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminAccountLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminRPCServer).GetAccountLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tdrpc.AdminRPC/GetAccountLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminRPCServer).GetAccountLimits(ctx, req.(*AdminAccountLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminRPC_UpdateAccountLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountLimits)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminRPCServer).UpdateAccountLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tdrpc.AdminRPC/UpdateAccountLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminRPCServer).UpdateAccountLimits(ctx, req.(*AccountLimits))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminRPC_ListScreeningEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminScreeningEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminRPCServer).ListScreeningEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tdrpc.AdminRPC/ListScreeningEntries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminRPCServer).ListScreeningEntries(ctx, req.(*AdminScreeningEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminRPC_CreateScreeningEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScreeningEntry)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminRPCServer).CreateScreeningEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tdrpc.AdminRPC/CreateScreeningEntry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminRPCServer).CreateScreeningEntry(ctx, req.(*ScreeningEntry))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminRPC_UpdateScreeningEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScreeningEntry)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminRPCServer).UpdateScreeningEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tdrpc.AdminRPC/UpdateScreeningEntry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminRPCServer).UpdateScreeningEntry(ctx, req.(*ScreeningEntry))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminRPC_DeleteScreeningEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminScreeningEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminRPCServer).DeleteScreeningEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tdrpc.AdminRPC/DeleteScreeningEntry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminRPCServer).DeleteScreeningEntry(ctx, req.(*AdminScreeningEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminRPC_ListScreeningHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminScreeningHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminRPCServer).ListScreeningHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tdrpc.AdminRPC/ListScreeningHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminRPCServer).ListScreeningHistory(ctx, req.(*AdminScreeningHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminRPC_ListAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminAuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminRPCServer).ListAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tdrpc.AdminRPC/ListAuditLog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminRPCServer).ListAuditLog(ctx, req.(*AdminAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminRPC_ListChannelProposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminChannelProposalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminRPCServer).ListChannelProposals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tdrpc.AdminRPC/ListChannelProposals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminRPCServer).ListChannelProposals(ctx, req.(*AdminChannelProposalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminRPC_ApproveChannelProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminChannelProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminRPCServer).ApproveChannelProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tdrpc.AdminRPC/ApproveChannelProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminRPCServer).ApproveChannelProposal(ctx, req.(*AdminChannelProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminRPC_RejectChannelProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminChannelProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminRPCServer).RejectChannelProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tdrpc.AdminRPC/RejectChannelProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminRPCServer).RejectChannelProposal(ctx, req.(*AdminChannelProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminRPC_ListChannelHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminChannelHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminRPCServer).ListChannelHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tdrpc.AdminRPC/ListChannelHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminRPCServer).ListChannelHistory(ctx, req.(*AdminChannelHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminRPC_ListChanBackups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminChanBackupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminRPCServer).ListChanBackups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tdrpc.AdminRPC/ListChanBackups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminRPCServer).ListChanBackups(ctx, req.(*AdminChanBackupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminRPC_DiffChanBackups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminDiffChanBackupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminRPCServer).DiffChanBackups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tdrpc.AdminRPC/DiffChanBackups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminRPCServer).DiffChanBackups(ctx, req.(*AdminDiffChanBackupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminRPC_VerifyChanBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminChanBackupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminRPCServer).VerifyChanBackup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tdrpc.AdminRPC/VerifyChanBackup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminRPCServer).VerifyChanBackup(ctx, req.(*AdminChanBackupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminRPC_DownloadChanBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminChanBackupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminRPCServer).DownloadChanBackup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tdrpc.AdminRPC/DownloadChanBackup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminRPCServer).DownloadChanBackup(ctx, req.(*AdminChanBackupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _AdminRPC_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "tdrpc.AdminRPC",
+	HandlerType: (*AdminRPCServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListAccounts",
+			Handler:    _AdminRPC_ListAccounts_Handler,
+		},
+		{
+			MethodName: "GetAccount",
+			Handler:    _AdminRPC_GetAccount_Handler,
+		},
+		{
+			MethodName: "UpdateAccount",
+			Handler:    _AdminRPC_UpdateAccount_Handler,
+		},
+		{
+			MethodName: "Ledger",
+			Handler:    _AdminRPC_Ledger_Handler,
+		},
+		{
+			MethodName: "ResolveLedgerRecord",
+			Handler:    _AdminRPC_ResolveLedgerRecord_Handler,
+		},
+		{
+			MethodName: "AdjustBalance",
+			Handler:    _AdminRPC_AdjustBalance_Handler,
+		},
+		{
+			MethodName: "ListAdjustments",
+			Handler:    _AdminRPC_ListAdjustments_Handler,
+		},
+		{
+			MethodName: "ListHeld",
+			Handler:    _AdminRPC_ListHeld_Handler,
+		},
+		{
+			MethodName: "ApproveHeld",
+			Handler:    _AdminRPC_ApproveHeld_Handler,
+		},
+		{
+			MethodName: "RejectHeld",
+			Handler:    _AdminRPC_RejectHeld_Handler,
+		},
+		{
+			MethodName: "ListAgentKeys",
+			Handler:    _AdminRPC_ListAgentKeys_Handler,
+		},
+		{
+			MethodName: "GetAgentKey",
+			Handler:    _AdminRPC_GetAgentKey_Handler,
+		},
+		{
+			MethodName: "CreateAgentKey",
+			Handler:    _AdminRPC_CreateAgentKey_Handler,
+		},
+		{
+			MethodName: "UpdateAgentKey",
+			Handler:    _AdminRPC_UpdateAgentKey_Handler,
+		},
+		{
+			MethodName: "RevokeAgentKey",
+			Handler:    _AdminRPC_RevokeAgentKey_Handler,
+		},
+		{
+			MethodName: "DeleteAgentKey",
+			Handler:    _AdminRPC_DeleteAgentKey_Handler,
+		},
+		{
+			MethodName: "GetAccountLimits",
+			Handler:    _AdminRPC_GetAccountLimits_Handler,
+		},
+		{
+			MethodName: "UpdateAccountLimits",
+			Handler:    _AdminRPC_UpdateAccountLimits_Handler,
+		},
+		{
+			MethodName: "ListScreeningEntries",
+			Handler:    _AdminRPC_ListScreeningEntries_Handler,
+		},
+		{
+			MethodName: "CreateScreeningEntry",
+			Handler:    _AdminRPC_CreateScreeningEntry_Handler,
+		},
+		{
+			MethodName: "UpdateScreeningEntry",
+			Handler:    _AdminRPC_UpdateScreeningEntry_Handler,
+		},
+		{
+			MethodName: "DeleteScreeningEntry",
+			Handler:    _AdminRPC_DeleteScreeningEntry_Handler,
+		},
+		{
+			MethodName: "ListScreeningHistory",
+			Handler:    _AdminRPC_ListScreeningHistory_Handler,
+		},
+		{
+			MethodName: "ListAuditLog",
+			Handler:    _AdminRPC_ListAuditLog_Handler,
+		},
+		{
+			MethodName: "ListChannelProposals",
+			Handler:    _AdminRPC_ListChannelProposals_Handler,
+		},
+		{
+			MethodName: "ApproveChannelProposal",
+			Handler:    _AdminRPC_ApproveChannelProposal_Handler,
+		},
+		{
+			MethodName: "RejectChannelProposal",
+			Handler:    _AdminRPC_RejectChannelProposal_Handler,
+		},
+		{
+			MethodName: "ListChannelHistory",
+			Handler:    _AdminRPC_ListChannelHistory_Handler,
+		},
+		{
+			MethodName: "ListChanBackups",
+			Handler:    _AdminRPC_ListChanBackups_Handler,
+		},
+		{
+			MethodName: "DiffChanBackups",
+			Handler:    _AdminRPC_DiffChanBackups_Handler,
+		},
+		{
+			MethodName: "VerifyChanBackup",
+			Handler:    _AdminRPC_VerifyChanBackup_Handler,
+		},
+		{
+			MethodName: "DownloadChanBackup",
+			Handler:    _AdminRPC_DownloadChanBackup_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "tdrpc/adminrpc.proto",
+}
+
+func (m *AdminAccountsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Filter) > 0 {
+		for k, _ := range m.Filter {
+			dAtA[i] = 0xa
+			i++
+			v := m.Filter[k]
+			mapSize := 1 + len(k) + sovAdminrpc(uint64(len(k))) + 1 + len(v) + sovAdminrpc(uint64(len(v)))
+			i = encodeVarintAdminrpc(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintAdminrpc(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintAdminrpc(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	if m.Offset != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(m.Offset))
+	}
+	if m.Limit != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(m.Limit))
+	}
+	return i, nil
+}
+
+func (m *AdminAccountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for _, msg := range m.Accounts {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintAdminrpc(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *AdminGetAccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminGetAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if len(m.Address) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(len(m.Address)))
+		i += copy(dAtA[i:], m.Address)
+	}
+	return i, nil
+}
+
+func (m *AdminUpdateAccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminUpdateAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if m.Locked {
+		dAtA[i] = 0x10
+		i++
+		if m.Locked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *AdminResolveLedgerRecordRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminResolveLedgerRecordRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if m.Direction != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(m.Direction))
+	}
+	if m.Confirm {
+		dAtA[i] = 0x18
+		i++
+		if m.Confirm {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *AdminResolveLedgerRecordResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminResolveLedgerRecordResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Current != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(m.Current.Size()))
+		n1, err := m.Current.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	if m.Proposed != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(m.Proposed.Size()))
+		n2, err := m.Proposed.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	if len(m.Reason) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(len(m.Reason)))
+		i += copy(dAtA[i:], m.Reason)
+	}
+	if m.Applied {
+		dAtA[i] = 0x20
+		i++
+		if m.Applied {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *AdminAdjustBalanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminAdjustBalanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AccountId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(len(m.AccountId)))
+		i += copy(dAtA[i:], m.AccountId)
+	}
+	if m.Value != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(m.Value))
+	}
+	if len(m.Reason) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(len(m.Reason)))
+		i += copy(dAtA[i:], m.Reason)
+	}
+	if len(m.TicketId) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(len(m.TicketId)))
+		i += copy(dAtA[i:], m.TicketId)
+	}
+	return i, nil
+}
+
+func (m *AdminAdjustBalanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminAdjustBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Result != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(m.Result.Size()))
+		n3, err := m.Result.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	if m.Adjustment != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(m.Adjustment.Size()))
+		n4, err := m.Adjustment.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	return i, nil
+}
+
+func (m *LedgerAdjustment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LedgerAdjustment) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.LedgerId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(len(m.LedgerId)))
+		i += copy(dAtA[i:], m.LedgerId)
+	}
+	if len(m.AccountId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(len(m.AccountId)))
+		i += copy(dAtA[i:], m.AccountId)
+	}
+	if m.CreatedAt != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt)))
+		n5, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreatedAt, dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	if m.Value != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(m.Value))
+	}
+	if len(m.Operator) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(len(m.Operator)))
+		i += copy(dAtA[i:], m.Operator)
+	}
+	if len(m.Reason) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(len(m.Reason)))
+		i += copy(dAtA[i:], m.Reason)
+	}
+	if len(m.TicketId) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(len(m.TicketId)))
+		i += copy(dAtA[i:], m.TicketId)
+	}
+	return i, nil
+}
+
+func (m *AdminAdjustmentsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminAdjustmentsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AccountId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(len(m.AccountId)))
+		i += copy(dAtA[i:], m.AccountId)
+	}
+	if m.Offset != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(m.Offset))
+	}
+	if m.Limit != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(m.Limit))
+	}
+	return i, nil
+}
+
+func (m *AdminAdjustmentsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminAdjustmentsResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Adjustments) > 0 {
+		for _, msg := range m.Adjustments {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintAdminrpc(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *AdminHeldRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminHeldRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Filter) > 0 {
+		for k, _ := range m.Filter {
+			dAtA[i] = 0xa
+			i++
+			v := m.Filter[k]
+			mapSize := 1 + len(k) + sovAdminrpc(uint64(len(k))) + 1 + len(v) + sovAdminrpc(uint64(len(v)))
+			i = encodeVarintAdminrpc(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintAdminrpc(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintAdminrpc(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	if m.Offset != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(m.Offset))
+	}
+	if m.Limit != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(m.Limit))
+	}
+	return i, nil
+}
+
+func (m *AdminReviewHeldRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminReviewHeldRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if len(m.Reason) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(len(m.Reason)))
+		i += copy(dAtA[i:], m.Reason)
+	}
+	return i, nil
+}
+
+func (m *AgentKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AgentKey) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if m.CreatedAt != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt)))
+		n6, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreatedAt, dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
+	if m.UpdatedAt != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt)))
+		n7, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.UpdatedAt, dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n7
+	}
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if len(m.Scopes) > 0 {
+		for _, s := range m.Scopes {
+			dAtA[i] = 0x2a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.AccountIds) > 0 {
+		for _, s := range m.AccountIds {
+			dAtA[i] = 0x32
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.ValueLimit != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(m.ValueLimit))
+	}
+	if m.ExpiresAt != nil {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt)))
+		n8, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiresAt, dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n8
+	}
+	if m.RevokedAt != nil {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.RevokedAt)))
+		n9, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.RevokedAt, dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n9
+	}
+	return i, nil
+}
+
+func (m *AdminAgentKeysRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminAgentKeysRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Offset != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(m.Offset))
+	}
+	if m.Limit != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(m.Limit))
+	}
+	return i, nil
+}
+
+func (m *AdminAgentKeysResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminAgentKeysResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AgentKeys) > 0 {
+		for _, msg := range m.AgentKeys {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintAdminrpc(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *AdminAgentKeyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminAgentKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	return i, nil
+}
+
+func (m *AdminSaveAgentKeyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminSaveAgentKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if len(m.Scopes) > 0 {
+		for _, s := range m.Scopes {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.AccountIds) > 0 {
+		for _, s := range m.AccountIds {
+			dAtA[i] = 0x22
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.ValueLimit != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(m.ValueLimit))
+	}
+	if m.ExpiresAt != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt)))
+		n10, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiresAt, dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
+	}
+	return i, nil
+}
+
+func (m *AdminCreateAgentKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminCreateAgentKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.AgentKey != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(m.AgentKey.Size()))
+		n11, err := m.AgentKey.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n11
+	}
+	if len(m.Secret) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(len(m.Secret)))
+		i += copy(dAtA[i:], m.Secret)
+	}
+	return i, nil
+}
+
+func (m *AccountLimits) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountLimits) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AccountId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(len(m.AccountId)))
+		i += copy(dAtA[i:], m.AccountId)
+	}
+	if m.CreatedAt != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt)))
+		n12, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreatedAt, dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n12
+	}
+	if m.UpdatedAt != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt)))
+		n13, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.UpdatedAt, dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n13
+	}
+	if len(m.Tier) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(len(m.Tier)))
+		i += copy(dAtA[i:], m.Tier)
+	}
+	if m.DailySend != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(m.DailySend))
+	}
+	if m.WeeklySend != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(m.WeeklySend))
+	}
+	if m.MaxPayment != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(m.MaxPayment))
+	}
+	if m.DailyWithdraw != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(m.DailyWithdraw))
+	}
+	return i, nil
+}
+
+func (m *AdminAccountLimitsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminAccountLimitsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AccountId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(len(m.AccountId)))
+		i += copy(dAtA[i:], m.AccountId)
+	}
+	return i, nil
+}
+
+func (m *AdminAccountLimitsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminAccountLimitsResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Limits != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(m.Limits.Size()))
+		n14, err := m.Limits.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n14
+	}
+	if m.Effective != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(m.Effective.Size()))
+		n15, err := m.Effective.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n15
+	}
+	return i, nil
+}
+
+func (m *ScreeningEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScreeningEntry) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if m.CreatedAt != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt)))
+		n16, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreatedAt, dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n16
+	}
+	if m.UpdatedAt != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt)))
+		n17, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.UpdatedAt, dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n17
+	}
+	if len(m.List) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(len(m.List)))
+		i += copy(dAtA[i:], m.List)
+	}
+	if len(m.Type) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(len(m.Type)))
+		i += copy(dAtA[i:], m.Type)
+	}
+	if len(m.Value) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(len(m.Value)))
+		i += copy(dAtA[i:], m.Value)
+	}
+	if len(m.Action) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(len(m.Action)))
+		i += copy(dAtA[i:], m.Action)
+	}
+	if len(m.Note) > 0 {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(len(m.Note)))
+		i += copy(dAtA[i:], m.Note)
+	}
+	return i, nil
+}
+
+func (m *AdminScreeningEntriesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminScreeningEntriesRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Filter) > 0 {
+		for k, _ := range m.Filter {
+			dAtA[i] = 0xa
+			i++
+			v := m.Filter[k]
+			mapSize := 1 + len(k) + sovAdminrpc(uint64(len(k))) + 1 + len(v) + sovAdminrpc(uint64(len(v)))
+			i = encodeVarintAdminrpc(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintAdminrpc(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintAdminrpc(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	if m.Offset != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(m.Offset))
+	}
+	if m.Limit != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(m.Limit))
+	}
+	return i, nil
+}
+
+func (m *AdminScreeningEntriesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminScreeningEntriesResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ScreeningEntries) > 0 {
+		for _, msg := range m.ScreeningEntries {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintAdminrpc(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *AdminScreeningEntryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminScreeningEntryRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	return i, nil
+}
+
+func (m *ScreeningHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScreeningHistory) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(m.Id))
+	}
+	if len(m.EntryId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(len(m.EntryId)))
+		i += copy(dAtA[i:], m.EntryId)
+	}
+	if m.CreatedAt != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt)))
+		n18, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreatedAt, dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n18
+	}
+	if len(m.Operation) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(len(m.Operation)))
+		i += copy(dAtA[i:], m.Operation)
+	}
+	if len(m.Operator) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(len(m.Operator)))
+		i += copy(dAtA[i:], m.Operator)
+	}
+	if len(m.List) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(len(m.List)))
+		i += copy(dAtA[i:], m.List)
+	}
+	if len(m.Type) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(len(m.Type)))
+		i += copy(dAtA[i:], m.Type)
+	}
+	if len(m.Value) > 0 {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(len(m.Value)))
+		i += copy(dAtA[i:], m.Value)
+	}
+	if len(m.Action) > 0 {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(len(m.Action)))
+		i += copy(dAtA[i:], m.Action)
+	}
+	if len(m.Note) > 0 {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(len(m.Note)))
+		i += copy(dAtA[i:], m.Note)
+	}
+	return i, nil
+}
+
+func (m *AdminScreeningHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminScreeningHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.EntryId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(len(m.EntryId)))
+		i += copy(dAtA[i:], m.EntryId)
+	}
+	if m.Offset != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(m.Offset))
+	}
+	if m.Limit != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(m.Limit))
+	}
+	return i, nil
+}
+
+func (m *AdminScreeningHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminScreeningHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ScreeningHistory) > 0 {
+		for _, msg := range m.ScreeningHistory {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintAdminrpc(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *AdminAudit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminAudit) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(m.Id))
+	}
+	if m.CreatedAt != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt)))
+		n19, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreatedAt, dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n19
+	}
+	if len(m.Operator) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(len(m.Operator)))
+		i += copy(dAtA[i:], m.Operator)
+	}
+	if len(m.Method) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(len(m.Method)))
+		i += copy(dAtA[i:], m.Method)
+	}
+	if len(m.Request) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(len(m.Request)))
+		i += copy(dAtA[i:], m.Request)
+	}
+	if len(m.Code) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(len(m.Code)))
+		i += copy(dAtA[i:], m.Code)
+	}
+	if len(m.Error) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
+	return i, nil
+}
+
+func (m *AdminAuditLogRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminAuditLogRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Filter) > 0 {
+		for k, _ := range m.Filter {
+			dAtA[i] = 0xa
+			i++
+			v := m.Filter[k]
+			mapSize := 1 + len(k) + sovAdminrpc(uint64(len(k))) + 1 + len(v) + sovAdminrpc(uint64(len(v)))
+			i = encodeVarintAdminrpc(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintAdminrpc(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintAdminrpc(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	if m.After != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.After)))
+		n20, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.After, dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n20
+	}
+	if m.Offset != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(m.Offset))
+	}
+	if m.Limit != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(m.Limit))
+	}
+	return i, nil
+}
+
+func (m *AdminAuditLogResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminAuditLogResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AuditLog) > 0 {
+		for _, msg := range m.AuditLog {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintAdminrpc(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *ChannelProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelProposal) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if m.CreatedAt != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt)))
+		n21, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreatedAt, dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n21
+	}
+	if m.UpdatedAt != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt)))
+		n22, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.UpdatedAt, dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n22
+	}
+	if len(m.Action) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(len(m.Action)))
+		i += copy(dAtA[i:], m.Action)
+	}
+	if len(m.NodePubkey) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(len(m.NodePubkey)))
+		i += copy(dAtA[i:], m.NodePubkey)
+	}
+	if len(m.ChanPoint) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(len(m.ChanPoint)))
+		i += copy(dAtA[i:], m.ChanPoint)
+	}
+	if m.Value != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(m.Value))
+	}
+	if len(m.Reason) > 0 {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(len(m.Reason)))
+		i += copy(dAtA[i:], m.Reason)
+	}
+	if len(m.Status) > 0 {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(len(m.Status)))
+		i += copy(dAtA[i:], m.Status)
+	}
+	if len(m.Operator) > 0 {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(len(m.Operator)))
+		i += copy(dAtA[i:], m.Operator)
+	}
+	if len(m.Txid) > 0 {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(len(m.Txid)))
+		i += copy(dAtA[i:], m.Txid)
+	}
+	if len(m.Error) > 0 {
+		dAtA[i] = 0x62
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
+	return i, nil
+}
+
+func (m *AdminChannelProposalsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminChannelProposalsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Filter) > 0 {
+		for k, _ := range m.Filter {
+			dAtA[i] = 0xa
+			i++
+			v := m.Filter[k]
+			mapSize := 1 + len(k) + sovAdminrpc(uint64(len(k))) + 1 + len(v) + sovAdminrpc(uint64(len(v)))
+			i = encodeVarintAdminrpc(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintAdminrpc(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintAdminrpc(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	if m.Offset != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(m.Offset))
+	}
+	if m.Limit != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(m.Limit))
+	}
+	return i, nil
+}
+
+func (m *AdminChannelProposalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminChannelProposalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelProposals) > 0 {
+		for _, msg := range m.ChannelProposals {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintAdminrpc(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *AdminChannelProposalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminChannelProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	return i, nil
+}
+
+func (m *AdminChannelHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminChannelHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.NodePubkey) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(len(m.NodePubkey)))
+		i += copy(dAtA[i:], m.NodePubkey)
+	}
+	if m.Offset != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(m.Offset))
+	}
+	if m.Limit != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(m.Limit))
+	}
+	return i, nil
+}
+
+func (m *AdminChanBackup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminChanBackup) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(m.Id))
+	}
+	if m.Timestamp != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.Timestamp)))
+		n23, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Timestamp, dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n23
+	}
+	if len(m.ChanPoints) > 0 {
+		for _, s := range m.ChanPoints {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.DataSize != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(m.DataSize))
+	}
+	return i, nil
+}
+
+func (m *AdminChanBackupsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminChanBackupsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChanPoint) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(len(m.ChanPoint)))
+		i += copy(dAtA[i:], m.ChanPoint)
+	}
+	if m.Offset != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(m.Offset))
+	}
+	if m.Limit != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(m.Limit))
+	}
+	return i, nil
+}
+
+func (m *AdminChanBackupsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminChanBackupsResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChanBackups) > 0 {
+		for _, msg := range m.ChanBackups {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintAdminrpc(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *AdminChanBackupRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminChanBackupRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(m.Id))
+	}
+	return i, nil
+}
+
+func (m *AdminDiffChanBackupsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminDiffChanBackupsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.FromId != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(m.FromId))
+	}
+	if m.ToId != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(m.ToId))
+	}
+	return i, nil
+}
+
+func (m *AdminDiffChanBackupsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminDiffChanBackupsResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.From != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(m.From.Size()))
+		n24, err := m.From.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n24
+	}
+	if m.To != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(m.To.Size()))
+		n25, err := m.To.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n25
+	}
+	if len(m.Added) > 0 {
+		for _, s := range m.Added {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Removed) > 0 {
+		for _, s := range m.Removed {
+			dAtA[i] = 0x22
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Unchanged) > 0 {
+		for _, s := range m.Unchanged {
+			dAtA[i] = 0x2a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
+}
+
+func (m *AdminVerifyChanBackupResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminVerifyChanBackupResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.ChanBackup != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(m.ChanBackup.Size()))
+		n26, err := m.ChanBackup.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n26
+	}
+	if m.Valid {
+		dAtA[i] = 0x10
+		i++
+		if m.Valid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Error) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
+	return i, nil
+}
+
+func (m *AdminDownloadChanBackupResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminDownloadChanBackupResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.ChanBackup != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(m.ChanBackup.Size()))
+		n27, err := m.ChanBackup.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n27
+	}
+	if len(m.Data) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintAdminrpc(dAtA, i, uint64(len(m.Data)))
+		i += copy(dAtA[i:], m.Data)
+	}
+	return i, nil
+}
+
+func encodeVarintAdminrpc(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *AdminAccountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Filter) > 0 {
+		for k, v := range m.Filter {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovAdminrpc(uint64(len(k))) + 1 + len(v) + sovAdminrpc(uint64(len(v)))
+			n += mapEntrySize + 1 + sovAdminrpc(uint64(mapEntrySize))
+		}
+	}
+	if m.Offset != 0 {
+		n += 1 + sovAdminrpc(uint64(m.Offset))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovAdminrpc(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *AdminAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovAdminrpc(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AdminGetAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	return n
+}
+
+func (m *AdminUpdateAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	if m.Locked {
+		n += 2
+	}
+	return n
+}
+
+func (m *AdminResolveLedgerRecordRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	if m.Direction != 0 {
+		n += 1 + sovAdminrpc(uint64(m.Direction))
+	}
+	if m.Confirm {
+		n += 2
+	}
+	return n
+}
+
+func (m *AdminResolveLedgerRecordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Current != nil {
+		l = m.Current.Size()
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	if m.Proposed != nil {
+		l = m.Proposed.Size()
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	if m.Applied {
+		n += 2
+	}
+	return n
+}
+
+func (m *AdminAdjustBalanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AccountId)
+	if l > 0 {
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	if m.Value != 0 {
+		n += 1 + sovAdminrpc(uint64(m.Value))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	l = len(m.TicketId)
+	if l > 0 {
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	return n
+}
+
+func (m *AdminAdjustBalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result != nil {
+		l = m.Result.Size()
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	if m.Adjustment != nil {
+		l = m.Adjustment.Size()
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	return n
+}
+
+func (m *LedgerAdjustment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LedgerId)
+	if l > 0 {
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	l = len(m.AccountId)
+	if l > 0 {
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	if m.CreatedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt)
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	if m.Value != 0 {
+		n += 1 + sovAdminrpc(uint64(m.Value))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	l = len(m.TicketId)
+	if l > 0 {
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	return n
+}
+
+func (m *AdminAdjustmentsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AccountId)
+	if l > 0 {
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovAdminrpc(uint64(m.Offset))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovAdminrpc(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *AdminAdjustmentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Adjustments) > 0 {
+		for _, e := range m.Adjustments {
+			l = e.Size()
+			n += 1 + l + sovAdminrpc(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AdminHeldRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Filter) > 0 {
+		for k, v := range m.Filter {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovAdminrpc(uint64(len(k))) + 1 + len(v) + sovAdminrpc(uint64(len(v)))
+			n += mapEntrySize + 1 + sovAdminrpc(uint64(mapEntrySize))
+		}
+	}
+	if m.Offset != 0 {
+		n += 1 + sovAdminrpc(uint64(m.Offset))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovAdminrpc(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *AdminReviewHeldRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	return n
+}
+
+func (m *AgentKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	if m.CreatedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt)
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	if m.UpdatedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt)
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	if len(m.Scopes) > 0 {
+		for _, s := range m.Scopes {
+			l = len(s)
+			n += 1 + l + sovAdminrpc(uint64(l))
+		}
+	}
+	if len(m.AccountIds) > 0 {
+		for _, s := range m.AccountIds {
+			l = len(s)
+			n += 1 + l + sovAdminrpc(uint64(l))
+		}
+	}
+	if m.ValueLimit != 0 {
+		n += 1 + sovAdminrpc(uint64(m.ValueLimit))
+	}
+	if m.ExpiresAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt)
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	if m.RevokedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.RevokedAt)
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	return n
+}
+
+func (m *AdminAgentKeysRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Offset != 0 {
+		n += 1 + sovAdminrpc(uint64(m.Offset))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovAdminrpc(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *AdminAgentKeysResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AgentKeys) > 0 {
+		for _, e := range m.AgentKeys {
+			l = e.Size()
+			n += 1 + l + sovAdminrpc(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AdminAgentKeyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	return n
+}
+
+func (m *AdminSaveAgentKeyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	if len(m.Scopes) > 0 {
+		for _, s := range m.Scopes {
+			l = len(s)
+			n += 1 + l + sovAdminrpc(uint64(l))
+		}
+	}
+	if len(m.AccountIds) > 0 {
+		for _, s := range m.AccountIds {
+			l = len(s)
+			n += 1 + l + sovAdminrpc(uint64(l))
+		}
+	}
+	if m.ValueLimit != 0 {
+		n += 1 + sovAdminrpc(uint64(m.ValueLimit))
+	}
+	if m.ExpiresAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt)
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	return n
+}
+
+func (m *AdminCreateAgentKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AgentKey != nil {
+		l = m.AgentKey.Size()
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	l = len(m.Secret)
+	if l > 0 {
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	return n
+}
+
+func (m *AccountLimits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AccountId)
+	if l > 0 {
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	if m.CreatedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt)
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	if m.UpdatedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt)
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	l = len(m.Tier)
+	if l > 0 {
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	if m.DailySend != 0 {
+		n += 1 + sovAdminrpc(uint64(m.DailySend))
+	}
+	if m.WeeklySend != 0 {
+		n += 1 + sovAdminrpc(uint64(m.WeeklySend))
+	}
+	if m.MaxPayment != 0 {
+		n += 1 + sovAdminrpc(uint64(m.MaxPayment))
+	}
+	if m.DailyWithdraw != 0 {
+		n += 1 + sovAdminrpc(uint64(m.DailyWithdraw))
+	}
+	return n
+}
+
+func (m *AdminAccountLimitsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AccountId)
+	if l > 0 {
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	return n
+}
+
+func (m *AdminAccountLimitsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Limits != nil {
+		l = m.Limits.Size()
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	if m.Effective != nil {
+		l = m.Effective.Size()
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	return n
+}
+
+func (m *ScreeningEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	if m.CreatedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt)
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	if m.UpdatedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt)
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	l = len(m.List)
+	if l > 0 {
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	l = len(m.Action)
+	if l > 0 {
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	l = len(m.Note)
+	if l > 0 {
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	return n
+}
+
+func (m *AdminScreeningEntriesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Filter) > 0 {
+		for k, v := range m.Filter {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovAdminrpc(uint64(len(k))) + 1 + len(v) + sovAdminrpc(uint64(len(v)))
+			n += mapEntrySize + 1 + sovAdminrpc(uint64(mapEntrySize))
+		}
+	}
+	if m.Offset != 0 {
+		n += 1 + sovAdminrpc(uint64(m.Offset))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovAdminrpc(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *AdminScreeningEntriesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ScreeningEntries) > 0 {
+		for _, e := range m.ScreeningEntries {
+			l = e.Size()
+			n += 1 + l + sovAdminrpc(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AdminScreeningEntryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	return n
+}
+
+func (m *ScreeningHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovAdminrpc(uint64(m.Id))
+	}
+	l = len(m.EntryId)
+	if l > 0 {
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	if m.CreatedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt)
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	l = len(m.Operation)
+	if l > 0 {
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	l = len(m.List)
+	if l > 0 {
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	l = len(m.Action)
+	if l > 0 {
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	l = len(m.Note)
+	if l > 0 {
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	return n
+}
+
+func (m *AdminScreeningHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EntryId)
+	if l > 0 {
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovAdminrpc(uint64(m.Offset))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovAdminrpc(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *AdminScreeningHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ScreeningHistory) > 0 {
+		for _, e := range m.ScreeningHistory {
+			l = e.Size()
+			n += 1 + l + sovAdminrpc(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AdminAudit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovAdminrpc(uint64(m.Id))
+	}
+	if m.CreatedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt)
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	l = len(m.Method)
+	if l > 0 {
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	l = len(m.Request)
+	if l > 0 {
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	l = len(m.Code)
+	if l > 0 {
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	return n
+}
+
+func (m *AdminAuditLogRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Filter) > 0 {
+		for k, v := range m.Filter {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovAdminrpc(uint64(len(k))) + 1 + len(v) + sovAdminrpc(uint64(len(v)))
+			n += mapEntrySize + 1 + sovAdminrpc(uint64(mapEntrySize))
+		}
+	}
+	if m.After != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.After)
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovAdminrpc(uint64(m.Offset))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovAdminrpc(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *AdminAuditLogResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AuditLog) > 0 {
+		for _, e := range m.AuditLog {
+			l = e.Size()
+			n += 1 + l + sovAdminrpc(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ChannelProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	if m.CreatedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt)
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	if m.UpdatedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt)
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	l = len(m.Action)
+	if l > 0 {
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	l = len(m.NodePubkey)
+	if l > 0 {
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	l = len(m.ChanPoint)
+	if l > 0 {
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	if m.Value != 0 {
+		n += 1 + sovAdminrpc(uint64(m.Value))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	l = len(m.Txid)
+	if l > 0 {
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	return n
+}
+
+func (m *AdminChannelProposalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Filter) > 0 {
+		for k, v := range m.Filter {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovAdminrpc(uint64(len(k))) + 1 + len(v) + sovAdminrpc(uint64(len(v)))
+			n += mapEntrySize + 1 + sovAdminrpc(uint64(mapEntrySize))
+		}
+	}
+	if m.Offset != 0 {
+		n += 1 + sovAdminrpc(uint64(m.Offset))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovAdminrpc(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *AdminChannelProposalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ChannelProposals) > 0 {
+		for _, e := range m.ChannelProposals {
+			l = e.Size()
+			n += 1 + l + sovAdminrpc(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AdminChannelProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	return n
+}
+
+func (m *AdminChannelHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NodePubkey)
+	if l > 0 {
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovAdminrpc(uint64(m.Offset))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovAdminrpc(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *AdminChanBackup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovAdminrpc(uint64(m.Id))
+	}
+	if m.Timestamp != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Timestamp)
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	if len(m.ChanPoints) > 0 {
+		for _, s := range m.ChanPoints {
+			l = len(s)
+			n += 1 + l + sovAdminrpc(uint64(l))
+		}
+	}
+	if m.DataSize != 0 {
+		n += 1 + sovAdminrpc(uint64(m.DataSize))
+	}
+	return n
+}
+
+func (m *AdminChanBackupsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChanPoint)
+	if l > 0 {
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovAdminrpc(uint64(m.Offset))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovAdminrpc(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *AdminChanBackupsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ChanBackups) > 0 {
+		for _, e := range m.ChanBackups {
+			l = e.Size()
+			n += 1 + l + sovAdminrpc(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AdminChanBackupRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovAdminrpc(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *AdminDiffChanBackupsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromId != 0 {
+		n += 1 + sovAdminrpc(uint64(m.FromId))
+	}
+	if m.ToId != 0 {
+		n += 1 + sovAdminrpc(uint64(m.ToId))
+	}
+	return n
+}
+
+func (m *AdminDiffChanBackupsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.From != nil {
+		l = m.From.Size()
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	if m.To != nil {
+		l = m.To.Size()
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	if len(m.Added) > 0 {
+		for _, s := range m.Added {
+			l = len(s)
+			n += 1 + l + sovAdminrpc(uint64(l))
+		}
+	}
+	if len(m.Removed) > 0 {
+		for _, s := range m.Removed {
+			l = len(s)
+			n += 1 + l + sovAdminrpc(uint64(l))
+		}
+	}
+	if len(m.Unchanged) > 0 {
+		for _, s := range m.Unchanged {
+			l = len(s)
+			n += 1 + l + sovAdminrpc(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AdminVerifyChanBackupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChanBackup != nil {
+		l = m.ChanBackup.Size()
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	if m.Valid {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	return n
+}
+
+func (m *AdminDownloadChanBackupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChanBackup != nil {
+		l = m.ChanBackup.Size()
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovAdminrpc(uint64(l))
+	}
+	return n
+}
+
+func sovAdminrpc(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozAdminrpc(x uint64) (n int) {
+	return sovAdminrpc(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *AdminAccountsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForFilter := make([]string, 0, len(this.Filter))
+	for k, _ := range this.Filter {
+		keysForFilter = append(keysForFilter, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForFilter)
+	mapStringForFilter := "map[string]string{"
+	for _, k := range keysForFilter {
+		mapStringForFilter += fmt.Sprintf("%v: %v,", k, this.Filter[k])
+	}
+	mapStringForFilter += "}"
+	s := strings.Join([]string{`&AdminAccountsRequest{`,
+		`Filter:` + mapStringForFilter + `,`,
+		`Offset:` + fmt.Sprintf("%v", this.Offset) + `,`,
+		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AdminAccountsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AdminAccountsResponse{`,
+		`Accounts:` + strings.Replace(fmt.Sprintf("%v", this.Accounts), "Account", "Account", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AdminGetAccountRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AdminGetAccountRequest{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AdminUpdateAccountRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AdminUpdateAccountRequest{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`Locked:` + fmt.Sprintf("%v", this.Locked) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AdminResolveLedgerRecordRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AdminResolveLedgerRecordRequest{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`Direction:` + fmt.Sprintf("%v", this.Direction) + `,`,
+		`Confirm:` + fmt.Sprintf("%v", this.Confirm) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AdminResolveLedgerRecordResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AdminResolveLedgerRecordResponse{`,
+		`Current:` + strings.Replace(fmt.Sprintf("%v", this.Current), "LedgerRecord", "LedgerRecord", 1) + `,`,
+		`Proposed:` + strings.Replace(fmt.Sprintf("%v", this.Proposed), "LedgerRecord", "LedgerRecord", 1) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`Applied:` + fmt.Sprintf("%v", this.Applied) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AdminAdjustBalanceRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AdminAdjustBalanceRequest{`,
+		`AccountId:` + fmt.Sprintf("%v", this.AccountId) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`TicketId:` + fmt.Sprintf("%v", this.TicketId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AdminAdjustBalanceResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AdminAdjustBalanceResponse{`,
+		`Result:` + strings.Replace(fmt.Sprintf("%v", this.Result), "LedgerRecord", "LedgerRecord", 1) + `,`,
+		`Adjustment:` + strings.Replace(fmt.Sprintf("%v", this.Adjustment), "LedgerAdjustment", "LedgerAdjustment", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *LedgerAdjustment) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&LedgerAdjustment{`,
+		`LedgerId:` + fmt.Sprintf("%v", this.LedgerId) + `,`,
+		`AccountId:` + fmt.Sprintf("%v", this.AccountId) + `,`,
+		`CreatedAt:` + strings.Replace(fmt.Sprintf("%v", this.CreatedAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`Operator:` + fmt.Sprintf("%v", this.Operator) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`TicketId:` + fmt.Sprintf("%v", this.TicketId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AdminAdjustmentsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AdminAdjustmentsRequest{`,
+		`AccountId:` + fmt.Sprintf("%v", this.AccountId) + `,`,
+		`Offset:` + fmt.Sprintf("%v", this.Offset) + `,`,
+		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AdminAdjustmentsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AdminAdjustmentsResponse{`,
+		`Adjustments:` + strings.Replace(fmt.Sprintf("%v", this.Adjustments), "LedgerAdjustment", "LedgerAdjustment", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AdminHeldRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForFilter := make([]string, 0, len(this.Filter))
+	for k, _ := range this.Filter {
+		keysForFilter = append(keysForFilter, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForFilter)
+	mapStringForFilter := "map[string]string{"
+	for _, k := range keysForFilter {
+		mapStringForFilter += fmt.Sprintf("%v: %v,", k, this.Filter[k])
+	}
+	mapStringForFilter += "}"
+	s := strings.Join([]string{`&AdminHeldRequest{`,
+		`Filter:` + mapStringForFilter + `,`,
+		`Offset:` + fmt.Sprintf("%v", this.Offset) + `,`,
+		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AdminReviewHeldRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AdminReviewHeldRequest{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AgentKey) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AgentKey{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`CreatedAt:` + strings.Replace(fmt.Sprintf("%v", this.CreatedAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`UpdatedAt:` + strings.Replace(fmt.Sprintf("%v", this.UpdatedAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Scopes:` + fmt.Sprintf("%v", this.Scopes) + `,`,
+		`AccountIds:` + fmt.Sprintf("%v", this.AccountIds) + `,`,
+		`ValueLimit:` + fmt.Sprintf("%v", this.ValueLimit) + `,`,
+		`ExpiresAt:` + strings.Replace(fmt.Sprintf("%v", this.ExpiresAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`RevokedAt:` + strings.Replace(fmt.Sprintf("%v", this.RevokedAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AdminAgentKeysRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AdminAgentKeysRequest{`,
+		`Offset:` + fmt.Sprintf("%v", this.Offset) + `,`,
+		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AdminAgentKeysResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AdminAgentKeysResponse{`,
+		`AgentKeys:` + strings.Replace(fmt.Sprintf("%v", this.AgentKeys), "AgentKey", "AgentKey", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AdminAgentKeyRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AdminAgentKeyRequest{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AdminSaveAgentKeyRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AdminSaveAgentKeyRequest{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Scopes:` + fmt.Sprintf("%v", this.Scopes) + `,`,
+		`AccountIds:` + fmt.Sprintf("%v", this.AccountIds) + `,`,
+		`ValueLimit:` + fmt.Sprintf("%v", this.ValueLimit) + `,`,
+		`ExpiresAt:` + strings.Replace(fmt.Sprintf("%v", this.ExpiresAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AdminCreateAgentKeyResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AdminCreateAgentKeyResponse{`,
+		`AgentKey:` + strings.Replace(fmt.Sprintf("%v", this.AgentKey), "AgentKey", "AgentKey", 1) + `,`,
+		`Secret:` + fmt.Sprintf("%v", this.Secret) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AccountLimits) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AccountLimits{`,
+		`AccountId:` + fmt.Sprintf("%v", this.AccountId) + `,`,
+		`CreatedAt:` + strings.Replace(fmt.Sprintf("%v", this.CreatedAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`UpdatedAt:` + strings.Replace(fmt.Sprintf("%v", this.UpdatedAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Tier:` + fmt.Sprintf("%v", this.Tier) + `,`,
+		`DailySend:` + fmt.Sprintf("%v", this.DailySend) + `,`,
+		`WeeklySend:` + fmt.Sprintf("%v", this.WeeklySend) + `,`,
+		`MaxPayment:` + fmt.Sprintf("%v", this.MaxPayment) + `,`,
+		`DailyWithdraw:` + fmt.Sprintf("%v", this.DailyWithdraw) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AdminAccountLimitsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AdminAccountLimitsRequest{`,
+		`AccountId:` + fmt.Sprintf("%v", this.AccountId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AdminAccountLimitsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AdminAccountLimitsResponse{`,
+		`Limits:` + strings.Replace(fmt.Sprintf("%v", this.Limits), "AccountLimits", "AccountLimits", 1) + `,`,
+		`Effective:` + strings.Replace(fmt.Sprintf("%v", this.Effective), "AccountLimits", "AccountLimits", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ScreeningEntry) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ScreeningEntry{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`CreatedAt:` + strings.Replace(fmt.Sprintf("%v", this.CreatedAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`UpdatedAt:` + strings.Replace(fmt.Sprintf("%v", this.UpdatedAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`List:` + fmt.Sprintf("%v", this.List) + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`Action:` + fmt.Sprintf("%v", this.Action) + `,`,
+		`Note:` + fmt.Sprintf("%v", this.Note) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AdminScreeningEntriesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForFilter := make([]string, 0, len(this.Filter))
+	for k, _ := range this.Filter {
+		keysForFilter = append(keysForFilter, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForFilter)
+	mapStringForFilter := "map[string]string{"
+	for _, k := range keysForFilter {
+		mapStringForFilter += fmt.Sprintf("%v: %v,", k, this.Filter[k])
+	}
+	mapStringForFilter += "}"
+	s := strings.Join([]string{`&AdminScreeningEntriesRequest{`,
+		`Filter:` + mapStringForFilter + `,`,
+		`Offset:` + fmt.Sprintf("%v", this.Offset) + `,`,
+		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AdminScreeningEntriesResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AdminScreeningEntriesResponse{`,
+		`ScreeningEntries:` + strings.Replace(fmt.Sprintf("%v", this.ScreeningEntries), "ScreeningEntry", "ScreeningEntry", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AdminScreeningEntryRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AdminScreeningEntryRequest{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ScreeningHistory) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ScreeningHistory{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`EntryId:` + fmt.Sprintf("%v", this.EntryId) + `,`,
+		`CreatedAt:` + strings.Replace(fmt.Sprintf("%v", this.CreatedAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Operation:` + fmt.Sprintf("%v", this.Operation) + `,`,
+		`Operator:` + fmt.Sprintf("%v", this.Operator) + `,`,
+		`List:` + fmt.Sprintf("%v", this.List) + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`Action:` + fmt.Sprintf("%v", this.Action) + `,`,
+		`Note:` + fmt.Sprintf("%v", this.Note) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AdminScreeningHistoryRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AdminScreeningHistoryRequest{`,
+		`EntryId:` + fmt.Sprintf("%v", this.EntryId) + `,`,
+		`Offset:` + fmt.Sprintf("%v", this.Offset) + `,`,
+		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AdminScreeningHistoryResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AdminScreeningHistoryResponse{`,
+		`ScreeningHistory:` + strings.Replace(fmt.Sprintf("%v", this.ScreeningHistory), "ScreeningHistory", "ScreeningHistory", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AdminAudit) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AdminAudit{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`CreatedAt:` + strings.Replace(fmt.Sprintf("%v", this.CreatedAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Operator:` + fmt.Sprintf("%v", this.Operator) + `,`,
+		`Method:` + fmt.Sprintf("%v", this.Method) + `,`,
+		`Request:` + fmt.Sprintf("%v", this.Request) + `,`,
+		`Code:` + fmt.Sprintf("%v", this.Code) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AdminAuditLogRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForFilter := make([]string, 0, len(this.Filter))
+	for k, _ := range this.Filter {
+		keysForFilter = append(keysForFilter, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForFilter)
+	mapStringForFilter := "map[string]string{"
+	for _, k := range keysForFilter {
+		mapStringForFilter += fmt.Sprintf("%v: %v,", k, this.Filter[k])
+	}
+	mapStringForFilter += "}"
+	s := strings.Join([]string{`&AdminAuditLogRequest{`,
+		`Filter:` + mapStringForFilter + `,`,
+		`After:` + strings.Replace(fmt.Sprintf("%v", this.After), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Offset:` + fmt.Sprintf("%v", this.Offset) + `,`,
+		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AdminAuditLogResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AdminAuditLogResponse{`,
+		`AuditLog:` + strings.Replace(fmt.Sprintf("%v", this.AuditLog), "AdminAudit", "AdminAudit", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ChannelProposal) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ChannelProposal{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`CreatedAt:` + strings.Replace(fmt.Sprintf("%v", this.CreatedAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`UpdatedAt:` + strings.Replace(fmt.Sprintf("%v", this.UpdatedAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Action:` + fmt.Sprintf("%v", this.Action) + `,`,
+		`NodePubkey:` + fmt.Sprintf("%v", this.NodePubkey) + `,`,
+		`ChanPoint:` + fmt.Sprintf("%v", this.ChanPoint) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`Operator:` + fmt.Sprintf("%v", this.Operator) + `,`,
+		`Txid:` + fmt.Sprintf("%v", this.Txid) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AdminChannelProposalsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForFilter := make([]string, 0, len(this.Filter))
+	for k, _ := range this.Filter {
+		keysForFilter = append(keysForFilter, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForFilter)
+	mapStringForFilter := "map[string]string{"
+	for _, k := range keysForFilter {
+		mapStringForFilter += fmt.Sprintf("%v: %v,", k, this.Filter[k])
+	}
+	mapStringForFilter += "}"
+	s := strings.Join([]string{`&AdminChannelProposalsRequest{`,
+		`Filter:` + mapStringForFilter + `,`,
+		`Offset:` + fmt.Sprintf("%v", this.Offset) + `,`,
+		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AdminChannelProposalsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AdminChannelProposalsResponse{`,
+		`ChannelProposals:` + strings.Replace(fmt.Sprintf("%v", this.ChannelProposals), "ChannelProposal", "ChannelProposal", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AdminChannelProposalRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AdminChannelProposalRequest{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AdminChannelHistoryRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AdminChannelHistoryRequest{`,
+		`NodePubkey:` + fmt.Sprintf("%v", this.NodePubkey) + `,`,
+		`Offset:` + fmt.Sprintf("%v", this.Offset) + `,`,
+		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AdminChanBackup) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AdminChanBackup{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`Timestamp:` + strings.Replace(fmt.Sprintf("%v", this.Timestamp), "Timestamp", "types.Timestamp", 1) + `,`,
+		`ChanPoints:` + fmt.Sprintf("%v", this.ChanPoints) + `,`,
+		`DataSize:` + fmt.Sprintf("%v", this.DataSize) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AdminChanBackupsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AdminChanBackupsRequest{`,
+		`ChanPoint:` + fmt.Sprintf("%v", this.ChanPoint) + `,`,
+		`Offset:` + fmt.Sprintf("%v", this.Offset) + `,`,
+		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AdminChanBackupsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AdminChanBackupsResponse{`,
+		`ChanBackups:` + strings.Replace(fmt.Sprintf("%v", this.ChanBackups), "AdminChanBackup", "AdminChanBackup", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AdminChanBackupRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AdminChanBackupRequest{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AdminDiffChanBackupsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AdminDiffChanBackupsRequest{`,
+		`FromId:` + fmt.Sprintf("%v", this.FromId) + `,`,
+		`ToId:` + fmt.Sprintf("%v", this.ToId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AdminDiffChanBackupsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AdminDiffChanBackupsResponse{`,
+		`From:` + strings.Replace(fmt.Sprintf("%v", this.From), "AdminChanBackup", "AdminChanBackup", 1) + `,`,
+		`To:` + strings.Replace(fmt.Sprintf("%v", this.To), "AdminChanBackup", "AdminChanBackup", 1) + `,`,
+		`Added:` + fmt.Sprintf("%v", this.Added) + `,`,
+		`Removed:` + fmt.Sprintf("%v", this.Removed) + `,`,
+		`Unchanged:` + fmt.Sprintf("%v", this.Unchanged) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AdminVerifyChanBackupResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AdminVerifyChanBackupResponse{`,
+		`ChanBackup:` + strings.Replace(fmt.Sprintf("%v", this.ChanBackup), "AdminChanBackup", "AdminChanBackup", 1) + `,`,
+		`Valid:` + fmt.Sprintf("%v", this.Valid) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AdminDownloadChanBackupResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AdminDownloadChanBackupResponse{`,
+		`ChanBackup:` + strings.Replace(fmt.Sprintf("%v", this.ChanBackup), "AdminChanBackup", "AdminChanBackup", 1) + `,`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringAdminrpc(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *AdminAccountsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminAccountsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Filter == nil {
+				m.Filter = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAdminrpc
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAdminrpc
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthAdminrpc
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthAdminrpc
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAdminrpc
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthAdminrpc
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthAdminrpc
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipAdminrpc(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthAdminrpc
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Filter[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdminAccountsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminAccountsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accounts = append(m.Accounts, &Account{})
+			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdminGetAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminGetAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminGetAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdminUpdateAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminUpdateAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminUpdateAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Locked = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdminResolveLedgerRecordRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminResolveLedgerRecordRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminResolveLedgerRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+			}
+			m.Direction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Direction |= LedgerRecord_Direction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Confirm", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Confirm = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdminResolveLedgerRecordResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminResolveLedgerRecordResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminResolveLedgerRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Current", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Current == nil {
+				m.Current = &LedgerRecord{}
+			}
+			if err := m.Current.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proposed == nil {
+				m.Proposed = &LedgerRecord{}
+			}
+			if err := m.Proposed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Applied", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Applied = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdminAdjustBalanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminAdjustBalanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminAdjustBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Value |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TicketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TicketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdminAdjustBalanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminAdjustBalanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminAdjustBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Result == nil {
+				m.Result = &LedgerRecord{}
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Adjustment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Adjustment == nil {
+				m.Adjustment = &LedgerAdjustment{}
+			}
+			if err := m.Adjustment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LedgerAdjustment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LedgerAdjustment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LedgerAdjustment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LedgerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LedgerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreatedAt == nil {
+				m.CreatedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Value |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TicketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TicketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdminAdjustmentsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminAdjustmentsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminAdjustmentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdminAdjustmentsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminAdjustmentsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminAdjustmentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Adjustments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Adjustments = append(m.Adjustments, &LedgerAdjustment{})
+			if err := m.Adjustments[len(m.Adjustments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdminHeldRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminHeldRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminHeldRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Filter == nil {
+				m.Filter = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAdminrpc
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAdminrpc
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthAdminrpc
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthAdminrpc
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAdminrpc
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthAdminrpc
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthAdminrpc
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipAdminrpc(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthAdminrpc
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Filter[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdminReviewHeldRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminReviewHeldRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminReviewHeldRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AgentKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AgentKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AgentKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreatedAt == nil {
+				m.CreatedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UpdatedAt == nil {
+				m.UpdatedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.UpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scopes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scopes = append(m.Scopes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountIds = append(m.AccountIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueLimit", wireType)
+			}
+			m.ValueLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValueLimit |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiresAt == nil {
+				m.ExpiresAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevokedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RevokedAt == nil {
+				m.RevokedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.RevokedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdminAgentKeysRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminAgentKeysRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminAgentKeysRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdminAgentKeysResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminAgentKeysResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminAgentKeysResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AgentKeys = append(m.AgentKeys, &AgentKey{})
+			if err := m.AgentKeys[len(m.AgentKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdminAgentKeyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminAgentKeyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminAgentKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdminSaveAgentKeyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminSaveAgentKeyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminSaveAgentKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scopes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scopes = append(m.Scopes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountIds = append(m.AccountIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueLimit", wireType)
+			}
+			m.ValueLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValueLimit |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiresAt == nil {
+				m.ExpiresAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdminCreateAgentKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminCreateAgentKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminCreateAgentKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AgentKey == nil {
+				m.AgentKey = &AgentKey{}
+			}
+			if err := m.AgentKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Secret = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountLimits) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountLimits: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountLimits: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreatedAt == nil {
+				m.CreatedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UpdatedAt == nil {
+				m.UpdatedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.UpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DailySend", wireType)
+			}
+			m.DailySend = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DailySend |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeeklySend", wireType)
+			}
+			m.WeeklySend = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WeeklySend |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPayment", wireType)
+			}
+			m.MaxPayment = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPayment |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DailyWithdraw", wireType)
+			}
+			m.DailyWithdraw = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DailyWithdraw |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdminAccountLimitsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminAccountLimitsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminAccountLimitsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdminAccountLimitsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminAccountLimitsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminAccountLimitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Limits == nil {
+				m.Limits = &AccountLimits{}
+			}
+			if err := m.Limits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Effective", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Effective == nil {
+				m.Effective = &AccountLimits{}
+			}
+			if err := m.Effective.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScreeningEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScreeningEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScreeningEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreatedAt == nil {
+				m.CreatedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UpdatedAt == nil {
+				m.UpdatedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.UpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.List = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Action = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Note", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Note = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdminScreeningEntriesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminScreeningEntriesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminScreeningEntriesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Filter == nil {
+				m.Filter = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAdminrpc
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAdminrpc
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthAdminrpc
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthAdminrpc
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAdminrpc
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthAdminrpc
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthAdminrpc
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipAdminrpc(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthAdminrpc
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Filter[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdminScreeningEntriesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminScreeningEntriesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminScreeningEntriesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScreeningEntries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScreeningEntries = append(m.ScreeningEntries, &ScreeningEntry{})
+			if err := m.ScreeningEntries[len(m.ScreeningEntries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdminScreeningEntryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminScreeningEntryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminScreeningEntryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScreeningHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScreeningHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScreeningHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntryId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EntryId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreatedAt == nil {
+				m.CreatedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.List = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Action = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Note", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Note = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdminScreeningHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminScreeningHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminScreeningHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntryId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EntryId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdminScreeningHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminScreeningHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminScreeningHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScreeningHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScreeningHistory = append(m.ScreeningHistory, &ScreeningHistory{})
+			if err := m.ScreeningHistory[len(m.ScreeningHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdminAudit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminAudit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminAudit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreatedAt == nil {
+				m.CreatedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Method = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Request = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Code = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdminAuditLogRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminAuditLogRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminAuditLogRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Filter == nil {
+				m.Filter = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAdminrpc
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAdminrpc
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthAdminrpc
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthAdminrpc
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAdminrpc
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthAdminrpc
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthAdminrpc
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipAdminrpc(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthAdminrpc
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Filter[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field After", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.After == nil {
+				m.After = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.After, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdminAuditLogResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminAuditLogResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminAuditLogResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuditLog", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuditLog = append(m.AuditLog, &AdminAudit{})
+			if err := m.AuditLog[len(m.AuditLog)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreatedAt == nil {
+				m.CreatedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UpdatedAt == nil {
+				m.UpdatedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.UpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Action = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodePubkey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodePubkey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChanPoint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChanPoint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Value |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdminChannelProposalsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminChannelProposalsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminChannelProposalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Filter == nil {
+				m.Filter = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAdminrpc
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAdminrpc
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthAdminrpc
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthAdminrpc
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAdminrpc
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthAdminrpc
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthAdminrpc
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipAdminrpc(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthAdminrpc
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Filter[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdminChannelProposalsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminChannelProposalsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminChannelProposalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelProposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelProposals = append(m.ChannelProposals, &ChannelProposal{})
+			if err := m.ChannelProposals[len(m.ChannelProposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdminChannelProposalRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminChannelProposalRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminChannelProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdminChannelHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminChannelHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminChannelHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodePubkey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodePubkey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdminChanBackup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminChanBackup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminChanBackup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Timestamp == nil {
+				m.Timestamp = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChanPoints", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChanPoints = append(m.ChanPoints, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSize", wireType)
+			}
+			m.DataSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdminChanBackupsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminChanBackupsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminChanBackupsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChanPoint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChanPoint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-func sozAdminrpc(x uint64) (n int) {
-	return sovAdminrpc(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (this *AdminAccountsRequest) String() string {
-	if this == nil {
-		return "nil"
-	}
-	keysForFilter := make([]string, 0, len(this.Filter))
-	for k, _ := range this.Filter {
-		keysForFilter = append(keysForFilter, k)
-	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForFilter)
-	mapStringForFilter := "map[string]string{"
-	for _, k := range keysForFilter {
-		mapStringForFilter += fmt.Sprintf("%v: %v,", k, this.Filter[k])
-	}
-	mapStringForFilter += "}"
-	s := strings.Join([]string{`&AdminAccountsRequest{`,
-		`Filter:` + mapStringForFilter + `,`,
-		`Offset:` + fmt.Sprintf("%v", this.Offset) + `,`,
-		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *AdminAccountsResponse) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&AdminAccountsResponse{`,
-		`Accounts:` + strings.Replace(fmt.Sprintf("%v", this.Accounts), "Account", "Account", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *AdminGetAccountRequest) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&AdminGetAccountRequest{`,
-		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
-		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *AdminUpdateAccountRequest) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&AdminUpdateAccountRequest{`,
-		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
-		`Locked:` + fmt.Sprintf("%v", this.Locked) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringAdminrpc(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return nil
 }
-func (m *AdminAccountsRequest) Unmarshal(dAtA []byte) error {
+func (m *AdminChanBackupsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -973,15 +16718,15 @@ func (m *AdminAccountsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AdminAccountsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AdminChanBackupsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AdminAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AdminChanBackupsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChanBackups", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1008,109 +16753,141 @@ func (m *AdminAccountsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Filter == nil {
-				m.Filter = make(map[string]string)
+			m.ChanBackups = append(m.ChanBackups, &AdminChanBackup{})
+			if err := m.ChanBackups[len(m.ChanBackups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowAdminrpc
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdminChanBackupRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminChanBackupRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminChanBackupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowAdminrpc
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthAdminrpc
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthAdminrpc
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowAdminrpc
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthAdminrpc
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthAdminrpc
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipAdminrpc(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthAdminrpc
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Filter[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdminDiffChanBackupsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminDiffChanBackupsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminDiffChanBackupsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromId", wireType)
 			}
-			m.Offset = 0
+			m.FromId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdminrpc
@@ -1120,16 +16897,16 @@ func (m *AdminAccountsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Offset |= int32(b&0x7F) << shift
+				m.FromId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToId", wireType)
 			}
-			m.Limit = 0
+			m.ToId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdminrpc
@@ -1139,7 +16916,7 @@ func (m *AdminAccountsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Limit |= int32(b&0x7F) << shift
+				m.ToId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1168,7 +16945,7 @@ func (m *AdminAccountsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AdminAccountsResponse) Unmarshal(dAtA []byte) error {
+func (m *AdminDiffChanBackupsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1191,15 +16968,15 @@ func (m *AdminAccountsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AdminAccountsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AdminDiffChanBackupsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AdminAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AdminDiffChanBackupsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1226,11 +17003,145 @@ func (m *AdminAccountsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Accounts = append(m.Accounts, &Account{})
-			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.From == nil {
+				m.From = &AdminChanBackup{}
+			}
+			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.To == nil {
+				m.To = &AdminChanBackup{}
+			}
+			if err := m.To.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Added", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Added = append(m.Added, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Removed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Removed = append(m.Removed, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unchanged", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unchanged = append(m.Unchanged, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdminrpc(dAtA[iNdEx:])
@@ -1255,7 +17166,7 @@ func (m *AdminAccountsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AdminGetAccountRequest) Unmarshal(dAtA []byte) error {
+func (m *AdminVerifyChanBackupResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1278,17 +17189,17 @@ func (m *AdminGetAccountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AdminGetAccountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AdminVerifyChanBackupResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AdminGetAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AdminVerifyChanBackupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChanBackup", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdminrpc
@@ -1298,27 +17209,51 @@ func (m *AdminGetAccountRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAdminrpc
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAdminrpc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			if m.ChanBackup == nil {
+				m.ChanBackup = &AdminChanBackup{}
+			}
+			if err := m.ChanBackup.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Valid = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1346,7 +17281,7 @@ func (m *AdminGetAccountRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1372,7 +17307,7 @@ func (m *AdminGetAccountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AdminUpdateAccountRequest) Unmarshal(dAtA []byte) error {
+func (m *AdminDownloadChanBackupResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1395,17 +17330,17 @@ func (m *AdminUpdateAccountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AdminUpdateAccountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AdminDownloadChanBackupResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AdminUpdateAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AdminDownloadChanBackupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChanBackup", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdminrpc
@@ -1415,29 +17350,33 @@ func (m *AdminUpdateAccountRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAdminrpc
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAdminrpc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			if m.ChanBackup == nil {
+				m.ChanBackup = &AdminChanBackup{}
+			}
+			if err := m.ChanBackup.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Locked", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var v int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdminrpc
@@ -1447,12 +17386,26 @@ func (m *AdminUpdateAccountRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Locked = bool(v != 0)
+			if byteLen < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdminrpc(dAtA[iNdEx:])
@@ -0,0 +1,81 @@
+package adminrpcserver
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"git.coinninja.net/backend/thunderdome/store"
+	"git.coinninja.net/backend/thunderdome/tdrpc"
+)
+
+func (s *adminRPCServer) GetAccountLimits(ctx context.Context, request *tdrpc.AdminAccountLimitsRequest) (*tdrpc.AdminAccountLimitsResponse, error) {
+
+	if request.AccountId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid account id")
+	}
+
+	_, err := s.store.GetAccountByID(ctx, request.AccountId)
+	if err == store.ErrNotFound {
+		return nil, status.Errorf(codes.NotFound, "account not found")
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not fetch account: %v", err)
+	}
+
+	// If there are no limits set, the account uses the default tier
+	limits, err := s.store.GetAccountLimits(ctx, request.AccountId)
+	if err == store.ErrNotFound {
+		limits = &tdrpc.AccountLimits{AccountId: request.AccountId}
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not fetch account limits: %v", err)
+	}
+
+	return &tdrpc.AdminAccountLimitsResponse{
+		Limits:    limits,
+		Effective: limits.Effective(),
+	}, nil
+
+}
+
+func (s *adminRPCServer) UpdateAccountLimits(ctx context.Context, request *tdrpc.AccountLimits) (*tdrpc.AdminAccountLimitsResponse, error) {
+
+	// Ensure the user has write access
+	if err := requireWrite(ctx); err != nil {
+		return nil, err
+	}
+
+	if request.AccountId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid account id")
+	}
+
+	if request.Tier != "" && !tdrpc.ValidLimitTier(request.Tier) {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid tier %s", request.Tier)
+	}
+
+	for _, limit := range []int64{request.DailySend, request.WeeklySend, request.MaxPayment, request.DailyWithdraw} {
+		if limit < tdrpc.LimitUnlimited {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid limit %d", limit)
+		}
+	}
+
+	_, err := s.store.GetAccountByID(ctx, request.AccountId)
+	if err == store.ErrNotFound {
+		return nil, status.Errorf(codes.NotFound, "account not found")
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not fetch account: %v", err)
+	}
+
+	limits, err := s.store.SaveAccountLimits(ctx, request)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not update account limits: %v", err)
+	}
+
+	s.logger.Infow("Account Limits Updated", "account_id", limits.AccountId, "tier", limits.Tier)
+
+	return &tdrpc.AdminAccountLimitsResponse{
+		Limits:    limits,
+		Effective: limits.Effective(),
+	}, nil
+
+}
@@ -0,0 +1,94 @@
+package adminrpcserver
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"git.coinninja.net/backend/thunderdome/store"
+	"git.coinninja.net/backend/thunderdome/tdrpc"
+)
+
+// AdjustBalance credits or debits an account through the ledger so the balance always matches the ledger
+func (s *adminRPCServer) AdjustBalance(ctx context.Context, request *tdrpc.AdminAdjustBalanceRequest) (*tdrpc.AdminAdjustBalanceResponse, error) {
+
+	// Ensure the user has write access
+	if err := requireWrite(ctx); err != nil {
+		return nil, err
+	}
+
+	if request.Value == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid value")
+	}
+
+	if request.Reason == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Reason is required")
+	}
+
+	account, err := s.store.GetAccountByID(ctx, request.AccountId)
+	if err == store.ErrNotFound {
+		return nil, status.Errorf(codes.NotFound, "account not found")
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not fetch account: %v", err)
+	}
+
+	// Generate a random id for the adjustment
+	randomID := make([]byte, 32)
+	if _, err := rand.Read(randomID); err != nil {
+		return nil, status.Errorf(codes.Internal, "could not get random id")
+	}
+
+	// Credits are inbound and debits are outbound
+	lr := &tdrpc.LedgerRecord{
+		Id:        tdrpc.AdjustmentLedgerRecordIdPrefix + hex.EncodeToString(randomID),
+		AccountId: account.Id,
+		Status:    tdrpc.COMPLETED,
+		Type:      tdrpc.ADJUSTMENT,
+		Direction: tdrpc.IN,
+		Value:     request.Value,
+		Memo:      request.Reason,
+	}
+	if request.Value < 0 {
+		lr.Direction = tdrpc.OUT
+		lr.Value = -request.Value
+	}
+
+	adjustment, err := s.store.AdjustBalance(ctx, lr, &tdrpc.LedgerAdjustment{
+		Value:    request.Value,
+		Operator: getOperator(ctx),
+		Reason:   request.Reason,
+		TicketId: request.TicketId,
+	})
+	if err != nil {
+		// A valid message is provided with this error
+		if status.Code(err) == codes.InvalidArgument {
+			return nil, err
+		}
+		return nil, status.Errorf(codes.Internal, "Could not adjust balance: %v", err)
+	}
+
+	s.logger.Infow("Balance Adjusted", "id", lr.Id, "account_id", account.Id, "value", request.Value, "reason", request.Reason, "ticket_id", request.TicketId, "operator", adjustment.Operator)
+
+	return &tdrpc.AdminAdjustBalanceResponse{
+		Result:     lr,
+		Adjustment: adjustment,
+	}, nil
+
+}
+
+// ListAdjustments returns the manual adjustments, newest first
+func (s *adminRPCServer) ListAdjustments(ctx context.Context, request *tdrpc.AdminAdjustmentsRequest) (*tdrpc.AdminAdjustmentsResponse, error) {
+
+	adjustments, err := s.store.GetLedgerAdjustments(ctx, request.AccountId, int(request.Offset), int(request.Limit))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Error on GetLedgerAdjustments: %v", err)
+	}
+
+	return &tdrpc.AdminAdjustmentsResponse{
+		Adjustments: adjustments,
+	}, nil
+
+}
@@ -2,31 +2,39 @@ package adminrpcserver
 
 import (
 	"git.coinninja.net/backend/cnauth"
+	"github.com/lightningnetwork/lnd/lnrpc"
 	"go.uber.org/zap"
 
 	"git.coinninja.net/backend/thunderdome/tdrpc"
+	"git.coinninja.net/backend/thunderdome/tdrpc/sender"
 )
 
 type adminRPCServer struct {
 	logger       *zap.SugaredLogger
 	store        tdrpc.Store
+	cbstore      tdrpc.ChanBackupStore
+	lclient      lnrpc.LightningClient
 	cnAuthClient *cnauth.Client
+	sender       *sender.Sender
 }
 
 // NewAdminRPCServer creates the server
-func NewAdminRPCServer(store tdrpc.Store, cnAuthClient *cnauth.Client) (tdrpc.AdminRPCServer, error) {
+func NewAdminRPCServer(store tdrpc.Store, cbstore tdrpc.ChanBackupStore, lclient lnrpc.LightningClient, cnAuthClient *cnauth.Client) (tdrpc.AdminRPCServer, error) {
 
-	return newAdminRPCServer(store, cnAuthClient)
+	return newAdminRPCServer(store, cbstore, lclient, cnAuthClient)
 
 }
 
-func newAdminRPCServer(store tdrpc.Store, cnAuthClient *cnauth.Client) (*adminRPCServer, error) {
+func newAdminRPCServer(store tdrpc.Store, cbstore tdrpc.ChanBackupStore, lclient lnrpc.LightningClient, cnAuthClient *cnauth.Client) (*adminRPCServer, error) {
 
 	// Return the server
 	s := &adminRPCServer{
 		logger:       zap.S().With("package", "adminrpc"),
 		store:        store,
+		cbstore:      cbstore,
+		lclient:      lclient,
 		cnAuthClient: cnAuthClient,
+		sender:       sender.NewSender(store, lclient),
 	}
 
 	if cnAuthClient == nil {
@@ -0,0 +1,202 @@
+package adminrpcserver
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	emptypb "github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"git.coinninja.net/backend/thunderdome/store"
+	"git.coinninja.net/backend/thunderdome/tdrpc"
+)
+
+func (s *adminRPCServer) ListAgentKeys(ctx context.Context, request *tdrpc.AdminAgentKeysRequest) (*tdrpc.AdminAgentKeysResponse, error) {
+
+	agentKeys, err := s.store.GetAgentKeys(ctx, int(request.Offset), int(request.Limit))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Error on GetAgentKeys: %v", err)
+	}
+
+	return &tdrpc.AdminAgentKeysResponse{
+		AgentKeys: agentKeys,
+	}, nil
+
+}
+
+func (s *adminRPCServer) GetAgentKey(ctx context.Context, request *tdrpc.AdminAgentKeyRequest) (*tdrpc.AgentKey, error) {
+
+	agentKey, err := s.store.GetAgentKey(ctx, request.Id)
+	if err == store.ErrNotFound {
+		return nil, status.Errorf(codes.NotFound, "agent key not found")
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not fetch agent key: %v", err)
+	}
+
+	return agentKey, nil
+
+}
+
+func (s *adminRPCServer) CreateAgentKey(ctx context.Context, request *tdrpc.AdminSaveAgentKeyRequest) (*tdrpc.AdminCreateAgentKeyResponse, error) {
+
+	// Ensure the user has write access
+	if err := requireWrite(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := validateAgentKeyRequest(request); err != nil {
+		return nil, err
+	}
+
+	// Generate the secret, only the hash is stored
+	secretBytes := make([]byte, 32)
+	if _, err := rand.Read(secretBytes); err != nil {
+		return nil, status.Errorf(codes.Internal, "could not generate secret")
+	}
+	secret := tdrpc.AgentKeyPrefix + hex.EncodeToString(secretBytes)
+
+	agentKey, err := s.store.CreateAgentKey(ctx, &tdrpc.AgentKey{
+		Name:       request.Name,
+		Scopes:     request.Scopes,
+		AccountIds: request.AccountIds,
+		ValueLimit: request.ValueLimit,
+		ExpiresAt:  request.ExpiresAt,
+	}, tdrpc.HashAgentKeySecret(secret))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not create agent key: %v", err)
+	}
+
+	s.logger.Infow("Agent Key Created", "agent_key_id", agentKey.Id, "name", agentKey.Name)
+
+	return &tdrpc.AdminCreateAgentKeyResponse{
+		AgentKey: agentKey,
+		Secret:   secret,
+	}, nil
+
+}
+
+func (s *adminRPCServer) UpdateAgentKey(ctx context.Context, request *tdrpc.AdminSaveAgentKeyRequest) (*tdrpc.AgentKey, error) {
+
+	// Ensure the user has write access
+	if err := requireWrite(ctx); err != nil {
+		return nil, err
+	}
+
+	if request.Id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid id")
+	}
+
+	if err := validateAgentKeyRequest(request); err != nil {
+		return nil, err
+	}
+
+	agentKey, err := s.store.GetAgentKey(ctx, request.Id)
+	if err == store.ErrNotFound {
+		return nil, status.Errorf(codes.NotFound, "agent key not found")
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not fetch agent key: %v", err)
+	}
+
+	agentKey.Name = request.Name
+	agentKey.Scopes = request.Scopes
+	agentKey.AccountIds = request.AccountIds
+	agentKey.ValueLimit = request.ValueLimit
+	agentKey.ExpiresAt = request.ExpiresAt
+
+	agentKey, err = s.store.SaveAgentKey(ctx, agentKey)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not update agent key: %v", err)
+	}
+
+	return agentKey, nil
+
+}
+
+func (s *adminRPCServer) RevokeAgentKey(ctx context.Context, request *tdrpc.AdminAgentKeyRequest) (*tdrpc.AgentKey, error) {
+
+	// Ensure the user has write access
+	if err := requireWrite(ctx); err != nil {
+		return nil, err
+	}
+
+	agentKey, err := s.store.GetAgentKey(ctx, request.Id)
+	if err == store.ErrNotFound {
+		return nil, status.Errorf(codes.NotFound, "agent key not found")
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not fetch agent key: %v", err)
+	}
+
+	// Already revoked
+	if agentKey.RevokedAt != nil {
+		return agentKey, nil
+	}
+
+	now := time.Now().UTC()
+	agentKey.RevokedAt = &now
+
+	agentKey, err = s.store.SaveAgentKey(ctx, agentKey)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not revoke agent key: %v", err)
+	}
+
+	s.logger.Infow("Agent Key Revoked", "agent_key_id", agentKey.Id, "name", agentKey.Name)
+
+	return agentKey, nil
+
+}
+
+func (s *adminRPCServer) DeleteAgentKey(ctx context.Context, request *tdrpc.AdminAgentKeyRequest) (*emptypb.Empty, error) {
+
+	// Ensure the user has write access
+	if err := requireWrite(ctx); err != nil {
+		return nil, err
+	}
+
+	err := s.store.DeleteAgentKey(ctx, request.Id)
+	if err == store.ErrNotFound {
+		return nil, status.Errorf(codes.NotFound, "agent key not found")
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not delete agent key: %v", err)
+	}
+
+	s.logger.Infow("Agent Key Deleted", "agent_key_id", request.Id)
+
+	return &emptypb.Empty{}, nil
+
+}
+
+// validateAgentKeyRequest ensures the scopes, accounts and limits are valid
+func validateAgentKeyRequest(request *tdrpc.AdminSaveAgentKeyRequest) error {
+
+	if request.Name == "" {
+		return status.Errorf(codes.InvalidArgument, "Name is required")
+	}
+
+	if len(request.Scopes) == 0 {
+		return status.Errorf(codes.InvalidArgument, "At least one scope is required")
+	}
+	for _, scope := range request.Scopes {
+		if _, ok := tdrpc.AgentKeyScopes[scope]; !ok {
+			return status.Errorf(codes.InvalidArgument, "Invalid scope %s", scope)
+		}
+	}
+
+	if len(request.AccountIds) == 0 {
+		return status.Errorf(codes.InvalidArgument, "At least one account id is required")
+	}
+	for _, accountID := range request.AccountIds {
+		if accountID == "" {
+			return status.Errorf(codes.InvalidArgument, "Invalid account id")
+		}
+	}
+
+	if request.ValueLimit < 0 {
+		return status.Errorf(codes.InvalidArgument, "Invalid value limit")
+	}
+
+	return nil
+
+}
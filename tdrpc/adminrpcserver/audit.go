@@ -0,0 +1,141 @@
+package adminrpcserver
+
+import (
+	"context"
+	"encoding/json"
+	"path"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"git.coinninja.net/backend/thunderdome/tdrpc"
+)
+
+const auditRedacted = "[REDACTED]"
+
+// auditReadMethods are the admin RPC methods that do not change anything and are not audited
+// Any new method is audited until it's added here
+var auditReadMethods = map[string]bool{
+	"ListAccounts":         true,
+	"GetAccount":           true,
+	"Ledger":               true,
+	"ListAdjustments":      true,
+	"ListHeld":             true,
+	"ListAgentKeys":        true,
+	"GetAgentKey":          true,
+	"GetAccountLimits":     true,
+	"ListScreeningEntries": true,
+	"ListScreeningHistory": true,
+	"ListAuditLog":         true,
+	"ListChannelProposals": true,
+	"ListChannelHistory":   true,
+	"ListChanBackups":      true,
+	"DiffChanBackups":      true,
+	"VerifyChanBackup":     true,
+	"DownloadChanBackup":   true,
+}
+
+// auditRedactFields are request fields that are never stored in the audit log
+var auditRedactFields = map[string]bool{
+	"secret":    true,
+	"token":     true,
+	"password":  true,
+	"signature": true,
+}
+
+// UnaryInterceptorOverride records every change made through the admin API in the audit log
+func (s *adminRPCServer) UnaryInterceptorOverride(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+
+	if !auditMethod(info.FullMethod) {
+		return handler(ctx, req)
+	}
+
+	resp, err := handler(ctx, req)
+
+	audit := &tdrpc.AdminAudit{
+		Operator: getOperator(ctx),
+		Method:   info.FullMethod,
+		Request:  auditRequest(req),
+		Code:     status.Code(err).String(),
+	}
+	if err != nil {
+		audit.Error = status.Convert(err).Message()
+	}
+
+	// Ensure the audit is recorded even if the request was cancelled
+	if _, auditErr := s.store.CreateAdminAudit(context.Background(), audit); auditErr != nil {
+		s.logger.Errorw("CreateAdminAudit Error", "method", audit.Method, "operator", audit.Operator, "code", audit.Code, "error", auditErr)
+	}
+
+	return resp, err
+
+}
+
+// ListAuditLog returns the audit log, newest first
+func (s *adminRPCServer) ListAuditLog(ctx context.Context, request *tdrpc.AdminAuditLogRequest) (*tdrpc.AdminAuditLogResponse, error) {
+
+	// Ensure after has a value
+	var after time.Time
+	if request.After != nil {
+		after = *request.After
+	}
+
+	auditLog, err := s.store.GetAdminAuditLog(ctx, request.Filter, after, int(request.Offset), int(request.Limit))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Error on GetAdminAuditLog: %v", err)
+	}
+
+	return &tdrpc.AdminAuditLogResponse{
+		AuditLog: auditLog,
+	}, nil
+
+}
+
+// auditMethod returns true if the full method name is a method that could change something
+func auditMethod(fullMethod string) bool {
+	return !auditReadMethods[path.Base(fullMethod)]
+}
+
+// auditRequest returns the request as JSON with any secrets redacted
+func auditRequest(req interface{}) string {
+
+	data, err := json.Marshal(req)
+	if err != nil {
+		return ""
+	}
+
+	var fields interface{}
+	if err = json.Unmarshal(data, &fields); err != nil {
+		return ""
+	}
+
+	data, err = json.Marshal(auditRedact(fields))
+	if err != nil {
+		return ""
+	}
+
+	return string(data)
+
+}
+
+// auditRedact replaces the values of any secret fields
+func auditRedact(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			if auditRedactFields[strings.ToLower(key)] {
+				v[key] = auditRedacted
+			} else {
+				v[key] = auditRedact(field)
+			}
+		}
+	case []interface{}:
+		for i, field := range v {
+			v[i] = auditRedact(field)
+		}
+	}
+	return value
+}
@@ -0,0 +1,109 @@
+package adminrpcserver
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	_ "git.coinninja.net/backend/thunderdome/conf"
+	"git.coinninja.net/backend/thunderdome/mocks"
+	"git.coinninja.net/backend/thunderdome/tdrpc"
+)
+
+func TestAuditWrite(t *testing.T) {
+
+	// Mocks
+	mockStore := new(mocks.Store)
+	mockLClient := new(mocks.LightningClient)
+
+	// RPC Server
+	s, err := newAdminRPCServer(mockStore, nil, mockLClient, nil)
+	assert.Nil(t, err)
+
+	ctx := addOperator(context.Background(), "operator1")
+
+	request := map[string]interface{}{
+		"id":       "key1",
+		"Secret":   "secret1",
+		"password": "password1",
+		"agent": map[string]interface{}{
+			"name":  "agent1",
+			"token": "token1",
+		},
+		"signatures": []interface{}{
+			map[string]interface{}{"signature": "signature1", "pubkey": "02aa"},
+		},
+	}
+
+	// The write is recorded with the secrets redacted along with the result
+	var audit *tdrpc.AdminAudit
+	mockStore.On("CreateAdminAudit", mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("*tdrpc.AdminAudit")).Once().Run(func(args mock.Arguments) {
+		audit = args.Get(1).(*tdrpc.AdminAudit)
+	}).Return(&tdrpc.AdminAudit{}, nil)
+
+	_, err = s.UnaryInterceptorOverride(ctx, request, &grpc.UnaryServerInfo{FullMethod: "/tdrpc.AdminRPC/UpdateAgentKey"}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, status.Errorf(codes.NotFound, "agent key not found")
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	if assert.NotNil(t, audit) {
+		assert.Equal(t, "operator1", audit.Operator)
+		assert.Equal(t, "/tdrpc.AdminRPC/UpdateAgentKey", audit.Method)
+		assert.Equal(t, codes.NotFound.String(), audit.Code)
+		assert.Equal(t, "agent key not found", audit.Error)
+
+		var recorded map[string]interface{}
+		assert.Nil(t, json.Unmarshal([]byte(audit.Request), &recorded))
+		assert.Equal(t, map[string]interface{}{
+			"id":       "key1",
+			"Secret":   auditRedacted,
+			"password": auditRedacted,
+			"agent": map[string]interface{}{
+				"name":  "agent1",
+				"token": auditRedacted,
+			},
+			"signatures": []interface{}{
+				map[string]interface{}{"signature": auditRedacted, "pubkey": "02aa"},
+			},
+		}, recorded)
+	}
+
+	mockStore.AssertExpectations(t)
+
+}
+
+func TestAuditRead(t *testing.T) {
+
+	// Mocks
+	mockStore := new(mocks.Store)
+	mockLClient := new(mocks.LightningClient)
+
+	// RPC Server
+	s, err := newAdminRPCServer(mockStore, nil, mockLClient, nil)
+	assert.Nil(t, err)
+
+	ctx := addOperator(context.Background(), "operator1")
+
+	// Reads are not recorded
+	for _, method := range []string{"ListAccounts", "Ledger", "DiffChanBackups", "VerifyChanBackup", "DownloadChanBackup"} {
+		var called bool
+		_, err = s.UnaryInterceptorOverride(ctx, &tdrpc.AdminAccountsRequest{}, &grpc.UnaryServerInfo{FullMethod: "/tdrpc.AdminRPC/" + method}, func(ctx context.Context, req interface{}) (interface{}, error) {
+			called = true
+			return nil, nil
+		})
+		assert.Nil(t, err)
+		assert.True(t, called, method)
+	}
+
+	// A method that is not known to be a read is recorded
+	assert.True(t, auditMethod("/tdrpc.AdminRPC/GetAccountSecrets"))
+
+	mockStore.AssertNotCalled(t, "CreateAdminAudit", mock.Anything, mock.Anything)
+
+}
@@ -16,7 +16,8 @@ import (
 type contextKey string
 
 const (
-	contextKeyRole = "role"
+	contextKeyRole     = "role"
+	contextKeyOperator = "operator"
 )
 
 // AuthFuncOverride will handle authentication
@@ -33,7 +34,7 @@ func (s *adminRPCServer) AuthFuncOverride(ctx context.Context, fullMethodName st
 	}
 
 	// Verify the user role
-	_, role, err := s.cnAuthClient.VerifyGetRole(ctx, jwt, config.GetString("tdome.firebase_admin_role"))
+	token, role, err := s.cnAuthClient.VerifyGetRole(ctx, jwt, config.GetString("tdome.firebase_admin_role"))
 	if err != nil {
 		s.logger.Errorw("VerifyGetRole Failed", "error", err)
 		return ctx, tdrpc.ErrInvalidLogin
@@ -48,7 +49,13 @@ func (s *adminRPCServer) AuthFuncOverride(ctx context.Context, fullMethodName st
 		return ctx, tdrpc.ErrPermissionDenied
 	}
 
-	return addRole(ctx, role), nil
+	// The operator is recorded with any changes made
+	var operator string
+	if token != nil {
+		operator = token.UID
+	}
+
+	return addOperator(addRole(ctx, role), operator), nil
 
 }
 
@@ -65,3 +72,29 @@ func getRole(ctx context.Context) cnauth.Role {
 	}
 	return cnauth.RoleUnknown
 }
+
+// addOperator will include the id of the authenticated admin user in the RPC context
+func addOperator(ctx context.Context, operator string) context.Context {
+	return context.WithValue(ctx, contextKey(contextKeyOperator), operator)
+}
+
+// getOperator returns the id of the authenticated admin user from the RPC context (returning empty if not found)
+func getOperator(ctx context.Context) string {
+	operator, ok := ctx.Value(contextKey(contextKeyOperator)).(string)
+	if ok {
+		return operator
+	}
+	return ""
+}
+
+// requireWrite ensures the user has write access
+func requireWrite(ctx context.Context) error {
+	hasRole, err := cnauth.HasRole(getRole(ctx), cnauth.RoleWrite)
+	if err != nil {
+		return status.Errorf(codes.PermissionDenied, "role error: %v", err)
+	}
+	if !hasRole {
+		return tdrpc.ErrPermissionDenied
+	}
+	return nil
+}
@@ -0,0 +1,158 @@
+package adminrpcserver
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"git.coinninja.net/backend/thunderdome/store"
+	"git.coinninja.net/backend/thunderdome/tdrpc"
+)
+
+// ListChanBackups returns the stored channel backups without their data
+func (s *adminRPCServer) ListChanBackups(ctx context.Context, request *tdrpc.AdminChanBackupsRequest) (*tdrpc.AdminChanBackupsResponse, error) {
+
+	cbs, err := s.cbstore.GetChanBackups(ctx, request.ChanPoint, int(request.Offset), int(request.Limit))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Error on GetChanBackups: %v", err)
+	}
+
+	response := &tdrpc.AdminChanBackupsResponse{
+		ChanBackups: make([]*tdrpc.AdminChanBackup, 0, len(cbs)),
+	}
+	for _, cb := range cbs {
+		response.ChanBackups = append(response.ChanBackups, adminChanBackup(cb))
+	}
+
+	return response, nil
+
+}
+
+// DiffChanBackups compares the channels in two backups
+func (s *adminRPCServer) DiffChanBackups(ctx context.Context, request *tdrpc.AdminDiffChanBackupsRequest) (*tdrpc.AdminDiffChanBackupsResponse, error) {
+
+	from, err := s.getChanBackup(ctx, request.FromId)
+	if err != nil {
+		return nil, err
+	}
+	to, err := s.getChanBackup(ctx, request.ToId)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &tdrpc.AdminDiffChanBackupsResponse{
+		From:      adminChanBackup(from),
+		To:        adminChanBackup(to),
+		Added:     make([]string, 0),
+		Removed:   make([]string, 0),
+		Unchanged: make([]string, 0),
+	}
+
+	fromChanPoints := make(map[string]bool)
+	for _, chanPoint := range response.From.ChanPoints {
+		fromChanPoints[chanPoint] = true
+	}
+	toChanPoints := make(map[string]bool)
+	for _, chanPoint := range response.To.ChanPoints {
+		toChanPoints[chanPoint] = true
+		if fromChanPoints[chanPoint] {
+			response.Unchanged = append(response.Unchanged, chanPoint)
+		} else {
+			response.Added = append(response.Added, chanPoint)
+		}
+	}
+	for _, chanPoint := range response.From.ChanPoints {
+		if !toChanPoints[chanPoint] {
+			response.Removed = append(response.Removed, chanPoint)
+		}
+	}
+
+	return response, nil
+
+}
+
+// VerifyChanBackup checks a stored backup with lnd
+func (s *adminRPCServer) VerifyChanBackup(ctx context.Context, request *tdrpc.AdminChanBackupRequest) (*tdrpc.AdminVerifyChanBackupResponse, error) {
+
+	cb, err := s.getChanBackup(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &tdrpc.AdminVerifyChanBackupResponse{
+		ChanBackup: adminChanBackup(cb),
+		Valid:      true,
+	}
+
+	_, err = s.lclient.VerifyChanBackup(ctx, &lnrpc.ChanBackupSnapshot{
+		MultiChanBackup: &lnrpc.MultiChanBackup{
+			MultiChanBackup: cb.Data,
+		},
+	})
+	if err != nil {
+		// Only an invalid backup is reported in the response
+		if st, ok := status.FromError(err); ok && st.Code() == codes.Unknown {
+			response.Valid = false
+			response.Error = st.Message()
+		} else {
+			return nil, status.Errorf(codes.Internal, "Could not VerifyChanBackup: %v", err)
+		}
+	}
+
+	return response, nil
+
+}
+
+// DownloadChanBackup returns a stored backup
+func (s *adminRPCServer) DownloadChanBackup(ctx context.Context, request *tdrpc.AdminChanBackupRequest) (*tdrpc.AdminDownloadChanBackupResponse, error) {
+
+	cb, err := s.getChanBackup(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Infow("Channel Backup Downloaded", "id", cb.Id, "operator", getOperator(ctx))
+
+	return &tdrpc.AdminDownloadChanBackupResponse{
+		ChanBackup: adminChanBackup(cb),
+		Data:       cb.Data,
+	}, nil
+
+}
+
+// getChanBackup fetches a backup and returns a grpc error if it fails
+func (s *adminRPCServer) getChanBackup(ctx context.Context, id int64) (*tdrpc.ChanBackup, error) {
+
+	cb, err := s.cbstore.GetChanBackup(ctx, id)
+	if err == store.ErrNotFound {
+		return nil, status.Errorf(codes.NotFound, "channel backup %d not found", id)
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "Error on GetChanBackup: %v", err)
+	}
+
+	return cb, nil
+
+}
+
+// adminChanBackup converts a stored backup to the admin representation
+func adminChanBackup(cb *tdrpc.ChanBackup) *tdrpc.AdminChanBackup {
+
+	chanPoints := make([]string, 0)
+	if cb.FundingTXIDs != "" {
+		chanPoints = strings.Split(cb.FundingTXIDs, ",")
+	}
+	sort.Strings(chanPoints)
+	timestamp := cb.Timestamp
+
+	return &tdrpc.AdminChanBackup{
+		Id:         cb.Id,
+		Timestamp:  &timestamp,
+		ChanPoints: chanPoints,
+		DataSize:   cb.Size,
+	}
+
+}
@@ -0,0 +1,105 @@
+package adminrpcserver
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"git.coinninja.net/backend/thunderdome/autopilot"
+	"git.coinninja.net/backend/thunderdome/store"
+	"git.coinninja.net/backend/thunderdome/tdrpc"
+)
+
+// ListChannelProposals returns the channel opens and closes proposed by the autopilot
+func (s *adminRPCServer) ListChannelProposals(ctx context.Context, request *tdrpc.AdminChannelProposalsRequest) (*tdrpc.AdminChannelProposalsResponse, error) {
+
+	proposals, err := s.store.GetChannelProposals(ctx, request.Filter, time.Time{}, int(request.Offset), int(request.Limit))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Error on GetChannelProposals: %v", err)
+	}
+
+	return &tdrpc.AdminChannelProposalsResponse{
+		ChannelProposals: proposals,
+	}, nil
+
+}
+
+// ApproveChannelProposal approves a proposal and opens or closes the channel
+func (s *adminRPCServer) ApproveChannelProposal(ctx context.Context, request *tdrpc.AdminChannelProposalRequest) (*tdrpc.ChannelProposal, error) {
+
+	// Ensure the user has write access
+	if err := requireWrite(ctx); err != nil {
+		return nil, err
+	}
+
+	// This can only happen once for a proposal
+	proposal, err := s.store.ReviewChannelProposal(ctx, request.Id, tdrpc.ChannelProposalStatusApproved, getOperator(ctx))
+	if err == store.ErrNotFound {
+		return nil, status.Errorf(codes.NotFound, "channel proposal not found")
+	} else if err == tdrpc.ErrNotProposed {
+		return nil, err
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not approve channel proposal: %v", err)
+	}
+
+	s.logger.Infow("Channel Proposal Approved", "id", proposal.Id, "action", proposal.Action, "node_pubkey", proposal.NodePubkey, "chan_point", proposal.ChanPoint, "value", proposal.Value, "operator", getOperator(ctx))
+
+	// ********* AT THIS POINT IN TIME ALL FUNCTIONS BELOW MUST COMPLETE *********
+	// DO NOT ALLOW THE REQUEST CONTEXT TO CANCEL ANY OPERATION IN PROGRESS
+	ctx = context.Background()
+
+	// A failure is recorded in the proposal
+	proposal, err = autopilot.ExecuteChannelProposal(ctx, s.store, s.lclient, proposal)
+	if err != nil {
+		s.logger.Warnw("Channel Proposal Failed", "id", proposal.Id, "error", err)
+	}
+
+	return proposal, nil
+
+}
+
+// RejectChannelProposal rejects a proposal
+func (s *adminRPCServer) RejectChannelProposal(ctx context.Context, request *tdrpc.AdminChannelProposalRequest) (*tdrpc.ChannelProposal, error) {
+
+	// Ensure the user has write access
+	if err := requireWrite(ctx); err != nil {
+		return nil, err
+	}
+
+	proposal, err := s.store.ReviewChannelProposal(ctx, request.Id, tdrpc.ChannelProposalStatusRejected, getOperator(ctx))
+	if err == store.ErrNotFound {
+		return nil, status.Errorf(codes.NotFound, "channel proposal not found")
+	} else if err == tdrpc.ErrNotProposed {
+		return nil, err
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not reject channel proposal: %v", err)
+	}
+
+	s.logger.Infow("Channel Proposal Rejected", "id", proposal.Id, "action", proposal.Action, "node_pubkey", proposal.NodePubkey, "chan_point", proposal.ChanPoint, "operator", getOperator(ctx))
+
+	return proposal, nil
+
+}
+
+// ListChannelHistory returns the channels that were opened and closed, optionally for a single node
+func (s *adminRPCServer) ListChannelHistory(ctx context.Context, request *tdrpc.AdminChannelHistoryRequest) (*tdrpc.AdminChannelProposalsResponse, error) {
+
+	filter := map[string]string{
+		"status": tdrpc.ChannelProposalStatusCompleted,
+	}
+	if request.NodePubkey != "" {
+		filter["node_pubkey"] = request.NodePubkey
+	}
+
+	proposals, err := s.store.GetChannelProposals(ctx, filter, time.Time{}, int(request.Offset), int(request.Limit))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Error on GetChannelProposals: %v", err)
+	}
+
+	return &tdrpc.AdminChannelProposalsResponse{
+		ChannelProposals: proposals,
+	}, nil
+
+}
@@ -0,0 +1,151 @@
+package adminrpcserver
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"git.coinninja.net/backend/thunderdome/store"
+	"git.coinninja.net/backend/thunderdome/tdrpc"
+)
+
+// ListHeld returns the outbound ledger records waiting for review
+func (s *adminRPCServer) ListHeld(ctx context.Context, request *tdrpc.AdminHeldRequest) (*tdrpc.LedgerResponse, error) {
+
+	if request.Filter == nil {
+		request.Filter = make(map[string]string)
+	}
+
+	request.Filter["status"] = tdrpc.HELD.String()
+	request.Filter["direction"] = tdrpc.OUT.String()
+	request.Filter["hidden"] = "*"
+
+	lrs, err := s.store.GetLedger(ctx, request.Filter, time.Time{}, int(request.Offset), int(request.Limit))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Error on GetLedger: %v", err)
+	}
+
+	return &tdrpc.LedgerResponse{
+		Ledger: lrs,
+	}, nil
+
+}
+
+// ApproveHeld releases a held ledger record and resumes sending it
+func (s *adminRPCServer) ApproveHeld(ctx context.Context, request *tdrpc.AdminReviewHeldRequest) (*tdrpc.LedgerRecordResponse, error) {
+
+	// Ensure the user has write access
+	if err := requireWrite(ctx); err != nil {
+		return nil, err
+	}
+
+	// Move the record to pending, this can only happen once for a held record
+	lr, err := s.store.ReviewHeldLedgerRecord(ctx, request.Id, tdrpc.PENDING, request.Reason)
+	if err == store.ErrNotFound {
+		return nil, status.Errorf(codes.NotFound, "ledger record not found")
+	} else if err == tdrpc.ErrNotHeld {
+		return nil, err
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not approve ledger record: %v", err)
+	}
+
+	s.logger.Infow("Held Ledger Record Approved", "id", lr.Id, "account_id", lr.AccountId, "value", lr.Value, "reason", request.Reason, "operator", getOperator(ctx))
+
+	// ********* AT THIS POINT IN TIME ALL FUNCTIONS BELOW MUST COMPLETE *********
+	// DO NOT ALLOW THE REQUEST CONTEXT TO CANCEL ANY OPERATION IN PROGRESS
+	ctx = context.Background()
+
+	switch lr.Type {
+	case tdrpc.LIGHTNING:
+		if strings.HasSuffix(lr.Id, tdrpc.InternalIdSuffix) {
+			return s.approveHeldInternal(ctx, lr)
+		}
+		return s.approveHeldPay(ctx, lr)
+	case tdrpc.BTC:
+		return s.approveHeldWithdraw(ctx, lr)
+	}
+
+	return nil, status.Errorf(codes.Internal, "Unknown ledger record type %v", lr.Type)
+
+}
+
+// RejectHeld fails a held ledger record returning the funds to the account
+func (s *adminRPCServer) RejectHeld(ctx context.Context, request *tdrpc.AdminReviewHeldRequest) (*tdrpc.LedgerRecordResponse, error) {
+
+	// Ensure the user has write access
+	if err := requireWrite(ctx); err != nil {
+		return nil, err
+	}
+
+	if request.Reason == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Reason is required")
+	}
+
+	lr, err := s.store.ReviewHeldLedgerRecord(ctx, request.Id, tdrpc.FAILED, request.Reason)
+	if err == store.ErrNotFound {
+		return nil, status.Errorf(codes.NotFound, "ledger record not found")
+	} else if err == tdrpc.ErrNotHeld {
+		return nil, err
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not reject ledger record: %v", err)
+	}
+
+	s.logger.Infow("Held Ledger Record Rejected", "id", lr.Id, "account_id", lr.AccountId, "value", lr.Value, "reason", request.Reason, "operator", getOperator(ctx))
+
+	return &tdrpc.LedgerRecordResponse{
+		Result: lr,
+	}, nil
+
+}
+
+// approveHeldInternal transfers an approved payment to another user of this service
+func (s *adminRPCServer) approveHeldInternal(ctx context.Context, lr *tdrpc.LedgerRecord) (*tdrpc.LedgerRecordResponse, error) {
+
+	intLr, err := s.sender.Internal(ctx, strings.TrimSuffix(lr.Id, tdrpc.InternalIdSuffix), lr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tdrpc.LedgerRecordResponse{
+		Result: intLr,
+	}, nil
+
+}
+
+// approveHeldPay sends an approved lightning payment
+func (s *adminRPCServer) approveHeldPay(ctx context.Context, lr *tdrpc.LedgerRecord) (*tdrpc.LedgerRecordResponse, error) {
+
+	if err := s.sender.Pay(ctx, lr); err != nil {
+		return nil, err
+	}
+
+	return &tdrpc.LedgerRecordResponse{
+		Result: lr,
+	}, nil
+
+}
+
+// approveHeldWithdraw sends an approved withdraw at the fee rate quoted when it was held
+func (s *adminRPCServer) approveHeldWithdraw(ctx context.Context, lr *tdrpc.LedgerRecord) (*tdrpc.LedgerRecordResponse, error) {
+
+	// Without a quoted fee rate lnd picks one for tdome.default_withdraw_target_blocks
+	txid, err := s.sender.Withdraw(ctx, lr, lr.SatPerByte)
+	if err != nil {
+		return nil, err
+	}
+
+	// Otherwise we succeeded, update the ledger record ID to be the transaction id
+	if err = s.sender.RenameWithdraw(ctx, lr.Id, txid); err != nil {
+		return nil, err
+	}
+
+	lr.Id = txid
+
+	return &tdrpc.LedgerRecordResponse{
+		Result: lr,
+	}, nil
+
+}
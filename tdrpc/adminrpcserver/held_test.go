@@ -0,0 +1,207 @@
+package adminrpcserver
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"git.coinninja.net/backend/cnauth"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	_ "git.coinninja.net/backend/thunderdome/conf"
+	"git.coinninja.net/backend/thunderdome/mocks"
+	"git.coinninja.net/backend/thunderdome/store"
+	"git.coinninja.net/backend/thunderdome/tdrpc"
+)
+
+func TestApproveHeldWithdraw(t *testing.T) {
+
+	// Mocks
+	mockStore := new(mocks.Store)
+	mockLClient := new(mocks.LightningClient)
+
+	// RPC Server
+	s, err := newAdminRPCServer(mockStore, nil, mockLClient, nil)
+	assert.Nil(t, err)
+
+	ctx := addOperator(addRole(context.Background(), cnauth.RoleWrite), "operator1")
+
+	lr := &tdrpc.LedgerRecord{
+		Id:         tdrpc.TempLedgerRecordIdPrefix + "1",
+		AccountId:  "account1",
+		Status:     tdrpc.PENDING,
+		Type:       tdrpc.BTC,
+		Direction:  tdrpc.OUT,
+		Value:      50000,
+		NetworkFee: 2400,
+		Request:    "2MsoezssHTCZbeoVcZ5NgYmtNiUpyzAc5hm",
+		SatPerByte: 12,
+	}
+	mockStore.On("ReviewHeldLedgerRecord", mock.AnythingOfType("*context.valueCtx"), lr.Id, tdrpc.PENDING, "verified").Once().Return(lr, nil)
+
+	// It's sent at the fee rate quoted when it was held
+	mockLClient.On("SendCoins", mock.AnythingOfType("*context.emptyCtx"), &lnrpc.SendCoinsRequest{
+		Addr:       lr.Request,
+		Amount:     lr.Value,
+		SatPerByte: 12,
+	}).Once().Return(&lnrpc.SendCoinsResponse{Txid: "txid1"}, nil)
+	mockStore.On("UpdateLedgerRecordID", mock.AnythingOfType("*context.emptyCtx"), lr.Id, "txid1", tdrpc.OUT).Once().Return(nil)
+
+	response, err := s.ApproveHeld(ctx, &tdrpc.AdminReviewHeldRequest{
+		Id:     lr.Id,
+		Reason: "verified",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "txid1", response.Result.Id)
+
+	mockStore.AssertExpectations(t)
+	mockLClient.AssertExpectations(t)
+
+}
+
+func TestApproveHeldWithdrawFailed(t *testing.T) {
+
+	// Mocks
+	mockStore := new(mocks.Store)
+	mockLClient := new(mocks.LightningClient)
+
+	// RPC Server
+	s, err := newAdminRPCServer(mockStore, nil, mockLClient, nil)
+	assert.Nil(t, err)
+
+	ctx := addOperator(addRole(context.Background(), cnauth.RoleWrite), "operator1")
+
+	lr := &tdrpc.LedgerRecord{
+		Id:        tdrpc.TempLedgerRecordIdPrefix + "1",
+		AccountId: "account1",
+		Status:    tdrpc.PENDING,
+		Type:      tdrpc.BTC,
+		Direction: tdrpc.OUT,
+		Value:     50000,
+		Request:   "2MsoezssHTCZbeoVcZ5NgYmtNiUpyzAc5hm",
+	}
+	mockStore.On("ReviewHeldLedgerRecord", mock.AnythingOfType("*context.valueCtx"), lr.Id, tdrpc.PENDING, "verified").Once().Return(lr, nil)
+
+	// Without a quoted fee rate lnd picks the fee rate
+	mockLClient.On("SendCoins", mock.AnythingOfType("*context.emptyCtx"), mock.MatchedBy(func(request *lnrpc.SendCoinsRequest) bool {
+		return request.SatPerByte == 0 && request.TargetConf > 0
+	})).Once().Return(nil, fmt.Errorf("insufficient funds"))
+
+	// The funds are returned
+	mockStore.On("ProcessLedgerRecord", mock.AnythingOfType("*context.emptyCtx"), mock.MatchedBy(func(lr *tdrpc.LedgerRecord) bool {
+		return lr.Status == tdrpc.FAILED && lr.Error == "insufficient funds"
+	})).Once().Return(nil)
+
+	_, err = s.ApproveHeld(ctx, &tdrpc.AdminReviewHeldRequest{
+		Id:     lr.Id,
+		Reason: "verified",
+	})
+	assert.Equal(t, codes.Internal, status.Code(err))
+
+	mockStore.AssertExpectations(t)
+	mockLClient.AssertExpectations(t)
+
+}
+
+func TestApproveHeldPay(t *testing.T) {
+
+	// Mocks
+	mockStore := new(mocks.Store)
+	mockLClient := new(mocks.LightningClient)
+
+	// RPC Server
+	s, err := newAdminRPCServer(mockStore, nil, mockLClient, nil)
+	assert.Nil(t, err)
+
+	ctx := addOperator(addRole(context.Background(), cnauth.RoleWrite), "operator1")
+
+	lr := &tdrpc.LedgerRecord{
+		Id:        "paymenthash1",
+		AccountId: "account1",
+		Status:    tdrpc.PENDING,
+		Type:      tdrpc.LIGHTNING,
+		Direction: tdrpc.OUT,
+		Value:     1000,
+		Request:   "lnbc1",
+	}
+	mockStore.On("ReviewHeldLedgerRecord", mock.AnythingOfType("*context.valueCtx"), lr.Id, tdrpc.PENDING, "verified").Once().Return(lr, nil)
+	mockLClient.On("SendPaymentSync", mock.AnythingOfType("*context.emptyCtx"), &lnrpc.SendRequest{
+		Amt:            lr.Value,
+		PaymentRequest: lr.Request,
+	}).Once().Return(&lnrpc.SendResponse{}, nil)
+	mockStore.On("ProcessLedgerRecord", mock.AnythingOfType("*context.emptyCtx"), mock.MatchedBy(func(lr *tdrpc.LedgerRecord) bool {
+		return lr.Status == tdrpc.COMPLETED
+	})).Once().Return(nil)
+
+	response, err := s.ApproveHeld(ctx, &tdrpc.AdminReviewHeldRequest{
+		Id:     lr.Id,
+		Reason: "verified",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, tdrpc.COMPLETED, response.Result.Status)
+
+	// It can only be reviewed once
+	mockStore.On("ReviewHeldLedgerRecord", mock.AnythingOfType("*context.valueCtx"), lr.Id, tdrpc.PENDING, "again").Once().Return(nil, tdrpc.ErrNotHeld)
+	_, err = s.ApproveHeld(ctx, &tdrpc.AdminReviewHeldRequest{
+		Id:     lr.Id,
+		Reason: "again",
+	})
+	assert.Equal(t, tdrpc.ErrNotHeld, err)
+
+	mockStore.AssertExpectations(t)
+	mockLClient.AssertExpectations(t)
+
+}
+
+func TestRejectHeld(t *testing.T) {
+
+	// Mocks
+	mockStore := new(mocks.Store)
+	mockLClient := new(mocks.LightningClient)
+
+	// RPC Server
+	s, err := newAdminRPCServer(mockStore, nil, mockLClient, nil)
+	assert.Nil(t, err)
+
+	ctx := addOperator(addRole(context.Background(), cnauth.RoleWrite), "operator1")
+
+	// A reason is required
+	_, err = s.RejectHeld(ctx, &tdrpc.AdminReviewHeldRequest{
+		Id: "paymenthash1",
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	lr := &tdrpc.LedgerRecord{
+		Id:        "paymenthash1",
+		AccountId: "account1",
+		Status:    tdrpc.FAILED,
+		Type:      tdrpc.LIGHTNING,
+		Direction: tdrpc.OUT,
+		Value:     1000,
+		Error:     "suspicious",
+	}
+	mockStore.On("ReviewHeldLedgerRecord", mock.AnythingOfType("*context.valueCtx"), lr.Id, tdrpc.FAILED, "suspicious").Once().Return(lr, nil)
+
+	// Nothing is sent
+	response, err := s.RejectHeld(ctx, &tdrpc.AdminReviewHeldRequest{
+		Id:     lr.Id,
+		Reason: "suspicious",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, tdrpc.FAILED, response.Result.Status)
+
+	mockStore.On("ReviewHeldLedgerRecord", mock.AnythingOfType("*context.valueCtx"), "missing", tdrpc.FAILED, "suspicious").Once().Return(nil, store.ErrNotFound)
+	_, err = s.RejectHeld(ctx, &tdrpc.AdminReviewHeldRequest{
+		Id:     "missing",
+		Reason: "suspicious",
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	mockStore.AssertExpectations(t)
+	mockLClient.AssertExpectations(t)
+
+}
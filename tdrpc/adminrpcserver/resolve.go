@@ -0,0 +1,231 @@
+package adminrpcserver
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"git.coinninja.net/backend/thunderdome/store"
+	"git.coinninja.net/backend/thunderdome/tdrpc"
+	"git.coinninja.net/backend/thunderdome/tdrpc/sender"
+)
+
+// ResolveLedgerRecord checks lnd for the real outcome of a pending ledger record and proposes a transition
+// When confirmed, the transition is applied through the ledger
+func (s *adminRPCServer) ResolveLedgerRecord(ctx context.Context, request *tdrpc.AdminResolveLedgerRecordRequest) (*tdrpc.AdminResolveLedgerRecordResponse, error) {
+
+	// Applying the transition requires write access
+	if request.Confirm {
+		if err := requireWrite(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	lr, err := s.store.GetLedgerRecord(ctx, request.Id, request.Direction)
+	if err == store.ErrNotFound {
+		return nil, status.Errorf(codes.NotFound, "ledger record not found")
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not fetch ledger record: %v", err)
+	}
+
+	if lr.Status != tdrpc.PENDING {
+		return nil, tdrpc.ErrNotPending
+	}
+
+	proposed, reason, err := s.resolveLedgerRecord(ctx, lr)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &tdrpc.AdminResolveLedgerRecordResponse{
+		Current:  lr,
+		Proposed: proposed,
+		Reason:   reason,
+	}
+
+	if proposed == nil || !request.Confirm {
+		return response, nil
+	}
+
+	// ********* AT THIS POINT IN TIME ALL FUNCTIONS BELOW MUST COMPLETE *********
+	ctx = context.Background()
+
+	// A withdraw that was sent but never renamed gets the transaction id
+	if proposed.Id != lr.Id {
+		err = s.store.UpdateLedgerRecordID(ctx, lr.Id, proposed.Id, lr.Direction)
+		if err == store.ErrAlreadyExists {
+			return nil, status.Errorf(codes.FailedPrecondition, "ledger record %s already exists", proposed.Id)
+		} else if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not update ledger record id: %v", err)
+		}
+	}
+
+	if proposed.Status != lr.Status || proposed.Value != lr.Value {
+		if err = s.store.ProcessLedgerRecord(ctx, proposed); err != nil {
+			// A valid message is provided with this error
+			if status.Code(err) == codes.InvalidArgument {
+				return nil, err
+			}
+			s.logger.Errorw("ProcessLedgerRecord Error", zap.Any("lr", proposed), "error", err)
+			return nil, status.Errorf(codes.Internal, "ProcessLedgerRecord internal error")
+		}
+	}
+
+	response.Applied = true
+
+	s.logger.Infow("Ledger Record Resolved", "id", lr.Id, "new_id", proposed.Id, "direction", lr.Direction, "status", proposed.Status, "reason", reason, "operator", getOperator(ctx))
+
+	return response, nil
+
+}
+
+// resolveLedgerRecord returns the ledger record after the transition lnd says should happen and why
+// It returns nil if the record should remain pending
+func (s *adminRPCServer) resolveLedgerRecord(ctx context.Context, lr *tdrpc.LedgerRecord) (*tdrpc.LedgerRecord, string, error) {
+
+	proposed := *lr
+
+	switch {
+	case lr.Direction == tdrpc.OUT && lr.Request == tdrpc.PreAuthRequest:
+		proposed.Status = tdrpc.EXPIRED
+		return &proposed, "Pre-authorized funds were never used", nil
+
+	case lr.Direction == tdrpc.OUT && lr.Type == tdrpc.LIGHTNING && strings.HasSuffix(lr.Id, tdrpc.InternalIdSuffix):
+		lrIn, err := s.store.GetLedgerRecord(ctx, strings.TrimSuffix(lr.Id, tdrpc.InternalIdSuffix), tdrpc.IN)
+		if err != nil && err != store.ErrNotFound {
+			return nil, "", status.Errorf(codes.Internal, "Could not fetch ledger record: %v", err)
+		}
+		if err == nil && lrIn.Status == tdrpc.COMPLETED {
+			proposed.Status = tdrpc.COMPLETED
+			return &proposed, "Internal payment was received", nil
+		}
+		proposed.Status = tdrpc.FAILED
+		proposed.Error = "Internal payment was not received"
+		return &proposed, proposed.Error, nil
+
+	case lr.Direction == tdrpc.OUT && lr.Type == tdrpc.LIGHTNING:
+		payments, err := s.lclient.ListPayments(ctx, &lnrpc.ListPaymentsRequest{IncludeIncomplete: true})
+		if err != nil {
+			return nil, "", status.Errorf(codes.Internal, "Could not ListPayments: %v", status.Convert(err).Message())
+		}
+		for _, payment := range payments.Payments {
+			if payment.PaymentHash != lr.Id {
+				continue
+			}
+			switch payment.Status {
+			case lnrpc.Payment_SUCCEEDED:
+				proposed.Status = tdrpc.COMPLETED
+				return &proposed, "Payment succeeded", nil
+			case lnrpc.Payment_FAILED:
+				proposed.Status = tdrpc.FAILED
+				proposed.Error = "Payment failed"
+				return &proposed, proposed.Error, nil
+			}
+			return nil, "Payment is still in flight", nil
+		}
+		proposed.Status = tdrpc.FAILED
+		proposed.Error = "Payment was never sent"
+		return &proposed, proposed.Error, nil
+
+	case lr.Direction == tdrpc.OUT && lr.Type == tdrpc.BTC && strings.HasPrefix(lr.Id, tdrpc.TempLedgerRecordIdPrefix):
+		address := lr.WithdrawAddress()
+		if address == "" {
+			return nil, "Could not determine the withdraw address", nil
+		}
+		txs, err := s.lclient.GetTransactions(ctx, &lnrpc.GetTransactionsRequest{})
+		if err != nil {
+			return nil, "", status.Errorf(codes.Internal, "Could not GetTransactions: %v", status.Convert(err).Message())
+		}
+		// Match the address and amount like the withdraw recovery monitor
+		tx, err := sender.MatchWithdrawTransaction(ctx, s.store, lr, address, txs.Transactions)
+		if err != nil {
+			return nil, "", status.Errorf(codes.Internal, "Could not match withdraw transaction: %v", err)
+		}
+		if tx == nil {
+			proposed.Status = tdrpc.FAILED
+			proposed.Error = "Withdraw transaction was never sent"
+			return &proposed, proposed.Error, nil
+		}
+		proposed.Id = tx.TxHash
+		if tx.NumConfirmations > 0 {
+			proposed.Status = tdrpc.COMPLETED
+		}
+		return &proposed, fmt.Sprintf("Withdraw was sent in transaction %s with %d confirmations", tx.TxHash, tx.NumConfirmations), nil
+
+	case lr.Type == tdrpc.BTC:
+		// Inbound records are txid:output
+		txHash := strings.Split(lr.Id, ":")[0]
+		tx, err := s.findTransaction(ctx, func(tx *lnrpc.Transaction) bool {
+			return tx.TxHash == txHash
+		})
+		if err != nil {
+			return nil, "", err
+		}
+		if tx == nil {
+			proposed.Status = tdrpc.FAILED
+			proposed.Error = "Transaction not found"
+			return &proposed, proposed.Error, nil
+		}
+		if tx.NumConfirmations > 0 {
+			proposed.Status = tdrpc.COMPLETED
+			return &proposed, fmt.Sprintf("Transaction has %d confirmations", tx.NumConfirmations), nil
+		}
+		return nil, "Transaction is unconfirmed", nil
+
+	case lr.Direction == tdrpc.IN && lr.Type == tdrpc.LIGHTNING:
+		invoice, err := s.lclient.LookupInvoice(ctx, &lnrpc.PaymentHash{RHashStr: lr.Id})
+		if err != nil {
+			if strings.Contains(status.Convert(err).Message(), "unable to locate invoice") {
+				proposed.Status = tdrpc.EXPIRED
+				return &proposed, "Invoice not found", nil
+			}
+			return nil, "", status.Errorf(codes.Internal, "Could not LookupInvoice: %v", status.Convert(err).Message())
+		}
+		switch invoice.State {
+		case lnrpc.Invoice_SETTLED:
+			proposed.Status = tdrpc.COMPLETED
+			proposed.Value = invoice.AmtPaidSat
+			return &proposed, "Invoice was settled", nil
+		case lnrpc.Invoice_CANCELED:
+			proposed.Status = tdrpc.EXPIRED
+			return &proposed, "Invoice was canceled", nil
+		case lnrpc.Invoice_ACCEPTED:
+			proposed.Status = tdrpc.ACCEPTED
+			proposed.Value = invoice.AmtPaidSat
+			return &proposed, "Hold invoice was paid and is waiting to be settled", nil
+		case lnrpc.Invoice_OPEN:
+			if time.Now().UTC().After(time.Unix(invoice.CreationDate+invoice.Expiry, 0)) {
+				proposed.Status = tdrpc.EXPIRED
+				return &proposed, "Invoice expired", nil
+			}
+		}
+		return nil, "Invoice is still open", nil
+	}
+
+	return nil, "Unknown ledger record type", nil
+
+}
+
+// findTransaction returns the first wallet transaction matching match (or nil if there is none)
+func (s *adminRPCServer) findTransaction(ctx context.Context, match func(tx *lnrpc.Transaction) bool) (*lnrpc.Transaction, error) {
+
+	txs, err := s.lclient.GetTransactions(ctx, &lnrpc.GetTransactionsRequest{})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not GetTransactions: %v", status.Convert(err).Message())
+	}
+
+	for _, tx := range txs.Transactions {
+		if match(tx) {
+			return tx, nil
+		}
+	}
+
+	return nil, nil
+
+}
@@ -0,0 +1,307 @@
+package adminrpcserver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"git.coinninja.net/backend/cnauth"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	_ "git.coinninja.net/backend/thunderdome/conf"
+	"git.coinninja.net/backend/thunderdome/mocks"
+	"git.coinninja.net/backend/thunderdome/store"
+	"git.coinninja.net/backend/thunderdome/tdrpc"
+)
+
+func TestResolveLightningPayment(t *testing.T) {
+
+	// Mocks
+	mockStore := new(mocks.Store)
+	mockLClient := new(mocks.LightningClient)
+
+	// RPC Server
+	s, err := newAdminRPCServer(mockStore, nil, mockLClient, nil)
+	assert.Nil(t, err)
+
+	ctx := context.Background()
+
+	lr := &tdrpc.LedgerRecord{
+		Id:        "paymenthash1",
+		AccountId: "account1",
+		Status:    tdrpc.PENDING,
+		Type:      tdrpc.LIGHTNING,
+		Direction: tdrpc.OUT,
+		Value:     1000,
+	}
+
+	// Succeeded
+	mockLClient.On("ListPayments", mock.AnythingOfType("*context.emptyCtx"), &lnrpc.ListPaymentsRequest{IncludeIncomplete: true}).Once().Return(&lnrpc.ListPaymentsResponse{
+		Payments: []*lnrpc.Payment{
+			{PaymentHash: "otherhash", Status: lnrpc.Payment_FAILED},
+			{PaymentHash: lr.Id, Status: lnrpc.Payment_SUCCEEDED},
+		},
+	}, nil)
+	proposed, _, err := s.resolveLedgerRecord(ctx, lr)
+	assert.Nil(t, err)
+	assert.Equal(t, tdrpc.COMPLETED, proposed.Status)
+
+	// Failed
+	mockLClient.On("ListPayments", mock.AnythingOfType("*context.emptyCtx"), &lnrpc.ListPaymentsRequest{IncludeIncomplete: true}).Once().Return(&lnrpc.ListPaymentsResponse{
+		Payments: []*lnrpc.Payment{{PaymentHash: lr.Id, Status: lnrpc.Payment_FAILED}},
+	}, nil)
+	proposed, _, err = s.resolveLedgerRecord(ctx, lr)
+	assert.Nil(t, err)
+	assert.Equal(t, tdrpc.FAILED, proposed.Status)
+	assert.Equal(t, "Payment failed", proposed.Error)
+
+	// In flight stays pending
+	mockLClient.On("ListPayments", mock.AnythingOfType("*context.emptyCtx"), &lnrpc.ListPaymentsRequest{IncludeIncomplete: true}).Once().Return(&lnrpc.ListPaymentsResponse{
+		Payments: []*lnrpc.Payment{{PaymentHash: lr.Id, Status: lnrpc.Payment_IN_FLIGHT}},
+	}, nil)
+	proposed, _, err = s.resolveLedgerRecord(ctx, lr)
+	assert.Nil(t, err)
+	assert.Nil(t, proposed)
+
+	// Never sent
+	mockLClient.On("ListPayments", mock.AnythingOfType("*context.emptyCtx"), &lnrpc.ListPaymentsRequest{IncludeIncomplete: true}).Once().Return(&lnrpc.ListPaymentsResponse{}, nil)
+	proposed, _, err = s.resolveLedgerRecord(ctx, lr)
+	assert.Nil(t, err)
+	assert.Equal(t, tdrpc.FAILED, proposed.Status)
+	assert.Equal(t, "Payment was never sent", proposed.Error)
+
+	// The current record is not changed
+	assert.Equal(t, tdrpc.PENDING, lr.Status)
+
+	mockStore.AssertExpectations(t)
+	mockLClient.AssertExpectations(t)
+
+}
+
+func TestResolveInternalPayment(t *testing.T) {
+
+	// Mocks
+	mockStore := new(mocks.Store)
+	mockLClient := new(mocks.LightningClient)
+
+	// RPC Server
+	s, err := newAdminRPCServer(mockStore, nil, mockLClient, nil)
+	assert.Nil(t, err)
+
+	ctx := context.Background()
+
+	lr := &tdrpc.LedgerRecord{
+		Id:        "paymenthash1" + tdrpc.InternalIdSuffix,
+		AccountId: "account1",
+		Status:    tdrpc.PENDING,
+		Type:      tdrpc.LIGHTNING,
+		Direction: tdrpc.OUT,
+		Value:     1000,
+	}
+
+	// Received
+	mockStore.On("GetLedgerRecord", mock.AnythingOfType("*context.emptyCtx"), "paymenthash1", tdrpc.IN).Once().Return(&tdrpc.LedgerRecord{Id: "paymenthash1", Status: tdrpc.COMPLETED}, nil)
+	proposed, _, err := s.resolveLedgerRecord(ctx, lr)
+	assert.Nil(t, err)
+	assert.Equal(t, tdrpc.COMPLETED, proposed.Status)
+
+	// Not received
+	mockStore.On("GetLedgerRecord", mock.AnythingOfType("*context.emptyCtx"), "paymenthash1", tdrpc.IN).Once().Return(nil, store.ErrNotFound)
+	proposed, _, err = s.resolveLedgerRecord(ctx, lr)
+	assert.Nil(t, err)
+	assert.Equal(t, tdrpc.FAILED, proposed.Status)
+
+	mockStore.AssertExpectations(t)
+	mockLClient.AssertExpectations(t)
+
+}
+
+func TestResolveWithdraw(t *testing.T) {
+
+	// Mocks
+	mockStore := new(mocks.Store)
+	mockLClient := new(mocks.LightningClient)
+
+	// RPC Server
+	s, err := newAdminRPCServer(mockStore, nil, mockLClient, nil)
+	assert.Nil(t, err)
+
+	ctx := addOperator(addRole(context.Background(), cnauth.RoleWrite), "operator1")
+
+	createdAt := time.Now().UTC().Add(-time.Hour)
+	lr := &tdrpc.LedgerRecord{
+		Id:         tdrpc.TempLedgerRecordIdPrefix + "1",
+		AccountId:  "account1",
+		CreatedAt:  &createdAt,
+		Status:     tdrpc.PENDING,
+		Type:       tdrpc.BTC,
+		Direction:  tdrpc.OUT,
+		Value:      50000,
+		NetworkFee: 2400,
+		Request:    "2MsoezssHTCZbeoVcZ5NgYmtNiUpyzAc5hm",
+	}
+
+	txs := &lnrpc.TransactionDetails{
+		Transactions: []*lnrpc.Transaction{
+			// Another withdraw to the same address for a different amount
+			{TxHash: "txid1", Amount: -30300, TotalFees: 300, TimeStamp: createdAt.Unix(), NumConfirmations: 3, DestAddresses: []string{lr.Request}},
+			// Before the withdraw was created
+			{TxHash: "txid2", Amount: -50300, TotalFees: 300, TimeStamp: createdAt.Add(-time.Hour).Unix(), NumConfirmations: 3, DestAddresses: []string{lr.Request}},
+			// The withdraw, the wallet amount includes the fee
+			{TxHash: "txid3", Amount: -50300, TotalFees: 300, TimeStamp: createdAt.Unix(), NumConfirmations: 1, DestAddresses: []string{"change", lr.Request}},
+		},
+	}
+
+	mockStore.On("GetLedgerRecord", mock.AnythingOfType("*context.valueCtx"), lr.Id, tdrpc.OUT).Once().Return(lr, nil)
+	mockLClient.On("GetTransactions", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("*lnrpc.GetTransactionsRequest")).Once().Return(txs, nil)
+	mockStore.On("GetLedgerRecord", mock.AnythingOfType("*context.valueCtx"), "txid3", tdrpc.OUT).Once().Return(nil, store.ErrNotFound)
+
+	// Confirming renames it to the transaction and completes it
+	mockStore.On("UpdateLedgerRecordID", mock.AnythingOfType("*context.emptyCtx"), lr.Id, "txid3", tdrpc.OUT).Once().Return(nil)
+	mockStore.On("ProcessLedgerRecord", mock.AnythingOfType("*context.emptyCtx"), mock.MatchedBy(func(lr *tdrpc.LedgerRecord) bool {
+		return lr.Id == "txid3" && lr.Status == tdrpc.COMPLETED
+	})).Once().Return(nil)
+
+	response, err := s.ResolveLedgerRecord(ctx, &tdrpc.AdminResolveLedgerRecordRequest{
+		Id:        lr.Id,
+		Direction: tdrpc.OUT,
+		Confirm:   true,
+	})
+	assert.Nil(t, err)
+	assert.True(t, response.Applied)
+	assert.Equal(t, "txid3", response.Proposed.Id)
+
+	// The only matching transaction already belongs to another withdraw
+	mockLClient.On("GetTransactions", mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("*lnrpc.GetTransactionsRequest")).Once().Return(txs, nil)
+	mockStore.On("GetLedgerRecord", mock.AnythingOfType("*context.emptyCtx"), "txid3", tdrpc.OUT).Once().Return(&tdrpc.LedgerRecord{Id: "txid3"}, nil)
+	proposed, _, err := s.resolveLedgerRecord(context.Background(), lr)
+	assert.Nil(t, err)
+	assert.Equal(t, tdrpc.FAILED, proposed.Status)
+	assert.Equal(t, lr.Id, proposed.Id)
+
+	// Sent but unconfirmed is renamed to the transaction and stays pending
+	mockLClient.On("GetTransactions", mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("*lnrpc.GetTransactionsRequest")).Once().Return(&lnrpc.TransactionDetails{
+		Transactions: []*lnrpc.Transaction{
+			{TxHash: "txid4", Amount: -50300, TotalFees: 300, TimeStamp: createdAt.Unix(), DestAddresses: []string{lr.Request}},
+		},
+	}, nil)
+	mockStore.On("GetLedgerRecord", mock.AnythingOfType("*context.emptyCtx"), "txid4", tdrpc.OUT).Once().Return(nil, store.ErrNotFound)
+	proposed, _, err = s.resolveLedgerRecord(context.Background(), lr)
+	assert.Nil(t, err)
+	assert.Equal(t, tdrpc.PENDING, proposed.Status)
+	assert.Equal(t, "txid4", proposed.Id)
+
+	// Never sent
+	mockLClient.On("GetTransactions", mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("*lnrpc.GetTransactionsRequest")).Once().Return(&lnrpc.TransactionDetails{}, nil)
+	proposed, _, err = s.resolveLedgerRecord(context.Background(), lr)
+	assert.Nil(t, err)
+	assert.Equal(t, tdrpc.FAILED, proposed.Status)
+	assert.Equal(t, "Withdraw transaction was never sent", proposed.Error)
+
+	mockStore.AssertExpectations(t)
+	mockLClient.AssertExpectations(t)
+
+}
+
+func TestResolveInboundBTC(t *testing.T) {
+
+	// Mocks
+	mockStore := new(mocks.Store)
+	mockLClient := new(mocks.LightningClient)
+
+	// RPC Server
+	s, err := newAdminRPCServer(mockStore, nil, mockLClient, nil)
+	assert.Nil(t, err)
+
+	ctx := context.Background()
+
+	lr := &tdrpc.LedgerRecord{
+		Id:        "txid1:1",
+		AccountId: "account1",
+		Status:    tdrpc.PENDING,
+		Type:      tdrpc.BTC,
+		Direction: tdrpc.IN,
+		Value:     50000,
+	}
+
+	// Confirmed
+	mockLClient.On("GetTransactions", mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("*lnrpc.GetTransactionsRequest")).Once().Return(&lnrpc.TransactionDetails{
+		Transactions: []*lnrpc.Transaction{{TxHash: "txid1", NumConfirmations: 2}},
+	}, nil)
+	proposed, _, err := s.resolveLedgerRecord(ctx, lr)
+	assert.Nil(t, err)
+	assert.Equal(t, tdrpc.COMPLETED, proposed.Status)
+
+	// Unconfirmed stays pending
+	mockLClient.On("GetTransactions", mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("*lnrpc.GetTransactionsRequest")).Once().Return(&lnrpc.TransactionDetails{
+		Transactions: []*lnrpc.Transaction{{TxHash: "txid1"}},
+	}, nil)
+	proposed, _, err = s.resolveLedgerRecord(ctx, lr)
+	assert.Nil(t, err)
+	assert.Nil(t, proposed)
+
+	// Not found
+	mockLClient.On("GetTransactions", mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("*lnrpc.GetTransactionsRequest")).Once().Return(&lnrpc.TransactionDetails{}, nil)
+	proposed, _, err = s.resolveLedgerRecord(ctx, lr)
+	assert.Nil(t, err)
+	assert.Equal(t, tdrpc.FAILED, proposed.Status)
+
+	mockStore.AssertExpectations(t)
+	mockLClient.AssertExpectations(t)
+
+}
+
+func TestResolveInboundLightning(t *testing.T) {
+
+	// Mocks
+	mockStore := new(mocks.Store)
+	mockLClient := new(mocks.LightningClient)
+
+	// RPC Server
+	s, err := newAdminRPCServer(mockStore, nil, mockLClient, nil)
+	assert.Nil(t, err)
+
+	ctx := context.Background()
+
+	lr := &tdrpc.LedgerRecord{
+		Id:        "paymenthash1",
+		AccountId: "account1",
+		Status:    tdrpc.PENDING,
+		Type:      tdrpc.LIGHTNING,
+		Direction: tdrpc.IN,
+		Value:     0,
+	}
+
+	// Settled with the amount paid
+	mockLClient.On("LookupInvoice", mock.AnythingOfType("*context.emptyCtx"), &lnrpc.PaymentHash{RHashStr: lr.Id}).Once().Return(&lnrpc.Invoice{State: lnrpc.Invoice_SETTLED, AmtPaidSat: 1500}, nil)
+	proposed, _, err := s.resolveLedgerRecord(ctx, lr)
+	assert.Nil(t, err)
+	assert.Equal(t, tdrpc.COMPLETED, proposed.Status)
+	assert.Equal(t, int64(1500), proposed.Value)
+
+	// Canceled
+	mockLClient.On("LookupInvoice", mock.AnythingOfType("*context.emptyCtx"), &lnrpc.PaymentHash{RHashStr: lr.Id}).Once().Return(&lnrpc.Invoice{State: lnrpc.Invoice_CANCELED}, nil)
+	proposed, _, err = s.resolveLedgerRecord(ctx, lr)
+	assert.Nil(t, err)
+	assert.Equal(t, tdrpc.EXPIRED, proposed.Status)
+
+	// Open and not expired stays pending
+	mockLClient.On("LookupInvoice", mock.AnythingOfType("*context.emptyCtx"), &lnrpc.PaymentHash{RHashStr: lr.Id}).Once().Return(&lnrpc.Invoice{State: lnrpc.Invoice_OPEN, CreationDate: time.Now().Unix(), Expiry: 3600}, nil)
+	proposed, _, err = s.resolveLedgerRecord(ctx, lr)
+	assert.Nil(t, err)
+	assert.Nil(t, proposed)
+
+	// Not found in lnd
+	mockLClient.On("LookupInvoice", mock.AnythingOfType("*context.emptyCtx"), &lnrpc.PaymentHash{RHashStr: lr.Id}).Once().Return(nil, status.Error(codes.Unknown, "unable to locate invoice"))
+	proposed, _, err = s.resolveLedgerRecord(ctx, lr)
+	assert.Nil(t, err)
+	assert.Equal(t, tdrpc.EXPIRED, proposed.Status)
+
+	mockStore.AssertExpectations(t)
+	mockLClient.AssertExpectations(t)
+
+}
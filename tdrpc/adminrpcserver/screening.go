@@ -0,0 +1,129 @@
+package adminrpcserver
+
+import (
+	"context"
+
+	emptypb "github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"git.coinninja.net/backend/thunderdome/store"
+	"git.coinninja.net/backend/thunderdome/tdrpc"
+)
+
+func (s *adminRPCServer) ListScreeningEntries(ctx context.Context, request *tdrpc.AdminScreeningEntriesRequest) (*tdrpc.AdminScreeningEntriesResponse, error) {
+
+	if request.Filter == nil {
+		request.Filter = make(map[string]string)
+	}
+
+	// Match the stored form of the value
+	if value, ok := request.Filter["value"]; ok {
+		request.Filter["value"] = tdrpc.NormalizeScreeningValue(request.Filter["type"], value)
+	}
+
+	entries, err := s.store.GetScreeningEntries(ctx, request.Filter, int(request.Offset), int(request.Limit))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Error on GetScreeningEntries: %v", err)
+	}
+
+	return &tdrpc.AdminScreeningEntriesResponse{
+		ScreeningEntries: entries,
+	}, nil
+
+}
+
+func (s *adminRPCServer) CreateScreeningEntry(ctx context.Context, request *tdrpc.ScreeningEntry) (*tdrpc.ScreeningEntry, error) {
+
+	// Ensure the user has write access
+	if err := requireWrite(ctx); err != nil {
+		return nil, err
+	}
+
+	request.Value = tdrpc.NormalizeScreeningValue(request.Type, request.Value)
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
+	entry, err := s.store.CreateScreeningEntry(ctx, &tdrpc.ScreeningEntry{
+		List:   request.List,
+		Type:   request.Type,
+		Value:  request.Value,
+		Action: request.Action,
+		Note:   request.Note,
+	}, getOperator(ctx))
+	if err == store.ErrAlreadyExists {
+		return nil, status.Errorf(codes.AlreadyExists, "screening entry already exists")
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not create screening entry: %v", err)
+	}
+
+	s.logger.Infow("Screening Entry Created", "entry_id", entry.Id, "list", entry.List, "type", entry.Type, "value", entry.Value, "operator", getOperator(ctx))
+
+	return entry, nil
+
+}
+
+func (s *adminRPCServer) UpdateScreeningEntry(ctx context.Context, request *tdrpc.ScreeningEntry) (*tdrpc.ScreeningEntry, error) {
+
+	// Ensure the user has write access
+	if err := requireWrite(ctx); err != nil {
+		return nil, err
+	}
+
+	if request.Id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid id")
+	}
+
+	request.Value = tdrpc.NormalizeScreeningValue(request.Type, request.Value)
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
+	entry, err := s.store.SaveScreeningEntry(ctx, request, getOperator(ctx))
+	if err == store.ErrNotFound {
+		return nil, status.Errorf(codes.NotFound, "screening entry not found")
+	} else if err == store.ErrAlreadyExists {
+		return nil, status.Errorf(codes.AlreadyExists, "screening entry already exists")
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not update screening entry: %v", err)
+	}
+
+	s.logger.Infow("Screening Entry Updated", "entry_id", entry.Id, "list", entry.List, "type", entry.Type, "value", entry.Value, "operator", getOperator(ctx))
+
+	return entry, nil
+
+}
+
+func (s *adminRPCServer) DeleteScreeningEntry(ctx context.Context, request *tdrpc.AdminScreeningEntryRequest) (*emptypb.Empty, error) {
+
+	// Ensure the user has write access
+	if err := requireWrite(ctx); err != nil {
+		return nil, err
+	}
+
+	err := s.store.DeleteScreeningEntry(ctx, request.Id, getOperator(ctx))
+	if err == store.ErrNotFound {
+		return nil, status.Errorf(codes.NotFound, "screening entry not found")
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not delete screening entry: %v", err)
+	}
+
+	s.logger.Infow("Screening Entry Deleted", "entry_id", request.Id, "operator", getOperator(ctx))
+
+	return &emptypb.Empty{}, nil
+
+}
+
+func (s *adminRPCServer) ListScreeningHistory(ctx context.Context, request *tdrpc.AdminScreeningHistoryRequest) (*tdrpc.AdminScreeningHistoryResponse, error) {
+
+	history, err := s.store.GetScreeningHistory(ctx, request.EntryId, int(request.Offset), int(request.Limit))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Error on GetScreeningHistory: %v", err)
+	}
+
+	return &tdrpc.AdminScreeningHistoryResponse{
+		ScreeningHistory: history,
+	}, nil
+
+}
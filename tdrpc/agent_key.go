@@ -0,0 +1,65 @@
+package tdrpc
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"time"
+)
+
+const (
+	// AgentKeyPrefix is prepended to agent key secrets to distinguish them from signatures
+	AgentKeyPrefix = "ak."
+
+	// AgentKeyAccountWildcard at the end of an allowed account id makes it a prefix match
+	AgentKeyAccountWildcard = "*"
+)
+
+// AgentKeyScopes are the endpoints an agent key can be granted
+var AgentKeyScopes = map[string]string{
+	"CreateGenerated": CreateGeneratedEndpoint,
+	"Pay":             PayEndpoint,
+	"CreatePreAuth":   CreatePreAuthEndpoint,
+	"GetPreAuth":      GetPreAuthEndpoint,
+}
+
+// HashAgentKeySecret returns the hash of an agent key secret that is stored in the database
+func HashAgentKeySecret(secret string) string {
+	hash := sha256.Sum256([]byte(strings.TrimPrefix(secret, AgentKeyPrefix)))
+	return hex.EncodeToString(hash[:])
+}
+
+// Active returns true if the key has not been revoked or expired
+func (ak *AgentKey) Active(now time.Time) bool {
+	if ak.RevokedAt != nil {
+		return false
+	}
+	if ak.ExpiresAt != nil && !now.Before(*ak.ExpiresAt) {
+		return false
+	}
+	return true
+}
+
+// AllowsEndpoint returns true if the key has a scope for the endpoint
+func (ak *AgentKey) AllowsEndpoint(endpoint string) bool {
+	for _, scope := range ak.Scopes {
+		if AgentKeyScopes[scope] == endpoint {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowsAccount returns true if the key can act on behalf of the account
+func (ak *AgentKey) AllowsAccount(accountID string) bool {
+	for _, allowed := range ak.AccountIds {
+		if strings.HasSuffix(allowed, AgentKeyAccountWildcard) {
+			if strings.HasPrefix(accountID, strings.TrimSuffix(allowed, AgentKeyAccountWildcard)) {
+				return true
+			}
+		} else if allowed == accountID {
+			return true
+		}
+	}
+	return false
+}
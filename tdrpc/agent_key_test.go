@@ -0,0 +1,46 @@
+package tdrpc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAgentKey(t *testing.T) {
+
+	now := time.Now().UTC()
+	past := now.Add(-time.Minute)
+	future := now.Add(time.Minute)
+
+	ak := &AgentKey{
+		Scopes:     []string{"Pay", "CreatePreAuth"},
+		AccountIds: []string{"pubkey:abc", "xonlypubkey:*"},
+	}
+
+	// Scopes
+	assert.True(t, ak.AllowsEndpoint(PayEndpoint))
+	assert.True(t, ak.AllowsEndpoint(CreatePreAuthEndpoint))
+	assert.False(t, ak.AllowsEndpoint(CreateGeneratedEndpoint))
+	assert.False(t, ak.AllowsEndpoint(AccountEndpoint))
+
+	// Accounts
+	assert.True(t, ak.AllowsAccount("pubkey:abc"))
+	assert.False(t, ak.AllowsAccount("pubkey:abcd"))
+	assert.True(t, ak.AllowsAccount("xonlypubkey:abcd"))
+	assert.False(t, ak.AllowsAccount("other:abcd"))
+
+	// Expiration and revocation
+	assert.True(t, ak.Active(now))
+	ak.ExpiresAt = &future
+	assert.True(t, ak.Active(now))
+	ak.ExpiresAt = &past
+	assert.False(t, ak.Active(now))
+	ak.ExpiresAt = nil
+	ak.RevokedAt = &past
+	assert.False(t, ak.Active(now))
+
+	// The prefix is not part of the hash
+	assert.Equal(t, HashAgentKeySecret("secret"), HashAgentKeySecret(AgentKeyPrefix+"secret"))
+
+}
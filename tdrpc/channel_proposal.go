@@ -0,0 +1,34 @@
+package tdrpc
+
+import (
+	"time"
+)
+
+// Channel proposal actions and statuses
+const (
+	ChannelProposalActionOpen  = "open"
+	ChannelProposalActionClose = "close"
+
+	ChannelProposalStatusProposed  = "proposed"
+	ChannelProposalStatusApproved  = "approved"
+	ChannelProposalStatusRejected  = "rejected"
+	ChannelProposalStatusCompleted = "completed"
+	ChannelProposalStatusFailed    = "failed"
+
+	// ChannelProposalOperatorAutopilot is the operator of proposals the channel manager carries out itself
+	ChannelProposalOperatorAutopilot = "autopilot"
+)
+
+// RouteFailureStats are the payments to a destination that could not be routed
+type RouteFailureStats struct {
+	Destination string `db:"destination"`
+	Count       int64  `db:"count"`
+	Value       int64  `db:"value"`
+}
+
+// ChannelActivity is the last time the channel was used
+type ChannelActivity struct {
+	ChanPoint  string    `db:"chan_point"`
+	NumUpdates uint64    `db:"num_updates"`
+	ActiveAt   time.Time `db:"active_at"`
+}
@@ -0,0 +1,87 @@
+package tdrpc
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+)
+
+// DelegationScopes are the endpoints an account holder can delegate to a third party
+var DelegationScopes = map[string]string{
+	"GetAccount":      AccountEndpoint,
+	"Create":          CreateEndpoint,
+	"CreateGenerated": CreateGeneratedEndpoint,
+	"Ledger":          LedgerEndpoint,
+	"Pay":             PayEndpoint,
+	"CreatePreAuth":   CreatePreAuthEndpoint,
+	"GetPreAuth":      GetPreAuthEndpoint,
+}
+
+// Delegation is signed by an account holder to grant a delegate limited rights on their account
+type Delegation struct {
+	// Account is the public key of the account holder
+	Account string `json:"account"`
+	// Delegate is the public key of the third party that signs requests
+	Delegate string `json:"delegate"`
+	// Scopes are the endpoints the delegate may call
+	Scopes []string `json:"scopes"`
+	// DailyLimit is the max value the delegate can spend per UTC day
+	DailyLimit int64 `json:"daily_limit"`
+	// ExpiresAt is when the delegation is no longer valid
+	ExpiresAt time.Time `json:"expires_at"`
+	// SigType is the signature type used by the account holder to sign the delegation
+	SigType string `json:"sig_type,omitempty"`
+
+	// Id is the hash of the encoded delegation
+	Id string `json:"-"`
+}
+
+// ParseDelegation decodes a base64url encoded JSON delegation
+func ParseDelegation(encoded string) (*Delegation, error) {
+
+	data, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, ErrInvalidDelegation
+	}
+
+	var d = new(Delegation)
+	if err = json.Unmarshal(data, d); err != nil {
+		return nil, ErrInvalidDelegation
+	}
+
+	if d.Account == "" || d.Delegate == "" || d.ExpiresAt.IsZero() || d.DailyLimit < 0 {
+		return nil, ErrInvalidDelegation
+	}
+
+	hash := sha256.Sum256([]byte(encoded))
+	d.Id = hex.EncodeToString(hash[:])
+
+	return d, nil
+
+}
+
+// Encode returns the base64url encoded JSON delegation that is signed by the account holder
+func (d *Delegation) Encode() (string, error) {
+	data, err := json.Marshal(d)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// Active returns true if the delegation has not expired
+func (d *Delegation) Active(now time.Time) bool {
+	return now.Before(d.ExpiresAt)
+}
+
+// AllowsEndpoint returns true if the delegation has a scope for the endpoint
+func (d *Delegation) AllowsEndpoint(endpoint string) bool {
+	for _, scope := range d.Scopes {
+		if DelegationScopes[scope] == endpoint {
+			return true
+		}
+	}
+	return false
+}
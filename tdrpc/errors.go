@@ -9,18 +9,39 @@ import (
 var (
 	ErrSigVerficationFailed       = status.Errorf(codes.Unauthenticated, "signature verification failed")
 	ErrInvalidSig                 = status.Errorf(codes.Unauthenticated, "signature invalid")
+	ErrInvalidSigType             = status.Errorf(codes.Unauthenticated, "invalid signature type")
 	ErrInvalidPubKey              = status.Errorf(codes.Unauthenticated, "invalid public key string")
 	ErrInvalidTimestamp           = status.Errorf(codes.Unauthenticated, "invalid timestamp")
 	ErrInvalidTimestampOffset     = status.Errorf(codes.Unauthenticated, "invalid timestamp offset")
 	ErrInvalidLogin               = status.Errorf(codes.Unauthenticated, "invalid login")
+	ErrNonceRequired              = status.Errorf(codes.Unauthenticated, "nonce required")
+	ErrNonceReplay                = status.Errorf(codes.Unauthenticated, "nonce already used")
+	ErrInvalidToken               = status.Errorf(codes.Unauthenticated, "invalid token")
+	ErrInvalidDelegation          = status.Errorf(codes.Unauthenticated, "invalid delegation")
+	ErrDelegationLimitExceeded    = status.Errorf(codes.PermissionDenied, "delegation daily limit exceeded")
 	ErrPermissionDenied           = status.Errorf(codes.PermissionDenied, "permission denied")
+	ErrDestinationBlocked         = status.Errorf(codes.PermissionDenied, "destination is not allowed")
 	ErrAccountLocked              = status.Errorf(codes.PermissionDenied, "account is locked")
 	ErrServiceUnavailable         = status.Errorf(codes.Unavailable, "service unavailable")
 	ErrCreateRequestLimitExceeded = status.Errorf(codes.InvalidArgument, "You can only create %d unpaid requests.", config.GetInt64("tdome.create_request_limit"))
 	ErrRequestExpired             = status.Errorf(codes.InvalidArgument, "request is expired")
 	ErrRequestAlreadyPaid         = status.Errorf(codes.InvalidArgument, "request already paid")
+	ErrMaxPaymentExceeded         = status.Errorf(codes.InvalidArgument, "payment exceeds the max payment limit for this account")
+	ErrDailySendLimitExceeded     = status.Errorf(codes.InvalidArgument, "daily send limit exceeded for this account")
+	ErrWeeklySendLimitExceeded    = status.Errorf(codes.InvalidArgument, "weekly send limit exceeded for this account")
+	ErrDailyWithdrawLimitExceeded = status.Errorf(codes.InvalidArgument, "daily withdraw limit exceeded for this account")
+	ErrInvalidScreeningEntry      = status.Errorf(codes.InvalidArgument, "invalid screening entry")
+	ErrNotHeld                    = status.Errorf(codes.FailedPrecondition, "ledger record is not held")
+	ErrNotPending                 = status.Errorf(codes.FailedPrecondition, "ledger record is not pending")
+	ErrHoldNotAccepted            = status.Errorf(codes.FailedPrecondition, "hold invoice has not been paid")
+	ErrHoldNotActive              = status.Errorf(codes.FailedPrecondition, "hold invoice is not pending or accepted")
+	ErrNotProposed                = status.Errorf(codes.FailedPrecondition, "channel proposal has already been reviewed")
+	ErrInsufficientInbound        = status.Errorf(codes.FailedPrecondition, "the node cannot currently receive a payment this large, try a smaller amount")
+	ErrIdempotencyKeyMismatch     = status.Errorf(codes.InvalidArgument, "idempotency key was used for a different request")
+	ErrIdempotencyKeyInProgress   = status.Errorf(codes.Aborted, "a request with this idempotency key is in progress")
 	ErrInsufficientFunds          = status.Errorf(codes.InvalidArgument, "insufficient funds")
 	ErrCannotPaySelfInvoice       = status.Errorf(codes.InvalidArgument, "you cannot pay your own invoice")
+	ErrCannotPayHoldInternal      = status.Errorf(codes.InvalidArgument, "hold invoices cannot be paid by users of this service")
 	ErrNoRouteFound               = status.Errorf(codes.InvalidArgument, "No route was found in the Lightning Network. Your amount might be too large.")
 	ErrNotFound                   = status.Errorf(codes.NotFound, "not found")
 )
@@ -27,6 +27,10 @@ func (status *LedgerRecord_Status) Scan(src interface{}) error {
 		*status = EXPIRED
 	case "failed":
 		*status = FAILED
+	case "held":
+		*status = HELD
+	case "accepted":
+		*status = ACCEPTED
 	default:
 		return fmt.Errorf("Unknown status %s", statusString)
 	}
@@ -47,6 +51,10 @@ func (status LedgerRecord_Status) Value() (driver.Value, error) {
 		return "expired", nil
 	case FAILED:
 		return "failed", nil
+	case HELD:
+		return "held", nil
+	case ACCEPTED:
+		return "accepted", nil
 	}
 
 	return nil, fmt.Errorf("Unknown status %v", status)
@@ -65,6 +73,10 @@ func (status LedgerRecord_Status) MarshalJSON() ([]byte, error) {
 		return []byte(`"expired"`), nil
 	case FAILED:
 		return []byte(`"failed"`), nil
+	case HELD:
+		return []byte(`"held"`), nil
+	case ACCEPTED:
+		return []byte(`"accepted"`), nil
 	}
 
 	return nil, fmt.Errorf("Unknown type %v", status)
@@ -87,6 +99,12 @@ func (status *LedgerRecord_Status) UnmarshalJSON(in []byte) error {
 	case `"failed"`:
 		*status = FAILED
 		return nil
+	case `"held"`:
+		*status = HELD
+		return nil
+	case `"accepted"`:
+		*status = ACCEPTED
+		return nil
 	}
 
 	return fmt.Errorf("Unknown status %s", in)
@@ -105,6 +123,10 @@ func (status LedgerRecord_Status) String() string {
 		return "expired"
 	case FAILED:
 		return "failed"
+	case HELD:
+		return "held"
+	case ACCEPTED:
+		return "accepted"
 	}
 
 	return "unknown"
@@ -23,6 +23,10 @@ func (t *LedgerRecord_Type) Scan(src interface{}) error {
 		*t = BTC
 	case "lightning":
 		*t = LIGHTNING
+	case "adjustment":
+		*t = ADJUSTMENT
+	case "rebalance":
+		*t = REBALANCE
 	default:
 		return fmt.Errorf("Unknown type %s", typeString)
 	}
@@ -39,6 +43,10 @@ func (t LedgerRecord_Type) Value() (driver.Value, error) {
 		return "btc", nil
 	case LIGHTNING:
 		return "lightning", nil
+	case ADJUSTMENT:
+		return "adjustment", nil
+	case REBALANCE:
+		return "rebalance", nil
 	}
 
 	return nil, fmt.Errorf("Unknown type %v", t)
@@ -53,6 +61,10 @@ func (t LedgerRecord_Type) MarshalJSON() ([]byte, error) {
 		return []byte(`"btc"`), nil
 	case LIGHTNING:
 		return []byte(`"lightning"`), nil
+	case ADJUSTMENT:
+		return []byte(`"adjustment"`), nil
+	case REBALANCE:
+		return []byte(`"rebalance"`), nil
 	}
 
 	return nil, fmt.Errorf("Unknown type %v", t)
@@ -69,6 +81,12 @@ func (t *LedgerRecord_Type) UnmarshalJSON(in []byte) error {
 	case `"lightning"`:
 		*t = LIGHTNING
 		return nil
+	case `"adjustment"`:
+		*t = ADJUSTMENT
+		return nil
+	case `"rebalance"`:
+		*t = REBALANCE
+		return nil
 	}
 
 	return fmt.Errorf("Unknown type %s", in)
@@ -83,6 +101,10 @@ func (t LedgerRecord_Type) String() string {
 		return "btc"
 	case LIGHTNING:
 		return "lightning"
+	case ADJUSTMENT:
+		return "adjustment"
+	case REBALANCE:
+		return "rebalance"
 	}
 
 	return "unknown"
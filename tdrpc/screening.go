@@ -0,0 +1,62 @@
+package tdrpc
+
+import (
+	"strings"
+)
+
+// Screening lists, destination types and actions
+const (
+	ScreeningListDeny  = "deny"
+	ScreeningListAllow = "allow"
+
+	ScreeningTypeAddress = "address"
+	ScreeningTypeNode    = "node"
+
+	ScreeningActionBlock = "block"
+	ScreeningActionHold  = "hold"
+)
+
+// NormalizeScreeningValue returns the value in the form it is stored and matched
+// Node public keys and bech32 addresses are case insensitive, base58 addresses are not
+func NormalizeScreeningValue(screeningType string, value string) string {
+	value = strings.TrimSpace(value)
+	switch screeningType {
+	case ScreeningTypeNode:
+		return strings.ToLower(value)
+	case ScreeningTypeAddress:
+		lower := strings.ToLower(value)
+		if strings.HasPrefix(lower, "bc1") || strings.HasPrefix(lower, "tb1") || strings.HasPrefix(lower, "bcrt1") {
+			return lower
+		}
+	}
+	return value
+}
+
+// Validate ensures the screening entry has a valid list, type, value and action
+func (se *ScreeningEntry) Validate() error {
+
+	switch se.List {
+	case ScreeningListDeny:
+		if se.Action != ScreeningActionBlock && se.Action != ScreeningActionHold {
+			return ErrInvalidScreeningEntry
+		}
+	case ScreeningListAllow:
+		// Allow entries have no action
+		if se.Action != "" {
+			return ErrInvalidScreeningEntry
+		}
+	default:
+		return ErrInvalidScreeningEntry
+	}
+
+	if se.Type != ScreeningTypeAddress && se.Type != ScreeningTypeNode {
+		return ErrInvalidScreeningEntry
+	}
+
+	if se.Value == "" {
+		return ErrInvalidScreeningEntry
+	}
+
+	return nil
+
+}
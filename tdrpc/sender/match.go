@@ -0,0 +1,50 @@
+package sender
+
+import (
+	"context"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+
+	"git.coinninja.net/backend/thunderdome/store"
+	"git.coinninja.net/backend/thunderdome/tdrpc"
+)
+
+// MatchWithdrawTransaction finds the wallet transaction for a withdraw that does not already belong to another withdraw
+// It's used to find withdraws that were sent but never renamed from their temporary id, it returns nil if there is none
+func MatchWithdrawTransaction(ctx context.Context, s tdrpc.Store, lr *tdrpc.LedgerRecord, address string, txs []*lnrpc.Transaction) (*lnrpc.Transaction, error) {
+
+	var after int64
+	if lr.CreatedAt != nil {
+		after = lr.CreatedAt.Add(-time.Minute).Unix()
+	}
+
+	for _, tx := range txs {
+
+		// The wallet amount is the value sent plus the fee as a negative value
+		if tx.TimeStamp < after || -tx.Amount-tx.TotalFees != lr.Value {
+			continue
+		}
+
+		var found bool
+		for _, destAddress := range tx.DestAddresses {
+			if destAddress == address {
+				found = true
+				break
+			}
+		}
+		if !found {
+			continue
+		}
+
+		_, err := s.GetLedgerRecord(ctx, tx.TxHash, tdrpc.OUT)
+		if err == store.ErrNotFound {
+			return tx, nil
+		} else if err != nil {
+			return nil, err
+		}
+	}
+
+	return nil, nil
+
+}
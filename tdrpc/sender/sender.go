@@ -0,0 +1,162 @@
+package sender
+
+import (
+	"context"
+	"strings"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	config "github.com/spf13/viper"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"git.coinninja.net/backend/thunderdome/tdrpc"
+)
+
+// Sender sends outbound ledger records and updates the ledger with the result
+// It is shared by the user and admin servers so approved held records are sent the same way
+type Sender struct {
+	logger  *zap.SugaredLogger
+	store   tdrpc.Store
+	lclient lnrpc.LightningClient
+}
+
+// NewSender creates the sender
+func NewSender(store tdrpc.Store, lclient lnrpc.LightningClient) *Sender {
+
+	return &Sender{
+		logger:  zap.S().With("package", "sender"),
+		store:   store,
+		lclient: lclient,
+	}
+
+}
+
+// Internal transfers the payment lr to the user of this service that created the invoice with paymentHash
+// If it fails lr is marked failed and the funds are returned
+func (s *Sender) Internal(ctx context.Context, paymentHash string, lr *tdrpc.LedgerRecord) (*tdrpc.LedgerRecord, error) {
+
+	intLr, err := s.store.ProcessInternal(ctx, paymentHash, lr)
+	if err != nil {
+
+		// Mark the original record as failed
+		lr.Status = tdrpc.FAILED
+		lr.Error = status.Convert(err).Message()
+		if prlErr := s.store.ProcessLedgerRecord(ctx, lr); prlErr != nil {
+			s.logger.Errorw("ProcessLedgerRecord Error", zap.Any("lr", lr), "error", prlErr)
+		}
+
+		// A valid message is provided with this error
+		if status.Code(err) == codes.InvalidArgument {
+			return nil, err
+		}
+		s.logger.Errorw("ProcessInternal Error", zap.Any("lr", lr), "error", err)
+		return nil, status.Errorf(codes.Internal, "ProcessInternal error")
+	}
+
+	return intLr, nil
+
+}
+
+// Pay sends the lightning payment lr for the payment request in lr.Request and updates the ledger
+// lr.Status is failed if the funds were returned and pending if the payment is still in transition
+func (s *Sender) Pay(ctx context.Context, lr *tdrpc.LedgerRecord) error {
+
+	sendPaymentSyncRequest := &lnrpc.SendRequest{
+		Amt:            lr.Value,
+		PaymentRequest: lr.Request,
+	}
+	response, err := s.lclient.SendPaymentSync(ctx, sendPaymentSyncRequest)
+	if err != nil {
+		// The payment is still in transition, it could end up getting paid. Leave it for now as pending.
+		if strings.Contains(err.Error(), "transition") { // Error should be: payment is in transition
+			lr.Status = tdrpc.PENDING
+			lr.Error = err.Error()
+		} else {
+			lr.Status = tdrpc.FAILED
+			lr.Error = err.Error()
+		}
+	} else if response.PaymentError != "" {
+		lr.Status = tdrpc.FAILED
+		lr.Error = response.PaymentError
+	} else {
+		lr.Status = tdrpc.COMPLETED
+	}
+
+	// TODO: Determine if route taken was not the same as the quoted route and account for fee difference
+
+	// Update the status and the balance
+	if plrerr := s.store.ProcessLedgerRecord(ctx, lr); plrerr != nil {
+		// A valid message is provided with this error
+		if status.Code(plrerr) == codes.InvalidArgument {
+			return plrerr
+		}
+		s.logger.Errorw("ProcessLedgerRecord Error", zap.Any("lr", lr), "error", plrerr)
+		return status.Errorf(codes.Internal, "ProcessLedgerRecord internal error")
+	}
+
+	// If there was an error, the ledger has been updated, return the error now
+	if err != nil {
+		s.logger.Errorw("LND SendPaymentSync Error", zap.Any("request", sendPaymentSyncRequest), "error", err)
+		return status.Errorf(codes.Internal, "Could not SendPaymentSync: %v", status.Convert(err).Message())
+	}
+
+	return nil
+
+}
+
+// Withdraw sends the withdraw lr to the address in lr.Request at satPerByte and returns the transaction id
+// If satPerByte is zero lnd picks the fee rate for tdome.default_withdraw_target_blocks
+// If it fails lr is marked failed and the funds are returned
+func (s *Sender) Withdraw(ctx context.Context, lr *tdrpc.LedgerRecord, satPerByte int64) (string, error) {
+
+	sendCoinsRequest := &lnrpc.SendCoinsRequest{
+		Addr:       lr.Request,
+		Amount:     lr.Value,
+		SatPerByte: satPerByte,
+	}
+	if satPerByte == 0 {
+		sendCoinsRequest.TargetConf = config.GetInt32("tdome.default_withdraw_target_blocks")
+	}
+
+	// Send the payment
+	response, err := s.lclient.SendCoins(ctx, sendCoinsRequest)
+	if err != nil {
+		lr.Status = tdrpc.FAILED
+		lr.Error = err.Error()
+
+		// Update the record to failed - return funds
+		if plrerr := s.store.ProcessLedgerRecord(ctx, lr); plrerr != nil {
+			// A valid message is provided with this error
+			if status.Code(plrerr) == codes.InvalidArgument {
+				return "", plrerr
+			}
+			s.logger.Errorw("ProcessLedgerRecord Error", zap.Any("lr", lr), "error", plrerr)
+			return "", status.Errorf(codes.Internal, "ProcessLedgerRecord internal error")
+		}
+
+		s.logger.Errorw("LND SendCoins Error", zap.Any("request", sendCoinsRequest), "error", err)
+		return "", status.Errorf(codes.Internal, "Could not SendCoins: %v", status.Convert(err).Message())
+	}
+
+	return response.Txid, nil
+
+}
+
+// RenameWithdraw updates the id of a sent withdraw from its temporary id to the transaction id
+// If it does not complete, the withdraw recovery monitor will rename it
+func (s *Sender) RenameWithdraw(ctx context.Context, tempID string, txid string) error {
+
+	err := s.store.UpdateLedgerRecordID(ctx, tempID, txid, tdrpc.OUT)
+	if err != nil {
+		// A valid message is provided with this error
+		if status.Code(err) == codes.InvalidArgument {
+			return err
+		}
+		s.logger.Errorw("UpdateLedgerRecordID Error", "prev", tempID, "next", txid)
+		return status.Errorf(codes.Internal, "UpdateLedgerRecordID internal error")
+	}
+
+	return nil
+
+}
@@ -42,6 +42,8 @@ const (
 	COMPLETED LedgerRecord_Status = 1
 	EXPIRED   LedgerRecord_Status = 2
 	FAILED    LedgerRecord_Status = 3
+	HELD      LedgerRecord_Status = 4
+	ACCEPTED  LedgerRecord_Status = 5
 )
 
 var LedgerRecord_Status_name = map[int32]string{
@@ -49,6 +51,8 @@ var LedgerRecord_Status_name = map[int32]string{
 	1: "COMPLETED",
 	2: "EXPIRED",
 	3: "FAILED",
+	4: "HELD",
+	5: "ACCEPTED",
 }
 
 var LedgerRecord_Status_value = map[string]int32{
@@ -56,6 +60,8 @@ var LedgerRecord_Status_value = map[string]int32{
 	"COMPLETED": 1,
 	"EXPIRED":   2,
 	"FAILED":    3,
+	"HELD":      4,
+	"ACCEPTED":  5,
 }
 
 func (LedgerRecord_Status) EnumDescriptor() ([]byte, []int) {
@@ -66,18 +72,24 @@ func (LedgerRecord_Status) EnumDescriptor() ([]byte, []int) {
 type LedgerRecord_Type int32
 
 const (
-	BTC       LedgerRecord_Type = 0
-	LIGHTNING LedgerRecord_Type = 1
+	BTC        LedgerRecord_Type = 0
+	LIGHTNING  LedgerRecord_Type = 1
+	ADJUSTMENT LedgerRecord_Type = 2
+	REBALANCE  LedgerRecord_Type = 3
 )
 
 var LedgerRecord_Type_name = map[int32]string{
 	0: "BTC",
 	1: "LIGHTNING",
+	2: "ADJUSTMENT",
+	3: "REBALANCE",
 }
 
 var LedgerRecord_Type_value = map[string]int32{
-	"BTC":       0,
-	"LIGHTNING": 1,
+	"BTC":        0,
+	"LIGHTNING":  1,
+	"ADJUSTMENT": 2,
+	"REBALANCE":  3,
 }
 
 func (LedgerRecord_Type) EnumDescriptor() ([]byte, []int) {
@@ -227,7 +239,7 @@ type LedgerRecord struct {
 	ExpiresAt *time.Time `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at,omitempty" db:"expires_at"`
 	// The record status
 	Status LedgerRecord_Status `protobuf:"varint,6,opt,name=status,proto3,enum=tdrpc.LedgerRecord_Status" json:"status"`
-	// The record type (BTC or LN)
+	// The record type (BTC, LN, a manual adjustment or the cost of a channel rebalance)
 	Type LedgerRecord_Type `protobuf:"varint,7,opt,name=type,proto3,enum=tdrpc.LedgerRecord_Type" json:"type"`
 	// The direction of the transaction
 	Direction LedgerRecord_Direction `protobuf:"varint,8,opt,name=direction,proto3,enum=tdrpc.LedgerRecord_Direction" json:"direction"`
@@ -249,6 +261,12 @@ type LedgerRecord struct {
 	Error string `protobuf:"bytes,16,opt,name=error,proto3" json:"error"`
 	// Used to hide records that are duplicates
 	Hidden bool `protobuf:"varint,17,opt,name=hidden,proto3" json:"-"`
+	// Is this a hold invoice that must be settled with the preimage
+	Hold bool `protobuf:"varint,18,opt,name=hold,proto3" json:"hold"`
+	// The block height an accepted hold invoice is canceled at, before its htlcs expire
+	CancelHeight int64 `protobuf:"varint,19,opt,name=cancel_height,json=cancelHeight,proto3" json:"-" db:"cancel_height"`
+	// The fee rate quoted for a held withdraw, it is sent at this rate when approved
+	SatPerByte int64 `protobuf:"varint,20,opt,name=sat_per_byte,json=satPerByte,proto3" json:"-" db:"sat_per_byte"`
 }
 
 func (m *LedgerRecord) Reset()      { *m = LedgerRecord{} }
@@ -402,6 +420,27 @@ func (m *LedgerRecord) GetHidden() bool {
 	return false
 }
 
+func (m *LedgerRecord) GetHold() bool {
+	if m != nil {
+		return m.Hold
+	}
+	return false
+}
+
+func (m *LedgerRecord) GetCancelHeight() int64 {
+	if m != nil {
+		return m.CancelHeight
+	}
+	return 0
+}
+
+func (m *LedgerRecord) GetSatPerByte() int64 {
+	if m != nil {
+		return m.SatPerByte
+	}
+	return 0
+}
+
 // Decode Request
 type DecodeRequest struct {
 	// The payment request to be decoded
@@ -701,6 +740,8 @@ type CreateRequest struct {
 	Value int64 `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
 	// How long (in seconds) the payment request should be valid for
 	Expires int64 `protobuf:"varint,3,opt,name=expires,proto3" json:"expires,omitempty"`
+	// An optional key that ensures retries of the same request return the original result
+	IdempotencyKey string `protobuf:"bytes,4,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
 }
 
 func (m *CreateRequest) Reset()      { *m = CreateRequest{} }
@@ -756,16 +797,142 @@ func (m *CreateRequest) GetExpires() int64 {
 	return 0
 }
 
+func (m *CreateRequest) GetIdempotencyKey() string {
+	if m != nil {
+		return m.IdempotencyKey
+	}
+	return ""
+}
+
+// Create Hold Request
+type CreateHoldRequest struct {
+	// The hex encoded sha256 hash of the preimage that will settle the invoice
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// An optional memo to include
+	Memo string `protobuf:"bytes,2,opt,name=memo,proto3" json:"memo,omitempty"`
+	// The amount for the payment request
+	Value int64 `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"`
+	// How long (in seconds) the payment request can be paid and settled before it's canceled
+	Expires int64 `protobuf:"varint,4,opt,name=expires,proto3" json:"expires,omitempty"`
+}
+
+func (m *CreateHoldRequest) Reset()      { *m = CreateHoldRequest{} }
+func (*CreateHoldRequest) ProtoMessage() {}
+func (*CreateHoldRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1666a7b80216d36, []int{7}
+}
+func (m *CreateHoldRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateHoldRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateHoldRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreateHoldRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateHoldRequest.Merge(m, src)
+}
+func (m *CreateHoldRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateHoldRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateHoldRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateHoldRequest proto.InternalMessageInfo
+
+func (m *CreateHoldRequest) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *CreateHoldRequest) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+func (m *CreateHoldRequest) GetValue() int64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+func (m *CreateHoldRequest) GetExpires() int64 {
+	if m != nil {
+		return m.Expires
+	}
+	return 0
+}
+
+// Settle Hold Request
+type SettleHoldRequest struct {
+	// The hex encoded preimage for the hold invoice hash
+	Preimage string `protobuf:"bytes,1,opt,name=preimage,proto3" json:"preimage,omitempty"`
+}
+
+func (m *SettleHoldRequest) Reset()      { *m = SettleHoldRequest{} }
+func (*SettleHoldRequest) ProtoMessage() {}
+func (*SettleHoldRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1666a7b80216d36, []int{8}
+}
+func (m *SettleHoldRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SettleHoldRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SettleHoldRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SettleHoldRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SettleHoldRequest.Merge(m, src)
+}
+func (m *SettleHoldRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SettleHoldRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SettleHoldRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SettleHoldRequest proto.InternalMessageInfo
+
+func (m *SettleHoldRequest) GetPreimage() string {
+	if m != nil {
+		return m.Preimage
+	}
+	return ""
+}
+
 // Create Response
 type CreateResponse struct {
 	// The payment request string
 	Request string `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	// Set when the node may not have enough inbound liquidity to receive the payment
+	Warning string `protobuf:"bytes,2,opt,name=warning,proto3" json:"warning,omitempty"`
 }
 
 func (m *CreateResponse) Reset()      { *m = CreateResponse{} }
 func (*CreateResponse) ProtoMessage() {}
 func (*CreateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f1666a7b80216d36, []int{7}
+	return fileDescriptor_f1666a7b80216d36, []int{9}
 }
 func (m *CreateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -801,6 +968,13 @@ func (m *CreateResponse) GetRequest() string {
 	return ""
 }
 
+func (m *CreateResponse) GetWarning() string {
+	if m != nil {
+		return m.Warning
+	}
+	return ""
+}
+
 // Pay Request
 type PayRequest struct {
 	// The payment request string
@@ -811,12 +985,14 @@ type PayRequest struct {
 	Estimate bool `protobuf:"varint,3,opt,name=estimate,proto3" json:"estimate,omitempty"`
 	// A pre-authorized request id
 	PreAuthId string `protobuf:"bytes,4,opt,name=pre_auth_id,json=preAuthId,proto3" json:"preauth_id"`
+	// An optional key that ensures retries of the same payment return the original result
+	IdempotencyKey string `protobuf:"bytes,5,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
 }
 
 func (m *PayRequest) Reset()      { *m = PayRequest{} }
 func (*PayRequest) ProtoMessage() {}
 func (*PayRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f1666a7b80216d36, []int{8}
+	return fileDescriptor_f1666a7b80216d36, []int{10}
 }
 func (m *PayRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -873,6 +1049,13 @@ func (m *PayRequest) GetPreAuthId() string {
 	return ""
 }
 
+func (m *PayRequest) GetIdempotencyKey() string {
+	if m != nil {
+		return m.IdempotencyKey
+	}
+	return ""
+}
+
 // A single Ledger Record result
 type LedgerRecordResponse struct {
 	// The pay request result
@@ -882,7 +1065,7 @@ type LedgerRecordResponse struct {
 func (m *LedgerRecordResponse) Reset()      { *m = LedgerRecordResponse{} }
 func (*LedgerRecordResponse) ProtoMessage() {}
 func (*LedgerRecordResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f1666a7b80216d36, []int{9}
+	return fileDescriptor_f1666a7b80216d36, []int{11}
 }
 func (m *LedgerRecordResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -932,7 +1115,7 @@ type LedgerRequest struct {
 func (m *LedgerRequest) Reset()      { *m = LedgerRequest{} }
 func (*LedgerRequest) ProtoMessage() {}
 func (*LedgerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f1666a7b80216d36, []int{10}
+	return fileDescriptor_f1666a7b80216d36, []int{12}
 }
 func (m *LedgerRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -998,7 +1181,7 @@ type LedgerResponse struct {
 func (m *LedgerResponse) Reset()      { *m = LedgerResponse{} }
 func (*LedgerResponse) ProtoMessage() {}
 func (*LedgerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f1666a7b80216d36, []int{11}
+	return fileDescriptor_f1666a7b80216d36, []int{13}
 }
 func (m *LedgerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1046,12 +1229,14 @@ type WithdrawRequest struct {
 	SatPerByte int64 `protobuf:"varint,4,opt,name=sat_per_byte,json=satPerByte,proto3" json:"sat_per_byte,omitempty"`
 	// Estimate the withdraw request that would be created based on fee inputs
 	Estimate bool `protobuf:"varint,5,opt,name=estimate,proto3" json:"estimate,omitempty"`
+	// An optional key that ensures retries of the same withdraw are only sent once
+	IdempotencyKey string `protobuf:"bytes,6,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
 }
 
 func (m *WithdrawRequest) Reset()      { *m = WithdrawRequest{} }
 func (*WithdrawRequest) ProtoMessage() {}
 func (*WithdrawRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f1666a7b80216d36, []int{12}
+	return fileDescriptor_f1666a7b80216d36, []int{14}
 }
 func (m *WithdrawRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1115,6 +1300,13 @@ func (m *WithdrawRequest) GetEstimate() bool {
 	return false
 }
 
+func (m *WithdrawRequest) GetIdempotencyKey() string {
+	if m != nil {
+		return m.IdempotencyKey
+	}
+	return ""
+}
+
 // Withdraw Response
 type WithdrawResponse struct {
 	// The withdraw request result
@@ -1124,7 +1316,7 @@ type WithdrawResponse struct {
 func (m *WithdrawResponse) Reset()      { *m = WithdrawResponse{} }
 func (*WithdrawResponse) ProtoMessage() {}
 func (*WithdrawResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f1666a7b80216d36, []int{13}
+	return fileDescriptor_f1666a7b80216d36, []int{15}
 }
 func (m *WithdrawResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1169,7 +1361,7 @@ type CreateGeneratedRequest struct {
 func (m *CreateGeneratedRequest) Reset()      { *m = CreateGeneratedRequest{} }
 func (*CreateGeneratedRequest) ProtoMessage() {}
 func (*CreateGeneratedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f1666a7b80216d36, []int{14}
+	return fileDescriptor_f1666a7b80216d36, []int{16}
 }
 func (m *CreateGeneratedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1213,7 +1405,7 @@ type Id struct {
 func (m *Id) Reset()      { *m = Id{} }
 func (*Id) ProtoMessage() {}
 func (*Id) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f1666a7b80216d36, []int{15}
+	return fileDescriptor_f1666a7b80216d36, []int{17}
 }
 func (m *Id) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1249,6 +1441,132 @@ func (m *Id) GetId() string {
 	return ""
 }
 
+// CreateToken Request
+type CreateTokenRequest struct {
+	// The RPCs the token can be used for, all except CreateToken if not specified
+	Rpcs []string `protobuf:"bytes,1,rep,name=rpcs,proto3" json:"rpcs,omitempty"`
+	// The max value of any single payment, pre-auth or withdraw, no limit if not specified
+	MaxPay int64 `protobuf:"varint,2,opt,name=max_pay,json=maxPay,proto3" json:"max_pay,omitempty"`
+	// How long the token is valid for in seconds
+	Expires int64 `protobuf:"varint,3,opt,name=expires,proto3" json:"expires,omitempty"`
+}
+
+func (m *CreateTokenRequest) Reset()      { *m = CreateTokenRequest{} }
+func (*CreateTokenRequest) ProtoMessage() {}
+func (*CreateTokenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1666a7b80216d36, []int{18}
+}
+func (m *CreateTokenRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateTokenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateTokenRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreateTokenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateTokenRequest.Merge(m, src)
+}
+func (m *CreateTokenRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateTokenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateTokenRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateTokenRequest proto.InternalMessageInfo
+
+func (m *CreateTokenRequest) GetRpcs() []string {
+	if m != nil {
+		return m.Rpcs
+	}
+	return nil
+}
+
+func (m *CreateTokenRequest) GetMaxPay() int64 {
+	if m != nil {
+		return m.MaxPay
+	}
+	return 0
+}
+
+func (m *CreateTokenRequest) GetExpires() int64 {
+	if m != nil {
+		return m.Expires
+	}
+	return 0
+}
+
+// CreateToken Response
+type CreateTokenResponse struct {
+	// The id of the token, used to revoke it
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The bearer token
+	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	// When the token expires
+	ExpiresAt *time.Time `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at,omitempty"`
+}
+
+func (m *CreateTokenResponse) Reset()      { *m = CreateTokenResponse{} }
+func (*CreateTokenResponse) ProtoMessage() {}
+func (*CreateTokenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1666a7b80216d36, []int{19}
+}
+func (m *CreateTokenResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateTokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateTokenResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreateTokenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateTokenResponse.Merge(m, src)
+}
+func (m *CreateTokenResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateTokenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateTokenResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateTokenResponse proto.InternalMessageInfo
+
+func (m *CreateTokenResponse) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *CreateTokenResponse) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *CreateTokenResponse) GetExpiresAt() *time.Time {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("tdrpc.LedgerRecord_Status", LedgerRecord_Status_name, LedgerRecord_Status_value)
 	proto.RegisterEnum("tdrpc.LedgerRecord_Type", LedgerRecord_Type_name, LedgerRecord_Type_value)
@@ -1260,6 +1578,8 @@ func init() {
 	proto.RegisterType((*RouteHint)(nil), "tdrpc.RouteHint")
 	proto.RegisterType((*HopHint)(nil), "tdrpc.HopHint")
 	proto.RegisterType((*CreateRequest)(nil), "tdrpc.CreateRequest")
+	proto.RegisterType((*CreateHoldRequest)(nil), "tdrpc.CreateHoldRequest")
+	proto.RegisterType((*SettleHoldRequest)(nil), "tdrpc.SettleHoldRequest")
 	proto.RegisterType((*CreateResponse)(nil), "tdrpc.CreateResponse")
 	proto.RegisterType((*PayRequest)(nil), "tdrpc.PayRequest")
 	proto.RegisterType((*LedgerRecordResponse)(nil), "tdrpc.LedgerRecordResponse")
@@ -1270,215 +1590,253 @@ func init() {
 	proto.RegisterType((*WithdrawResponse)(nil), "tdrpc.WithdrawResponse")
 	proto.RegisterType((*CreateGeneratedRequest)(nil), "tdrpc.CreateGeneratedRequest")
 	proto.RegisterType((*Id)(nil), "tdrpc.Id")
+	proto.RegisterType((*CreateTokenRequest)(nil), "tdrpc.CreateTokenRequest")
+	proto.RegisterType((*CreateTokenResponse)(nil), "tdrpc.CreateTokenResponse")
 }
 
 func init() { proto.RegisterFile("tdrpc/tdrpc.proto", fileDescriptor_f1666a7b80216d36) }
 
 var fileDescriptor_f1666a7b80216d36 = []byte{
-	// 3242 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0x5d, 0x8c, 0x1b, 0x49,
-	0x5e, 0x9f, 0xb6, 0x67, 0x3c, 0xe3, 0xf2, 0x7c, 0xa5, 0x36, 0x9b, 0x78, 0x67, 0x37, 0xe3, 0xda,
-	0x66, 0x81, 0x90, 0xcd, 0xd8, 0xed, 0xf6, 0x77, 0xdf, 0x5d, 0xf6, 0xec, 0xc9, 0x24, 0x99, 0xbd,
-	0x24, 0x3b, 0x74, 0x86, 0xdb, 0x25, 0x27, 0xe4, 0x2d, 0x77, 0x95, 0xed, 0x4e, 0xda, 0xdd, 0xa6,
-	0xbb, 0x9c, 0xc4, 0x8a, 0x46, 0x3a, 0x21, 0x21, 0x21, 0xee, 0x81, 0xd3, 0x20, 0xf1, 0x70, 0x0f,
-	0xbc, 0xf0, 0x72, 0x8f, 0x9c, 0x84, 0x04, 0x12, 0x12, 0xf0, 0x84, 0x10, 0x42, 0x62, 0x25, 0x5e,
-	0xee, 0x69, 0x60, 0xb3, 0x08, 0xa1, 0x79, 0x40, 0xc7, 0xc2, 0x03, 0x8f, 0xa8, 0xaa, 0xab, 0xdd,
-	0xed, 0x99, 0x5c, 0xb2, 0x3a, 0x2d, 0x44, 0xca, 0x74, 0xff, 0x3f, 0xea, 0xd7, 0xff, 0xfa, 0x7f,
-	0xd5, 0xbf, 0x66, 0xc0, 0x05, 0x46, 0xfc, 0xb1, 0x55, 0x12, 0x3f, 0x8b, 0x63, 0xdf, 0x63, 0x1e,
-	0x5c, 0x12, 0xc4, 0xd6, 0x3b, 0x03, 0xcf, 0x1b, 0x38, 0xb4, 0x84, 0xc7, 0x76, 0x09, 0xbb, 0xae,
-	0xc7, 0x30, 0xb3, 0x3d, 0x37, 0x08, 0x95, 0xb6, 0xde, 0x96, 0x52, 0x41, 0xf5, 0x26, 0xfd, 0x12,
-	0x1d, 0x8d, 0xd9, 0x54, 0x0a, 0x0b, 0x67, 0x85, 0xcc, 0x1e, 0xd1, 0x80, 0xe1, 0xd1, 0x58, 0x2a,
-	0xec, 0x0c, 0x6c, 0x36, 0x9c, 0xf4, 0x8a, 0x96, 0x37, 0x2a, 0x0d, 0xbc, 0x81, 0x17, 0x6b, 0x72,
-	0x4a, 0x10, 0xe2, 0x4d, 0xaa, 0x5f, 0x17, 0x0f, 0x6b, 0x67, 0x40, 0xdd, 0x9d, 0xe0, 0x29, 0x1e,
-	0x0c, 0xa8, 0x5f, 0xf2, 0xc6, 0xc2, 0x9c, 0xf3, 0xa6, 0xa9, 0x7f, 0xb5, 0x04, 0x96, 0xdb, 0x96,
-	0xe5, 0x4d, 0x5c, 0x06, 0xd7, 0x41, 0xca, 0x26, 0x79, 0x05, 0x29, 0x57, 0xb3, 0x66, 0xca, 0x26,
-	0xd0, 0x04, 0xc0, 0xf2, 0x29, 0x66, 0x94, 0x74, 0x31, 0xcb, 0xa7, 0x90, 0x72, 0x35, 0xa7, 0x6f,
-	0x15, 0x43, 0x73, 0x8b, 0x91, 0x11, 0xc5, 0xc3, 0xc8, 0xdc, 0xce, 0xe5, 0x2f, 0x4f, 0x0a, 0x1b,
-	0xa4, 0x67, 0xa8, 0xf1, 0x2a, 0xf5, 0x87, 0xff, 0x5c, 0x50, 0xcc, 0xac, 0x64, 0xb4, 0x19, 0xc7,
-	0x9c, 0x8c, 0x49, 0x84, 0x99, 0xfe, 0xea, 0x98, 0xf1, 0x2a, 0x89, 0x29, 0x19, 0x6d, 0x06, 0xf3,
-	0x60, 0x19, 0x13, 0xe2, 0xd3, 0x20, 0xc8, 0x2f, 0x0a, 0xe3, 0x23, 0x12, 0x5e, 0x07, 0xcb, 0x3d,
-	0xec, 0x60, 0xd7, 0xa2, 0xf9, 0x25, 0xa4, 0x5c, 0x4d, 0x77, 0xe0, 0x71, 0x7b, 0xf1, 0x47, 0x29,
-	0x25, 0x7d, 0x7a, 0x52, 0x88, 0x24, 0x66, 0xf4, 0x02, 0x6f, 0x03, 0x30, 0xa6, 0x2e, 0xb1, 0xdd,
-	0x41, 0xd7, 0x76, 0xf3, 0x19, 0xb1, 0xe0, 0x6a, 0xbc, 0x20, 0x21, 0x8c, 0x8c, 0x8a, 0x39, 0xaa,
-	0x99, 0x95, 0xc4, 0xbe, 0x0b, 0xbf, 0x03, 0x72, 0x91, 0xc4, 0x9b, 0xb0, 0xfc, 0xb2, 0x40, 0xba,
-	0x16, 0x23, 0x25, 0xa5, 0x5f, 0x9e, 0x14, 0x36, 0x93, 0x50, 0xde, 0x84, 0xa9, 0x66, 0xf4, 0xa9,
-	0x8f, 0x26, 0x0c, 0xaa, 0x20, 0xe3, 0x78, 0xd6, 0x63, 0x4a, 0xf2, 0x2b, 0x48, 0xb9, 0xba, 0xd2,
-	0x01, 0xa7, 0x27, 0x05, 0xc9, 0x31, 0xe5, 0xd3, 0xf8, 0x1f, 0xe5, 0xb8, 0xfd, 0xdf, 0x8a, 0xfe,
-	0x9f, 0x0a, 0xfc, 0x0f, 0xe5, 0x39, 0x52, 0x6d, 0xa2, 0x1a, 0x48, 0x1d, 0x4f, 0x7a, 0x8f, 0xe9,
-	0xd4, 0xc0, 0x3d, 0x0b, 0xf7, 0xac, 0xb2, 0x5e, 0x29, 0xeb, 0x15, 0xf5, 0x3a, 0x4a, 0x06, 0xc7,
-	0x40, 0xaa, 0xae, 0x95, 0x5b, 0x3b, 0x65, 0x6d, 0x47, 0x2b, 0x1f, 0x96, 0x9b, 0x46, 0xa5, 0x62,
-	0x94, 0x1b, 0xc5, 0xba, 0x56, 0x7f, 0xc8, 0x35, 0x13, 0x2e, 0x7f, 0x8d, 0xa6, 0xf4, 0xb7, 0x6a,
-	0xa8, 0xfa, 0xbd, 0xe9, 0x08, 0xdf, 0x7c, 0xd4, 0xfc, 0xb0, 0xff, 0xa4, 0xc6, 0x3e, 0x79, 0x52,
-	0xef, 0x0d, 0x1f, 0x7d, 0xf7, 0xbb, 0x63, 0x3b, 0xb8, 0xf3, 0x24, 0xe8, 0x05, 0x9f, 0x8c, 0x86,
-	0xb7, 0x7a, 0x7b, 0x7c, 0x81, 0x74, 0xb9, 0x6a, 0x94, 0x35, 0xfe, 0xef, 0x3a, 0x4a, 0xba, 0xd2,
-	0xa8, 0xcd, 0xb3, 0xb8, 0x4b, 0x0c, 0x54, 0x0f, 0x99, 0xe1, 0x8e, 0x55, 0x03, 0x31, 0x7f, 0x42,
-	0xd1, 0x91, 0xfa, 0x8f, 0x39, 0xb0, 0x7a, 0x97, 0x92, 0x01, 0xf5, 0x4d, 0x6a, 0x79, 0x3e, 0x39,
-	0x97, 0xc5, 0x3a, 0x00, 0x38, 0x4c, 0xf0, 0xae, 0x4d, 0x44, 0x16, 0x67, 0x3b, 0x6f, 0x44, 0x01,
-	0x8c, 0x25, 0xaa, 0x99, 0x95, 0xc4, 0xfe, 0xd9, 0xcc, 0x4f, 0xff, 0x1f, 0x64, 0xfe, 0xe2, 0xd7,
-	0x92, 0xf9, 0x26, 0x00, 0xf4, 0xd9, 0xd8, 0xf6, 0x69, 0xc0, 0x31, 0x97, 0xbe, 0x3a, 0x66, 0xbc,
-	0x4a, 0x62, 0x4a, 0x46, 0x9b, 0xc1, 0x1b, 0x20, 0x13, 0x30, 0xcc, 0x26, 0x81, 0xa8, 0x80, 0x75,
-	0x7d, 0xab, 0x18, 0xf6, 0xbb, 0xa4, 0x93, 0x8b, 0x0f, 0x84, 0x46, 0x98, 0x8b, 0xa1, 0xb6, 0x29,
-	0x9f, 0xb0, 0x0e, 0x16, 0xd9, 0x74, 0x4c, 0x45, 0xd6, 0xaf, 0xeb, 0xf9, 0x97, 0xad, 0x3e, 0x9c,
-	0x8e, 0x69, 0x67, 0xe5, 0xf4, 0xa4, 0x20, 0x34, 0x4d, 0xf1, 0x13, 0x7e, 0x08, 0xb2, 0xc4, 0xf6,
-	0xa9, 0xc5, 0xbb, 0x93, 0x48, 0xf5, 0x75, 0xfd, 0xca, 0xcb, 0x16, 0xdf, 0x8c, 0x94, 0x3a, 0x6b,
-	0xa7, 0x27, 0x85, 0x78, 0x8d, 0x19, 0xbf, 0xc2, 0x5f, 0x02, 0xd9, 0x01, 0x75, 0xa9, 0xcf, 0xdd,
-	0x94, 0xcf, 0x8a, 0xb2, 0x59, 0x3a, 0x3d, 0x29, 0x28, 0x3b, 0x66, 0xcc, 0x87, 0xbf, 0x02, 0x96,
-	0x9e, 0x60, 0x67, 0x42, 0xf3, 0x40, 0xd4, 0xe7, 0x66, 0x5c, 0x9f, 0x21, 0xdf, 0x0c, 0x1f, 0xbc,
-	0x9a, 0x5d, 0xca, 0x9e, 0x7a, 0xfe, 0xe3, 0x6e, 0x9f, 0xd2, 0x7c, 0xee, 0x5c, 0x35, 0x27, 0xa4,
-	0x51, 0x35, 0x27, 0x58, 0xaa, 0x09, 0x24, 0x75, 0x8b, 0x52, 0xf8, 0x31, 0x58, 0x1f, 0xfb, 0x9e,
-	0x45, 0x83, 0x80, 0x67, 0x36, 0xc7, 0x5b, 0x15, 0x78, 0x5a, 0x8c, 0x77, 0x46, 0xe1, 0xcb, 0x93,
-	0xc2, 0x1b, 0xa2, 0x41, 0xcc, 0x71, 0x55, 0x73, 0x2d, 0x66, 0x70, 0xe0, 0x2a, 0xc8, 0x62, 0x42,
-	0xba, 0xb6, 0x4b, 0xe8, 0xb3, 0xfc, 0x1a, 0x52, 0xae, 0x2e, 0x76, 0x2e, 0x8b, 0x2d, 0x7f, 0x79,
-	0x52, 0x58, 0x17, 0xa9, 0x1e, 0x49, 0x55, 0x73, 0x05, 0x13, 0xb2, 0xcf, 0x5f, 0xe1, 0x3b, 0x60,
-	0x71, 0x44, 0x47, 0x5e, 0x7e, 0x5d, 0x94, 0x85, 0x08, 0x09, 0xa7, 0x4d, 0xf1, 0x13, 0xfe, 0x32,
-	0x58, 0xf6, 0xe9, 0x6f, 0x4f, 0x68, 0xc0, 0xf2, 0x1b, 0x42, 0x21, 0xc7, 0xfb, 0xa6, 0x64, 0x99,
-	0xd1, 0x0b, 0x2c, 0x80, 0x25, 0xea, 0xfb, 0x9e, 0x9f, 0xdf, 0x14, 0x4a, 0x59, 0xee, 0x41, 0xc1,
-	0x30, 0xc3, 0x07, 0xbc, 0x02, 0x32, 0x43, 0x9b, 0x10, 0xea, 0xe6, 0x2f, 0x24, 0x63, 0x21, 0x99,
-	0xea, 0xb7, 0x40, 0x26, 0xcc, 0x27, 0x98, 0x03, 0xcb, 0x07, 0x7b, 0xf7, 0x6f, 0xee, 0xdf, 0xbf,
-	0xbd, 0xb9, 0x00, 0xd7, 0x40, 0x76, 0xf7, 0xa3, 0x7b, 0x07, 0x77, 0xf7, 0x0e, 0xf7, 0x6e, 0x6e,
-	0x2a, 0x5c, 0xb6, 0xf7, 0xc9, 0xc1, 0xbe, 0xb9, 0x77, 0x73, 0x33, 0x05, 0x01, 0xc8, 0xdc, 0x6a,
-	0xef, 0xdf, 0xdd, 0xbb, 0xb9, 0x99, 0x56, 0xb7, 0xc1, 0x22, 0x4f, 0x28, 0xb8, 0x0c, 0xd2, 0x9d,
-	0xc3, 0xdd, 0x70, 0xe1, 0xdd, 0xfd, 0xdb, 0x77, 0x0e, 0xef, 0x73, 0x1c, 0x45, 0x7d, 0x07, 0x64,
-	0x67, 0x39, 0x03, 0x33, 0x20, 0xb5, 0x7f, 0x7f, 0x73, 0x81, 0x2b, 0x7f, 0xf4, 0x1b, 0x87, 0x9b,
-	0x8a, 0xf1, 0x07, 0xe9, 0xe3, 0xf6, 0x0f, 0xd2, 0xfa, 0xef, 0xa5, 0xe1, 0xef, 0xa6, 0x67, 0xad,
-	0xd3, 0xaa, 0x94, 0x7b, 0xb5, 0x4a, 0x9f, 0xd4, 0x68, 0xab, 0xd2, 0x6b, 0x69, 0x7a, 0x4d, 0xc3,
-	0x58, 0xa7, 0x7a, 0xb3, 0xd2, 0x6a, 0x54, 0xab, 0xa4, 0xdf, 0x6b, 0x90, 0x56, 0xbf, 0xd1, 0x6f,
-	0xd4, 0x9b, 0x98, 0x56, 0x5a, 0x35, 0x5c, 0xaf, 0xd5, 0x2a, 0x65, 0x5a, 0xc6, 0x5a, 0xa5, 0x42,
-	0x2c, 0xab, 0x52, 0x2e, 0x8b, 0x9e, 0x18, 0xb7, 0x96, 0xff, 0xdf, 0x66, 0x9c, 0xa8, 0xed, 0xd7,
-	0x68, 0x86, 0x15, 0xab, 0xce, 0x0e, 0x1f, 0xce, 0xe3, 0xb5, 0xa8, 0x1a, 0xaa, 0x63, 0x0f, 0x86,
-	0xcc, 0x95, 0xbc, 0x59, 0x61, 0xa9, 0x86, 0x6a, 0xbb, 0x9c, 0x23, 0x2a, 0x23, 0xd1, 0xbd, 0x93,
-	0xf9, 0x6e, 0x34, 0x64, 0xfb, 0x9e, 0x4f, 0x58, 0x03, 0x35, 0x04, 0x9b, 0x67, 0x16, 0x37, 0x6c,
-	0xef, 0x19, 0x1e, 0x8d, 0x1d, 0x8a, 0x1c, 0x51, 0xdc, 0xc8, 0x17, 0xd5, 0xad, 0xa2, 0x23, 0xd5,
-	0x04, 0x6b, 0x37, 0xa9, 0xe5, 0x11, 0x6a, 0xca, 0xfc, 0xca, 0xc7, 0x69, 0x18, 0xb6, 0xf5, 0x88,
-	0x34, 0x7e, 0xf5, 0xb8, 0xfd, 0x9e, 0xae, 0x42, 0xf4, 0x1c, 0xa9, 0x92, 0xc5, 0x91, 0x1d, 0xd7,
-	0x9f, 0xf9, 0xb9, 0x58, 0x2c, 0x72, 0xcc, 0x9f, 0x2c, 0x82, 0xf5, 0x08, 0x34, 0x18, 0x7b, 0x6e,
-	0x40, 0x61, 0x19, 0xe4, 0x08, 0x0d, 0x98, 0xed, 0x8a, 0x79, 0x28, 0x44, 0xee, 0x6c, 0xf0, 0x7a,
-	0x4e, 0xb0, 0xcd, 0x24, 0x01, 0x2b, 0x60, 0x75, 0x8c, 0xa7, 0x23, 0xea, 0xb2, 0xee, 0x10, 0x07,
-	0x43, 0x79, 0x98, 0x6c, 0x9e, 0x9e, 0x14, 0xe6, 0xf8, 0x66, 0x4e, 0x52, 0x77, 0x70, 0x30, 0x84,
-	0x06, 0x58, 0x75, 0x27, 0xa3, 0x6e, 0x80, 0x99, 0x17, 0x0c, 0xed, 0x40, 0x9c, 0x26, 0xe9, 0xce,
-	0xe5, 0xb8, 0xde, 0xe7, 0xc4, 0x66, 0xce, 0x9d, 0x8c, 0x1e, 0x48, 0x02, 0xbe, 0x0f, 0xb2, 0xb3,
-	0x69, 0x50, 0x1c, 0x19, 0xe9, 0xb0, 0xe9, 0xcd, 0x98, 0x66, 0xfc, 0xca, 0x07, 0x05, 0x11, 0xfa,
-	0xa9, 0x9c, 0x75, 0x44, 0x73, 0x0e, 0x39, 0xa6, 0x7c, 0xca, 0x4d, 0x5b, 0xbe, 0x2d, 0x06, 0x42,
-	0xd1, 0xe1, 0xe3, 0x4d, 0x47, 0x6c, 0x33, 0x49, 0xc0, 0x0f, 0xc0, 0x66, 0x82, 0x0c, 0x37, 0xbe,
-	0x2c, 0xd6, 0x5d, 0x3c, 0xe5, 0xdd, 0xee, 0x8c, 0xcc, 0xdc, 0x48, 0x70, 0x84, 0x03, 0xea, 0x60,
-	0xad, 0x8f, 0x1d, 0xa7, 0x87, 0xad, 0xc7, 0x5d, 0x3e, 0x28, 0x88, 0xe6, 0x9e, 0xed, 0x5c, 0x38,
-	0x3d, 0x29, 0xcc, 0x0b, 0xcc, 0xd5, 0x88, 0x6c, 0x13, 0xe2, 0x43, 0x0d, 0xe4, 0x2c, 0x87, 0x3d,
-	0xe9, 0xca, 0x4d, 0x65, 0xc5, 0xa6, 0x84, 0xad, 0x09, 0xb6, 0x09, 0x38, 0xb1, 0x17, 0xee, 0xee,
-	0x2e, 0xc8, 0xf9, 0xde, 0x84, 0xd1, 0xee, 0xd0, 0x76, 0x59, 0x90, 0x07, 0x28, 0x7d, 0x35, 0xa7,
-	0x6f, 0xca, 0x43, 0xc4, 0xe4, 0x92, 0x3b, 0xb6, 0xcb, 0x3a, 0x6f, 0x9d, 0x9e, 0x14, 0xde, 0x4c,
-	0x28, 0x5e, 0xf7, 0x46, 0x36, 0x13, 0x23, 0xb9, 0x09, 0xfc, 0x48, 0x2b, 0x50, 0x6f, 0x83, 0xec,
-	0x6c, 0x0d, 0x34, 0x40, 0x76, 0xe8, 0x8d, 0x25, 0xb0, 0x22, 0x80, 0xd7, 0x25, 0xf0, 0x1d, 0x6f,
-	0x2c, 0x60, 0x45, 0x64, 0x66, 0x4a, 0xe6, 0xca, 0x30, 0xe4, 0x07, 0xea, 0x9f, 0xa6, 0xc0, 0xb2,
-	0x54, 0x82, 0xef, 0x81, 0x65, 0xd7, 0x23, 0xb4, 0x1b, 0x8d, 0x28, 0x61, 0x4b, 0x95, 0x2c, 0x33,
-	0xc3, 0x5f, 0xf6, 0x09, 0xd7, 0xb2, 0x86, 0xd8, 0x8d, 0x06, 0x96, 0xc5, 0x50, 0x4b, 0xb2, 0xcc,
-	0x0c, 0x7f, 0xd9, 0x27, 0xb0, 0x06, 0xd6, 0xfa, 0x94, 0x76, 0x7b, 0x38, 0xa0, 0xdd, 0x51, 0x20,
-	0x07, 0x95, 0x35, 0xe9, 0xd8, 0xa4, 0xc0, 0xcc, 0xf5, 0x29, 0xed, 0xe0, 0x80, 0xde, 0x0b, 0x30,
-	0x83, 0x5d, 0xf0, 0x36, 0x97, 0x8e, 0x7d, 0x6f, 0xec, 0xf9, 0x3c, 0x4a, 0xd8, 0xe9, 0x8e, 0x6c,
-	0xc7, 0xb1, 0x3d, 0x97, 0x0d, 0xc3, 0x11, 0x7a, 0xad, 0x53, 0x38, 0x3d, 0x29, 0xbc, 0x4a, 0xcd,
-	0x7c, 0xab, 0x4f, 0xe9, 0x41, 0x42, 0x76, 0x6f, 0x26, 0x82, 0x6d, 0x70, 0x21, 0x11, 0xa1, 0x2e,
-	0xa1, 0x0e, 0xc3, 0x22, 0x27, 0xd7, 0x3a, 0x6f, 0x9e, 0x9e, 0x14, 0xce, 0x0b, 0xcd, 0x8d, 0x38,
-	0x88, 0x37, 0x39, 0x43, 0xfd, 0xb1, 0x02, 0xd6, 0x76, 0x45, 0x6f, 0x8c, 0x9a, 0x00, 0x94, 0x27,
-	0x55, 0xd8, 0x01, 0xc2, 0xf3, 0xe9, 0x4a, 0x74, 0x82, 0xa7, 0x44, 0x6e, 0x2c, 0xcb, 0x9a, 0x8a,
-	0x0e, 0xee, 0x77, 0xc1, 0xb2, 0xec, 0x85, 0xb2, 0xe8, 0x66, 0x0a, 0x11, 0xdf, 0x68, 0x1f, 0xb7,
-	0x6f, 0xe8, 0xdf, 0x84, 0xc6, 0xf3, 0xb8, 0x2f, 0x3d, 0x08, 0xdb, 0xd2, 0x3d, 0x4e, 0xc6, 0x9d,
-	0x0e, 0x95, 0x65, 0xa7, 0x93, 0x2b, 0x55, 0xa3, 0x59, 0xaf, 0x6a, 0x1a, 0x3a, 0x52, 0x1f, 0x80,
-	0xf5, 0xc8, 0x52, 0xd9, 0x59, 0xbe, 0x86, 0x7e, 0xf5, 0xf7, 0x0a, 0x00, 0x07, 0x78, 0xfa, 0xda,
-	0x0e, 0xf8, 0x3a, 0x17, 0x6c, 0x81, 0x15, 0xde, 0xbf, 0x46, 0x98, 0x51, 0xe1, 0x83, 0x15, 0x73,
-	0x46, 0xc3, 0x22, 0xc8, 0x8d, 0x7d, 0xda, 0xc5, 0x13, 0x36, 0xe4, 0x89, 0x26, 0xae, 0x4e, 0x9d,
-	0x75, 0x71, 0xd1, 0xf1, 0xa9, 0xe4, 0x9a, 0xd9, 0xb1, 0x4f, 0xdb, 0x13, 0x36, 0xdc, 0x27, 0x46,
-	0xe3, 0xb8, 0x5d, 0xd5, 0x75, 0xa8, 0xbd, 0xda, 0xf8, 0xb3, 0x2e, 0x43, 0x47, 0xea, 0x2e, 0xb8,
-	0x98, 0x9c, 0xe0, 0x66, 0x7e, 0x7a, 0x1f, 0x64, 0x7c, 0x1a, 0x4c, 0x9c, 0x70, 0x53, 0x39, 0xfd,
-	0x8d, 0x97, 0x8c, 0x7b, 0xa6, 0x54, 0x51, 0x4f, 0x15, 0xb0, 0x16, 0x09, 0xc2, 0xad, 0x37, 0x41,
-	0xa6, 0x6f, 0x3b, 0x8c, 0xfa, 0xb2, 0x1e, 0xd1, 0x99, 0xe5, 0x42, 0xab, 0x78, 0x4b, 0xa8, 0xec,
-	0xb9, 0x8c, 0x77, 0xc1, 0x50, 0x1f, 0xd6, 0xc1, 0x12, 0xee, 0xf3, 0x85, 0xaf, 0xbf, 0xd3, 0x2e,
-	0x8a, 0xf1, 0x38, 0x54, 0x87, 0x97, 0x40, 0xc6, 0xeb, 0xf7, 0x03, 0x1a, 0x56, 0xda, 0x92, 0x29,
-	0x29, 0x78, 0x11, 0x2c, 0x39, 0xf6, 0xc8, 0x0e, 0xa7, 0xfa, 0x25, 0x33, 0x24, 0xb6, 0x5a, 0x20,
-	0x97, 0xf8, 0x38, 0xdc, 0x04, 0xe9, 0xc7, 0x74, 0x2a, 0xe3, 0xc7, 0x5f, 0xf9, 0xb2, 0x38, 0x76,
-	0x59, 0x19, 0x32, 0x23, 0xd5, 0x54, 0xd4, 0x7d, 0xb0, 0x1e, 0xed, 0x42, 0xfa, 0xaa, 0x01, 0x32,
-	0xe1, 0x41, 0x29, 0x37, 0xfb, 0x32, 0x5f, 0xc9, 0xab, 0x61, 0xc8, 0x91, 0x4f, 0xf5, 0x07, 0x29,
-	0xb0, 0xf1, 0xb1, 0xcd, 0x86, 0xc4, 0xc7, 0x4f, 0x13, 0xe9, 0x14, 0x5d, 0x98, 0x95, 0xf9, 0x0b,
-	0xf3, 0x6b, 0xd2, 0xe9, 0x12, 0xc8, 0xf4, 0xf8, 0x05, 0x2c, 0x88, 0x1c, 0x10, 0x52, 0xf0, 0xd7,
-	0xc0, 0x6a, 0x80, 0x59, 0x77, 0x4c, 0xfd, 0x6e, 0x6f, 0xca, 0xa8, 0x3c, 0xaa, 0x66, 0xab, 0x41,
-	0x80, 0xd9, 0x01, 0xf5, 0x3b, 0x53, 0x36, 0x9f, 0x91, 0x4b, 0xf3, 0x19, 0x69, 0x7c, 0x7a, 0xdc,
-	0xfe, 0x2d, 0xfd, 0x7b, 0xf0, 0x37, 0x9f, 0x27, 0x6e, 0x94, 0xe8, 0xab, 0x5e, 0x29, 0xe7, 0xb2,
-	0x8e, 0x57, 0x6a, 0xd2, 0x22, 0xd5, 0x40, 0x55, 0x9e, 0x8a, 0x1f, 0x80, 0xcd, 0xd8, 0x19, 0xbf,
-	0x48, 0x1a, 0x7e, 0x03, 0x5c, 0x0a, 0xab, 0xfd, 0x76, 0x74, 0x8f, 0x88, 0x9c, 0xfa, 0x2e, 0x58,
-	0xc5, 0x8e, 0xe3, 0x3d, 0xed, 0xca, 0xdb, 0xba, 0x22, 0x36, 0x97, 0x13, 0xbc, 0xbb, 0xf2, 0xd2,
-	0x0a, 0x52, 0xfb, 0xe7, 0x2e, 0xa8, 0xc6, 0x7b, 0xc7, 0xed, 0x77, 0xf5, 0x02, 0xbc, 0x12, 0x5f,
-	0xdc, 0xc3, 0xf2, 0x33, 0x92, 0x1d, 0x41, 0xff, 0xcb, 0x0c, 0x58, 0x3f, 0x1c, 0x4e, 0x5c, 0x42,
-	0x7d, 0xe2, 0x8d, 0xa8, 0x79, 0xb0, 0x0b, 0x6f, 0x01, 0x70, 0x9b, 0xb2, 0xe8, 0xb7, 0x37, 0x97,
-	0xce, 0x65, 0xf1, 0x1e, 0x3f, 0xd2, 0xb6, 0xa2, 0x63, 0x4a, 0xea, 0xa9, 0x9b, 0xbf, 0xf3, 0x4f,
-	0xff, 0xfa, 0x87, 0x29, 0x00, 0x57, 0x4a, 0x72, 0x42, 0x85, 0x1f, 0x83, 0x4c, 0x38, 0x1b, 0xc1,
-	0x8b, 0x52, 0x77, 0x6e, 0xfe, 0xda, 0x7a, 0xf3, 0x0c, 0x37, 0xf4, 0x9b, 0x8a, 0x8e, 0xdb, 0x0b,
-	0x02, 0xeb, 0xb2, 0xba, 0x5c, 0x22, 0x42, 0x66, 0x28, 0xd7, 0x1e, 0x66, 0x61, 0x44, 0xc1, 0x7d,
-	0x90, 0x09, 0x9d, 0x35, 0x03, 0x9e, 0xeb, 0xe9, 0x33, 0xe0, 0xf9, 0xfe, 0xa9, 0x42, 0x81, 0xba,
-	0xaa, 0x2e, 0x97, 0xc2, 0xf1, 0xd8, 0x50, 0xae, 0xc1, 0x5b, 0x20, 0x7d, 0x80, 0xa7, 0xf0, 0x82,
-	0x5c, 0x11, 0xf7, 0xc6, 0xad, 0xb7, 0x5f, 0x16, 0xae, 0x08, 0x6a, 0x43, 0x40, 0x65, 0xd5, 0xc5,
-	0xd2, 0x18, 0x4f, 0x43, 0x9c, 0x4c, 0xa8, 0x38, 0x33, 0x69, 0xae, 0x5d, 0xcc, 0x4c, 0x9a, 0x2f,
-	0xbf, 0x08, 0x07, 0x2e, 0x97, 0xc2, 0xb2, 0x82, 0xbf, 0x0e, 0x56, 0xa2, 0x44, 0x82, 0x97, 0xe4,
-	0x9a, 0x33, 0x65, 0xb6, 0x75, 0xf9, 0x1c, 0x5f, 0xa2, 0x5d, 0x14, 0x68, 0xeb, 0x6a, 0xb6, 0xf4,
-	0x54, 0x8a, 0xb8, 0x69, 0x9f, 0x80, 0x8d, 0x33, 0xa9, 0x05, 0xaf, 0xcc, 0x39, 0xe8, 0x6c, 0xca,
-	0xfd, 0x3c, 0xff, 0xc5, 0xc6, 0x86, 0xfe, 0x83, 0xdf, 0x8b, 0x0e, 0xd3, 0x83, 0xb0, 0x99, 0xff,
-	0x9c, 0x70, 0xbc, 0xd2, 0x93, 0x97, 0x05, 0xe8, 0x05, 0x75, 0x95, 0x7b, 0xb2, 0x14, 0xa5, 0xa8,
-	0x72, 0x0d, 0x7e, 0x24, 0xb2, 0x30, 0x42, 0xce, 0x4a, 0x8c, 0x7d, 0xf2, 0x6a, 0xb8, 0xb7, 0x04,
-	0xdc, 0x1b, 0xf0, 0x42, 0x12, 0xae, 0xf4, 0xdc, 0x26, 0x47, 0xd0, 0x04, 0x6b, 0x62, 0x14, 0xa0,
-	0xbf, 0x20, 0xe6, 0xb5, 0xf3, 0x98, 0x9d, 0x9f, 0xac, 0x1e, 0xb7, 0xff, 0x21, 0x07, 0x2f, 0x81,
-	0x8d, 0x44, 0x0d, 0x21, 0xf3, 0x60, 0x57, 0x4f, 0x97, 0x8b, 0xda, 0x35, 0x25, 0xa5, 0x6f, 0xe2,
-	0xf1, 0xd8, 0xb1, 0x2d, 0x31, 0xe8, 0x97, 0x1e, 0x05, 0x9e, 0x6b, 0x9c, 0xe3, 0x98, 0x7f, 0xa3,
-	0x80, 0x74, 0x55, 0xd3, 0xe0, 0x5f, 0x28, 0xe0, 0xd1, 0xe1, 0x90, 0xfa, 0x14, 0x3d, 0xc5, 0x01,
-	0xc2, 0x2e, 0x12, 0xd7, 0x5c, 0x14, 0x5f, 0x78, 0x10, 0x1b, 0x52, 0x24, 0x0f, 0xc9, 0x22, 0x3a,
-	0x1c, 0x52, 0xa9, 0x31, 0xa2, 0x41, 0x80, 0x07, 0x14, 0xd9, 0x01, 0x0a, 0x7f, 0x0f, 0xe1, 0x38,
-	0x53, 0x44, 0x68, 0x60, 0x0f, 0x5c, 0x4a, 0x10, 0xf3, 0xd0, 0xd8, 0xa7, 0x01, 0x75, 0x19, 0x7f,
-	0xe5, 0x10, 0x93, 0x80, 0xfa, 0x45, 0xf8, 0x21, 0xe0, 0x6d, 0x34, 0xa3, 0x77, 0xe0, 0xb7, 0x9f,
-	0xab, 0x02, 0x48, 0x35, 0xd4, 0x6f, 0x86, 0x88, 0x84, 0x32, 0x6c, 0x3b, 0xc1, 0x0d, 0xf5, 0xba,
-	0xca, 0x4b, 0x4e, 0x35, 0x2a, 0xd7, 0x55, 0xf9, 0x95, 0x97, 0x28, 0x1d, 0x99, 0x3f, 0x12, 0x5b,
-	0x28, 0xc3, 0x63, 0x05, 0xdc, 0x36, 0x29, 0x9b, 0xf8, 0xfc, 0xc3, 0x4f, 0x87, 0xd4, 0x9d, 0x7d,
-	0x0f, 0x11, 0x8f, 0x06, 0xc8, 0xf5, 0x18, 0x1a, 0xe2, 0x27, 0x14, 0x8d, 0xa9, 0x3f, 0xb2, 0x83,
-	0xc0, 0xf6, 0x5c, 0x6e, 0x14, 0xb6, 0xf8, 0x0e, 0xe5, 0xf6, 0x02, 0x6f, 0xe2, 0x5b, 0xb4, 0x08,
-	0x6f, 0x4b, 0xfb, 0x3e, 0x80, 0xdf, 0x8a, 0xed, 0xb3, 0xdd, 0x27, 0xd8, 0xb1, 0x09, 0x72, 0xbc,
-	0x01, 0xbf, 0x32, 0x4a, 0xeb, 0xca, 0xf5, 0xa4, 0x79, 0xf3, 0x3a, 0x47, 0x66, 0xc0, 0x6d, 0xab,
-	0x42, 0x07, 0x5c, 0x3b, 0x6f, 0x5a, 0xf4, 0xb9, 0xd8, 0x3c, 0xfa, 0xcc, 0x0e, 0x58, 0x11, 0xde,
-	0x90, 0x5f, 0xaf, 0xc3, 0x6a, 0xfc, 0x75, 0x2e, 0xef, 0x7b, 0x13, 0x97, 0xcc, 0xbe, 0x5c, 0x4b,
-	0x7e, 0x38, 0x16, 0x1f, 0x99, 0x7f, 0xad, 0x80, 0x74, 0x4d, 0xd3, 0xe0, 0x9f, 0x2b, 0xe0, 0xf1,
-	0xbe, 0xcb, 0xa8, 0xef, 0x62, 0x27, 0x0c, 0x57, 0x18, 0x39, 0x7e, 0x1f, 0xd9, 0xa1, 0x2e, 0x41,
-	0xf4, 0xd9, 0x98, 0xfa, 0x36, 0x75, 0x2d, 0x4a, 0x66, 0x31, 0x2f, 0xa2, 0xfb, 0x1e, 0xf7, 0x5a,
-	0x7f, 0xe2, 0x20, 0xdb, 0xed, 0x7b, 0xfe, 0x48, 0xa4, 0x0b, 0x7a, 0x6a, 0x3b, 0x0e, 0xea, 0x51,
-	0x9e, 0x12, 0x4f, 0x6c, 0x42, 0x09, 0xb2, 0xdd, 0xf9, 0x14, 0x28, 0xc2, 0x3b, 0xd2, 0xee, 0x6f,
-	0xc3, 0x1b, 0x49, 0xaf, 0x25, 0x0d, 0x78, 0xb9, 0xf1, 0x67, 0x74, 0x8e, 0x1e, 0xfe, 0xd7, 0x12,
-	0xf8, 0x13, 0x05, 0x5c, 0xdc, 0xbd, 0xbf, 0xc3, 0xcb, 0x65, 0xe7, 0x60, 0xd2, 0xfb, 0x0e, 0x9d,
-	0x3e, 0x60, 0xbe, 0xed, 0x0e, 0xe0, 0xef, 0x2b, 0x2b, 0x29, 0xe8, 0xde, 0xa1, 0xcf, 0x10, 0x75,
-	0x39, 0x16, 0x41, 0x96, 0x37, 0xe2, 0x59, 0x16, 0x50, 0x82, 0xc6, 0x93, 0x9e, 0x63, 0x5b, 0xe8,
-	0x31, 0x9d, 0x16, 0x91, 0xbc, 0x8e, 0x1b, 0x48, 0xd3, 0x35, 0xab, 0x82, 0x35, 0xda, 0xe8, 0x69,
-	0x1a, 0xd5, 0x48, 0x93, 0x58, 0x96, 0x45, 0x48, 0xab, 0x52, 0xee, 0xe9, 0xa4, 0x5e, 0x6e, 0x56,
-	0x9b, 0x95, 0x96, 0xde, 0x6c, 0x34, 0xf5, 0x56, 0x03, 0xf7, 0xaa, 0xb5, 0x9a, 0xde, 0xd0, 0x2d,
-	0x0b, 0xb7, 0x9a, 0x55, 0xad, 0x5c, 0xad, 0xd6, 0x9b, 0x5c, 0x61, 0xeb, 0xa5, 0xa6, 0xa0, 0x14,
-	0xf8, 0xa3, 0x14, 0xb8, 0x10, 0x89, 0x1e, 0xd8, 0x03, 0x17, 0xb3, 0x89, 0x4f, 0xe1, 0xf7, 0x53,
-	0x2b, 0x29, 0xf8, 0x6f, 0x4a, 0xd2, 0xc6, 0x20, 0x12, 0x22, 0xaf, 0x2f, 0x88, 0xa8, 0xa6, 0x3e,
-	0x8d, 0x96, 0xcf, 0x26, 0xb4, 0xf7, 0x23, 0xce, 0x7d, 0xcf, 0xb5, 0xe8, 0xa7, 0x68, 0x48, 0x31,
-	0xa1, 0x7e, 0x62, 0x3f, 0x15, 0xad, 0x5a, 0xd3, 0x74, 0xbd, 0xac, 0x69, 0x98, 0xf6, 0xcb, 0xcd,
-	0x5a, 0xb9, 0x5e, 0xab, 0x59, 0xa4, 0x4e, 0x1b, 0x96, 0x65, 0x35, 0x1a, 0xb8, 0x6f, 0x55, 0x2c,
-	0x52, 0xb7, 0x9a, 0xfd, 0x06, 0x6e, 0xb5, 0x08, 0x6d, 0xd6, 0x6a, 0xb5, 0x46, 0xd9, 0xa2, 0x58,
-	0x27, 0x16, 0x6d, 0xd1, 0x56, 0xb5, 0x57, 0x6e, 0xf4, 0x2a, 0x2d, 0x5d, 0xd7, 0x9b, 0x7d, 0x4d,
-	0xd7, 0xb5, 0x7a, 0xaf, 0xd2, 0xe8, 0x57, 0x6a, 0x95, 0x56, 0x43, 0x2b, 0x37, 0x69, 0xaf, 0x5e,
-	0x25, 0x95, 0x7e, 0xbd, 0xd9, 0x6a, 0xd5, 0x68, 0xbd, 0xa6, 0x69, 0xa4, 0x62, 0x35, 0xea, 0x65,
-	0x4b, 0x6f, 0x56, 0x49, 0x1d, 0xd7, 0x1b, 0x58, 0xaf, 0x69, 0xad, 0x56, 0xb5, 0x41, 0x70, 0xab,
-	0x5c, 0x69, 0xd4, 0x6a, 0x4d, 0x52, 0xde, 0x3a, 0xef, 0x00, 0x94, 0x02, 0x76, 0xec, 0x97, 0xd9,
-	0xc6, 0xe0, 0xe1, 0x4a, 0x0a, 0x7e, 0x63, 0x77, 0xe2, 0xfb, 0xa2, 0x21, 0xd8, 0x23, 0xca, 0x93,
-	0xc8, 0xbc, 0xb5, 0x5b, 0xa9, 0x54, 0x5a, 0x89, 0xfd, 0xe9, 0x9a, 0x56, 0xdf, 0xd1, 0xca, 0x3b,
-	0x9a, 0x7e, 0x58, 0xae, 0x19, 0x5a, 0xd5, 0xd0, 0x6a, 0x0f, 0xb5, 0x86, 0xa1, 0x69, 0x5b, 0xe7,
-	0x31, 0x51, 0x0a, 0xfc, 0x2d, 0xbf, 0x59, 0x25, 0x5d, 0x06, 0xff, 0x8c, 0xa7, 0xc8, 0x1f, 0x2b,
-	0x6d, 0x17, 0x85, 0x7f, 0x26, 0xc2, 0x0e, 0xf2, 0xb1, 0x4b, 0xbc, 0x11, 0x0a, 0xc2, 0xc0, 0x31,
-	0x0f, 0x59, 0x9e, 0x6b, 0x61, 0x46, 0x5d, 0xcc, 0x28, 0x12, 0xb3, 0xb0, 0x88, 0xc6, 0x79, 0xfc,
-	0xd0, 0xfb, 0xa8, 0x47, 0xfb, 0x9e, 0x4f, 0x91, 0x85, 0x1d, 0x6b, 0xe2, 0x60, 0x16, 0x45, 0x8f,
-	0xff, 0x8f, 0x43, 0xdb, 0xb7, 0xa9, 0x43, 0xc2, 0x1a, 0x73, 0xb9, 0x21, 0x48, 0x4c, 0x70, 0xc8,
-	0xc2, 0x2e, 0xf2, 0x5c, 0x67, 0xca, 0xcb, 0x67, 0xc2, 0xb3, 0x94, 0xcb, 0x8a, 0x5b, 0xf3, 0x46,
-	0xa3, 0xd4, 0x67, 0x9f, 0x6f, 0x2f, 0xfc, 0xf4, 0xf3, 0xed, 0x85, 0x9f, 0x7d, 0xbe, 0xad, 0x7c,
-	0xff, 0xc5, 0xb6, 0xf2, 0xe3, 0x17, 0xdb, 0xca, 0xdf, 0xbd, 0xd8, 0x56, 0x3e, 0x7b, 0xb1, 0xad,
-	0xfc, 0xcb, 0x8b, 0x6d, 0xe5, 0xdf, 0x5f, 0x6c, 0x2f, 0xfc, 0xec, 0xc5, 0xf6, 0xc2, 0x0f, 0xbf,
-	0xd8, 0x5e, 0xf8, 0xec, 0x8b, 0xed, 0x85, 0x9f, 0x7e, 0xb1, 0xbd, 0xf0, 0xf0, 0xfd, 0x81, 0xcd,
-	0x8a, 0x96, 0x67, 0xbb, 0xae, 0xed, 0x3e, 0xc2, 0x45, 0x97, 0xb2, 0x12, 0x2f, 0x6f, 0xea, 0x92,
-	0x12, 0x8b, 0xcf, 0x85, 0xf0, 0x0f, 0x7c, 0xbd, 0x8c, 0x18, 0xa4, 0x2a, 0xff, 0x1b, 0x00, 0x00,
-	0xff, 0xff, 0xa0, 0x69, 0x34, 0x8b, 0xf6, 0x1b, 0x00, 0x00,
+	// 3817 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x5a, 0x4b, 0x8c, 0x23, 0x49,
+	0x5a, 0xae, 0xb4, 0xab, 0x5c, 0xe5, 0x70, 0x3d, 0x5c, 0xd1, 0x2f, 0x8f, 0x67, 0xba, 0x1c, 0x93,
+	0x0c, 0x6c, 0xd3, 0xd3, 0x65, 0xa7, 0xd3, 0xef, 0xdc, 0xd9, 0x9e, 0xb1, 0xab, 0xaa, 0xbb, 0xaa,
+	0xa7, 0x1f, 0x45, 0x76, 0xcd, 0x63, 0x7b, 0xb4, 0xf2, 0x84, 0x33, 0xc3, 0x76, 0x76, 0xa7, 0x33,
+	0x4d, 0x66, 0xba, 0xab, 0x4d, 0x53, 0xd2, 0x0a, 0x01, 0x82, 0x05, 0xc1, 0xa8, 0x90, 0x38, 0xec,
+	0x81, 0x0b, 0x1c, 0x38, 0x82, 0xb4, 0x12, 0x68, 0x0f, 0x80, 0x38, 0x20, 0x8e, 0x83, 0xb8, 0xec,
+	0x85, 0x82, 0xe9, 0x41, 0x08, 0xd5, 0x01, 0x2d, 0x03, 0x07, 0x8e, 0x28, 0x22, 0x23, 0x9d, 0xe9,
+	0xaa, 0xea, 0x07, 0xa3, 0x61, 0x47, 0x9a, 0x72, 0xfe, 0x8f, 0xf8, 0xe2, 0xff, 0xff, 0xf8, 0xe3,
+	0xff, 0x23, 0x42, 0x0d, 0x56, 0x3d, 0xdd, 0x19, 0x6a, 0x05, 0xf6, 0x37, 0x3f, 0x74, 0x6c, 0xcf,
+	0x86, 0x73, 0x8c, 0xc8, 0xbe, 0xd1, 0xb3, 0xed, 0x9e, 0x49, 0x0a, 0x78, 0x68, 0x14, 0xb0, 0x65,
+	0xd9, 0x1e, 0xf6, 0x0c, 0xdb, 0x72, 0x7d, 0xa5, 0xec, 0xeb, 0x5c, 0xca, 0xa8, 0xce, 0xa8, 0x5b,
+	0x20, 0x83, 0xa1, 0x37, 0xe6, 0xc2, 0xdc, 0x49, 0xa1, 0x67, 0x0c, 0x88, 0xeb, 0xe1, 0xc1, 0x90,
+	0x2b, 0xac, 0xf7, 0x0c, 0xaf, 0x3f, 0xea, 0xe4, 0x35, 0x7b, 0x50, 0xe8, 0xd9, 0x3d, 0x3b, 0xd4,
+	0xa4, 0x14, 0x23, 0xd8, 0x17, 0x57, 0xbf, 0xc6, 0x7e, 0xb4, 0xf5, 0x1e, 0xb1, 0xd6, 0xdd, 0x7d,
+	0xdc, 0xeb, 0x11, 0xa7, 0x60, 0x0f, 0x99, 0x39, 0xa7, 0x4d, 0x13, 0xff, 0x6a, 0x0e, 0xcc, 0x37,
+	0x35, 0xcd, 0x1e, 0x59, 0x1e, 0x5c, 0x06, 0x31, 0x43, 0xcf, 0x08, 0x48, 0xb8, 0x92, 0x54, 0x63,
+	0x86, 0x0e, 0x55, 0x00, 0x34, 0x87, 0x60, 0x8f, 0xe8, 0x6d, 0xec, 0x65, 0x62, 0x48, 0xb8, 0x92,
+	0x92, 0xb3, 0x79, 0xdf, 0xdc, 0x7c, 0x60, 0x44, 0x7e, 0x2f, 0x30, 0xb7, 0x75, 0xe9, 0xab, 0xa3,
+	0xdc, 0x8a, 0xde, 0x51, 0xc4, 0x70, 0x94, 0xf8, 0xd9, 0x3f, 0xe7, 0x04, 0x35, 0xc9, 0x19, 0x4d,
+	0x8f, 0x62, 0x8e, 0x86, 0x7a, 0x80, 0x19, 0x7f, 0x75, 0xcc, 0x70, 0x14, 0xc7, 0xe4, 0x8c, 0xa6,
+	0x07, 0x33, 0x60, 0x1e, 0xeb, 0xba, 0x43, 0x5c, 0x37, 0x33, 0xcb, 0x8c, 0x0f, 0x48, 0x78, 0x0d,
+	0xcc, 0x77, 0xb0, 0x89, 0x2d, 0x8d, 0x64, 0xe6, 0x90, 0x70, 0x25, 0xde, 0x82, 0x87, 0xcd, 0xd9,
+	0x1f, 0xc6, 0x84, 0xf8, 0xf1, 0x51, 0x2e, 0x90, 0xa8, 0xc1, 0x07, 0xbc, 0x09, 0xc0, 0x90, 0x58,
+	0xba, 0x61, 0xf5, 0xda, 0x86, 0x95, 0x49, 0xb0, 0x01, 0x57, 0xc2, 0x01, 0x11, 0x61, 0x60, 0x54,
+	0xc8, 0x11, 0xd5, 0x24, 0x27, 0x76, 0x2c, 0xf8, 0x3e, 0x48, 0x05, 0x12, 0x7b, 0xe4, 0x65, 0xe6,
+	0x19, 0xd2, 0xd5, 0x10, 0x29, 0x2a, 0xfd, 0xea, 0x28, 0x97, 0x8e, 0x42, 0xd9, 0x23, 0x4f, 0x54,
+	0x83, 0xa9, 0xee, 0x8d, 0x3c, 0x28, 0x82, 0x84, 0x69, 0x6b, 0x8f, 0x88, 0x9e, 0x59, 0x40, 0xc2,
+	0x95, 0x85, 0x16, 0x38, 0x3e, 0xca, 0x71, 0x8e, 0xca, 0x7f, 0x95, 0xff, 0x11, 0x0e, 0x9b, 0xff,
+	0x2d, 0xc8, 0xff, 0x29, 0xc0, 0xff, 0x10, 0x9e, 0x22, 0xd1, 0xd0, 0x45, 0x05, 0x89, 0xc3, 0x51,
+	0xe7, 0x11, 0x19, 0x2b, 0xb8, 0xa3, 0xe1, 0x8e, 0x56, 0x94, 0x4b, 0x45, 0xb9, 0x24, 0x5e, 0x43,
+	0xd1, 0xc5, 0x51, 0x90, 0x28, 0x4b, 0xc5, 0xc6, 0x7a, 0x51, 0x5a, 0x97, 0x8a, 0x7b, 0xc5, 0xba,
+	0x52, 0x2a, 0x29, 0xc5, 0x5a, 0xbe, 0x2a, 0x55, 0x1f, 0x50, 0xcd, 0x48, 0xc8, 0x5f, 0xa2, 0xc9,
+	0xe3, 0x2d, 0x2a, 0xa2, 0x7c, 0x67, 0x3c, 0xc0, 0x9b, 0x0f, 0xeb, 0xb7, 0xba, 0x8f, 0x2b, 0xde,
+	0xc7, 0x8f, 0xab, 0x9d, 0xfe, 0xc3, 0x0f, 0x3f, 0x1c, 0x1a, 0xee, 0xf6, 0x63, 0xb7, 0xe3, 0x7e,
+	0x3c, 0xe8, 0xdf, 0xe8, 0x6c, 0xd1, 0x01, 0x3c, 0xe4, 0xa2, 0x52, 0x94, 0xe8, 0x7f, 0xd7, 0x50,
+	0x34, 0x94, 0x4a, 0x65, 0x9a, 0x45, 0x43, 0xa2, 0xa0, 0xaa, 0xcf, 0xf4, 0x3d, 0x16, 0x15, 0xe4,
+	0x39, 0x23, 0x82, 0x0e, 0xc4, 0x3f, 0x59, 0x02, 0x8b, 0xb7, 0x89, 0xde, 0x23, 0x8e, 0x4a, 0x34,
+	0xdb, 0xd1, 0x4f, 0x65, 0xb1, 0x0c, 0x00, 0xf6, 0x13, 0xbc, 0x6d, 0xe8, 0x2c, 0x8b, 0x93, 0xad,
+	0x73, 0xc1, 0x02, 0x86, 0x12, 0x51, 0x4d, 0x72, 0x62, 0xe7, 0x64, 0xe6, 0xc7, 0xff, 0x1f, 0x32,
+	0x7f, 0xf6, 0x1b, 0xc9, 0x7c, 0x15, 0x00, 0xf2, 0x64, 0x68, 0x38, 0xc4, 0xa5, 0x98, 0x73, 0xaf,
+	0x8e, 0x19, 0x8e, 0xe2, 0x98, 0x9c, 0xd1, 0xf4, 0xe0, 0x75, 0x90, 0x70, 0x3d, 0xec, 0x8d, 0x5c,
+	0xb6, 0x03, 0x96, 0xe5, 0x6c, 0xde, 0xaf, 0x77, 0xd1, 0x20, 0xe7, 0xef, 0x33, 0x0d, 0x3f, 0x17,
+	0x7d, 0x6d, 0x95, 0xff, 0xc2, 0x2a, 0x98, 0xf5, 0xc6, 0x43, 0xc2, 0xb2, 0x7e, 0x59, 0xce, 0x9c,
+	0x35, 0x7a, 0x6f, 0x3c, 0x24, 0xad, 0x85, 0xe3, 0xa3, 0x1c, 0xd3, 0x54, 0xd9, 0x5f, 0x78, 0x0b,
+	0x24, 0x75, 0xc3, 0x21, 0x1a, 0xad, 0x4e, 0x2c, 0xd5, 0x97, 0xe5, 0xcb, 0x67, 0x0d, 0xde, 0x0c,
+	0x94, 0x5a, 0x4b, 0xc7, 0x47, 0xb9, 0x70, 0x8c, 0x1a, 0x7e, 0xc2, 0x9f, 0x03, 0xc9, 0x1e, 0xb1,
+	0x88, 0x43, 0xc3, 0x94, 0x49, 0xb2, 0x6d, 0x33, 0x77, 0x7c, 0x94, 0x13, 0xd6, 0xd5, 0x90, 0x0f,
+	0x7f, 0x01, 0xcc, 0x3d, 0xc6, 0xe6, 0x88, 0x64, 0x00, 0xdb, 0x9f, 0xe9, 0x70, 0x7f, 0xfa, 0x7c,
+	0xd5, 0xff, 0xa1, 0xbb, 0xd9, 0x22, 0xde, 0xbe, 0xed, 0x3c, 0x6a, 0x77, 0x09, 0xc9, 0xa4, 0x4e,
+	0xed, 0xe6, 0x88, 0x34, 0xd8, 0xcd, 0x11, 0x96, 0xa8, 0x02, 0x4e, 0xdd, 0x20, 0x04, 0x7e, 0x04,
+	0x96, 0x87, 0x8e, 0xad, 0x11, 0xd7, 0xa5, 0x99, 0x4d, 0xf1, 0x16, 0x19, 0x9e, 0x14, 0xe2, 0x9d,
+	0x50, 0xf8, 0xea, 0x28, 0x77, 0x8e, 0x15, 0x88, 0x29, 0xae, 0xa8, 0x2e, 0x85, 0x0c, 0x0a, 0x5c,
+	0x06, 0x49, 0xac, 0xeb, 0x6d, 0xc3, 0xd2, 0xc9, 0x93, 0xcc, 0x12, 0x12, 0xae, 0xcc, 0xb6, 0x2e,
+	0x31, 0x97, 0xbf, 0x3a, 0xca, 0x2d, 0xb3, 0x54, 0x0f, 0xa4, 0xa2, 0xba, 0x80, 0x75, 0x7d, 0x87,
+	0x7e, 0xc2, 0x37, 0xc0, 0xec, 0x80, 0x0c, 0xec, 0xcc, 0x32, 0xdb, 0x16, 0x6c, 0x49, 0x28, 0xad,
+	0xb2, 0xbf, 0xf0, 0xe7, 0xc1, 0xbc, 0x43, 0x7e, 0x79, 0x44, 0x5c, 0x2f, 0xb3, 0xc2, 0x14, 0x52,
+	0xb4, 0x6e, 0x72, 0x96, 0x1a, 0x7c, 0xc0, 0x1c, 0x98, 0x23, 0x8e, 0x63, 0x3b, 0x99, 0x34, 0x53,
+	0x4a, 0xd2, 0x08, 0x32, 0x86, 0xea, 0xff, 0xc0, 0xcb, 0x20, 0xd1, 0x37, 0x74, 0x9d, 0x58, 0x99,
+	0xd5, 0xe8, 0x5a, 0x70, 0x26, 0x35, 0xa2, 0x6f, 0x9b, 0x7a, 0x06, 0x32, 0x21, 0x33, 0x82, 0xd2,
+	0x2a, 0xfb, 0x0b, 0xdf, 0x03, 0x4b, 0x1a, 0xad, 0x15, 0x66, 0xbb, 0x4f, 0x8c, 0x5e, 0xdf, 0xcb,
+	0x9c, 0x63, 0x01, 0x7b, 0x3d, 0x70, 0x0e, 0xb2, 0x7d, 0x17, 0xd5, 0x10, 0xd5, 0x45, 0x9f, 0xde,
+	0x66, 0x24, 0x7c, 0x07, 0x2c, 0xba, 0xd8, 0x6b, 0x0f, 0x89, 0xd3, 0xee, 0x8c, 0x3d, 0x92, 0x39,
+	0xcf, 0x00, 0xb2, 0x01, 0xc0, 0x2a, 0x05, 0x88, 0x2a, 0x88, 0x2a, 0x70, 0xb1, 0xb7, 0x4b, 0x9c,
+	0x16, 0x25, 0x3e, 0x00, 0x09, 0x3f, 0xdb, 0x61, 0x0a, 0xcc, 0xef, 0x6e, 0xdd, 0xdd, 0xdc, 0xb9,
+	0x7b, 0x33, 0x3d, 0x03, 0x97, 0x40, 0x72, 0xe3, 0xde, 0x9d, 0xdd, 0xdb, 0x5b, 0x7b, 0x5b, 0x9b,
+	0x69, 0x81, 0xca, 0xb6, 0x3e, 0xde, 0xdd, 0x51, 0xb7, 0x36, 0xd3, 0x31, 0x08, 0x40, 0xe2, 0x46,
+	0x73, 0xe7, 0xf6, 0xd6, 0x66, 0x3a, 0x0e, 0x17, 0xc0, 0xec, 0xf6, 0xd6, 0xed, 0xcd, 0xf4, 0x2c,
+	0x5c, 0x04, 0x0b, 0xcd, 0x8d, 0x8d, 0xad, 0x5d, 0x3a, 0x60, 0x4e, 0xfc, 0x0e, 0x98, 0xa5, 0xdb,
+	0x00, 0xce, 0x83, 0x78, 0x6b, 0x6f, 0xc3, 0x07, 0xbc, 0xbd, 0x73, 0x73, 0x7b, 0xef, 0x2e, 0xc5,
+	0x17, 0xe0, 0x32, 0x00, 0xcd, 0xcd, 0x5b, 0x1f, 0xdc, 0xdf, 0xbb, 0xb3, 0x75, 0x77, 0x2f, 0x1d,
+	0xa3, 0x62, 0x75, 0xab, 0xd5, 0xbc, 0xdd, 0xbc, 0xbb, 0xb1, 0x95, 0x8e, 0x8b, 0x6f, 0x80, 0xe4,
+	0x64, 0x23, 0xc0, 0x04, 0x88, 0xed, 0xdc, 0x4d, 0xcf, 0x50, 0xac, 0x7b, 0x1f, 0xec, 0xa5, 0x05,
+	0xe5, 0xf7, 0xe3, 0x87, 0xcd, 0xdf, 0x89, 0xcb, 0xbf, 0x15, 0x87, 0xbf, 0x11, 0x9f, 0xf4, 0x03,
+	0xad, 0x54, 0xec, 0x54, 0x4a, 0x5d, 0xbd, 0x42, 0x1a, 0xa5, 0x4e, 0x43, 0x92, 0x2b, 0x12, 0xc6,
+	0x32, 0x91, 0xeb, 0xa5, 0x46, 0xad, 0x5c, 0xd6, 0xbb, 0x9d, 0x9a, 0xde, 0xe8, 0xd6, 0xba, 0xb5,
+	0x6a, 0x1d, 0x93, 0x52, 0xa3, 0x82, 0xab, 0x95, 0x4a, 0xa9, 0x48, 0x8a, 0x58, 0x2a, 0x95, 0x74,
+	0x4d, 0x2b, 0x15, 0x8b, 0xac, 0xd0, 0x87, 0xf5, 0xf2, 0x67, 0xdb, 0x61, 0x22, 0x05, 0xeb, 0x25,
+	0x9a, 0x7e, 0x19, 0x12, 0x27, 0x1d, 0x95, 0xf2, 0x68, 0x81, 0x11, 0x15, 0xd1, 0xa4, 0xe9, 0x60,
+	0x71, 0xde, 0xa4, 0x5a, 0x88, 0x8a, 0x68, 0x58, 0x94, 0xc3, 0xb6, 0x7b, 0xa4, 0x25, 0x45, 0x37,
+	0xb1, 0x52, 0xf3, 0x79, 0x27, 0x76, 0xa1, 0x82, 0x6a, 0x8c, 0x4d, 0xb7, 0x0b, 0x35, 0x6c, 0xeb,
+	0x09, 0x1e, 0x0c, 0x4d, 0x82, 0x4c, 0x56, 0xb1, 0x90, 0xc3, 0x4a, 0x96, 0x88, 0x0e, 0x44, 0x15,
+	0x2c, 0x6d, 0x12, 0xcd, 0xd6, 0x89, 0xca, 0x37, 0x4d, 0x26, 0xdc, 0x5b, 0x7e, 0xaf, 0x0a, 0x48,
+	0xe5, 0x5b, 0x87, 0xcd, 0xb7, 0x64, 0x11, 0xa2, 0xa7, 0x48, 0xe4, 0x2c, 0x8a, 0x6c, 0x5a, 0xce,
+	0x24, 0xce, 0xf9, 0x7c, 0x9e, 0x62, 0xfe, 0xf9, 0x2c, 0x58, 0x0e, 0x40, 0xdd, 0xa1, 0x6d, 0xb9,
+	0x04, 0x16, 0x41, 0x4a, 0x27, 0xae, 0x67, 0x58, 0xec, 0x90, 0xe7, 0x23, 0xb7, 0x56, 0x68, 0x91,
+	0x8a, 0xb0, 0xd5, 0x28, 0x01, 0x4b, 0x60, 0x71, 0x88, 0xc7, 0x03, 0x62, 0x79, 0xed, 0x3e, 0x76,
+	0xfb, 0xbc, 0x43, 0xa6, 0x8f, 0x8f, 0x72, 0x53, 0x7c, 0x35, 0xc5, 0xa9, 0x6d, 0xec, 0xf6, 0xa1,
+	0x02, 0x16, 0xad, 0xd1, 0xa0, 0xed, 0x62, 0xcf, 0x76, 0xfb, 0x86, 0xcb, 0x5a, 0x64, 0xbc, 0x75,
+	0x29, 0x2c, 0x62, 0x53, 0x62, 0x35, 0x65, 0x8d, 0x06, 0xf7, 0x39, 0x01, 0xdf, 0x06, 0xc9, 0xc9,
+	0x11, 0x97, 0xf5, 0xc1, 0xb8, 0x5f, 0xc9, 0x27, 0x4c, 0x35, 0xfc, 0xa4, 0xa7, 0x1f, 0xb6, 0xf4,
+	0x63, 0x7e, 0x80, 0x63, 0x1d, 0xc7, 0xe7, 0xa8, 0xfc, 0x97, 0x3b, 0xad, 0x39, 0x06, 0x3b, 0xe5,
+	0x66, 0x12, 0x53, 0x4e, 0x07, 0x6c, 0x35, 0x4a, 0xc0, 0x77, 0x41, 0x3a, 0x42, 0xfa, 0x8e, 0xcf,
+	0xb3, 0x71, 0xe7, 0x8f, 0x8f, 0x72, 0xa7, 0x64, 0xea, 0x4a, 0x84, 0xc3, 0x02, 0x50, 0x05, 0x4b,
+	0x5d, 0x6c, 0x9a, 0x1d, 0xac, 0x3d, 0x6a, 0xd3, 0xd3, 0x0f, 0xeb, 0x58, 0xc9, 0xd6, 0xea, 0xf1,
+	0x51, 0x6e, 0x5a, 0xa0, 0x2e, 0x06, 0x64, 0x53, 0xd7, 0x1d, 0x28, 0x81, 0x94, 0x66, 0x7a, 0x8f,
+	0xdb, 0xdc, 0xa9, 0x24, 0x73, 0x8a, 0xd9, 0x1a, 0x61, 0xab, 0x80, 0x12, 0x5b, 0xbe, 0x77, 0xb7,
+	0x41, 0xca, 0xb1, 0x47, 0x1e, 0x69, 0xf7, 0x0d, 0xcb, 0x73, 0x33, 0x00, 0xc5, 0xaf, 0xa4, 0xe4,
+	0x34, 0xef, 0x8c, 0x2a, 0x95, 0x6c, 0x1b, 0x96, 0xd7, 0x7a, 0xed, 0xf8, 0x28, 0x77, 0x21, 0xa2,
+	0x78, 0xcd, 0x1e, 0x18, 0x1e, 0xbb, 0x67, 0xa8, 0xc0, 0x09, 0xb4, 0x5c, 0xf1, 0x26, 0x48, 0x4e,
+	0xc6, 0x40, 0x05, 0x24, 0xfb, 0xf6, 0x90, 0x03, 0x0b, 0x0c, 0x78, 0x99, 0x03, 0x6f, 0xdb, 0x43,
+	0x06, 0xcb, 0x56, 0x66, 0xa2, 0xa4, 0x2e, 0xf4, 0x7d, 0xbe, 0x2b, 0xfe, 0x59, 0x0c, 0xcc, 0x73,
+	0x25, 0xf8, 0x16, 0x98, 0xb7, 0x6c, 0x9d, 0xb4, 0x83, 0x73, 0x97, 0xdf, 0x27, 0x38, 0x4b, 0x4d,
+	0xd0, 0x8f, 0x1d, 0x9d, 0x6a, 0x69, 0x7d, 0x6c, 0x05, 0xa7, 0xb0, 0x59, 0x5f, 0x8b, 0xb3, 0xd4,
+	0x04, 0xfd, 0xd8, 0xd1, 0x61, 0x05, 0x2c, 0x75, 0x09, 0x69, 0x77, 0xb0, 0x4b, 0xda, 0x03, 0x97,
+	0x9f, 0xbe, 0x96, 0x78, 0x60, 0xa3, 0x02, 0x35, 0xd5, 0x25, 0xa4, 0x85, 0x5d, 0x72, 0xc7, 0xc5,
+	0x1e, 0x6c, 0x83, 0xd7, 0xa9, 0x74, 0xe8, 0xd8, 0x43, 0xdb, 0xa1, 0xab, 0x84, 0xcd, 0xf6, 0xc0,
+	0x30, 0x4d, 0xc3, 0xb6, 0xbc, 0xbe, 0x7f, 0x2f, 0x58, 0x6a, 0xe5, 0x8e, 0x8f, 0x72, 0x2f, 0x52,
+	0x53, 0x5f, 0xeb, 0x12, 0xb2, 0x1b, 0x91, 0xdd, 0x99, 0x88, 0x60, 0x13, 0xac, 0x46, 0x56, 0xa8,
+	0xad, 0x13, 0xd3, 0xc3, 0x2c, 0x27, 0x97, 0x5a, 0x17, 0x8e, 0x8f, 0x72, 0xa7, 0x85, 0xea, 0x4a,
+	0xb8, 0x88, 0x9b, 0x94, 0x21, 0xfe, 0x83, 0x00, 0x96, 0x36, 0x58, 0x6d, 0x0c, 0x8a, 0x00, 0xe4,
+	0xed, 0xd7, 0xaf, 0x00, 0xec, 0x1b, 0x5e, 0x0e, 0x8e, 0x25, 0x31, 0x96, 0x1b, 0xf3, 0x7c, 0x4f,
+	0x05, 0xa7, 0x91, 0x37, 0xc1, 0x3c, 0xaf, 0x85, 0x99, 0xf8, 0xb4, 0x42, 0xc0, 0x87, 0xdf, 0x02,
+	0x2b, 0x86, 0x4e, 0x06, 0x43, 0xdb, 0x23, 0x96, 0x36, 0x6e, 0x3f, 0x22, 0x63, 0x7e, 0x2f, 0x5a,
+	0x8e, 0xb0, 0xdf, 0x27, 0x63, 0xa5, 0x79, 0xd8, 0xbc, 0x2e, 0xbf, 0x03, 0x95, 0xa7, 0x61, 0x01,
+	0xbb, 0xef, 0xd7, 0xaf, 0x3b, 0x94, 0x0c, 0x4b, 0x22, 0x2a, 0xf2, 0x92, 0xc8, 0xa7, 0x10, 0x95,
+	0x7a, 0xb5, 0x2c, 0x49, 0xe8, 0x40, 0xfc, 0x2c, 0x06, 0x56, 0x7d, 0x9f, 0xb6, 0x69, 0xcb, 0x0e,
+	0xfd, 0x62, 0x5b, 0x8a, 0xfb, 0x45, 0xbf, 0x27, 0xbe, 0xc6, 0xce, 0xf2, 0x35, 0xfe, 0x32, 0x5f,
+	0x67, 0xcf, 0xf6, 0x55, 0xf9, 0x4c, 0x38, 0x6c, 0xfe, 0xae, 0x20, 0xff, 0xb6, 0x00, 0x7f, 0x93,
+	0xde, 0x7c, 0xe8, 0x4c, 0xdf, 0x54, 0xaf, 0xfb, 0xba, 0x21, 0xf9, 0x81, 0x00, 0x56, 0xef, 0x13,
+	0xcf, 0x33, 0xa7, 0x42, 0x92, 0x05, 0x0b, 0x43, 0x87, 0x18, 0x03, 0xdc, 0x23, 0x3c, 0x2c, 0x13,
+	0x5a, 0xf9, 0xee, 0x61, 0xf3, 0x43, 0x79, 0x0f, 0xaa, 0x4f, 0x91, 0x18, 0xf0, 0xe8, 0xc4, 0x5f,
+	0xd7, 0xf8, 0xc0, 0x79, 0xda, 0x23, 0x06, 0x60, 0x39, 0x48, 0x39, 0xde, 0x22, 0x9e, 0xdb, 0x78,
+	0xa8, 0x64, 0x1f, 0x3b, 0xb4, 0x3f, 0xf2, 0x45, 0x0a, 0xc8, 0x57, 0x6f, 0x49, 0xff, 0x25, 0x00,
+	0xb0, 0x8b, 0xc7, 0x2f, 0x6d, 0x72, 0x2f, 0xcb, 0xf2, 0x2c, 0x58, 0xa0, 0x2d, 0x6a, 0x80, 0x3d,
+	0x3f, 0x37, 0x16, 0xd4, 0x09, 0x0d, 0xf3, 0x20, 0x35, 0x74, 0x48, 0x1b, 0x8f, 0xbc, 0x3e, 0xad,
+	0x25, 0x2c, 0xb5, 0x5b, 0xcb, 0xec, 0x82, 0xee, 0x10, 0xce, 0x55, 0x93, 0x43, 0x87, 0x34, 0x47,
+	0x5e, 0x7f, 0x47, 0x3f, 0x6b, 0x3b, 0xcc, 0x9d, 0xb9, 0x1d, 0x6a, 0x87, 0xcd, 0xb2, 0x2c, 0x43,
+	0xe9, 0xc5, 0x5e, 0x9e, 0x4c, 0x01, 0x74, 0x20, 0x6e, 0x80, 0xf3, 0xd1, 0x2b, 0xca, 0x24, 0xd4,
+	0x6f, 0x83, 0x84, 0x43, 0xdc, 0x91, 0xe9, 0x7b, 0x9f, 0x92, 0xcf, 0x9d, 0x71, 0x9f, 0x51, 0xb9,
+	0x8a, 0x78, 0x2c, 0x80, 0xa5, 0x40, 0xe0, 0xc7, 0xa8, 0x0e, 0x12, 0x5d, 0xc3, 0xf4, 0x88, 0xc3,
+	0x6b, 0x33, 0x3a, 0x31, 0x9c, 0x69, 0xe5, 0x6f, 0x30, 0x95, 0x2d, 0xcb, 0xa3, 0x1d, 0xd1, 0xd7,
+	0x87, 0x55, 0x30, 0x87, 0xbb, 0x74, 0xe0, 0xcb, 0x1f, 0x6d, 0x66, 0xd9, 0xfd, 0xcf, 0x57, 0x87,
+	0x17, 0x41, 0xc2, 0xee, 0x76, 0x5d, 0xe2, 0x57, 0xdd, 0x39, 0x95, 0x53, 0xf0, 0x3c, 0x98, 0x33,
+	0x8d, 0x81, 0xe1, 0x5f, 0x5b, 0xe7, 0x54, 0x9f, 0xc8, 0x36, 0x40, 0x2a, 0x32, 0x39, 0x4c, 0x83,
+	0x38, 0x8d, 0xad, 0xbf, 0xd0, 0xf4, 0x93, 0x0e, 0x0b, 0x17, 0x39, 0xc9, 0xd7, 0x56, 0x89, 0xd5,
+	0x05, 0x71, 0x07, 0x2c, 0x07, 0x5e, 0xf0, 0x58, 0xd5, 0x40, 0xc2, 0x3f, 0x34, 0x71, 0x67, 0xcf,
+	0x8a, 0x15, 0x7f, 0xfb, 0xf0, 0x39, 0xfc, 0x57, 0xfc, 0x51, 0x0c, 0xac, 0x7c, 0x64, 0x78, 0x7d,
+	0xdd, 0xc1, 0xfb, 0x91, 0xbc, 0x0b, 0x5e, 0x84, 0x84, 0xe9, 0x17, 0xa1, 0x97, 0xe4, 0xdd, 0x45,
+	0x90, 0xe8, 0xd0, 0x17, 0x06, 0x37, 0x08, 0x80, 0x4f, 0xc1, 0x5f, 0x3c, 0x71, 0x85, 0x38, 0x51,
+	0x8e, 0x22, 0xf7, 0x85, 0xa9, 0xd4, 0x9d, 0x3b, 0x91, 0xba, 0x67, 0xa4, 0x62, 0xe2, 0xcc, 0x54,
+	0xfc, 0xf4, 0xb0, 0xf9, 0x3d, 0xf9, 0x13, 0xf8, 0xdd, 0xa7, 0x91, 0xb7, 0x15, 0xf4, 0xaa, 0x8f,
+	0x2b, 0x53, 0xe9, 0x49, 0x4b, 0xd4, 0xd4, 0xe5, 0x46, 0x41, 0x65, 0x9a, 0xb3, 0xef, 0x82, 0x74,
+	0x18, 0xb5, 0xaf, 0x93, 0xaf, 0xdf, 0x06, 0x17, 0xfd, 0xca, 0x72, 0x33, 0xb8, 0x51, 0x07, 0xd1,
+	0x7f, 0x13, 0x2c, 0x62, 0xd3, 0xb4, 0xf7, 0xdb, 0xfc, 0xdd, 0x4a, 0x60, 0x51, 0x48, 0x31, 0xde,
+	0x6d, 0xfe, 0x7c, 0x03, 0x62, 0x3b, 0xa7, 0x9e, 0x6a, 0x94, 0xb7, 0x0e, 0x9b, 0x6f, 0xca, 0x39,
+	0x78, 0x39, 0x7c, 0xc2, 0xf2, 0x37, 0xb4, 0x32, 0x55, 0x63, 0x7e, 0x2c, 0x00, 0xe8, 0xcf, 0xbc,
+	0x67, 0x3f, 0x22, 0x56, 0xa4, 0xe7, 0x38, 0x43, 0xcd, 0x3f, 0xc7, 0x24, 0x55, 0xf6, 0x0d, 0x11,
+	0x98, 0x1f, 0xe0, 0x27, 0xed, 0x21, 0x1e, 0x9f, 0x5c, 0xef, 0xc4, 0x00, 0x3f, 0xd9, 0xc5, 0xe3,
+	0x57, 0x68, 0xa7, 0xca, 0xfb, 0x87, 0xcd, 0x6d, 0xf9, 0x06, 0xdc, 0xa4, 0x65, 0x61, 0xa8, 0xd1,
+	0x85, 0xf8, 0x44, 0xbc, 0x49, 0x3c, 0xfe, 0x6a, 0x4a, 0x03, 0xbe, 0x8b, 0xc7, 0xe2, 0xf7, 0x68,
+	0xc7, 0xf0, 0xe7, 0x3a, 0xab, 0x39, 0xa0, 0x52, 0x95, 0x95, 0x8a, 0x5f, 0x05, 0xe7, 0xa6, 0x6c,
+	0xe7, 0x91, 0x3f, 0xf9, 0x68, 0x75, 0x1e, 0xcc, 0x79, 0x54, 0x21, 0xd8, 0x39, 0x8c, 0x80, 0xef,
+	0x4e, 0x3d, 0xf7, 0xc4, 0x5f, 0x71, 0x6f, 0x87, 0x6f, 0x3b, 0xf2, 0x8f, 0x01, 0x58, 0xde, 0xeb,
+	0x8f, 0x2c, 0x9d, 0x38, 0xba, 0x3d, 0x20, 0xea, 0xee, 0x06, 0xbc, 0x01, 0x40, 0xe8, 0x0c, 0xbc,
+	0x78, 0x0a, 0x6d, 0x8b, 0x1e, 0x21, 0xb3, 0xc1, 0xb1, 0x30, 0x70, 0x3a, 0xfd, 0x6b, 0xff, 0xf8,
+	0xaf, 0x7f, 0x10, 0x03, 0x70, 0xa1, 0xc0, 0x6f, 0x84, 0xf0, 0x23, 0x90, 0xf0, 0xef, 0x22, 0xf0,
+	0x3c, 0xd7, 0x9d, 0xba, 0xef, 0x64, 0x2f, 0x9c, 0xe0, 0xfa, 0x8e, 0x8b, 0xe8, 0xb0, 0x39, 0xc3,
+	0xb0, 0x2e, 0x89, 0xf3, 0x05, 0x9d, 0xc9, 0x14, 0xe1, 0xea, 0x83, 0x24, 0x0c, 0x28, 0xb8, 0x03,
+	0x12, 0x7e, 0xc4, 0x26, 0xc0, 0x53, 0x67, 0xa8, 0xec, 0x85, 0x13, 0x5c, 0x0e, 0x0c, 0x19, 0xea,
+	0xa2, 0x38, 0x5f, 0xf0, 0xaf, 0xa3, 0x8a, 0x70, 0x15, 0xde, 0x00, 0x71, 0xba, 0xe6, 0xab, 0x7c,
+	0x44, 0xd8, 0xa8, 0xb2, 0xaf, 0x9f, 0x95, 0xe9, 0x01, 0xd4, 0x0a, 0x83, 0x4a, 0x8a, 0xb3, 0x85,
+	0x21, 0x1e, 0xfb, 0x38, 0x09, 0x5f, 0x71, 0x62, 0xd2, 0x54, 0x49, 0xce, 0x5e, 0x38, 0xc1, 0x9d,
+	0xc6, 0x81, 0xf3, 0x05, 0xbf, 0x74, 0xc1, 0x5f, 0x02, 0x0b, 0xc1, 0x1e, 0x84, 0x17, 0xf9, 0x98,
+	0x13, 0xa5, 0x2c, 0x7b, 0xe9, 0x14, 0x9f, 0xa3, 0x9d, 0x67, 0x68, 0xcb, 0x62, 0xb2, 0xb0, 0xcf,
+	0x45, 0xd4, 0xb4, 0x8f, 0xc1, 0xca, 0x89, 0x5d, 0x09, 0x2f, 0x4f, 0x05, 0xe8, 0xe4, 0x6e, 0x7d,
+	0x5e, 0xfc, 0x42, 0x63, 0xfd, 0xf8, 0xc1, 0x4f, 0x82, 0xc3, 0xeb, 0xae, 0xdf, 0x59, 0x9f, 0xb3,
+	0x1c, 0x2f, 0x8c, 0xe4, 0x25, 0x06, 0xba, 0x2a, 0x2e, 0xd2, 0x48, 0x16, 0x82, 0xdd, 0x2d, 0x5c,
+	0x85, 0xf7, 0x58, 0x16, 0x06, 0xc8, 0x49, 0x8e, 0xb1, 0xa3, 0xbf, 0x18, 0xee, 0x35, 0x06, 0x77,
+	0x0e, 0xae, 0x46, 0xe1, 0x0a, 0x4f, 0x0d, 0xfd, 0x00, 0xaa, 0x60, 0x89, 0x1d, 0xbd, 0xc9, 0xd7,
+	0xc4, 0xbc, 0x7a, 0x06, 0xe6, 0x87, 0x00, 0x84, 0x47, 0x5d, 0x98, 0x99, 0x72, 0x3f, 0x72, 0xd4,
+	0x7b, 0x5e, 0x44, 0x43, 0xe7, 0xfd, 0x88, 0x16, 0xe8, 0xf3, 0x16, 0x75, 0x5e, 0x03, 0x20, 0x3c,
+	0x2f, 0x4e, 0x70, 0x4f, 0x1d, 0x21, 0x5f, 0x6c, 0xf7, 0x1a, 0x43, 0xcf, 0x88, 0xe7, 0xa2, 0xe8,
+	0x05, 0x97, 0x81, 0xf0, 0x08, 0x6f, 0xf8, 0x8f, 0x62, 0x74, 0x92, 0xff, 0x7b, 0x34, 0xa2, 0xa8,
+	0x2c, 0x1a, 0x1f, 0x81, 0x54, 0xa4, 0x92, 0xc1, 0xd7, 0xa6, 0x9c, 0x8e, 0x56, 0xe6, 0x6c, 0xf6,
+	0x2c, 0x11, 0x9f, 0x60, 0x95, 0x4d, 0x90, 0x12, 0x13, 0x05, 0x56, 0xe2, 0xa8, 0xa5, 0x5b, 0x20,
+	0xa5, 0x92, 0xc7, 0xf6, 0x23, 0x0e, 0x1c, 0x31, 0xf5, 0x39, 0xd5, 0x49, 0x3c, 0xc7, 0x40, 0x96,
+	0xae, 0xa6, 0x7c, 0x10, 0xdf, 0xbe, 0x7b, 0x20, 0xed, 0xc3, 0x6c, 0x12, 0x93, 0xf4, 0xfc, 0xb7,
+	0x8e, 0x57, 0xc0, 0xca, 0x30, 0x2c, 0x78, 0x35, 0x5d, 0xd0, 0x27, 0xe3, 0x18, 0x60, 0xeb, 0x6f,
+	0x97, 0x0e, 0x9b, 0xff, 0xb4, 0x08, 0x2f, 0x82, 0x95, 0x48, 0x09, 0x45, 0xea, 0xee, 0x86, 0x1c,
+	0x2f, 0xe6, 0xa5, 0xab, 0x42, 0x4c, 0x4e, 0xe3, 0xe1, 0xd0, 0x34, 0x34, 0x7f, 0xcc, 0x43, 0xd7,
+	0xb6, 0x94, 0x53, 0x1c, 0xf5, 0x6f, 0x04, 0x10, 0x2f, 0x4b, 0x12, 0xfc, 0x4b, 0x01, 0x3c, 0xdc,
+	0xeb, 0x13, 0x87, 0xa0, 0x7d, 0xec, 0x22, 0x6c, 0x21, 0xf6, 0x54, 0x8a, 0xc2, 0xf7, 0x25, 0xe4,
+	0xf5, 0x09, 0xe2, 0xe7, 0xd0, 0x3c, 0xda, 0xeb, 0x13, 0xae, 0x31, 0x20, 0xae, 0x8b, 0x7b, 0x04,
+	0x19, 0x2e, 0xf2, 0xdf, 0xb2, 0x4d, 0x73, 0x8c, 0x74, 0xe2, 0x1a, 0x3d, 0x8b, 0xe8, 0xc8, 0xb3,
+	0xd1, 0xd0, 0x21, 0x2e, 0xb1, 0x3c, 0xfa, 0x49, 0x21, 0x46, 0x2e, 0x71, 0xf2, 0xf0, 0x16, 0xa0,
+	0x5d, 0x2d, 0x21, 0xb7, 0xe0, 0x7b, 0x4f, 0x45, 0x06, 0x24, 0x2a, 0xe2, 0x3b, 0x3e, 0xa2, 0x4e,
+	0x3c, 0x6c, 0x98, 0xee, 0x75, 0xf1, 0x9a, 0x48, 0x2b, 0xae, 0xa8, 0x94, 0xae, 0x89, 0x7c, 0x96,
+	0x33, 0x94, 0x0e, 0xd4, 0x1f, 0x32, 0x17, 0x8a, 0xf0, 0x50, 0x00, 0x37, 0x55, 0xe2, 0x8d, 0x1c,
+	0x3a, 0xf1, 0x7e, 0x9f, 0x58, 0x93, 0xf9, 0x90, 0x6e, 0x13, 0x17, 0x59, 0xb6, 0x87, 0xfa, 0xf8,
+	0x31, 0x41, 0x43, 0xe2, 0x0c, 0x0c, 0xd7, 0x35, 0x6c, 0x8b, 0x1a, 0x85, 0x35, 0xea, 0x21, 0x77,
+	0xcf, 0xb5, 0x47, 0x8e, 0x46, 0xf2, 0xf0, 0x26, 0xb7, 0xef, 0x5d, 0xf8, 0x9d, 0xd0, 0x3e, 0xc3,
+	0x7a, 0x8c, 0x4d, 0x43, 0x47, 0xa6, 0xdd, 0x33, 0xac, 0x89, 0x75, 0xc5, 0x6a, 0xd4, 0xbc, 0x69,
+	0x9d, 0x03, 0xd5, 0xa5, 0xb6, 0x95, 0xa1, 0x09, 0xae, 0x9e, 0x36, 0x2d, 0x98, 0x2e, 0x34, 0x8f,
+	0x3c, 0x31, 0x5c, 0x2f, 0x0f, 0xaf, 0xf3, 0xd9, 0xab, 0xb0, 0x1c, 0xce, 0x4e, 0xe5, 0x5d, 0x7b,
+	0x64, 0xe9, 0x93, 0x99, 0x2b, 0xd1, 0x89, 0x43, 0xf1, 0x81, 0xfa, 0xd7, 0x02, 0x88, 0x57, 0x24,
+	0x09, 0xfe, 0x85, 0x00, 0x1e, 0xed, 0x58, 0x1e, 0x71, 0x2c, 0x6c, 0xfa, 0xcb, 0xe5, 0xaf, 0x1c,
+	0x7d, 0xfe, 0x59, 0x27, 0x96, 0x8e, 0xc8, 0x93, 0x21, 0x71, 0x0c, 0x62, 0x69, 0x44, 0x9f, 0xac,
+	0x79, 0x1e, 0xdd, 0xb5, 0x69, 0xd4, 0xba, 0x23, 0x13, 0x19, 0x56, 0xd7, 0x76, 0x06, 0x2c, 0x5d,
+	0xd0, 0xbe, 0x61, 0x9a, 0xa8, 0x43, 0x68, 0x4a, 0x3c, 0x36, 0x74, 0xa2, 0x23, 0xc3, 0x9a, 0x4e,
+	0x81, 0x3c, 0xdc, 0xe6, 0x76, 0xbf, 0x07, 0xaf, 0x47, 0xa3, 0x16, 0x35, 0xe0, 0x6c, 0xe3, 0x4f,
+	0xe8, 0x1c, 0x3c, 0xf8, 0xf5, 0x79, 0xf0, 0xc7, 0x02, 0x38, 0xbf, 0x71, 0x77, 0x9d, 0x56, 0xcb,
+	0xf5, 0xdd, 0x51, 0xe7, 0x7d, 0x32, 0xbe, 0xef, 0x39, 0x86, 0xd5, 0x83, 0x3f, 0x10, 0x16, 0x62,
+	0xd0, 0xda, 0x26, 0x4f, 0x10, 0xb1, 0x28, 0x96, 0x8e, 0x34, 0x7b, 0x40, 0xb3, 0xcc, 0x25, 0x3a,
+	0x1a, 0x8e, 0x3a, 0xa6, 0xa1, 0xa1, 0x47, 0x64, 0x9c, 0x47, 0xfc, 0xf5, 0x53, 0x41, 0x92, 0x2c,
+	0x69, 0x25, 0x2c, 0x91, 0x5a, 0x47, 0x92, 0x88, 0xa4, 0xd7, 0x75, 0x4d, 0xd3, 0x74, 0xbd, 0x51,
+	0x2a, 0x76, 0x64, 0xbd, 0x5a, 0xac, 0x97, 0xeb, 0xa5, 0x86, 0x5c, 0xaf, 0xd5, 0xe5, 0x46, 0x0d,
+	0x77, 0xca, 0x95, 0x8a, 0x5c, 0x93, 0x35, 0x0d, 0x37, 0xea, 0x65, 0xa9, 0x58, 0x2e, 0x57, 0xeb,
+	0x54, 0x21, 0x7b, 0xa6, 0x29, 0x28, 0x06, 0xfe, 0x90, 0x3e, 0x38, 0x70, 0xd1, 0x7d, 0xa3, 0x67,
+	0x61, 0x6f, 0xe4, 0x10, 0xf8, 0xfd, 0xd8, 0x42, 0x0c, 0xfe, 0x9b, 0x10, 0xb5, 0xd1, 0x0d, 0x84,
+	0xc8, 0xee, 0x32, 0x22, 0xd8, 0x53, 0x9f, 0x06, 0xc3, 0x27, 0x07, 0xa5, 0xb7, 0x03, 0xce, 0x5d,
+	0xdb, 0xd2, 0xc8, 0xa7, 0xa8, 0x4f, 0xb0, 0x4e, 0x9c, 0x88, 0x3f, 0x25, 0xa9, 0x5c, 0x91, 0x64,
+	0xb9, 0x28, 0x49, 0x98, 0x74, 0x8b, 0xf5, 0x4a, 0xb1, 0x5a, 0xa9, 0x68, 0x7a, 0x95, 0xd4, 0x34,
+	0x4d, 0xab, 0xd5, 0x70, 0x57, 0x2b, 0x69, 0x7a, 0x55, 0xab, 0x77, 0x6b, 0xb8, 0xd1, 0xd0, 0x49,
+	0xbd, 0x52, 0xa9, 0xd4, 0x8a, 0x1a, 0xc1, 0xb2, 0xae, 0x91, 0x06, 0x69, 0x94, 0x3b, 0xc5, 0x5a,
+	0xa7, 0xd4, 0x90, 0x65, 0xb9, 0xde, 0x95, 0x64, 0x59, 0xaa, 0x76, 0x4a, 0xb5, 0x6e, 0xa9, 0x52,
+	0x6a, 0xd4, 0xa4, 0x62, 0x9d, 0x74, 0xaa, 0x65, 0xbd, 0xd4, 0xad, 0xd6, 0x1b, 0x8d, 0x0a, 0xa9,
+	0x56, 0x24, 0x49, 0x2f, 0x69, 0xb5, 0x6a, 0x51, 0x93, 0xeb, 0x65, 0xbd, 0x8a, 0xab, 0x35, 0x2c,
+	0x57, 0xa4, 0x46, 0xa3, 0x5c, 0xd3, 0x71, 0xa3, 0x58, 0xaa, 0x55, 0x2a, 0x75, 0xbd, 0x98, 0x3d,
+	0x1d, 0x00, 0x14, 0x03, 0x06, 0x58, 0x3d, 0xe5, 0x18, 0xdc, 0x5b, 0x88, 0xc1, 0x6f, 0x6f, 0x8c,
+	0x1c, 0x87, 0x15, 0x04, 0x63, 0x40, 0x68, 0x12, 0xa9, 0x37, 0x36, 0x4a, 0xa5, 0x52, 0x23, 0xe2,
+	0x9f, 0x2c, 0x49, 0xd5, 0x75, 0xa9, 0xb8, 0x2e, 0xc9, 0x7b, 0xc5, 0x8a, 0x22, 0x95, 0x15, 0xa9,
+	0xf2, 0x40, 0xaa, 0x29, 0x92, 0x94, 0x3d, 0x8d, 0x89, 0x62, 0xe0, 0xef, 0xe8, 0x43, 0x56, 0x34,
+	0x64, 0xf0, 0x47, 0x34, 0x45, 0xfe, 0x48, 0x68, 0x5a, 0xc8, 0xff, 0xa7, 0x06, 0xd8, 0x44, 0x0e,
+	0xb6, 0x74, 0x7b, 0x80, 0x5c, 0x7f, 0xe1, 0x3c, 0x1b, 0x69, 0xb6, 0xa5, 0x61, 0x8f, 0x58, 0xd8,
+	0x23, 0x88, 0x5d, 0x37, 0xd9, 0x6a, 0x9c, 0xc6, 0xf7, 0xa3, 0x8f, 0x3a, 0xa4, 0x6b, 0x3b, 0x04,
+	0x69, 0xd8, 0xd4, 0x46, 0x26, 0xf6, 0x82, 0xd5, 0xa3, 0xff, 0x87, 0x4b, 0xdb, 0x35, 0x88, 0xa9,
+	0xfb, 0x7b, 0xcc, 0xa2, 0x86, 0x20, 0x76, 0xf7, 0x41, 0x1a, 0xb6, 0x90, 0x6d, 0x99, 0x63, 0xba,
+	0x7d, 0x46, 0x34, 0x4b, 0xa9, 0x2c, 0x9f, 0x9d, 0x36, 0x1a, 0xc5, 0xc0, 0xef, 0x09, 0x60, 0x89,
+	0x32, 0x6c, 0xc7, 0xf8, 0x15, 0xbf, 0x43, 0x1c, 0x2c, 0xc4, 0x60, 0xbf, 0x89, 0x3a, 0x04, 0x3b,
+	0xd4, 0x40, 0xda, 0x4e, 0x50, 0xd7, 0xb1, 0x07, 0xfe, 0xdc, 0x8c, 0x24, 0x96, 0x3e, 0xb4, 0x0d,
+	0xcb, 0xf3, 0x91, 0x0d, 0xcb, 0xf5, 0x08, 0xd6, 0xa3, 0x49, 0x46, 0xb0, 0xd6, 0x0f, 0x2b, 0xf7,
+	0x24, 0xc8, 0x2d, 0x1f, 0x93, 0x8c, 0x6f, 0x0d, 0x1f, 0x6c, 0xec, 0x54, 0xf3, 0xf9, 0x7c, 0x76,
+	0x7a, 0x72, 0x14, 0xfb, 0xfc, 0x8b, 0xb5, 0x99, 0x9f, 0x7c, 0xb1, 0x36, 0xf3, 0xd3, 0x2f, 0xd6,
+	0x84, 0xef, 0x3f, 0x5b, 0x13, 0xfe, 0xf4, 0xd9, 0x9a, 0xf0, 0xf7, 0xcf, 0xd6, 0x84, 0xcf, 0x9f,
+	0xad, 0x09, 0xff, 0xf2, 0x6c, 0x4d, 0xf8, 0xf7, 0x67, 0x6b, 0x33, 0x3f, 0x7d, 0xb6, 0x36, 0xf3,
+	0xd9, 0x97, 0x6b, 0x33, 0x9f, 0x7f, 0xb9, 0x36, 0xf3, 0x93, 0x2f, 0xd7, 0x66, 0x1e, 0xbc, 0xdd,
+	0x33, 0xbc, 0xbc, 0x66, 0x1b, 0x96, 0x65, 0x58, 0x0f, 0x71, 0xde, 0x22, 0x5e, 0x81, 0xd6, 0x1b,
+	0x62, 0xe9, 0x05, 0x2f, 0x6c, 0x54, 0xfe, 0xbf, 0x5a, 0xe9, 0x24, 0x58, 0xbb, 0x2b, 0xfd, 0xef,
+	0x00, 0x72, 0x80, 0x5c, 0x74, 0xcb, 0x22, 0x00, 0x00,
 }
 
 func (this *Account) Equal(that interface{}) bool {
@@ -1616,6 +1974,15 @@ func (this *LedgerRecord) Equal(that interface{}) bool {
 	if this.Hidden != that1.Hidden {
 		return false
 	}
+	if this.Hold != that1.Hold {
+		return false
+	}
+	if this.CancelHeight != that1.CancelHeight {
+		return false
+	}
+	if this.SatPerByte != that1.SatPerByte {
+		return false
+	}
 	return true
 }
 func (this *DecodeRequest) Equal(that interface{}) bool {
@@ -1791,16 +2158,19 @@ func (this *CreateRequest) Equal(that interface{}) bool {
 	if this.Expires != that1.Expires {
 		return false
 	}
+	if this.IdempotencyKey != that1.IdempotencyKey {
+		return false
+	}
 	return true
 }
-func (this *CreateResponse) Equal(that interface{}) bool {
+func (this *CreateHoldRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*CreateResponse)
+	that1, ok := that.(*CreateHoldRequest)
 	if !ok {
-		that2, ok := that.(CreateResponse)
+		that2, ok := that.(CreateHoldRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1812,19 +2182,28 @@ func (this *CreateResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Request != that1.Request {
+	if this.Hash != that1.Hash {
+		return false
+	}
+	if this.Memo != that1.Memo {
+		return false
+	}
+	if this.Value != that1.Value {
+		return false
+	}
+	if this.Expires != that1.Expires {
 		return false
 	}
 	return true
 }
-func (this *PayRequest) Equal(that interface{}) bool {
+func (this *SettleHoldRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*PayRequest)
+	that1, ok := that.(*SettleHoldRequest)
 	if !ok {
-		that2, ok := that.(PayRequest)
+		that2, ok := that.(SettleHoldRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1836,28 +2215,19 @@ func (this *PayRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Request != that1.Request {
-		return false
-	}
-	if this.Value != that1.Value {
-		return false
-	}
-	if this.Estimate != that1.Estimate {
-		return false
-	}
-	if this.PreAuthId != that1.PreAuthId {
+	if this.Preimage != that1.Preimage {
 		return false
 	}
 	return true
 }
-func (this *LedgerRecordResponse) Equal(that interface{}) bool {
+func (this *CreateResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*LedgerRecordResponse)
+	that1, ok := that.(*CreateResponse)
 	if !ok {
-		that2, ok := that.(LedgerRecordResponse)
+		that2, ok := that.(CreateResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1869,19 +2239,22 @@ func (this *LedgerRecordResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.Result.Equal(that1.Result) {
+	if this.Request != that1.Request {
+		return false
+	}
+	if this.Warning != that1.Warning {
 		return false
 	}
 	return true
 }
-func (this *LedgerRequest) Equal(that interface{}) bool {
+func (this *PayRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*LedgerRequest)
+	that1, ok := that.(*PayRequest)
 	if !ok {
-		that2, ok := that.(LedgerRequest)
+		that2, ok := that.(PayRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1893,10 +2266,70 @@ func (this *LedgerRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if len(this.Filter) != len(that1.Filter) {
+	if this.Request != that1.Request {
 		return false
 	}
-	for i := range this.Filter {
+	if this.Value != that1.Value {
+		return false
+	}
+	if this.Estimate != that1.Estimate {
+		return false
+	}
+	if this.PreAuthId != that1.PreAuthId {
+		return false
+	}
+	if this.IdempotencyKey != that1.IdempotencyKey {
+		return false
+	}
+	return true
+}
+func (this *LedgerRecordResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LedgerRecordResponse)
+	if !ok {
+		that2, ok := that.(LedgerRecordResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Result.Equal(that1.Result) {
+		return false
+	}
+	return true
+}
+func (this *LedgerRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LedgerRequest)
+	if !ok {
+		that2, ok := that.(LedgerRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Filter) != len(that1.Filter) {
+		return false
+	}
+	for i := range this.Filter {
 		if this.Filter[i] != that1.Filter[i] {
 			return false
 		}
@@ -1979,6 +2412,9 @@ func (this *WithdrawRequest) Equal(that interface{}) bool {
 	if this.Estimate != that1.Estimate {
 		return false
 	}
+	if this.IdempotencyKey != that1.IdempotencyKey {
+		return false
+	}
 	return true
 }
 func (this *WithdrawResponse) Equal(that interface{}) bool {
@@ -2053,6 +2489,75 @@ func (this *Id) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *CreateTokenRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CreateTokenRequest)
+	if !ok {
+		that2, ok := that.(CreateTokenRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Rpcs) != len(that1.Rpcs) {
+		return false
+	}
+	for i := range this.Rpcs {
+		if this.Rpcs[i] != that1.Rpcs[i] {
+			return false
+		}
+	}
+	if this.MaxPay != that1.MaxPay {
+		return false
+	}
+	if this.Expires != that1.Expires {
+		return false
+	}
+	return true
+}
+func (this *CreateTokenResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CreateTokenResponse)
+	if !ok {
+		that2, ok := that.(CreateTokenResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Token != that1.Token {
+		return false
+	}
+	if that1.ExpiresAt == nil {
+		if this.ExpiresAt != nil {
+			return false
+		}
+	} else if !this.ExpiresAt.Equal(*that1.ExpiresAt) {
+		return false
+	}
+	return true
+}
 func (this *Account) GoString() string {
 	if this == nil {
 		return "nil"
@@ -2074,7 +2579,7 @@ func (this *LedgerRecord) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 21)
+	s := make([]string, 0, 24)
 	s = append(s, "&tdrpc.LedgerRecord{")
 	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
 	s = append(s, "AccountId: "+fmt.Sprintf("%#v", this.AccountId)+",\n")
@@ -2093,6 +2598,9 @@ func (this *LedgerRecord) GoString() string {
 	s = append(s, "Request: "+fmt.Sprintf("%#v", this.Request)+",\n")
 	s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
 	s = append(s, "Hidden: "+fmt.Sprintf("%#v", this.Hidden)+",\n")
+	s = append(s, "Hold: "+fmt.Sprintf("%#v", this.Hold)+",\n")
+	s = append(s, "CancelHeight: "+fmt.Sprintf("%#v", this.CancelHeight)+",\n")
+	s = append(s, "SatPerByte: "+fmt.Sprintf("%#v", this.SatPerByte)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -2157,21 +2665,46 @@ func (this *CreateRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 7)
+	s := make([]string, 0, 8)
 	s = append(s, "&tdrpc.CreateRequest{")
 	s = append(s, "Memo: "+fmt.Sprintf("%#v", this.Memo)+",\n")
 	s = append(s, "Value: "+fmt.Sprintf("%#v", this.Value)+",\n")
 	s = append(s, "Expires: "+fmt.Sprintf("%#v", this.Expires)+",\n")
+	s = append(s, "IdempotencyKey: "+fmt.Sprintf("%#v", this.IdempotencyKey)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *CreateResponse) GoString() string {
+func (this *CreateHoldRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&tdrpc.CreateHoldRequest{")
+	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
+	s = append(s, "Memo: "+fmt.Sprintf("%#v", this.Memo)+",\n")
+	s = append(s, "Value: "+fmt.Sprintf("%#v", this.Value)+",\n")
+	s = append(s, "Expires: "+fmt.Sprintf("%#v", this.Expires)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SettleHoldRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
 	s := make([]string, 0, 5)
+	s = append(s, "&tdrpc.SettleHoldRequest{")
+	s = append(s, "Preimage: "+fmt.Sprintf("%#v", this.Preimage)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CreateResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
 	s = append(s, "&tdrpc.CreateResponse{")
 	s = append(s, "Request: "+fmt.Sprintf("%#v", this.Request)+",\n")
+	s = append(s, "Warning: "+fmt.Sprintf("%#v", this.Warning)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -2179,12 +2712,13 @@ func (this *PayRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 8)
+	s := make([]string, 0, 9)
 	s = append(s, "&tdrpc.PayRequest{")
 	s = append(s, "Request: "+fmt.Sprintf("%#v", this.Request)+",\n")
 	s = append(s, "Value: "+fmt.Sprintf("%#v", this.Value)+",\n")
 	s = append(s, "Estimate: "+fmt.Sprintf("%#v", this.Estimate)+",\n")
 	s = append(s, "PreAuthId: "+fmt.Sprintf("%#v", this.PreAuthId)+",\n")
+	s = append(s, "IdempotencyKey: "+fmt.Sprintf("%#v", this.IdempotencyKey)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -2241,13 +2775,14 @@ func (this *WithdrawRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 9)
+	s := make([]string, 0, 10)
 	s = append(s, "&tdrpc.WithdrawRequest{")
 	s = append(s, "Address: "+fmt.Sprintf("%#v", this.Address)+",\n")
 	s = append(s, "Value: "+fmt.Sprintf("%#v", this.Value)+",\n")
 	s = append(s, "Blocks: "+fmt.Sprintf("%#v", this.Blocks)+",\n")
 	s = append(s, "SatPerByte: "+fmt.Sprintf("%#v", this.SatPerByte)+",\n")
 	s = append(s, "Estimate: "+fmt.Sprintf("%#v", this.Estimate)+",\n")
+	s = append(s, "IdempotencyKey: "+fmt.Sprintf("%#v", this.IdempotencyKey)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -2283,6 +2818,30 @@ func (this *Id) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *CreateTokenRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&tdrpc.CreateTokenRequest{")
+	s = append(s, "Rpcs: "+fmt.Sprintf("%#v", this.Rpcs)+",\n")
+	s = append(s, "MaxPay: "+fmt.Sprintf("%#v", this.MaxPay)+",\n")
+	s = append(s, "Expires: "+fmt.Sprintf("%#v", this.Expires)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CreateTokenResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&tdrpc.CreateTokenResponse{")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "Token: "+fmt.Sprintf("%#v", this.Token)+",\n")
+	s = append(s, "ExpiresAt: "+fmt.Sprintf("%#v", this.ExpiresAt)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func valueToGoStringTdrpc(v interface{}, typ string) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -2323,6 +2882,18 @@ type ThunderdomeRPCClient interface {
 	// Get a pre-authorized request
 	GetPreAuth(ctx context.Context, in *Id, opts ...grpc.CallOption) (*LedgerRecordResponse, error)
 	ExpirePreAuth(ctx context.Context, in *Id, opts ...grpc.CallOption) (*LedgerRecordResponse, error)
+	// Create a hold invoice for the hash of a preimage. The funds are not credited until it is settled with the preimage.
+	CreateHold(ctx context.Context, in *CreateHoldRequest, opts ...grpc.CallOption) (*CreateResponse, error)
+	// Settle an accepted hold invoice crediting the account
+	SettleHold(ctx context.Context, in *SettleHoldRequest, opts ...grpc.CallOption) (*LedgerRecordResponse, error)
+	// Cancel a hold invoice returning any accepted funds to the payer
+	CancelHold(ctx context.Context, in *Id, opts ...grpc.CallOption) (*LedgerRecordResponse, error)
+	// Create a bearer token that can be used instead of signing each request. Requires a signed request.
+	CreateToken(ctx context.Context, in *CreateTokenRequest, opts ...grpc.CallOption) (*CreateTokenResponse, error)
+	// Revoke a bearer token
+	RevokeToken(ctx context.Context, in *Id, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Revoke a delegation by its id (the hex sha256 of the encoded delegation). Requires a signed request.
+	RevokeDelegation(ctx context.Context, in *Id, opts ...grpc.CallOption) (*empty.Empty, error)
 }
 
 type thunderdomeRPCClient struct {
@@ -2423,6 +2994,60 @@ func (c *thunderdomeRPCClient) ExpirePreAuth(ctx context.Context, in *Id, opts .
 	return out, nil
 }
 
+func (c *thunderdomeRPCClient) CreateHold(ctx context.Context, in *CreateHoldRequest, opts ...grpc.CallOption) (*CreateResponse, error) {
+	out := new(CreateResponse)
+	err := c.cc.Invoke(ctx, "/tdrpc.ThunderdomeRPC/CreateHold", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thunderdomeRPCClient) SettleHold(ctx context.Context, in *SettleHoldRequest, opts ...grpc.CallOption) (*LedgerRecordResponse, error) {
+	out := new(LedgerRecordResponse)
+	err := c.cc.Invoke(ctx, "/tdrpc.ThunderdomeRPC/SettleHold", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thunderdomeRPCClient) CancelHold(ctx context.Context, in *Id, opts ...grpc.CallOption) (*LedgerRecordResponse, error) {
+	out := new(LedgerRecordResponse)
+	err := c.cc.Invoke(ctx, "/tdrpc.ThunderdomeRPC/CancelHold", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thunderdomeRPCClient) CreateToken(ctx context.Context, in *CreateTokenRequest, opts ...grpc.CallOption) (*CreateTokenResponse, error) {
+	out := new(CreateTokenResponse)
+	err := c.cc.Invoke(ctx, "/tdrpc.ThunderdomeRPC/CreateToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thunderdomeRPCClient) RevokeToken(ctx context.Context, in *Id, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/tdrpc.ThunderdomeRPC/RevokeToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thunderdomeRPCClient) RevokeDelegation(ctx context.Context, in *Id, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/tdrpc.ThunderdomeRPC/RevokeDelegation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ThunderdomeRPCServer is the server API for ThunderdomeRPC service.
 type ThunderdomeRPCServer interface {
 	// Get/Create user account
@@ -2444,6 +3069,18 @@ type ThunderdomeRPCServer interface {
 	// Get a pre-authorized request
 	GetPreAuth(context.Context, *Id) (*LedgerRecordResponse, error)
 	ExpirePreAuth(context.Context, *Id) (*LedgerRecordResponse, error)
+	// Create a hold invoice for the hash of a preimage. The funds are not credited until it is settled with the preimage.
+	CreateHold(context.Context, *CreateHoldRequest) (*CreateResponse, error)
+	// Settle an accepted hold invoice crediting the account
+	SettleHold(context.Context, *SettleHoldRequest) (*LedgerRecordResponse, error)
+	// Cancel a hold invoice returning any accepted funds to the payer
+	CancelHold(context.Context, *Id) (*LedgerRecordResponse, error)
+	// Create a bearer token that can be used instead of signing each request. Requires a signed request.
+	CreateToken(context.Context, *CreateTokenRequest) (*CreateTokenResponse, error)
+	// Revoke a bearer token
+	RevokeToken(context.Context, *Id) (*empty.Empty, error)
+	// Revoke a delegation by its id (the hex sha256 of the encoded delegation). Requires a signed request.
+	RevokeDelegation(context.Context, *Id) (*empty.Empty, error)
 }
 
 func RegisterThunderdomeRPCServer(s *grpc.Server, srv ThunderdomeRPCServer) {
@@ -2630,63 +3267,195 @@ func _ThunderdomeRPC_ExpirePreAuth_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
-var _ThunderdomeRPC_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "tdrpc.ThunderdomeRPC",
-	HandlerType: (*ThunderdomeRPCServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetAccount",
-			Handler:    _ThunderdomeRPC_GetAccount_Handler,
-		},
-		{
-			MethodName: "Decode",
-			Handler:    _ThunderdomeRPC_Decode_Handler,
-		},
-		{
-			MethodName: "Create",
-			Handler:    _ThunderdomeRPC_Create_Handler,
-		},
-		{
-			MethodName: "Pay",
-			Handler:    _ThunderdomeRPC_Pay_Handler,
-		},
-		{
-			MethodName: "Ledger",
-			Handler:    _ThunderdomeRPC_Ledger_Handler,
-		},
-		{
-			MethodName: "Withdraw",
-			Handler:    _ThunderdomeRPC_Withdraw_Handler,
-		},
-		{
-			MethodName: "CreateGenerated",
-			Handler:    _ThunderdomeRPC_CreateGenerated_Handler,
-		},
-		{
-			MethodName: "CreatePreAuth",
-			Handler:    _ThunderdomeRPC_CreatePreAuth_Handler,
-		},
-		{
-			MethodName: "GetPreAuth",
-			Handler:    _ThunderdomeRPC_GetPreAuth_Handler,
-		},
-		{
-			MethodName: "ExpirePreAuth",
-			Handler:    _ThunderdomeRPC_ExpirePreAuth_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "tdrpc/tdrpc.proto",
-}
-
-func (m *Account) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _ThunderdomeRPC_CreateHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateHoldRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(ThunderdomeRPCServer).CreateHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tdrpc.ThunderdomeRPC/CreateHold",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThunderdomeRPCServer).CreateHold(ctx, req.(*CreateHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThunderdomeRPC_SettleHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SettleHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThunderdomeRPCServer).SettleHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tdrpc.ThunderdomeRPC/SettleHold",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThunderdomeRPCServer).SettleHold(ctx, req.(*SettleHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThunderdomeRPC_CancelHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Id)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThunderdomeRPCServer).CancelHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tdrpc.ThunderdomeRPC/CancelHold",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThunderdomeRPCServer).CancelHold(ctx, req.(*Id))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThunderdomeRPC_CreateToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThunderdomeRPCServer).CreateToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tdrpc.ThunderdomeRPC/CreateToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThunderdomeRPCServer).CreateToken(ctx, req.(*CreateTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThunderdomeRPC_RevokeToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Id)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThunderdomeRPCServer).RevokeToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tdrpc.ThunderdomeRPC/RevokeToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThunderdomeRPCServer).RevokeToken(ctx, req.(*Id))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThunderdomeRPC_RevokeDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Id)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThunderdomeRPCServer).RevokeDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tdrpc.ThunderdomeRPC/RevokeDelegation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThunderdomeRPCServer).RevokeDelegation(ctx, req.(*Id))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ThunderdomeRPC_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "tdrpc.ThunderdomeRPC",
+	HandlerType: (*ThunderdomeRPCServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetAccount",
+			Handler:    _ThunderdomeRPC_GetAccount_Handler,
+		},
+		{
+			MethodName: "Decode",
+			Handler:    _ThunderdomeRPC_Decode_Handler,
+		},
+		{
+			MethodName: "Create",
+			Handler:    _ThunderdomeRPC_Create_Handler,
+		},
+		{
+			MethodName: "Pay",
+			Handler:    _ThunderdomeRPC_Pay_Handler,
+		},
+		{
+			MethodName: "Ledger",
+			Handler:    _ThunderdomeRPC_Ledger_Handler,
+		},
+		{
+			MethodName: "Withdraw",
+			Handler:    _ThunderdomeRPC_Withdraw_Handler,
+		},
+		{
+			MethodName: "CreateGenerated",
+			Handler:    _ThunderdomeRPC_CreateGenerated_Handler,
+		},
+		{
+			MethodName: "CreatePreAuth",
+			Handler:    _ThunderdomeRPC_CreatePreAuth_Handler,
+		},
+		{
+			MethodName: "GetPreAuth",
+			Handler:    _ThunderdomeRPC_GetPreAuth_Handler,
+		},
+		{
+			MethodName: "ExpirePreAuth",
+			Handler:    _ThunderdomeRPC_ExpirePreAuth_Handler,
+		},
+		{
+			MethodName: "CreateHold",
+			Handler:    _ThunderdomeRPC_CreateHold_Handler,
+		},
+		{
+			MethodName: "SettleHold",
+			Handler:    _ThunderdomeRPC_SettleHold_Handler,
+		},
+		{
+			MethodName: "CancelHold",
+			Handler:    _ThunderdomeRPC_CancelHold_Handler,
+		},
+		{
+			MethodName: "CreateToken",
+			Handler:    _ThunderdomeRPC_CreateToken_Handler,
+		},
+		{
+			MethodName: "RevokeToken",
+			Handler:    _ThunderdomeRPC_RevokeToken_Handler,
+		},
+		{
+			MethodName: "RevokeDelegation",
+			Handler:    _ThunderdomeRPC_RevokeDelegation_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "tdrpc/tdrpc.proto",
+}
+
+func (m *Account) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
 
 func (m *Account) MarshalTo(dAtA []byte) (int, error) {
@@ -2888,6 +3657,32 @@ func (m *LedgerRecord) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i++
 	}
+	if m.Hold {
+		dAtA[i] = 0x90
+		i++
+		dAtA[i] = 0x1
+		i++
+		if m.Hold {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.CancelHeight != 0 {
+		dAtA[i] = 0x98
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintTdrpc(dAtA, i, uint64(m.CancelHeight))
+	}
+	if m.SatPerByte != 0 {
+		dAtA[i] = 0xa0
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintTdrpc(dAtA, i, uint64(m.SatPerByte))
+	}
 	return i, nil
 }
 
@@ -3100,6 +3895,76 @@ func (m *CreateRequest) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintTdrpc(dAtA, i, uint64(m.Expires))
 	}
+	if len(m.IdempotencyKey) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintTdrpc(dAtA, i, uint64(len(m.IdempotencyKey)))
+		i += copy(dAtA[i:], m.IdempotencyKey)
+	}
+	return i, nil
+}
+
+func (m *CreateHoldRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateHoldRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTdrpc(dAtA, i, uint64(len(m.Hash)))
+		i += copy(dAtA[i:], m.Hash)
+	}
+	if len(m.Memo) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTdrpc(dAtA, i, uint64(len(m.Memo)))
+		i += copy(dAtA[i:], m.Memo)
+	}
+	if m.Value != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintTdrpc(dAtA, i, uint64(m.Value))
+	}
+	if m.Expires != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintTdrpc(dAtA, i, uint64(m.Expires))
+	}
+	return i, nil
+}
+
+func (m *SettleHoldRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SettleHoldRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Preimage) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTdrpc(dAtA, i, uint64(len(m.Preimage)))
+		i += copy(dAtA[i:], m.Preimage)
+	}
 	return i, nil
 }
 
@@ -3124,6 +3989,12 @@ func (m *CreateResponse) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintTdrpc(dAtA, i, uint64(len(m.Request)))
 		i += copy(dAtA[i:], m.Request)
 	}
+	if len(m.Warning) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTdrpc(dAtA, i, uint64(len(m.Warning)))
+		i += copy(dAtA[i:], m.Warning)
+	}
 	return i, nil
 }
 
@@ -3169,6 +4040,12 @@ func (m *PayRequest) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintTdrpc(dAtA, i, uint64(len(m.PreAuthId)))
 		i += copy(dAtA[i:], m.PreAuthId)
 	}
+	if len(m.IdempotencyKey) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintTdrpc(dAtA, i, uint64(len(m.IdempotencyKey)))
+		i += copy(dAtA[i:], m.IdempotencyKey)
+	}
 	return i, nil
 }
 
@@ -3331,6 +4208,12 @@ func (m *WithdrawRequest) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i++
 	}
+	if len(m.IdempotencyKey) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintTdrpc(dAtA, i, uint64(len(m.IdempotencyKey)))
+		i += copy(dAtA[i:], m.IdempotencyKey)
+	}
 	return i, nil
 }
 
@@ -3414,25 +4297,108 @@ func (m *Id) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func encodeVarintTdrpc(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *CreateTokenRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	return dAtA[:n], nil
 }
-func (m *Account) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *CreateTokenRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovTdrpc(uint64(l))
-	}
+	if len(m.Rpcs) > 0 {
+		for _, s := range m.Rpcs {
+			dAtA[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.MaxPay != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintTdrpc(dAtA, i, uint64(m.MaxPay))
+	}
+	if m.Expires != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintTdrpc(dAtA, i, uint64(m.Expires))
+	}
+	return i, nil
+}
+
+func (m *CreateTokenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateTokenResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTdrpc(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if len(m.Token) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTdrpc(dAtA, i, uint64(len(m.Token)))
+		i += copy(dAtA[i:], m.Token)
+	}
+	if m.ExpiresAt != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintTdrpc(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt)))
+		n9, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiresAt, dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n9
+	}
+	return i, nil
+}
+
+func encodeVarintTdrpc(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *Account) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTdrpc(uint64(l))
+	}
 	if m.CreatedAt != nil {
 		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt)
 		n += 1 + l + sovTdrpc(uint64(l))
@@ -3525,6 +4491,15 @@ func (m *LedgerRecord) Size() (n int) {
 	if m.Hidden {
 		n += 3
 	}
+	if m.Hold {
+		n += 3
+	}
+	if m.CancelHeight != 0 {
+		n += 2 + sovTdrpc(uint64(m.CancelHeight))
+	}
+	if m.SatPerByte != 0 {
+		n += 2 + sovTdrpc(uint64(m.SatPerByte))
+	}
 	return n
 }
 
@@ -3644,6 +4619,46 @@ func (m *CreateRequest) Size() (n int) {
 	if m.Expires != 0 {
 		n += 1 + sovTdrpc(uint64(m.Expires))
 	}
+	l = len(m.IdempotencyKey)
+	if l > 0 {
+		n += 1 + l + sovTdrpc(uint64(l))
+	}
+	return n
+}
+
+func (m *CreateHoldRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTdrpc(uint64(l))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovTdrpc(uint64(l))
+	}
+	if m.Value != 0 {
+		n += 1 + sovTdrpc(uint64(m.Value))
+	}
+	if m.Expires != 0 {
+		n += 1 + sovTdrpc(uint64(m.Expires))
+	}
+	return n
+}
+
+func (m *SettleHoldRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Preimage)
+	if l > 0 {
+		n += 1 + l + sovTdrpc(uint64(l))
+	}
 	return n
 }
 
@@ -3657,6 +4672,10 @@ func (m *CreateResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTdrpc(uint64(l))
 	}
+	l = len(m.Warning)
+	if l > 0 {
+		n += 1 + l + sovTdrpc(uint64(l))
+	}
 	return n
 }
 
@@ -3680,6 +4699,10 @@ func (m *PayRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTdrpc(uint64(l))
 	}
+	l = len(m.IdempotencyKey)
+	if l > 0 {
+		n += 1 + l + sovTdrpc(uint64(l))
+	}
 	return n
 }
 
@@ -3760,6 +4783,10 @@ func (m *WithdrawRequest) Size() (n int) {
 	if m.Estimate {
 		n += 2
 	}
+	l = len(m.IdempotencyKey)
+	if l > 0 {
+		n += 1 + l + sovTdrpc(uint64(l))
+	}
 	return n
 }
 
@@ -3801,6 +4828,48 @@ func (m *Id) Size() (n int) {
 	return n
 }
 
+func (m *CreateTokenRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rpcs) > 0 {
+		for _, s := range m.Rpcs {
+			l = len(s)
+			n += 1 + l + sovTdrpc(uint64(l))
+		}
+	}
+	if m.MaxPay != 0 {
+		n += 1 + sovTdrpc(uint64(m.MaxPay))
+	}
+	if m.Expires != 0 {
+		n += 1 + sovTdrpc(uint64(m.Expires))
+	}
+	return n
+}
+
+func (m *CreateTokenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTdrpc(uint64(l))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovTdrpc(uint64(l))
+	}
+	if m.ExpiresAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt)
+		n += 1 + l + sovTdrpc(uint64(l))
+	}
+	return n
+}
+
 func sovTdrpc(x uint64) (n int) {
 	for {
 		n++
@@ -3853,6 +4922,9 @@ func (this *LedgerRecord) String() string {
 		`Request:` + fmt.Sprintf("%v", this.Request) + `,`,
 		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
 		`Hidden:` + fmt.Sprintf("%v", this.Hidden) + `,`,
+		`Hold:` + fmt.Sprintf("%v", this.Hold) + `,`,
+		`CancelHeight:` + fmt.Sprintf("%v", this.CancelHeight) + `,`,
+		`SatPerByte:` + fmt.Sprintf("%v", this.SatPerByte) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3918,6 +4990,30 @@ func (this *CreateRequest) String() string {
 		`Memo:` + fmt.Sprintf("%v", this.Memo) + `,`,
 		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
 		`Expires:` + fmt.Sprintf("%v", this.Expires) + `,`,
+		`IdempotencyKey:` + fmt.Sprintf("%v", this.IdempotencyKey) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CreateHoldRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CreateHoldRequest{`,
+		`Hash:` + fmt.Sprintf("%v", this.Hash) + `,`,
+		`Memo:` + fmt.Sprintf("%v", this.Memo) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`Expires:` + fmt.Sprintf("%v", this.Expires) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SettleHoldRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SettleHoldRequest{`,
+		`Preimage:` + fmt.Sprintf("%v", this.Preimage) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3928,6 +5024,7 @@ func (this *CreateResponse) String() string {
 	}
 	s := strings.Join([]string{`&CreateResponse{`,
 		`Request:` + fmt.Sprintf("%v", this.Request) + `,`,
+		`Warning:` + fmt.Sprintf("%v", this.Warning) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3941,6 +5038,7 @@ func (this *PayRequest) String() string {
 		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
 		`Estimate:` + fmt.Sprintf("%v", this.Estimate) + `,`,
 		`PreAuthId:` + fmt.Sprintf("%v", this.PreAuthId) + `,`,
+		`IdempotencyKey:` + fmt.Sprintf("%v", this.IdempotencyKey) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3998,6 +5096,7 @@ func (this *WithdrawRequest) String() string {
 		`Blocks:` + fmt.Sprintf("%v", this.Blocks) + `,`,
 		`SatPerByte:` + fmt.Sprintf("%v", this.SatPerByte) + `,`,
 		`Estimate:` + fmt.Sprintf("%v", this.Estimate) + `,`,
+		`IdempotencyKey:` + fmt.Sprintf("%v", this.IdempotencyKey) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4032,6 +5131,30 @@ func (this *Id) String() string {
 	}, "")
 	return s
 }
+func (this *CreateTokenRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CreateTokenRequest{`,
+		`Rpcs:` + fmt.Sprintf("%v", this.Rpcs) + `,`,
+		`MaxPay:` + fmt.Sprintf("%v", this.MaxPay) + `,`,
+		`Expires:` + fmt.Sprintf("%v", this.Expires) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CreateTokenResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CreateTokenResponse{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`Token:` + fmt.Sprintf("%v", this.Token) + `,`,
+		`ExpiresAt:` + strings.Replace(fmt.Sprintf("%v", this.ExpiresAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringTdrpc(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -4776,6 +5899,64 @@ func (m *LedgerRecord) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Hidden = bool(v != 0)
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hold", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTdrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Hold = bool(v != 0)
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelHeight", wireType)
+			}
+			m.CancelHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTdrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CancelHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SatPerByte", wireType)
+			}
+			m.SatPerByte = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTdrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SatPerByte |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTdrpc(dAtA[iNdEx:])
@@ -5555,62 +6736,9 @@ func (m *CreateRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTdrpc(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthTdrpc
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthTdrpc
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CreateResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTdrpc
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CreateResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdempotencyKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5638,7 +6766,7 @@ func (m *CreateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Request = string(dAtA[iNdEx:postIndex])
+			m.IdempotencyKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5664,7 +6792,7 @@ func (m *CreateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PayRequest) Unmarshal(dAtA []byte) error {
+func (m *CreateHoldRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5687,15 +6815,15 @@ func (m *PayRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PayRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateHoldRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PayRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateHoldRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5723,13 +6851,13 @@ func (m *PayRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Request = string(dAtA[iNdEx:postIndex])
+			m.Hash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
 			}
-			m.Value = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTdrpc
@@ -5739,16 +6867,29 @@ func (m *PayRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Value |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTdrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTdrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Estimate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var v int
+			m.Value = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTdrpc
@@ -5758,17 +6899,16 @@ func (m *PayRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Value |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Estimate = bool(v != 0)
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreAuthId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expires", wireType)
 			}
-			var stringLen uint64
+			m.Expires = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTdrpc
@@ -5778,24 +6918,11 @@ func (m *PayRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Expires |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTdrpc
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTdrpc
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PreAuthId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTdrpc(dAtA[iNdEx:])
@@ -5820,7 +6947,7 @@ func (m *PayRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LedgerRecordResponse) Unmarshal(dAtA []byte) error {
+func (m *SettleHoldRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5843,17 +6970,17 @@ func (m *LedgerRecordResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LedgerRecordResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SettleHoldRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LedgerRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SettleHoldRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Preimage", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTdrpc
@@ -5863,27 +6990,23 @@ func (m *LedgerRecordResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTdrpc
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTdrpc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Result == nil {
-				m.Result = &LedgerRecord{}
-			}
-			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Preimage = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5909,7 +7032,7 @@ func (m *LedgerRecordResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LedgerRequest) Unmarshal(dAtA []byte) error {
+func (m *CreateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5932,17 +7055,17 @@ func (m *LedgerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LedgerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LedgerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTdrpc
@@ -5952,124 +7075,29 @@ func (m *LedgerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTdrpc
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTdrpc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Filter == nil {
-				m.Filter = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTdrpc
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTdrpc
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthTdrpc
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthTdrpc
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTdrpc
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthTdrpc
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthTdrpc
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipTdrpc(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthTdrpc
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Filter[mapkey] = mapvalue
+			m.Request = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field After", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Warning", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTdrpc
@@ -6079,35 +7107,615 @@ func (m *LedgerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTdrpc
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTdrpc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.After == nil {
-				m.After = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.After, dAtA[iNdEx:postIndex]); err != nil {
+			m.Warning = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTdrpc(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			if skippy < 0 {
+				return ErrInvalidLengthTdrpc
 			}
-			m.Offset = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTdrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PayRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTdrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PayRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PayRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTdrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTdrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTdrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Request = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTdrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Value |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Estimate", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTdrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Estimate = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreAuthId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTdrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTdrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTdrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreAuthId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdempotencyKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTdrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTdrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTdrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IdempotencyKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTdrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTdrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTdrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LedgerRecordResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTdrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LedgerRecordResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LedgerRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTdrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTdrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTdrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Result == nil {
+				m.Result = &LedgerRecord{}
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTdrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTdrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTdrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LedgerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTdrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LedgerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LedgerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTdrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTdrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTdrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Filter == nil {
+				m.Filter = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTdrpc
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTdrpc
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthTdrpc
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthTdrpc
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTdrpc
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthTdrpc
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthTdrpc
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTdrpc(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthTdrpc
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Filter[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field After", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTdrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTdrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTdrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.After == nil {
+				m.After = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.After, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTdrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTdrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTdrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTdrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTdrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LedgerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTdrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LedgerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LedgerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ledger", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
 					return ErrIntOverflowTdrpc
 				}
 				if iNdEx >= l {
@@ -6115,16 +7723,154 @@ func (m *LedgerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Offset |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTdrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTdrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ledger = append(m.Ledger, &LedgerRecord{})
+			if err := m.Ledger[len(m.Ledger)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTdrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTdrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTdrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WithdrawRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTdrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WithdrawRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WithdrawRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTdrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTdrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTdrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTdrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Value |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			m.Blocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTdrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blocks |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SatPerByte", wireType)
 			}
-			m.Limit = 0
+			m.SatPerByte = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTdrpc
@@ -6134,11 +7880,63 @@ func (m *LedgerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Limit |= int32(b&0x7F) << shift
+				m.SatPerByte |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Estimate", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTdrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Estimate = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdempotencyKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTdrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTdrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTdrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IdempotencyKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTdrpc(dAtA[iNdEx:])
@@ -6163,7 +7961,7 @@ func (m *LedgerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LedgerResponse) Unmarshal(dAtA []byte) error {
+func (m *WithdrawResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6186,15 +7984,15 @@ func (m *LedgerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LedgerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: WithdrawResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LedgerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WithdrawResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ledger", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6221,8 +8019,10 @@ func (m *LedgerResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ledger = append(m.Ledger, &LedgerRecord{})
-			if err := m.Ledger[len(m.Ledger)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Result == nil {
+				m.Result = &LedgerRecord{}
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6250,7 +8050,7 @@ func (m *LedgerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WithdrawRequest) Unmarshal(dAtA []byte) error {
+func (m *CreateGeneratedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6273,104 +8073,15 @@ func (m *WithdrawRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WithdrawRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateGeneratedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WithdrawRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateGeneratedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTdrpc
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTdrpc
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTdrpc
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
-			}
-			m.Value = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTdrpc
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Value |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
-			}
-			m.Blocks = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTdrpc
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Blocks |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SatPerByte", wireType)
-			}
-			m.SatPerByte = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTdrpc
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SatPerByte |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Estimate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowLocked", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -6387,7 +8098,7 @@ func (m *WithdrawRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Estimate = bool(v != 0)
+			m.AllowLocked = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTdrpc(dAtA[iNdEx:])
@@ -6412,7 +8123,7 @@ func (m *WithdrawRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WithdrawResponse) Unmarshal(dAtA []byte) error {
+func (m *Id) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6435,17 +8146,17 @@ func (m *WithdrawResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WithdrawResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: Id: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WithdrawResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Id: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTdrpc
@@ -6455,27 +8166,23 @@ func (m *WithdrawResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTdrpc
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTdrpc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Result == nil {
-				m.Result = &LedgerRecord{}
-			}
-			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6501,7 +8208,7 @@ func (m *WithdrawResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateGeneratedRequest) Unmarshal(dAtA []byte) error {
+func (m *CreateTokenRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6524,17 +8231,49 @@ func (m *CreateGeneratedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateGeneratedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateTokenRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateGeneratedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateTokenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rpcs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTdrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTdrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTdrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rpcs = append(m.Rpcs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowLocked", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPay", wireType)
 			}
-			var v int
+			m.MaxPay = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTdrpc
@@ -6544,12 +8283,30 @@ func (m *CreateGeneratedRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.MaxPay |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expires", wireType)
+			}
+			m.Expires = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTdrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Expires |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.AllowLocked = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTdrpc(dAtA[iNdEx:])
@@ -6574,7 +8331,7 @@ func (m *CreateGeneratedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Id) Unmarshal(dAtA []byte) error {
+func (m *CreateTokenResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6597,10 +8354,10 @@ func (m *Id) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Id: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateTokenResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Id: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6635,6 +8392,74 @@ func (m *Id) Unmarshal(dAtA []byte) error {
 			}
 			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTdrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTdrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTdrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTdrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTdrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTdrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiresAt == nil {
+				m.ExpiresAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTdrpc(dAtA[iNdEx:])
@@ -21,7 +21,7 @@ func TestGetAccount(t *testing.T) {
 	mockDCache := new(mocks.DistCache)
 
 	// RPC Server
-	s, err := NewTDRPCServer(mockStore, mockLClient, mockDCache)
+	s, err := NewTDRPCServer(mockStore, mockLClient, nil, mockDCache)
 	assert.Nil(t, err)
 
 	// Create a sample account and put it into the context for the call
@@ -13,16 +13,19 @@ import (
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
+	"git.coinninja.net/backend/thunderdome/metrics"
 	"git.coinninja.net/backend/thunderdome/store"
 	"git.coinninja.net/backend/thunderdome/tdrpc"
 )
 
 const (
-	AccountTypePubKey = "pubkey"
+	AccountTypePubKey      = "pubkey"
+	AccountTypeXOnlyPubKey = "xonlypubkey"
 )
 
 var (
-	pubkeyRegexp = regexp.MustCompile("^[a-f0-9]{66}$")
+	pubkeyRegexp      = regexp.MustCompile("^[a-f0-9]{66}$")
+	xonlyPubkeyRegexp = regexp.MustCompile("^[a-f0-9]{64}$")
 )
 
 // AuthFuncOverride will handle authentication
@@ -44,6 +47,16 @@ func (s *tdRPCServer) AuthFuncOverride(ctx context.Context, fullMethodName strin
 		return ctx, tdrpc.ErrInvalidLogin
 	}
 
+	// A bearer token can be used instead of signing each request
+	if encodedToken := bearerToken(md); encodedToken != "" {
+		token, err := s.authToken(fullMethodName, encodedToken)
+		if err != nil {
+			return ctx, err
+		}
+		// Add the token to the context
+		return s.authAccount(addToken(ctx, token), fullMethodName, token.AccountId)
+	}
+
 	// Get the user pubKeyString
 	pubKeyString := mdfirst(md, tdrpc.MetadataAuthPubKeyString)
 	if pubKeyString == "" {
@@ -54,6 +67,13 @@ func (s *tdRPCServer) AuthFuncOverride(ctx context.Context, fullMethodName strin
 	ts := mdfirst(md, tdrpc.MetadataAuthTimestamp)
 	sig := mdfirst(md, tdrpc.MetadataAuthSignature)
 	nonce := mdfirst(md, tdrpc.MetadataAuthNonce)
+	sigType := mdfirst(md, tdrpc.MetadataAuthSigType)
+
+	// The accountID will account for different methods of logging in
+	accountID, err := accountIDFromPubKey(pubKeyString)
+	if err != nil {
+		return nil, err
+	}
 
 	// This handles authentication, there are several cases, break from the for loop when Authenticated
 	for {
@@ -62,30 +82,42 @@ func (s *tdRPCServer) AuthFuncOverride(ctx context.Context, fullMethodName strin
 			break // Authenticated
 		}
 
-		// If this is an agent and it's going to an allowed endpoint
-		if allowAgent(fullMethodName, sig) {
-			// Add the agent flag to the context
-			ctx = context.WithValue(ctx, contextKey(contextKeyAgent), true)
+		// If this is an agent key, it must be allowed to call this endpoint for this account
+		if strings.HasPrefix(sig, tdrpc.AgentKeyPrefix) {
+			agentKey, err := s.authAgentKey(ctx, fullMethodName, accountID, sig)
+			if err != nil {
+				return ctx, err
+			}
+			// Add the agent key to the context
+			ctx = addAgentKey(ctx, agentKey)
 			break // Authenticated
 		}
 
+		// If nonces are mandatory, reject any signed request without one
+		if nonce == "" && config.GetBool("tdome.require_nonce") {
+			return ctx, tdrpc.ErrNonceRequired
+		}
+
 		// Otherwise require a valid signature
-		err := ValidateTimestampAndNonceSigntature(ts, nonce, pubKeyString, sig, time.Now())
+		err := ValidateTimestampAndNonceSigntature(sigType, ts, nonce, pubKeyString, sig, time.Now(), config.GetDuration("tdome.auth_time_window"))
 		if err != nil {
 			return ctx, err
 		}
 
-		break //nolint - We are authenticated
-	}
+		// If this is a delegate signing on behalf of an account holder, the delegation determines the account
+		if encodedDelegation := mdfirst(md, tdrpc.MetadataAuthDelegation); encodedDelegation != "" {
+			delegation, err := s.authDelegation(fullMethodName, pubKeyString, encodedDelegation, mdfirst(md, tdrpc.MetadataAuthDelegationSignature))
+			if err != nil {
+				return ctx, err
+			}
+			if accountID, err = accountIDFromPubKey(delegation.Account); err != nil {
+				return ctx, tdrpc.ErrInvalidDelegation
+			}
+			// Add the delegation to the context
+			ctx = addDelegation(ctx, delegation)
+		}
 
-	// The accountID will account for different methods of logging in, right now we support public key
-	var accountID string
-	if pubkeyRegexp.MatchString(pubKeyString) {
-		// The account ID is prefix:value
-		accountID = AccountTypePubKey + ":" + pubKeyString
-		// PERFORM AUTH
-	} else {
-		return nil, tdrpc.ErrInvalidLogin
+		break //nolint - We are authenticated
 	}
 
 	// Check the nonce and see if it's been used already
@@ -98,16 +130,25 @@ func (s *tdRPCServer) AuthFuncOverride(ctx context.Context, fullMethodName strin
 
 		// This Nonce has been used already
 		if exists {
-			return ctx, tdrpc.ErrInvalidLogin
+			s.logger.Warnw("Nonce Replay", "account_id", accountID, "nonce", nonce)
+			metrics.Incr("auth.nonce_replay", nil)
+			return ctx, tdrpc.ErrNonceReplay
 		}
 
-		// Create the nonce
-		err = s.cache.Set("nonce", accountID+":"+nonce, 1, 20*time.Minute)
+		// Create the nonce, the timestamp can be +/- the window so it must be remembered for twice as long
+		err = s.cache.Set("nonce", accountID+":"+nonce, 1, 2*config.GetDuration("tdome.auth_time_window"))
 		if err != nil {
 			s.logger.Errorw("DistCache Set Error", "error", err, "nonce", accountID+":"+nonce)
 		}
 	}
 
+	return s.authAccount(ctx, fullMethodName, accountID)
+
+}
+
+// authAccount fetches the authenticated account, creating it if needed, and includes it in the context
+func (s *tdRPCServer) authAccount(ctx context.Context, fullMethodName string, accountID string) (context.Context, error) {
+
 	// See if we have an account already?
 	account, err := s.store.GetAccountByID(ctx, accountID)
 	if err == store.ErrNotFound {
@@ -149,6 +190,17 @@ func (s *tdRPCServer) AuthFuncOverride(ctx context.Context, fullMethodName strin
 
 }
 
+// accountIDFromPubKey returns the accountID for a public key, right now we support public key and x-only public key
+func accountIDFromPubKey(pubKeyString string) (string, error) {
+	if pubkeyRegexp.MatchString(pubKeyString) {
+		// The account ID is prefix:value
+		return AccountTypePubKey + ":" + pubKeyString, nil
+	} else if xonlyPubkeyRegexp.MatchString(pubKeyString) {
+		return AccountTypeXOnlyPubKey + ":" + pubKeyString, nil
+	}
+	return "", tdrpc.ErrInvalidLogin
+}
+
 func mdfirst(md metadata.MD, key string) string {
 	val := md.Get(strings.ToLower(key))
 	if len(val) > 0 {
@@ -157,22 +209,27 @@ func mdfirst(md metadata.MD, key string) string {
 	return ""
 }
 
-func allowAgent(endpoint string, sig string) bool {
+// authAgentKey validates an agent key secret and ensures it's allowed to call endpoint on behalf of accountID
+func (s *tdRPCServer) authAgentKey(ctx context.Context, endpoint string, accountID string, secret string) (*tdrpc.AgentKey, error) {
 
-	// We allow the following endpoints from the agent
-	switch endpoint {
-	case tdrpc.CreateGeneratedEndpoint:
-	case tdrpc.PayEndpoint:
-	case tdrpc.CreatePreAuthEndpoint:
-	case tdrpc.GetPreAuthEndpoint:
-	default:
-		return false
+	agentKey, err := s.store.GetAgentKeyBySecretHash(ctx, tdrpc.HashAgentKeySecret(secret))
+	if err == store.ErrNotFound {
+		return nil, tdrpc.ErrInvalidLogin
+	} else if err != nil {
+		s.logger.Errorw("GetAgentKeyBySecretHash Error", "error", err)
+		return nil, status.Errorf(codes.Internal, "GetAgentKeyBySecretHash internal error")
+	}
+
+	// Revoked or expired
+	if !agentKey.Active(time.Now().UTC()) {
+		return nil, tdrpc.ErrInvalidLogin
 	}
 
-	// The signature must match the agent_secret
-	if sig != "" && sig == config.GetString("tdome.agent_secret") {
-		return true
+	// Must have the scope and be allowed to use the account
+	if !agentKey.AllowsEndpoint(endpoint) || !agentKey.AllowsAccount(accountID) {
+		s.logger.Warnw("Agent Key Denied", "agent_key_id", agentKey.Id, "endpoint", endpoint, "account_id", accountID)
+		return nil, tdrpc.ErrPermissionDenied
 	}
 
-	return false
+	return agentKey, nil
 }
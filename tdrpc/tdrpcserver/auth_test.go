@@ -8,10 +8,12 @@ import (
 
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/lightningnetwork/lnd/lnrpc"
+	config "github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"google.golang.org/grpc/metadata"
 
+	_ "git.coinninja.net/backend/thunderdome/conf"
 	"git.coinninja.net/backend/thunderdome/mocks"
 	"git.coinninja.net/backend/thunderdome/store"
 	"git.coinninja.net/backend/thunderdome/tdrpc"
@@ -26,7 +28,7 @@ func TestAuthFuncOverride(t *testing.T) {
 	mockDCache := new(mocks.DistCache)
 
 	// RPC Server
-	s, err := newTDRPCServer(mockStore, mockLClient, mockDCache)
+	s, err := newTDRPCServer(mockStore, mockLClient, nil, mockDCache)
 	assert.Nil(t, err)
 
 	// Bad Value
@@ -98,3 +100,130 @@ func TestAuthFuncOverride(t *testing.T) {
 	mockLClient.AssertExpectations(t)
 
 }
+
+func TestAuthFuncOverrideNonce(t *testing.T) {
+
+	// Mocks
+	mockStore := new(mocks.Store)
+	mockLClient := new(mocks.LightningClient)
+	mockLClient.On("GetInfo", mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("*lnrpc.GetInfoRequest")).Once().Return(&lnrpc.GetInfoResponse{IdentityPubkey: "testing"}, nil)
+	mockDCache := new(mocks.DistCache)
+
+	// RPC Server
+	s, err := newTDRPCServer(mockStore, mockLClient, nil, mockDCache)
+	assert.Nil(t, err)
+
+	config.Set("tdome.require_nonce", true)
+	defer config.Set("tdome.require_nonce", false)
+
+	key, err := NewKey()
+	assert.Nil(t, err)
+	pubKey := HexEncodedPublicKey(key)
+	accountID := AccountTypePubKey + ":" + pubKey
+	timeString := time.Now().UTC().Format(time.RFC3339)
+
+	// Missing nonce should be rejected
+	sig, err := key.Sign(chainhash.DoubleHashB([]byte(timeString)))
+	assert.Nil(t, err)
+	_, err = s.AuthFuncOverride(metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		tdrpc.MetadataAuthPubKeyString, pubKey,
+		tdrpc.MetadataAuthSignature, hex.EncodeToString(sig.Serialize()),
+		tdrpc.MetadataAuthTimestamp, timeString,
+	)), "test")
+	assert.Equal(t, tdrpc.ErrNonceRequired, err)
+
+	// Valid nonce is stored for twice the auth window
+	nonce := "SomeRandomString"
+	sig, err = key.Sign(chainhash.DoubleHashB([]byte(timeString + nonce)))
+	assert.Nil(t, err)
+	md := metadata.Pairs(
+		tdrpc.MetadataAuthPubKeyString, pubKey,
+		tdrpc.MetadataAuthSignature, hex.EncodeToString(sig.Serialize()),
+		tdrpc.MetadataAuthTimestamp, timeString,
+		tdrpc.MetadataAuthNonce, nonce,
+	)
+	account := &tdrpc.Account{Id: accountID}
+	mockDCache.On("Exists", "nonce", accountID+":"+nonce).Once().Return(false, nil)
+	mockDCache.On("Set", "nonce", accountID+":"+nonce, 1, 2*config.GetDuration("tdome.auth_time_window")).Once().Return(nil)
+	mockStore.On("GetAccountByID", mock.AnythingOfType("*context.valueCtx"), accountID).Once().Return(account, nil)
+	ctx, err := s.AuthFuncOverride(metadata.NewIncomingContext(context.Background(), md), "test")
+	assert.Nil(t, err)
+	assert.Equal(t, account, getAccount(ctx))
+
+	// Replaying the nonce should be rejected
+	mockDCache.On("Exists", "nonce", accountID+":"+nonce).Once().Return(true, nil)
+	_, err = s.AuthFuncOverride(metadata.NewIncomingContext(context.Background(), md), "test")
+	assert.Equal(t, tdrpc.ErrNonceReplay, err)
+
+	mockStore.AssertExpectations(t)
+	mockLClient.AssertExpectations(t)
+	mockDCache.AssertExpectations(t)
+
+}
+
+func TestAuthFuncOverrideAgentKey(t *testing.T) {
+
+	// Mocks
+	mockStore := new(mocks.Store)
+	mockLClient := new(mocks.LightningClient)
+	mockLClient.On("GetInfo", mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("*lnrpc.GetInfoRequest")).Once().Return(&lnrpc.GetInfoResponse{IdentityPubkey: "testing"}, nil)
+	mockDCache := new(mocks.DistCache)
+
+	// RPC Server
+	s, err := newTDRPCServer(mockStore, mockLClient, nil, mockDCache)
+	assert.Nil(t, err)
+
+	key, err := NewKey()
+	assert.Nil(t, err)
+	pubKey := HexEncodedPublicKey(key)
+	accountID := AccountTypePubKey + ":" + pubKey
+	account := &tdrpc.Account{Id: accountID}
+
+	secret := tdrpc.AgentKeyPrefix + "secret"
+	agentKey := &tdrpc.AgentKey{
+		Id:         "agent1",
+		Scopes:     []string{"Pay"},
+		AccountIds: []string{AccountTypePubKey + ":*"},
+		ValueLimit: 1000,
+	}
+	md := metadata.Pairs(
+		tdrpc.MetadataAuthPubKeyString, pubKey,
+		tdrpc.MetadataAuthSignature, secret,
+	)
+
+	// Unknown key
+	mockStore.On("GetAgentKeyBySecretHash", mock.AnythingOfType("*context.valueCtx"), tdrpc.HashAgentKeySecret(secret)).Once().Return(nil, store.ErrNotFound)
+	_, err = s.AuthFuncOverride(metadata.NewIncomingContext(context.Background(), md), tdrpc.PayEndpoint)
+	assert.Equal(t, tdrpc.ErrInvalidLogin, err)
+
+	// Valid key and scope
+	mockStore.On("GetAgentKeyBySecretHash", mock.AnythingOfType("*context.valueCtx"), tdrpc.HashAgentKeySecret(secret)).Once().Return(agentKey, nil)
+	mockStore.On("GetAccountByID", mock.AnythingOfType("*context.valueCtx"), accountID).Once().Return(account, nil)
+	ctx, err := s.AuthFuncOverride(metadata.NewIncomingContext(context.Background(), md), tdrpc.PayEndpoint)
+	assert.Nil(t, err)
+	assert.Equal(t, agentKey, getAgentKey(ctx))
+	assert.Equal(t, account, getAccount(ctx))
+
+	// Missing scope
+	mockStore.On("GetAgentKeyBySecretHash", mock.AnythingOfType("*context.valueCtx"), tdrpc.HashAgentKeySecret(secret)).Once().Return(agentKey, nil)
+	_, err = s.AuthFuncOverride(metadata.NewIncomingContext(context.Background(), md), tdrpc.CreatePreAuthEndpoint)
+	assert.Equal(t, tdrpc.ErrPermissionDenied, err)
+
+	// Account not allowed
+	agentKey.AccountIds = []string{AccountTypeXOnlyPubKey + ":*"}
+	mockStore.On("GetAgentKeyBySecretHash", mock.AnythingOfType("*context.valueCtx"), tdrpc.HashAgentKeySecret(secret)).Once().Return(agentKey, nil)
+	_, err = s.AuthFuncOverride(metadata.NewIncomingContext(context.Background(), md), tdrpc.PayEndpoint)
+	assert.Equal(t, tdrpc.ErrPermissionDenied, err)
+
+	// Revoked
+	agentKey.AccountIds = []string{accountID}
+	revokedAt := time.Now().UTC()
+	agentKey.RevokedAt = &revokedAt
+	mockStore.On("GetAgentKeyBySecretHash", mock.AnythingOfType("*context.valueCtx"), tdrpc.HashAgentKeySecret(secret)).Once().Return(agentKey, nil)
+	_, err = s.AuthFuncOverride(metadata.NewIncomingContext(context.Background(), md), tdrpc.PayEndpoint)
+	assert.Equal(t, tdrpc.ErrInvalidLogin, err)
+
+	mockStore.AssertExpectations(t)
+	mockLClient.AssertExpectations(t)
+
+}
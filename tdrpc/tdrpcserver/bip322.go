@@ -0,0 +1,212 @@
+package tdrpcserver
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"math/big"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+
+	"git.coinninja.net/backend/thunderdome/tdrpc"
+)
+
+// Tags used for BIP322 and taproot tagged hashes
+const (
+	bip322MessageTag = "BIP0322-signed-message"
+	tapTweakTag      = "TapTweak"
+	tapSighashTag    = "TapSighash"
+)
+
+// The signature hash types allowed in a taproot key path signature
+const (
+	sigHashDefault = 0x00
+	sigHashAll     = 0x01
+)
+
+// ValidateBIP322Signature validates a BIP322 simple signature of a message by the taproot (BIP86) address of an x-only public key
+// The signature is the base64 encoded witness stack of the virtual to_sign transaction
+func ValidateBIP322Signature(message []byte, pubKeyHexString string, sigBase64String string) error {
+
+	pubKey, err := ParseXOnlyPubKeyHexString(pubKeyHexString)
+	if err != nil {
+		return tdrpc.ErrInvalidPubKey
+	}
+
+	witness, err := parseBIP322Witness(sigBase64String)
+	if err != nil {
+		return tdrpc.ErrInvalidSig
+	}
+
+	// A key path spend has only the signature, 64 bytes for the default hash type or 65 with it appended
+	if len(witness) != 1 {
+		return tdrpc.ErrInvalidSig
+	}
+	sig := witness[0]
+	hashType := byte(sigHashDefault)
+	if len(sig) == 65 && sig[64] == sigHashAll {
+		hashType = sigHashAll
+		sig = sig[:64]
+	} else if len(sig) != 64 {
+		return tdrpc.ErrInvalidSig
+	}
+
+	outputKey, err := taprootOutputKey(pubKey)
+	if err != nil {
+		return tdrpc.ErrInvalidPubKey
+	}
+
+	toSpend := bip322ToSpend(taprootPkScript(outputKey), message)
+	toSign := bip322ToSign(toSpend, witness)
+
+	if !VerifySchnorr(taprootSigHash(toSign, toSpend.TxOut[0], hashType), outputKey, sig) {
+		return tdrpc.ErrSigVerficationFailed
+	}
+
+	return nil
+
+}
+
+// parseBIP322Witness decodes a base64 encoded serialized witness stack
+func parseBIP322Witness(str string) (wire.TxWitness, error) {
+
+	if str == "" {
+		return nil, tdrpc.ErrInvalidSig
+	}
+
+	data, err := base64.StdEncoding.DecodeString(str)
+	if err != nil {
+		return nil, err
+	}
+
+	r := bytes.NewReader(data)
+	count, err := wire.ReadVarInt(r, 0)
+	if err != nil {
+		return nil, err
+	}
+	// Every item takes at least a byte
+	if count > uint64(len(data)) {
+		return nil, tdrpc.ErrInvalidSig
+	}
+
+	witness := make(wire.TxWitness, count)
+	for i := range witness {
+		witness[i], err = wire.ReadVarBytes(r, 0, uint32(len(data)), "witness")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Nothing can follow the witness stack
+	if r.Len() != 0 {
+		return nil, tdrpc.ErrInvalidSig
+	}
+
+	return witness, nil
+
+}
+
+// bip322ToSpend returns the virtual to_spend transaction committing to the message and the address being signed for
+func bip322ToSpend(pkScript []byte, message []byte) *wire.MsgTx {
+
+	scriptSig := append([]byte{txscript.OP_0, txscript.OP_DATA_32}, TaggedHash(bip322MessageTag, message)...)
+
+	tx := wire.NewMsgTx(0)
+	tx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{Index: 0xffffffff},
+		SignatureScript:  scriptSig,
+		Sequence:         0,
+	})
+	tx.AddTxOut(wire.NewTxOut(0, pkScript))
+
+	return tx
+
+}
+
+// bip322ToSign returns the virtual to_sign transaction spending to_spend with the witness
+func bip322ToSign(toSpend *wire.MsgTx, witness wire.TxWitness) *wire.MsgTx {
+
+	tx := wire.NewMsgTx(0)
+	tx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{Hash: toSpend.TxHash(), Index: 0},
+		Witness:          witness,
+		Sequence:         0,
+	})
+	tx.AddTxOut(wire.NewTxOut(0, []byte{txscript.OP_RETURN}))
+
+	return tx
+
+}
+
+// taprootOutputKey returns the BIP86 output key of an internal key without a script tree, Q = P + int(hashTapTweak(bytes(P)))G
+func taprootOutputKey(internalKey *btcec.PublicKey) (*btcec.PublicKey, error) {
+
+	curve := btcec.S256()
+
+	P, err := liftX(internalKey.X)
+	if err != nil {
+		return nil, err
+	}
+
+	t := new(big.Int).SetBytes(TaggedHash(tapTweakTag, padBytes(P.X)))
+	if t.Cmp(curve.N) >= 0 {
+		return nil, tdrpc.ErrInvalidPubKey
+	}
+
+	tGx, tGy := curve.ScalarBaseMult(padBytes(t))
+	Qx, Qy := curve.Add(P.X, P.Y, tGx, tGy)
+	if Qx.Sign() == 0 && Qy.Sign() == 0 {
+		return nil, tdrpc.ErrInvalidPubKey
+	}
+
+	return &btcec.PublicKey{Curve: curve, X: Qx, Y: Qy}, nil
+
+}
+
+// taprootPkScript returns the segwit v1 output script of an output key
+func taprootPkScript(outputKey *btcec.PublicKey) []byte {
+	return append([]byte{txscript.OP_1, txscript.OP_DATA_32}, padBytes(outputKey.X)...)
+}
+
+// taprootSigHash returns the BIP341 key path signature hash of the first input of tx spending prevOut
+// Only the default and all hash types are supported and the transaction must have a single input
+func taprootSigHash(tx *wire.MsgTx, prevOut *wire.TxOut, hashType byte) []byte {
+
+	var prevouts, amounts, pkScripts, sequences, outputs bytes.Buffer
+	for _, in := range tx.TxIn {
+		_, _ = prevouts.Write(in.PreviousOutPoint.Hash[:])
+		_ = binary.Write(&prevouts, binary.LittleEndian, in.PreviousOutPoint.Index)
+		_ = binary.Write(&sequences, binary.LittleEndian, in.Sequence)
+	}
+	_ = binary.Write(&amounts, binary.LittleEndian, prevOut.Value)
+	_ = wire.WriteVarBytes(&pkScripts, 0, prevOut.PkScript)
+	for _, out := range tx.TxOut {
+		_ = wire.WriteTxOut(&outputs, 0, tx.Version, out)
+	}
+
+	sha := func(b *bytes.Buffer) []byte {
+		h := sha256.Sum256(b.Bytes())
+		return h[:]
+	}
+
+	// The epoch is followed by the signature message
+	var sigMsg bytes.Buffer
+	_ = sigMsg.WriteByte(0x00)
+	_ = sigMsg.WriteByte(hashType)
+	_ = binary.Write(&sigMsg, binary.LittleEndian, tx.Version)
+	_ = binary.Write(&sigMsg, binary.LittleEndian, tx.LockTime)
+	_, _ = sigMsg.Write(sha(&prevouts))
+	_, _ = sigMsg.Write(sha(&amounts))
+	_, _ = sigMsg.Write(sha(&pkScripts))
+	_, _ = sigMsg.Write(sha(&sequences))
+	_, _ = sigMsg.Write(sha(&outputs))
+	// Key path spend without an annex of input 0
+	_ = sigMsg.WriteByte(0x00)
+	_ = binary.Write(&sigMsg, binary.LittleEndian, uint32(0))
+
+	return TaggedHash(tapSighashTag, sigMsg.Bytes())
+
+}
@@ -0,0 +1,148 @@
+package tdrpcserver
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"git.coinninja.net/backend/thunderdome/tdrpc"
+)
+
+// SignBIP322 creates a BIP322 simple signature for the tests of a message by the taproot (BIP86) address of the key
+func SignBIP322(key *btcec.PrivateKey, message []byte) (string, error) {
+
+	curve := btcec.S256()
+
+	// Use the private key matching the even y internal key and tweak it
+	d := new(big.Int).Set(key.D)
+	Px, Py := curve.ScalarBaseMult(padBytes(d))
+	if Py.Bit(0) != 0 {
+		d.Sub(curve.N, d)
+	}
+	d.Add(d, new(big.Int).SetBytes(TaggedHash(tapTweakTag, padBytes(Px))))
+	d.Mod(d, curve.N)
+	outputKey, _ := btcec.PrivKeyFromBytes(curve, padBytes(d))
+
+	toSpend := bip322ToSpend(taprootPkScript(outputKey.PubKey()), message)
+	toSign := bip322ToSign(toSpend, nil)
+	sig, err := SignSchnorr(outputKey, taprootSigHash(toSign, toSpend.TxOut[0], sigHashDefault), make([]byte, 32))
+	if err != nil {
+		return "", err
+	}
+
+	return encodeBIP322Witness(wire.TxWitness{sig}), nil
+
+}
+
+func encodeBIP322Witness(witness wire.TxWitness) string {
+	var buf bytes.Buffer
+	_ = wire.WriteVarInt(&buf, 0, uint64(len(witness)))
+	for _, item := range witness {
+		_ = wire.WriteVarBytes(&buf, 0, item)
+	}
+	return base64.StdEncoding.EncodeToString(buf.Bytes())
+}
+
+// BIP322 message hash and virtual transaction test vectors
+var bip322TestVectors = []struct {
+	message     string
+	messageHash string
+	toSpend     string
+	toSign      string
+}{
+	{
+		message:     "",
+		messageHash: "c90c269c4f8fcbe6880f72a721ddfbf1914268a794cbb21cfafee13770ae19f1",
+		toSpend:     "c5680aa69bb8d860bf82d4e9cd3504b55dde018de765a91bb566283c545a99a7",
+		toSign:      "1e9654e951a5ba44c8604c4de6c67fd78a27e81dcadcfe1edf638ba3aaebaed6",
+	},
+	{
+		message:     "Hello World",
+		messageHash: "f0eb03b1a75ac6d9847f55c624a99169b5dccba2a31f5b23bea77ba270de0a7a",
+		toSpend:     "b79d196740ad5217771c1098fc4a4b51e0535c32236c71f1ea4d61a2d603352b",
+		toSign:      "88737ae86f2077145f93cc4b153ae9a1cb8d56afa511988c149c5c8c9d93bddf",
+	},
+}
+
+func TestBIP322TestVectors(t *testing.T) {
+
+	// The vectors sign for bc1q9vza2e8x573nczrlzms0wvx3gsqjx7vavgkx0l
+	pkScript := mustDecodeHex(t, "00142b05d564e6a7a33c087f16e0f730d1440123799d")
+
+	for _, v := range bip322TestVectors {
+		assert.Equal(t, v.messageHash, hex.EncodeToString(TaggedHash(bip322MessageTag, []byte(v.message))))
+
+		toSpend := bip322ToSpend(pkScript, []byte(v.message))
+		assert.Equal(t, v.toSpend, toSpend.TxHash().String())
+		assert.Equal(t, v.toSign, bip322ToSign(toSpend, nil).TxHash().String())
+	}
+
+}
+
+func TestValidateBIP322Signature(t *testing.T) {
+
+	// The BIP322 taproot vector signing for bc1ppv609nr0vr25u07u95waq5lucwfm6tde4nydujnu8npg4q75mr5sxq8lt3 with SIGHASH_ALL
+	wif, err := btcutil.DecodeWIF("L3VFeEujGtevx9w18HD1fhRbCH67Az2dpCymeRE1SoPK6XQtaN2k")
+	require.Nil(t, err)
+	pubKey := HexEncodedXOnlyPublicKey(wif.PrivKey)
+	sig := "AUHd69PrJQEv+oKTfZ8l+WROBHuy9HKrbFCJu7U1iK2iiEy1vMU5EfMtjc+VSHM7aU0SDbak5IUZRVno2P5mjSafAQ=="
+	assert.Nil(t, ValidateBIP322Signature([]byte("Hello World"), pubKey, sig))
+	assert.Equal(t, tdrpc.ErrSigVerficationFailed, ValidateBIP322Signature([]byte("Hello World!"), pubKey, sig))
+
+	// Sign with the default hash type
+	key, err := NewKey()
+	require.Nil(t, err)
+	message := []byte("Hello World")
+	sig, err = SignBIP322(key, message)
+	require.Nil(t, err)
+	assert.Nil(t, ValidateBIP322Signature(message, HexEncodedXOnlyPublicKey(key), sig))
+	assert.Nil(t, ValidateBIP322Signature(message, HexEncodedPublicKey(key), sig))
+
+	// Another key or message
+	other, err := NewKey()
+	require.Nil(t, err)
+	assert.Equal(t, tdrpc.ErrSigVerficationFailed, ValidateBIP322Signature(message, HexEncodedXOnlyPublicKey(other), sig))
+	assert.Equal(t, tdrpc.ErrSigVerficationFailed, ValidateBIP322Signature([]byte("Hello World!"), HexEncodedXOnlyPublicKey(key), sig))
+
+	// A plain schnorr signature of the message hash is not a BIP322 signature
+	plain, err := SignSchnorr(key, TaggedHash(bip322MessageTag, message), make([]byte, 32))
+	require.Nil(t, err)
+	assert.Equal(t, tdrpc.ErrSigVerficationFailed, ValidateBIP322Signature(message, HexEncodedXOnlyPublicKey(key), encodeBIP322Witness(wire.TxWitness{plain})))
+
+	// Malformed witnesses
+	raw, err := base64.StdEncoding.DecodeString(sig)
+	require.Nil(t, err)
+	for _, bad := range []string{
+		"",
+		"not base64",
+		hex.EncodeToString(raw[2:]),
+		encodeBIP322Witness(wire.TxWitness{}),
+		encodeBIP322Witness(wire.TxWitness{raw[2:], raw[2:]}),
+		encodeBIP322Witness(wire.TxWitness{raw[2:65]}),
+		encodeBIP322Witness(wire.TxWitness{append(raw[2:66:66], 0x03)}),
+		base64.StdEncoding.EncodeToString(append(raw[:66:66], 0x00)),
+	} {
+		assert.Equal(t, tdrpc.ErrInvalidSig, ValidateBIP322Signature(message, HexEncodedXOnlyPublicKey(key), bad), bad)
+	}
+
+	// Bad public key
+	assert.Equal(t, tdrpc.ErrInvalidPubKey, ValidateBIP322Signature(message, "abcd", sig))
+
+	// Through the signature type
+	timeString := time.Now().UTC().Format(time.RFC3339)
+	nonce := "SomeRandomString"
+	sig, err = SignBIP322(key, []byte(timeString+nonce))
+	require.Nil(t, err)
+	err = ValidateTimestampAndNonceSigntature(SigTypeBIP322, timeString, nonce, HexEncodedXOnlyPublicKey(key), sig, time.Now().UTC(), 10*time.Minute)
+	assert.Nil(t, err)
+
+}
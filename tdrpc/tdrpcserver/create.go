@@ -28,6 +28,15 @@ func (s *tdRPCServer) Create(ctx context.Context, request *tdrpc.CreateRequest)
 		return nil, tdrpc.ErrAccountLocked
 	}
 
+	// Retries with the same idempotency key return the original payment request instead of creating another
+	requestHash := idempotencyRequestHash(request)
+	replay := new(tdrpc.CreateResponse)
+	if replayed, err := s.idempotencyReplay(ctx, account.Id, tdrpc.CreateEndpoint, request.IdempotencyKey, requestHash, replay); err != nil {
+		return nil, err
+	} else if replayed {
+		return replay, nil
+	}
+
 	if request.Value < 0 {
 		return nil, status.Errorf(codes.InvalidArgument, "Invalid Value")
 	} else if request.Value > config.GetInt64("tdome.value_limit") {
@@ -58,6 +67,17 @@ func (s *tdRPCServer) Create(ctx context.Context, request *tdrpc.CreateRequest)
 		return nil, tdrpc.ErrCreateRequestLimitExceeded
 	}
 
+	// Make sure the payment can be received
+	warning, err := s.checkInbound(ctx, request.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	// Claim the idempotency key so a concurrent retry cannot also create an invoice
+	if err = s.idempotencyClaim(ctx, account.Id, tdrpc.CreateEndpoint, request.IdempotencyKey, requestHash); err != nil {
+		return nil, err
+	}
+
 	// Create the invoice
 	addInvoiceRequest := &lnrpc.Invoice{
 		Memo:   request.Memo,
@@ -66,6 +86,7 @@ func (s *tdRPCServer) Create(ctx context.Context, request *tdrpc.CreateRequest)
 	}
 	invoice, err := s.lclient.AddInvoice(ctx, addInvoiceRequest)
 	if err != nil {
+		s.idempotencyRelease(account.Id, request.IdempotencyKey)
 		s.logger.Errorw("LND AddInvoice Error", zap.Any("request", addInvoiceRequest), "error", err)
 		return nil, status.Errorf(codes.Internal, "Could not AddInvoice: %s", status.Convert(err).Message())
 	}
@@ -91,6 +112,8 @@ func (s *tdRPCServer) Create(ctx context.Context, request *tdrpc.CreateRequest)
 
 	err = s.store.ProcessLedgerRecord(ctx, lr)
 	if err != nil {
+		// The invoice will never be in the ledger, it can be created again
+		s.idempotencyRelease(account.Id, request.IdempotencyKey)
 		// A valid message is provided with this error
 		if status.Code(err) == codes.InvalidArgument {
 			return nil, err
@@ -100,9 +123,13 @@ func (s *tdRPCServer) Create(ctx context.Context, request *tdrpc.CreateRequest)
 	}
 
 	// Return the payment request
-	return &tdrpc.CreateResponse{
+	response := &tdrpc.CreateResponse{
 		Request: invoice.PaymentRequest,
-	}, nil
+		Warning: warning,
+	}
+	s.idempotencySave(account.Id, request.IdempotencyKey, response)
+
+	return response, nil
 
 }
 
@@ -127,12 +154,19 @@ func (s *tdRPCServer) CreateGenerated(ctx context.Context, request *tdrpc.Create
 		}
 	}
 
+	// Make sure payments can be received
+	warning, err := s.checkInbound(ctx, 0)
+	if err != nil {
+		return nil, err
+	}
+
 	// See if we already have an existing invoice
 	lr, err := s.store.GetActiveGeneratedLightningLedgerRequest(ctx, account.Id)
 	if err == nil {
 		// Found one, return it
 		return &tdrpc.CreateResponse{
 			Request: lr.Request,
+			Warning: warning,
 		}, nil
 	} else if err != store.ErrNotFound {
 		// Some other error
@@ -181,6 +215,7 @@ func (s *tdRPCServer) CreateGenerated(ctx context.Context, request *tdrpc.Create
 	// Return the payment request
 	return &tdrpc.CreateResponse{
 		Request: invoice.PaymentRequest,
+		Warning: warning,
 	}, nil
 
 }
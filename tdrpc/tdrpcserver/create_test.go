@@ -23,7 +23,7 @@ func TestCreate(t *testing.T) {
 	mockDCache := new(mocks.DistCache)
 
 	// RPC Server
-	s, err := NewTDRPCServer(mockStore, mockLClient, mockDCache)
+	s, err := NewTDRPCServer(mockStore, mockLClient, nil, mockDCache)
 	assert.Nil(t, err)
 
 	// Bootstrap authentication
@@ -51,6 +51,11 @@ func TestCreate(t *testing.T) {
 		Count: 0,
 	}, nil)
 
+	// Inbound capacity check
+	mockLClient.On("ListChannels", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("*lnrpc.ListChannelsRequest")).Once().Return(&lnrpc.ListChannelsResponse{
+		Channels: []*lnrpc.Channel{{RemoteBalance: 100000}},
+	}, nil)
+
 	// AddInvoice call
 	mockLClient.On("AddInvoice", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("*lnrpc.Invoice")).Once().Return(
 		&lnrpc.AddInvoiceResponse{
@@ -81,7 +86,7 @@ func TestCreateThrottled(t *testing.T) {
 	mockDCache := new(mocks.DistCache)
 
 	// RPC Server
-	s, err := NewTDRPCServer(mockStore, mockLClient, mockDCache)
+	s, err := NewTDRPCServer(mockStore, mockLClient, nil, mockDCache)
 	assert.Nil(t, err)
 
 	// Bootstrap authentication
@@ -144,15 +149,80 @@ func TestCreateGenerated(t *testing.T) {
 	)
 	mockStore.On("ProcessLedgerRecord", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("*tdrpc.LedgerRecord")).Once().Return(nil)
 	mockStore.On("GetActiveGeneratedLightningLedgerRequest", mock.AnythingOfType("*context.valueCtx"), account.Id).Once().Return(nil, store.ErrNotFound)
+	mockLClient.On("ListChannels", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("*lnrpc.ListChannelsRequest")).Once().Return(&lnrpc.ListChannelsResponse{
+		Channels: []*lnrpc.Channel{{RemoteBalance: 100000}},
+	}, nil)
 
 	// RPC Server
-	s, err := NewTDRPCServer(mockStore, mockLClient, mockDCache)
+	s, err := NewTDRPCServer(mockStore, mockLClient, nil, mockDCache)
 	assert.Nil(t, err)
 
 	// Bad Value
 	r, err := s.CreateGenerated(ctx, nil)
 	assert.Nil(t, err)
 	assert.Equal(t, "whutwhute", r.Request)
+	assert.Empty(t, r.Warning)
+
+	mockStore.AssertExpectations(t)
+	mockLClient.AssertExpectations(t)
+
+}
+
+func TestCreateInbound(t *testing.T) {
+
+	// Mocks
+	mockStore := new(mocks.Store)
+	mockLClient := new(mocks.LightningClient)
+	mockLClient.On("GetInfo", mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("*lnrpc.GetInfoRequest")).Once().Return(&lnrpc.GetInfoResponse{IdentityPubkey: "testing"}, nil)
+	mockDCache := new(mocks.DistCache)
+
+	// RPC Server
+	s, err := NewTDRPCServer(mockStore, mockLClient, nil, mockDCache)
+	assert.Nil(t, err)
+
+	// Bootstrap authentication
+	account := &tdrpc.Account{
+		Id:      "123123123123132132132123131123123123123132132132123131123123123333",
+		Address: "2MsoezssHTCZbeoVcZ5NgYmtNiUpyzAc5hm",
+	}
+	ctx := addAccount(context.Background(), account)
+
+	mockStore.On("GetLedgerRecordStats", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("map[string]string"), mock.AnythingOfType("time.Time")).Twice().Return(&tdrpc.LedgerRecordStats{
+		Count: 0,
+	}, nil)
+
+	// The most that can be received is the largest channel less the reserve, it's cached
+	mockLClient.On("ListChannels", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("*lnrpc.ListChannelsRequest")).Once().Return(&lnrpc.ListChannelsResponse{
+		Channels: []*lnrpc.Channel{
+			{RemoteBalance: 3000, RemoteChanReserveSat: 1000},
+			{RemoteBalance: 5000, RemoteChanReserveSat: 1000},
+		},
+	}, nil)
+
+	// Rejected
+	config.Set("tdome.inbound_check", "reject")
+	_, err = s.Create(ctx, &tdrpc.CreateRequest{
+		Memo:  "test",
+		Value: 5000,
+	})
+	assert.Equal(t, tdrpc.ErrInsufficientInbound, err)
+
+	// Warning
+	config.Set("tdome.inbound_check", "warn")
+	defer config.Set("tdome.inbound_check", "warn")
+	mockLClient.On("AddInvoice", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("*lnrpc.Invoice")).Once().Return(
+		&lnrpc.AddInvoiceResponse{
+			RHash:          []byte("asdfasdfasdf"),
+			PaymentRequest: "whutwhute",
+		}, nil,
+	)
+	mockStore.On("ProcessLedgerRecord", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("*tdrpc.LedgerRecord")).Once().Return(nil)
+	r, err := s.Create(ctx, &tdrpc.CreateRequest{
+		Memo:  "test",
+		Value: 5000,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "The node can currently receive at most 4,000 sats in a single payment", r.Warning)
 
 	mockStore.AssertExpectations(t)
 	mockLClient.AssertExpectations(t)
@@ -0,0 +1,164 @@
+package tdrpcserver
+
+import (
+	"context"
+	"time"
+
+	emptypb "github.com/golang/protobuf/ptypes/empty"
+	config "github.com/spf13/viper"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"git.coinninja.net/backend/thunderdome/store"
+	"git.coinninja.net/backend/thunderdome/tdrpc"
+)
+
+const delegationRevokedBucket = "delegation_revoked"
+
+// RevokeDelegation revokes a delegation of the account holder so the delegate can no longer use it
+func (s *tdRPCServer) RevokeDelegation(ctx context.Context, request *tdrpc.Id) (*emptypb.Empty, error) {
+
+	// Get the authenticated user from the context
+	account := getAccount(ctx)
+	if account == nil {
+		return nil, tdrpc.ErrNotFound
+	}
+
+	// Only the account holder can revoke a delegation
+	if getDelegation(ctx) != nil || getToken(ctx) != nil {
+		return nil, tdrpc.ErrPermissionDenied
+	}
+
+	if request.Id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid id")
+	}
+
+	// The delegation can be forgotten once it would have expired anyhow
+	err := s.cache.Set(delegationRevokedBucket, account.Id+":"+request.Id, 1, time.Duration(config.GetInt64("tdome.delegation_max_expires"))*time.Second)
+	if err != nil {
+		s.logger.Errorw("DistCache Set Error", "error", err, "delegation_id", request.Id)
+		return nil, status.Errorf(codes.Internal, "DistCache Error: %v", err)
+	}
+
+	s.logger.Infow("Delegation Revoked", "delegation_id", request.Id, "account_id", account.Id)
+
+	return &emptypb.Empty{}, nil
+
+}
+
+// addDelegation will include the delegation a delegate is acting under in the RPC context
+func addDelegation(ctx context.Context, delegation *tdrpc.Delegation) context.Context {
+	return context.WithValue(ctx, contextKey(contextKeyDelegation), delegation)
+}
+
+// getDelegation returns the delegation if it's being called by a delegate (returning nil if not)
+func getDelegation(ctx context.Context) *tdrpc.Delegation {
+	delegation, ok := ctx.Value(contextKey(contextKeyDelegation)).(*tdrpc.Delegation)
+	if ok {
+		return delegation
+	}
+	return nil
+}
+
+// authDelegation validates that the account holder signed a delegation to the delegate allowing it to call endpoint
+func (s *tdRPCServer) authDelegation(endpoint string, delegatePubKeyString string, encodedDelegation string, sigHexString string) (*tdrpc.Delegation, error) {
+
+	delegation, err := tdrpc.ParseDelegation(encodedDelegation)
+	if err != nil {
+		return nil, err
+	}
+
+	// The delegation must be for the delegate that signed this request
+	if delegation.Delegate != delegatePubKeyString {
+		return nil, tdrpc.ErrInvalidDelegation
+	}
+
+	// The account holder must have signed the encoded delegation
+	if err = ValidateSigntatureType(delegation.SigType, encodedDelegation, delegation.Account, sigHexString); err != nil {
+		return nil, tdrpc.ErrInvalidDelegation
+	}
+
+	// It must not be good for longer than a revocation is kept
+	now := time.Now().UTC()
+	if !delegation.Active(now) || delegation.ExpiresAt.After(now.Add(time.Duration(config.GetInt64("tdome.delegation_max_expires"))*time.Second)) {
+		return nil, tdrpc.ErrInvalidDelegation
+	}
+
+	if !delegation.AllowsEndpoint(endpoint) {
+		s.logger.Warnw("Delegation Denied", "delegation_id", delegation.Id, "endpoint", endpoint, "account", delegation.Account)
+		return nil, tdrpc.ErrPermissionDenied
+	}
+
+	accountID, err := accountIDFromPubKey(delegation.Account)
+	if err != nil {
+		return nil, tdrpc.ErrInvalidDelegation
+	}
+	revoked, err := s.cache.Exists(delegationRevokedBucket, accountID+":"+delegation.Id)
+	if err != nil {
+		s.logger.Errorw("DistCache Exists Error", "error", err, "delegation_id", delegation.Id)
+		return nil, status.Errorf(codes.Internal, "DistCache Error: %v", err)
+	}
+	if revoked {
+		return nil, tdrpc.ErrInvalidDelegation
+	}
+
+	return delegation, nil
+
+}
+
+// spendDelegation records value against the daily limit of the delegation in the context (if any)
+// It returns a function that will refund the value if the spend does not complete
+func (s *tdRPCServer) spendDelegation(ctx context.Context, accountID string, value int64) (func(), error) {
+
+	delegation := getDelegation(ctx)
+	if delegation == nil {
+		return func() {}, nil
+	}
+
+	if value > delegation.DailyLimit {
+		return nil, tdrpc.ErrDelegationLimitExceeded
+	}
+
+	day := time.Now().UTC()
+	_, err := s.store.AddDelegationSpend(ctx, delegation.Id, accountID, day, value, delegation.DailyLimit)
+	if err == tdrpc.ErrDelegationLimitExceeded {
+		return nil, err
+	} else if err != nil {
+		s.logger.Errorw("AddDelegationSpend Error", "delegation_id", delegation.Id, "value", value, "error", err)
+		return nil, status.Errorf(codes.Internal, "AddDelegationSpend internal error")
+	}
+
+	return func() {
+		// Ensure the refund completes outside of the request context
+		if _, err := s.store.AddDelegationSpend(context.Background(), delegation.Id, accountID, day, -value, delegation.DailyLimit); err != nil {
+			s.logger.Errorw("AddDelegationSpend Refund Error", "delegation_id", delegation.Id, "value", value, "error", err)
+		}
+	}, nil
+
+}
+
+// spendPreAuthDelegation ensures a delegate only pays with funds it pre-authorized under the same delegation
+// Any value above the pre-authorized value is recorded against the daily limit of the delegation
+func (s *tdRPCServer) spendPreAuthDelegation(ctx context.Context, accountID string, preAuthLr *tdrpc.LedgerRecord, value int64) (func(), error) {
+
+	delegation := getDelegation(ctx)
+	if delegation == nil {
+		return func() {}, nil
+	}
+
+	delegationID, err := s.store.GetPreAuthDelegation(ctx, preAuthLr.Id)
+	if err == store.ErrNotFound || (err == nil && delegationID != delegation.Id) {
+		s.logger.Warnw("Pre-Auth Delegation Denied", "delegation_id", delegation.Id, "preauth_id", preAuthLr.Id, "account", delegation.Account)
+		return nil, tdrpc.ErrPermissionDenied
+	} else if err != nil {
+		s.logger.Errorw("GetPreAuthDelegation Error", "preauth_id", preAuthLr.Id, "error", err)
+		return nil, status.Errorf(codes.Internal, "GetPreAuthDelegation internal error")
+	}
+
+	if value <= preAuthLr.Value {
+		return func() {}, nil
+	}
+
+	return s.spendDelegation(ctx, accountID, value-preAuthLr.Value)
+
+}
@@ -0,0 +1,266 @@
+package tdrpcserver
+
+import (
+	"context"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	config "github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	_ "git.coinninja.net/backend/thunderdome/conf"
+	"git.coinninja.net/backend/thunderdome/mocks"
+	"git.coinninja.net/backend/thunderdome/store"
+	"git.coinninja.net/backend/thunderdome/tdrpc"
+)
+
+func TestAuthFuncOverrideDelegation(t *testing.T) {
+
+	// Mocks
+	mockStore := new(mocks.Store)
+	mockLClient := new(mocks.LightningClient)
+	mockLClient.On("GetInfo", mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("*lnrpc.GetInfoRequest")).Once().Return(&lnrpc.GetInfoResponse{IdentityPubkey: "testing"}, nil)
+	mockDCache := new(mocks.DistCache)
+
+	// RPC Server
+	s, err := newTDRPCServer(mockStore, mockLClient, nil, mockDCache)
+	require.Nil(t, err)
+
+	// The account holder and the delegate
+	accountKey, err := NewKey()
+	require.Nil(t, err)
+	delegateKey, err := NewKey()
+	require.Nil(t, err)
+	accountID := AccountTypePubKey + ":" + HexEncodedPublicKey(accountKey)
+
+	// Sign the delegation
+	delegation := &tdrpc.Delegation{
+		Account:    HexEncodedPublicKey(accountKey),
+		Delegate:   HexEncodedPublicKey(delegateKey),
+		Scopes:     []string{"Create"},
+		DailyLimit: 1000,
+		ExpiresAt:  time.Now().UTC().Add(time.Hour),
+	}
+	encodedDelegation, err := delegation.Encode()
+	require.Nil(t, err)
+	delegationSig, err := accountKey.Sign(chainhash.DoubleHashB([]byte(encodedDelegation)))
+	require.Nil(t, err)
+
+	// The delegate signs the request
+	timeString := time.Now().UTC().Format(time.RFC3339)
+	sig, err := delegateKey.Sign(chainhash.DoubleHashB([]byte(timeString)))
+	require.Nil(t, err)
+	md := metadata.Pairs(
+		tdrpc.MetadataAuthPubKeyString, HexEncodedPublicKey(delegateKey),
+		tdrpc.MetadataAuthSignature, hex.EncodeToString(sig.Serialize()),
+		tdrpc.MetadataAuthTimestamp, timeString,
+		tdrpc.MetadataAuthDelegation, encodedDelegation,
+		tdrpc.MetadataAuthDelegationSignature, hex.EncodeToString(delegationSig.Serialize()),
+	)
+
+	// Valid delegation acts on the account holders account
+	account := &tdrpc.Account{Id: accountID}
+	delegationID := getDelegationID(t, encodedDelegation)
+	mockDCache.On("Exists", delegationRevokedBucket, accountID+":"+delegationID).Once().Return(false, nil)
+	mockStore.On("GetAccountByID", mock.AnythingOfType("*context.valueCtx"), accountID).Once().Return(account, nil)
+	ctx, err := s.AuthFuncOverride(metadata.NewIncomingContext(context.Background(), md), tdrpc.CreateEndpoint)
+	assert.Nil(t, err)
+	assert.Equal(t, account, getAccount(ctx))
+	assert.NotNil(t, getDelegation(ctx))
+	assert.Equal(t, delegation.Delegate, getDelegation(ctx).Delegate)
+
+	// Not in scope
+	_, err = s.AuthFuncOverride(metadata.NewIncomingContext(context.Background(), md), tdrpc.PayEndpoint)
+	assert.Equal(t, tdrpc.ErrPermissionDenied, err)
+
+	// Revoked
+	mockDCache.On("Exists", delegationRevokedBucket, accountID+":"+delegationID).Once().Return(true, nil)
+	_, err = s.AuthFuncOverride(metadata.NewIncomingContext(context.Background(), md), tdrpc.CreateEndpoint)
+	assert.Equal(t, tdrpc.ErrInvalidDelegation, err)
+
+	// Delegation signed by the wrong key
+	badSig, err := delegateKey.Sign(chainhash.DoubleHashB([]byte(encodedDelegation)))
+	require.Nil(t, err)
+	md.Set(tdrpc.MetadataAuthDelegationSignature, hex.EncodeToString(badSig.Serialize()))
+	_, err = s.AuthFuncOverride(metadata.NewIncomingContext(context.Background(), md), tdrpc.CreateEndpoint)
+	assert.Equal(t, tdrpc.ErrInvalidDelegation, err)
+
+	// Good for longer than tdome.delegation_max_expires
+	delegation.ExpiresAt = time.Now().UTC().Add(time.Duration(config.GetInt64("tdome.delegation_max_expires"))*time.Second + time.Hour)
+	encodedDelegation, err = delegation.Encode()
+	require.Nil(t, err)
+	delegationSig, err = accountKey.Sign(chainhash.DoubleHashB([]byte(encodedDelegation)))
+	require.Nil(t, err)
+	md.Set(tdrpc.MetadataAuthDelegation, encodedDelegation)
+	md.Set(tdrpc.MetadataAuthDelegationSignature, hex.EncodeToString(delegationSig.Serialize()))
+	_, err = s.AuthFuncOverride(metadata.NewIncomingContext(context.Background(), md), tdrpc.CreateEndpoint)
+	assert.Equal(t, tdrpc.ErrInvalidDelegation, err)
+
+	mockStore.AssertExpectations(t)
+	mockLClient.AssertExpectations(t)
+	mockDCache.AssertExpectations(t)
+
+}
+
+// getDelegationID returns the id of an encoded delegation
+func getDelegationID(t *testing.T, encodedDelegation string) string {
+	delegation, err := tdrpc.ParseDelegation(encodedDelegation)
+	require.Nil(t, err)
+	return delegation.Id
+}
+
+func TestRevokeDelegation(t *testing.T) {
+
+	// Mocks
+	mockStore := new(mocks.Store)
+	mockLClient := new(mocks.LightningClient)
+	mockLClient.On("GetInfo", mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("*lnrpc.GetInfoRequest")).Once().Return(&lnrpc.GetInfoResponse{IdentityPubkey: "testing"}, nil)
+	mockDCache := new(mocks.DistCache)
+
+	// RPC Server
+	s, err := newTDRPCServer(mockStore, mockLClient, nil, mockDCache)
+	require.Nil(t, err)
+
+	account := &tdrpc.Account{Id: "pubkey:test"}
+	ctx := addAccount(context.Background(), account)
+
+	// Missing id
+	_, err = s.RevokeDelegation(ctx, &tdrpc.Id{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// A delegate can't revoke delegations
+	_, err = s.RevokeDelegation(addDelegation(ctx, &tdrpc.Delegation{Id: "delegation1"}), &tdrpc.Id{Id: "delegation1"})
+	assert.Equal(t, tdrpc.ErrPermissionDenied, err)
+
+	// It's kept as long as a delegation can be good for
+	mockDCache.On("Set", delegationRevokedBucket, account.Id+":delegation1", 1, time.Duration(config.GetInt64("tdome.delegation_max_expires"))*time.Second).Once().Return(nil)
+	_, err = s.RevokeDelegation(ctx, &tdrpc.Id{Id: "delegation1"})
+	assert.Nil(t, err)
+
+	mockDCache.AssertExpectations(t)
+
+}
+
+func TestCreatePreAuthDelegationLimit(t *testing.T) {
+
+	// Mocks
+	mockStore := new(mocks.Store)
+	mockLClient := new(mocks.LightningClient)
+	mockLClient.On("GetInfo", mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("*lnrpc.GetInfoRequest")).Once().Return(&lnrpc.GetInfoResponse{IdentityPubkey: "testing"}, nil)
+	mockDCache := new(mocks.DistCache)
+
+	// RPC Server
+	s, err := newTDRPCServer(mockStore, mockLClient, nil, mockDCache)
+	require.Nil(t, err)
+
+	account := &tdrpc.Account{Id: "pubkey:test", Balance: 1000}
+	delegation := &tdrpc.Delegation{Id: "delegation1", DailyLimit: 100}
+	ctx := addDelegation(addAccount(context.Background(), account), delegation)
+
+	// Larger than the daily limit
+	_, err = s.CreatePreAuth(ctx, &tdrpc.CreateRequest{Value: 200})
+	assert.Equal(t, tdrpc.ErrDelegationLimitExceeded, err)
+
+	// Daily limit already used
+	mockStore.On("AddDelegationSpend", mock.AnythingOfType("*context.valueCtx"), delegation.Id, account.Id, mock.AnythingOfType("time.Time"), int64(50), delegation.DailyLimit).Once().Return(int64(0), tdrpc.ErrDelegationLimitExceeded)
+	_, err = s.CreatePreAuth(ctx, &tdrpc.CreateRequest{Value: 50})
+	assert.Equal(t, tdrpc.ErrDelegationLimitExceeded, err)
+
+	// Refunded when the pre-auth fails
+	mockStore.On("AddDelegationSpend", mock.AnythingOfType("*context.valueCtx"), delegation.Id, account.Id, mock.AnythingOfType("time.Time"), int64(50), delegation.DailyLimit).Once().Return(int64(50), nil)
+	mockStore.On("SavePreAuthDelegation", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("string"), delegation.Id, account.Id).Once().Return(nil)
+	mockStore.On("ProcessLedgerRecord", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("*tdrpc.LedgerRecord")).Once().Return(tdrpc.ErrInsufficientFunds)
+	mockStore.On("AddDelegationSpend", mock.AnythingOfType("*context.emptyCtx"), delegation.Id, account.Id, mock.AnythingOfType("time.Time"), int64(-50), delegation.DailyLimit).Once().Return(int64(0), nil)
+	_, err = s.CreatePreAuth(ctx, &tdrpc.CreateRequest{Value: 50})
+	assert.Equal(t, tdrpc.ErrInsufficientFunds, err)
+
+	mockStore.AssertExpectations(t)
+
+}
+
+func TestPayPreAuthDelegation(t *testing.T) {
+
+	// Mocks
+	mockStore := new(mocks.Store)
+	mockLClient := new(mocks.LightningClient)
+	mockLClient.On("GetInfo", mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("*lnrpc.GetInfoRequest")).Once().Return(&lnrpc.GetInfoResponse{IdentityPubkey: "testing"}, nil)
+	mockDCache := new(mocks.DistCache)
+
+	// RPC Server
+	s, err := newTDRPCServer(mockStore, mockLClient, nil, mockDCache)
+	require.Nil(t, err)
+
+	account := &tdrpc.Account{Id: "pubkey:test", Balance: 1000}
+	delegation := &tdrpc.Delegation{Id: "delegation1", DailyLimit: 100}
+	ctx := addDelegation(addAccount(context.Background(), account), delegation)
+
+	pr := &lnrpc.PayReq{
+		Destination: "test",
+		PaymentHash: "hash1",
+		Expiry:      time.Now().Add(time.Hour).Unix(),
+	}
+	preAuthLr := &tdrpc.LedgerRecord{
+		Id:        tdrpc.PreAuthLedgerRecordIdPrefix + "1",
+		AccountId: account.Id,
+		Status:    tdrpc.PENDING,
+		Direction: tdrpc.OUT,
+		Value:     100,
+		Request:   tdrpc.PreAuthRequest,
+	}
+
+	mockLClient.On("DecodePayReq", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("*lnrpc.PayReqString")).Return(pr, nil)
+	mockStore.On("GetScreeningEntriesByValue", mock.AnythingOfType("*context.valueCtx"), tdrpc.ScreeningTypeNode, pr.Destination).Return([]*tdrpc.ScreeningEntry{}, nil)
+	mockLClient.On("QueryRoutes", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("*lnrpc.QueryRoutesRequest")).Return(&lnrpc.QueryRoutesResponse{Routes: []*lnrpc.Route{{TotalFees: 1}}}, nil)
+
+	// Pre-auths of another account are not found
+	mockStore.On("GetLedgerRecord", mock.AnythingOfType("*context.valueCtx"), "preauth:other", tdrpc.OUT).Once().Return(&tdrpc.LedgerRecord{
+		Id:        "preauth:other",
+		AccountId: "pubkey:other",
+		Status:    tdrpc.PENDING,
+		Direction: tdrpc.OUT,
+		Value:     100,
+		Request:   tdrpc.PreAuthRequest,
+	}, nil)
+	_, err = s.Pay(ctx, &tdrpc.PayRequest{Request: "somerequest", Value: 50, PreAuthId: "preauth:other"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// Pre-auths made by the account holder cannot be used by a delegate
+	mockStore.On("GetLedgerRecord", mock.AnythingOfType("*context.valueCtx"), preAuthLr.Id, tdrpc.OUT).Return(preAuthLr, nil)
+	mockStore.On("GetPreAuthDelegation", mock.AnythingOfType("*context.valueCtx"), preAuthLr.Id).Once().Return("", store.ErrNotFound)
+	_, err = s.Pay(ctx, &tdrpc.PayRequest{Request: "somerequest", Value: 50, PreAuthId: preAuthLr.Id})
+	assert.Equal(t, tdrpc.ErrPermissionDenied, err)
+
+	// Or by another delegate
+	mockStore.On("GetPreAuthDelegation", mock.AnythingOfType("*context.valueCtx"), preAuthLr.Id).Once().Return("delegation2", nil)
+	_, err = s.Pay(ctx, &tdrpc.PayRequest{Request: "somerequest", Value: 50, PreAuthId: preAuthLr.Id})
+	assert.Equal(t, tdrpc.ErrPermissionDenied, err)
+
+	// Paying more than was pre-authorized counts the difference against the daily limit, it's refunded if the pre-auth was already used
+	mockStore.On("GetPreAuthDelegation", mock.AnythingOfType("*context.valueCtx"), preAuthLr.Id).Return(delegation.Id, nil)
+	mockStore.On("AddDelegationSpend", mock.AnythingOfType("*context.valueCtx"), delegation.Id, account.Id, mock.AnythingOfType("time.Time"), int64(51), delegation.DailyLimit).Once().Return(int64(51), nil)
+	mockStore.On("UpdateLedgerRecordID", mock.AnythingOfType("*context.valueCtx"), preAuthLr.Id, pr.PaymentHash, tdrpc.OUT).Once().Return(store.ErrAlreadyExists)
+	mockStore.On("AddDelegationSpend", mock.AnythingOfType("*context.emptyCtx"), delegation.Id, account.Id, mock.AnythingOfType("time.Time"), int64(-51), delegation.DailyLimit).Once().Return(int64(0), nil)
+	_, err = s.Pay(ctx, &tdrpc.PayRequest{Request: "somerequest", Value: 150, PreAuthId: preAuthLr.Id})
+	assert.Equal(t, tdrpc.ErrRequestAlreadyPaid, err)
+
+	// Paying within the pre-authorized value was counted when it was pre-authorized
+	mockStore.On("UpdateLedgerRecordID", mock.AnythingOfType("*context.valueCtx"), preAuthLr.Id, pr.PaymentHash, tdrpc.OUT).Once().Return(nil)
+	mockStore.On("ProcessLedgerRecord", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("*tdrpc.LedgerRecord")).Once().Return(nil)
+	mockLClient.On("SendPaymentSync", mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("*lnrpc.SendRequest")).Once().Return(&lnrpc.SendResponse{}, nil)
+	mockStore.On("ProcessLedgerRecord", mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("*tdrpc.LedgerRecord")).Once().Return(nil)
+	response, err := s.Pay(ctx, &tdrpc.PayRequest{Request: "somerequest", Value: 50, PreAuthId: preAuthLr.Id})
+	assert.Nil(t, err)
+	assert.Equal(t, tdrpc.COMPLETED, response.Result.Status)
+
+	mockStore.AssertExpectations(t)
+	mockLClient.AssertExpectations(t)
+
+}
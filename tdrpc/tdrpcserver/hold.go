@@ -0,0 +1,280 @@
+package tdrpcserver
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/invoicesrpc"
+	config "github.com/spf13/viper"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"git.coinninja.net/backend/thunderdome/store"
+	"git.coinninja.net/backend/thunderdome/tdrpc"
+)
+
+// CreateHold creates a hold invoice for the current user. Once paid the funds are accepted but not
+// credited to the account until the invoice is settled with the preimage.
+func (s *tdRPCServer) CreateHold(ctx context.Context, request *tdrpc.CreateHoldRequest) (*tdrpc.CreateResponse, error) {
+
+	// Get the authenticated user from the context
+	account := getAccount(ctx)
+	if account == nil {
+		return nil, tdrpc.ErrNotFound
+	}
+
+	if account.Locked {
+		return nil, tdrpc.ErrAccountLocked
+	}
+
+	hash, err := hex.DecodeString(request.Hash)
+	if err != nil || len(hash) != sha256.Size {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid hash")
+	}
+
+	if request.Value <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid Value")
+	} else if request.Value > config.GetInt64("tdome.value_limit") {
+		return nil, status.Errorf(codes.InvalidArgument, "Max invoice value is %s sats", tdrpc.FormatInt(ctx, config.GetInt64("tdome.value_limit")))
+	}
+
+	// The payer's funds are locked until it's settled or canceled, keep the window to pay it short
+	maxExpires := config.GetInt64("tdome.hold_max_expires")
+	if request.Expires != 0 && (request.Expires < 300 || request.Expires > maxExpires) {
+		return nil, status.Errorf(codes.InvalidArgument, "Expires cannot be less than %s or greater than %s seconds", tdrpc.FormatInt(ctx, 300), tdrpc.FormatInt(ctx, maxExpires))
+	}
+	if request.Expires == 0 {
+		request.Expires = config.GetInt64("tdome.default_request_expires")
+		if request.Expires > maxExpires {
+			request.Expires = maxExpires
+		}
+	}
+
+	// Hold invoices count towards the unpaid request limit
+	pendingStats, err := s.store.GetLedgerRecordStats(ctx, map[string]string{
+		"account_id": account.Id,
+		"type":       tdrpc.LIGHTNING.String(),
+		"direction":  tdrpc.IN.String(),
+		"status":     tdrpc.PENDING.String(),
+		"generated":  "false",
+	}, time.Time{})
+	if err != nil {
+		s.logger.Errorw("GetLedgerRecordStats Error", "error", err)
+		return nil, status.Errorf(codes.Internal, "GetLedgerRecordStats internal error")
+	}
+
+	if pendingStats.Count >= config.GetInt64("tdome.create_request_limit") {
+		return nil, tdrpc.ErrCreateRequestLimitExceeded
+	}
+
+	// Create the hold invoice
+	addHoldInvoiceRequest := &invoicesrpc.AddHoldInvoiceRequest{
+		Hash:       hash,
+		Memo:       request.Memo,
+		Value:      request.Value,
+		Expiry:     request.Expires,
+		CltvExpiry: config.GetUint64("tdome.hold_cltv_expiry"),
+	}
+	invoice, err := s.iclient.AddHoldInvoice(ctx, addHoldInvoiceRequest)
+	if err != nil {
+		if strings.Contains(status.Convert(err).Message(), "already exists") {
+			return nil, status.Errorf(codes.InvalidArgument, "An invoice for this hash already exists")
+		}
+		s.logger.Errorw("LND AddHoldInvoice Error", zap.Any("request", addHoldInvoiceRequest), "error", err)
+		return nil, status.Errorf(codes.Internal, "Could not AddHoldInvoice: %s", status.Convert(err).Message())
+	}
+
+	// The add index is not returned for hold invoices, look it up so the monitor can resume from it
+	var addIndex uint64
+	if lookup, err := s.lclient.LookupInvoice(ctx, &lnrpc.PaymentHash{RHash: hash}); err != nil {
+		s.logger.Errorw("LND LookupInvoice Error", "payment_hash", request.Hash, "error", err)
+	} else {
+		addIndex = lookup.AddIndex
+	}
+
+	// Get the expires time
+	expiresAt := time.Now().UTC().Add(time.Duration(request.Expires) * time.Second)
+
+	// Put it in the ledger
+	lr := &tdrpc.LedgerRecord{
+		Id:        hex.EncodeToString(hash),
+		AccountId: account.Id,
+		ExpiresAt: &expiresAt,
+		Status:    tdrpc.PENDING,
+		Type:      tdrpc.LIGHTNING,
+		Direction: tdrpc.IN,
+		Value:     request.Value,
+		AddIndex:  addIndex,
+		Memo:      request.Memo,
+		Request:   invoice.PaymentRequest,
+		Hold:      true,
+	}
+
+	s.logger.Debugw("request.create_hold", "account_id", account.Id, zap.Any("request", lr))
+
+	err = s.store.ProcessLedgerRecord(ctx, lr)
+	if err != nil {
+		// A valid message is provided with this error
+		if status.Code(err) == codes.InvalidArgument {
+			return nil, err
+		}
+		s.logger.Errorw("ProcessLedgerRecord Error", zap.Any("lr", lr), "error", err)
+		return nil, status.Errorf(codes.Internal, "ProcessLedgerRecord internal error")
+	}
+
+	// Return the payment request
+	return &tdrpc.CreateResponse{
+		Request: invoice.PaymentRequest,
+	}, nil
+
+}
+
+// SettleHold settles an accepted hold invoice with the preimage and credits the account
+func (s *tdRPCServer) SettleHold(ctx context.Context, request *tdrpc.SettleHoldRequest) (*tdrpc.LedgerRecordResponse, error) {
+
+	// Get the authenticated user from the context
+	account := getAccount(ctx)
+	if account == nil {
+		return nil, tdrpc.ErrNotFound
+	}
+
+	if account.Locked {
+		return nil, tdrpc.ErrAccountLocked
+	}
+
+	preimage, err := hex.DecodeString(request.Preimage)
+	if err != nil || len(preimage) != 32 {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid preimage")
+	}
+	hash := sha256.Sum256(preimage)
+
+	lr, err := s.getHoldLedgerRecord(ctx, account.Id, hex.EncodeToString(hash[:]))
+	if err != nil {
+		return nil, err
+	}
+
+	if lr.Status != tdrpc.PENDING && lr.Status != tdrpc.ACCEPTED {
+		return nil, tdrpc.ErrHoldNotActive
+	}
+
+	// lnd knows if it has been paid, the monitor may not have seen it yet
+	invoice, err := s.lclient.LookupInvoice(ctx, &lnrpc.PaymentHash{RHash: hash[:]})
+	if err != nil {
+		s.logger.Errorw("LND LookupInvoice Error", "payment_hash", lr.Id, "error", err)
+		return nil, status.Errorf(codes.Internal, "Could not LookupInvoice: %v", status.Convert(err).Message())
+	}
+	if invoice.State != lnrpc.Invoice_ACCEPTED {
+		return nil, tdrpc.ErrHoldNotAccepted
+	}
+
+	_, err = s.iclient.SettleInvoice(ctx, &invoicesrpc.SettleInvoiceMsg{Preimage: preimage})
+	if err != nil {
+		s.logger.Errorw("LND SettleInvoice Error", "payment_hash", lr.Id, "error", err)
+		return nil, status.Errorf(codes.Internal, "Could not SettleInvoice: %v", status.Convert(err).Message())
+	}
+
+	// ********* AT THIS POINT IN TIME ALL FUNCTIONS BELOW MUST COMPLETE *********
+	// DO NOT ALLOW THE REQUEST CONTEXT TO CANCEL ANY OPERATION IN PROGRESS
+	ctx = context.Background()
+
+	lr.Status = tdrpc.COMPLETED
+	if invoice.AmtPaidSat > 0 {
+		lr.Value = invoice.AmtPaidSat
+	}
+
+	// The monitor will also complete it when it sees the settle, whichever is first credits the account
+	if err = s.store.ProcessLedgerRecord(ctx, lr); err != nil {
+		// A valid message is provided with this error
+		if status.Code(err) == codes.InvalidArgument {
+			return nil, err
+		}
+		s.logger.Errorw("ProcessLedgerRecord Error", zap.Any("lr", lr), "error", err)
+		return nil, status.Errorf(codes.Internal, "ProcessLedgerRecord internal error")
+	}
+
+	s.logger.Infow("Hold Invoice Settled", "payment_hash", lr.Id, "account_id", account.Id, "value", lr.Value)
+
+	return &tdrpc.LedgerRecordResponse{
+		Result: lr,
+	}, nil
+
+}
+
+// CancelHold cancels a pending or accepted hold invoice, any accepted funds are returned to the payer
+func (s *tdRPCServer) CancelHold(ctx context.Context, request *tdrpc.Id) (*tdrpc.LedgerRecordResponse, error) {
+
+	// Get the authenticated user from the context
+	account := getAccount(ctx)
+	if account == nil {
+		return nil, tdrpc.ErrNotFound
+	}
+
+	if account.Locked {
+		return nil, tdrpc.ErrAccountLocked
+	}
+
+	hash, err := hex.DecodeString(request.Id)
+	if err != nil || len(hash) != sha256.Size {
+		return nil, tdrpc.ErrNotFound
+	}
+
+	lr, err := s.getHoldLedgerRecord(ctx, account.Id, request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if lr.Status != tdrpc.PENDING && lr.Status != tdrpc.ACCEPTED {
+		return nil, tdrpc.ErrHoldNotActive
+	}
+
+	_, err = s.iclient.CancelInvoice(ctx, &invoicesrpc.CancelInvoiceMsg{PaymentHash: hash})
+	if err != nil {
+		s.logger.Errorw("LND CancelInvoice Error", "payment_hash", lr.Id, "error", err)
+		return nil, status.Errorf(codes.Internal, "Could not CancelInvoice: %v", status.Convert(err).Message())
+	}
+
+	// ********* AT THIS POINT IN TIME ALL FUNCTIONS BELOW MUST COMPLETE *********
+	ctx = context.Background()
+
+	lr.Status = tdrpc.EXPIRED
+	if err = s.store.ProcessLedgerRecord(ctx, lr); err != nil {
+		// A valid message is provided with this error
+		if status.Code(err) == codes.InvalidArgument {
+			return nil, err
+		}
+		s.logger.Errorw("ProcessLedgerRecord Error", zap.Any("lr", lr), "error", err)
+		return nil, status.Errorf(codes.Internal, "ProcessLedgerRecord internal error")
+	}
+
+	s.logger.Infow("Hold Invoice Canceled", "payment_hash", lr.Id, "account_id", account.Id)
+
+	return &tdrpc.LedgerRecordResponse{
+		Result: lr,
+	}, nil
+
+}
+
+// getHoldLedgerRecord returns the inbound hold invoice ledger record belonging to the account
+func (s *tdRPCServer) getHoldLedgerRecord(ctx context.Context, accountID string, id string) (*tdrpc.LedgerRecord, error) {
+
+	lr, err := s.store.GetLedgerRecord(ctx, id, tdrpc.IN)
+	if err == store.ErrNotFound {
+		return nil, tdrpc.ErrNotFound
+	} else if err != nil {
+		s.logger.Errorw("GetLedgerRecord Error", "id", id, "error", err)
+		return nil, status.Errorf(codes.Internal, "GetLedgerRecord internal error")
+	}
+
+	// If the account id doesn't match or it's not a hold invoice, deny access
+	if lr.AccountId != accountID || !lr.Hold {
+		return nil, tdrpc.ErrNotFound
+	}
+
+	return lr, nil
+
+}
@@ -0,0 +1,125 @@
+package tdrpcserver
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/invoicesrpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"git.coinninja.net/backend/thunderdome/mocks"
+	"git.coinninja.net/backend/thunderdome/tdrpc"
+)
+
+func TestHold(t *testing.T) {
+
+	// Mocks
+	mockStore := new(mocks.Store)
+	mockLClient := new(mocks.LightningClient)
+	mockLClient.On("GetInfo", mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("*lnrpc.GetInfoRequest")).Once().Return(&lnrpc.GetInfoResponse{IdentityPubkey: "testing"}, nil)
+	mockIClient := new(mocks.InvoicesClient)
+	mockDCache := new(mocks.DistCache)
+
+	// RPC Server
+	s, err := newTDRPCServer(mockStore, mockLClient, mockIClient, mockDCache)
+	require.Nil(t, err)
+
+	account := &tdrpc.Account{
+		Id:      "pubkey:test",
+		Address: "2MsoezssHTCZbeoVcZ5NgYmtNiUpyzAc5hm",
+	}
+	ctx := addAccount(context.Background(), account)
+
+	preimage := make([]byte, 32)
+	preimage[0] = 1
+	hash := sha256.Sum256(preimage)
+	hashString := hex.EncodeToString(hash[:])
+
+	// Bad hash
+	_, err = s.CreateHold(ctx, &tdrpc.CreateHoldRequest{Hash: "abc", Value: 1000})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// The payer's funds cannot be locked for longer than tdome.hold_max_expires
+	_, err = s.CreateHold(ctx, &tdrpc.CreateHoldRequest{Hash: hashString, Value: 1000, Expires: 7776000})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Create the hold invoice
+	mockStore.On("GetLedgerRecordStats", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("map[string]string"), mock.AnythingOfType("time.Time")).Once().Return(&tdrpc.LedgerRecordStats{}, nil)
+	mockIClient.On("AddHoldInvoice", mock.AnythingOfType("*context.valueCtx"), mock.MatchedBy(func(r *invoicesrpc.AddHoldInvoiceRequest) bool {
+		return hex.EncodeToString(r.Hash) == hashString && r.Value == 1000 && r.CltvExpiry == 144 && r.Expiry == 86400
+	})).Once().Return(&invoicesrpc.AddHoldInvoiceResp{PaymentRequest: "lnhold"}, nil)
+	mockLClient.On("LookupInvoice", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("*lnrpc.PaymentHash")).Once().Return(&lnrpc.Invoice{AddIndex: 5, State: lnrpc.Invoice_OPEN}, nil)
+	mockStore.On("ProcessLedgerRecord", mock.AnythingOfType("*context.valueCtx"), mock.MatchedBy(func(lr *tdrpc.LedgerRecord) bool {
+		return lr.Id == hashString && lr.Hold && lr.Status == tdrpc.PENDING && lr.AddIndex == 5
+	})).Once().Return(nil)
+
+	response, err := s.CreateHold(ctx, &tdrpc.CreateHoldRequest{Hash: hashString, Value: 1000})
+	assert.Nil(t, err)
+	assert.Equal(t, "lnhold", response.Request)
+
+	lr := &tdrpc.LedgerRecord{
+		Id:        hashString,
+		AccountId: account.Id,
+		Status:    tdrpc.PENDING,
+		Type:      tdrpc.LIGHTNING,
+		Direction: tdrpc.IN,
+		Value:     1000,
+		Hold:      true,
+	}
+
+	// It cannot be settled until it's paid
+	mockStore.On("GetLedgerRecord", mock.AnythingOfType("*context.valueCtx"), hashString, tdrpc.IN).Once().Return(lr, nil)
+	mockLClient.On("LookupInvoice", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("*lnrpc.PaymentHash")).Once().Return(&lnrpc.Invoice{State: lnrpc.Invoice_OPEN}, nil)
+	_, err = s.SettleHold(ctx, &tdrpc.SettleHoldRequest{Preimage: hex.EncodeToString(preimage)})
+	assert.Equal(t, tdrpc.ErrHoldNotAccepted, err)
+
+	// Settle it once it's accepted
+	acceptedLr := *lr
+	acceptedLr.Status = tdrpc.ACCEPTED
+	mockStore.On("GetLedgerRecord", mock.AnythingOfType("*context.valueCtx"), hashString, tdrpc.IN).Once().Return(&acceptedLr, nil)
+	mockLClient.On("LookupInvoice", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("*lnrpc.PaymentHash")).Once().Return(&lnrpc.Invoice{State: lnrpc.Invoice_ACCEPTED, AmtPaidSat: 1000}, nil)
+	mockIClient.On("SettleInvoice", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("*invoicesrpc.SettleInvoiceMsg")).Once().Return(&invoicesrpc.SettleInvoiceResp{}, nil)
+	mockStore.On("ProcessLedgerRecord", mock.AnythingOfType("*context.emptyCtx"), mock.MatchedBy(func(lr *tdrpc.LedgerRecord) bool {
+		return lr.Id == hashString && lr.Status == tdrpc.COMPLETED && lr.Value == 1000
+	})).Once().Return(nil)
+
+	settled, err := s.SettleHold(ctx, &tdrpc.SettleHoldRequest{Preimage: hex.EncodeToString(preimage)})
+	assert.Nil(t, err)
+	assert.Equal(t, tdrpc.COMPLETED, settled.Result.Status)
+
+	// Completed hold invoices cannot be canceled
+	mockStore.On("GetLedgerRecord", mock.AnythingOfType("*context.valueCtx"), hashString, tdrpc.IN).Once().Return(settled.Result, nil)
+	_, err = s.CancelHold(ctx, &tdrpc.Id{Id: hashString})
+	assert.Equal(t, tdrpc.ErrHoldNotActive, err)
+
+	// Another account's hold invoice is not found
+	otherLr := *lr
+	otherLr.AccountId = "pubkey:other"
+	mockStore.On("GetLedgerRecord", mock.AnythingOfType("*context.valueCtx"), hashString, tdrpc.IN).Once().Return(&otherLr, nil)
+	_, err = s.CancelHold(ctx, &tdrpc.Id{Id: hashString})
+	assert.Equal(t, tdrpc.ErrNotFound, err)
+
+	// Cancel a pending hold invoice
+	pendingLr := *lr
+	mockStore.On("GetLedgerRecord", mock.AnythingOfType("*context.valueCtx"), hashString, tdrpc.IN).Once().Return(&pendingLr, nil)
+	mockIClient.On("CancelInvoice", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("*invoicesrpc.CancelInvoiceMsg")).Once().Return(&invoicesrpc.CancelInvoiceResp{}, nil)
+	mockStore.On("ProcessLedgerRecord", mock.AnythingOfType("*context.emptyCtx"), mock.MatchedBy(func(lr *tdrpc.LedgerRecord) bool {
+		return lr.Id == hashString && lr.Status == tdrpc.EXPIRED
+	})).Once().Return(nil)
+
+	canceled, err := s.CancelHold(ctx, &tdrpc.Id{Id: hashString})
+	assert.Nil(t, err)
+	assert.Equal(t, tdrpc.EXPIRED, canceled.Result.Status)
+
+	mockStore.AssertExpectations(t)
+	mockLClient.AssertExpectations(t)
+	mockIClient.AssertExpectations(t)
+
+}
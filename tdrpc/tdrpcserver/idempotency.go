@@ -0,0 +1,123 @@
+package tdrpcserver
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	config "github.com/spf13/viper"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"git.coinninja.net/backend/thunderdome/store"
+	"git.coinninja.net/backend/thunderdome/tdrpc"
+)
+
+const idempotencyKeyMaxLength = 128
+
+// idempotencyRequestHash returns a hash of the request used to ensure a replayed key is for the same request
+func idempotencyRequestHash(request proto.Message) string {
+	data, _ := proto.Marshal(request)
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:])
+}
+
+// idempotencyReplay checks for a previous request with the same idempotency key
+// If the previous request completed, response is populated with the original response and it returns true
+func (s *tdRPCServer) idempotencyReplay(ctx context.Context, accountID string, endpoint string, key string, requestHash string, response proto.Message) (bool, error) {
+
+	if key == "" {
+		return false, nil
+	}
+
+	if len(key) > idempotencyKeyMaxLength {
+		return false, status.Errorf(codes.InvalidArgument, "Idempotency key cannot be longer than %d characters", idempotencyKeyMaxLength)
+	}
+
+	ik, err := s.store.GetIdempotencyKey(ctx, accountID, key)
+	if err == store.ErrNotFound {
+		return false, nil
+	} else if err != nil {
+		s.logger.Errorw("GetIdempotencyKey Error", "account_id", accountID, "key", key, "error", err)
+		return false, status.Errorf(codes.Internal, "GetIdempotencyKey internal error")
+	}
+
+	if ik.Endpoint != endpoint || ik.RequestHash != requestHash {
+		return false, tdrpc.ErrIdempotencyKeyMismatch
+	}
+
+	// The original request has not finished (or it never will and must expire)
+	if ik.Response == nil {
+		return false, tdrpc.ErrIdempotencyKeyInProgress
+	}
+
+	if err = proto.Unmarshal(ik.Response, response); err != nil {
+		s.logger.Errorw("Idempotency Response Unmarshal Error", "account_id", accountID, "key", key, "error", err)
+		return false, status.Errorf(codes.Internal, "Idempotency internal error")
+	}
+
+	s.logger.Infow("Idempotent Request Replayed", "account_id", accountID, "key", key, "endpoint", endpoint)
+
+	return true, nil
+
+}
+
+// idempotencyClaim claims the idempotency key before the request does anything that cannot be repeated
+func (s *tdRPCServer) idempotencyClaim(ctx context.Context, accountID string, endpoint string, key string, requestHash string) error {
+
+	if key == "" {
+		return nil
+	}
+
+	err := s.store.CreateIdempotencyKey(ctx, &tdrpc.IdempotencyKey{
+		AccountId:   accountID,
+		Key:         key,
+		Endpoint:    endpoint,
+		RequestHash: requestHash,
+		ExpiresAt:   time.Now().UTC().Add(time.Duration(config.GetInt64("tdome.idempotency_key_expires")) * time.Second),
+	})
+	if err == store.ErrAlreadyExists {
+		// Another request with the same key claimed it first
+		return tdrpc.ErrIdempotencyKeyInProgress
+	} else if err != nil {
+		s.logger.Errorw("CreateIdempotencyKey Error", "account_id", accountID, "key", key, "error", err)
+		return status.Errorf(codes.Internal, "CreateIdempotencyKey internal error")
+	}
+
+	return nil
+
+}
+
+// idempotencySave stores the response to return when the idempotency key is replayed
+func (s *tdRPCServer) idempotencySave(accountID string, key string, response proto.Message) {
+
+	if key == "" {
+		return
+	}
+
+	data, err := proto.Marshal(response)
+	if err == nil {
+		// Ensure it completes outside of the request context
+		err = s.store.SaveIdempotencyKeyResponse(context.Background(), accountID, key, data)
+	}
+	if err != nil {
+		s.logger.Errorw("SaveIdempotencyKeyResponse Error", "account_id", accountID, "key", key, "error", err)
+	}
+
+}
+
+// idempotencyRelease releases the idempotency key when the request failed without side effects so it can be retried
+func (s *tdRPCServer) idempotencyRelease(accountID string, key string) {
+
+	if key == "" {
+		return
+	}
+
+	// Ensure it completes outside of the request context
+	if err := s.store.DeleteIdempotencyKey(context.Background(), accountID, key); err != nil {
+		s.logger.Errorw("DeleteIdempotencyKey Error", "account_id", accountID, "key", key, "error", err)
+	}
+
+}
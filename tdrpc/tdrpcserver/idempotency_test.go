@@ -0,0 +1,269 @@
+package tdrpcserver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	_ "git.coinninja.net/backend/thunderdome/conf"
+	"git.coinninja.net/backend/thunderdome/mocks"
+	"git.coinninja.net/backend/thunderdome/store"
+	"git.coinninja.net/backend/thunderdome/tdrpc"
+)
+
+func TestWithdrawIdempotency(t *testing.T) {
+
+	// Mocks
+	mockStore := new(mocks.Store)
+	mockLClient := new(mocks.LightningClient)
+	mockLClient.On("GetInfo", mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("*lnrpc.GetInfoRequest")).Once().Return(&lnrpc.GetInfoResponse{IdentityPubkey: "testing"}, nil)
+	mockDCache := new(mocks.DistCache)
+
+	// RPC Server
+	s, err := newTDRPCServer(mockStore, mockLClient, nil, mockDCache)
+	require.Nil(t, err)
+
+	account := &tdrpc.Account{
+		Id:      "pubkey:test",
+		Address: "2MsoezssHTCZbeoVcZ5NgYmtNiUpyzAc5hm",
+		Balance: 100000,
+	}
+	ctx := addAccount(context.Background(), account)
+
+	request := &tdrpc.WithdrawRequest{
+		Address:        "2MymaDj8Jfv5tXv6bhjVVpisHvsbsXmhFbE",
+		Value:          50000,
+		IdempotencyKey: "key1",
+	}
+	original := &tdrpc.WithdrawResponse{Result: &tdrpc.LedgerRecord{Id: "abc1234", AccountId: account.Id, Type: tdrpc.BTC, Direction: tdrpc.OUT, Value: 40000}}
+	originalData, err := proto.Marshal(original)
+	require.Nil(t, err)
+
+	// A completed withdraw is replayed without sending anything
+	mockStore.On("GetIdempotencyKey", mock.AnythingOfType("*context.valueCtx"), account.Id, "key1").Once().Return(&tdrpc.IdempotencyKey{
+		AccountId:   account.Id,
+		Key:         "key1",
+		Endpoint:    tdrpc.WithdrawEndpoint,
+		RequestHash: idempotencyRequestHash(request),
+		Response:    originalData,
+	}, nil)
+	response, err := s.Withdraw(ctx, request)
+	assert.Nil(t, err)
+	assert.Equal(t, original.Result.Id, response.Result.Id)
+	assert.Equal(t, original.Result.Value, response.Result.Value)
+
+	// The same key for a different withdraw
+	mockStore.On("GetIdempotencyKey", mock.AnythingOfType("*context.valueCtx"), account.Id, "key1").Once().Return(&tdrpc.IdempotencyKey{
+		AccountId:   account.Id,
+		Key:         "key1",
+		Endpoint:    tdrpc.WithdrawEndpoint,
+		RequestHash: "other",
+		Response:    originalData,
+	}, nil)
+	_, err = s.Withdraw(ctx, request)
+	assert.Equal(t, tdrpc.ErrIdempotencyKeyMismatch, err)
+
+	// The original has not finished
+	mockStore.On("GetIdempotencyKey", mock.AnythingOfType("*context.valueCtx"), account.Id, "key1").Once().Return(&tdrpc.IdempotencyKey{
+		AccountId:   account.Id,
+		Key:         "key1",
+		Endpoint:    tdrpc.WithdrawEndpoint,
+		RequestHash: idempotencyRequestHash(request),
+	}, nil)
+	_, err = s.Withdraw(ctx, request)
+	assert.Equal(t, tdrpc.ErrIdempotencyKeyInProgress, err)
+
+	// A new key is claimed before sending and the response is saved
+	request.IdempotencyKey = "key2"
+	mockStore.On("GetIdempotencyKey", mock.AnythingOfType("*context.valueCtx"), account.Id, "key2").Once().Return(nil, store.ErrNotFound)
+	mockStore.On("GetLedgerRecordStats", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("map[string]string"), mock.AnythingOfType("time.Time")).Once().Return(&tdrpc.LedgerRecordStats{}, nil)
+	mockStore.On("GetScreeningEntriesByValue", mock.AnythingOfType("*context.valueCtx"), tdrpc.ScreeningTypeAddress, request.Address).Once().Return([]*tdrpc.ScreeningEntry{}, nil)
+	mockLClient.On("EstimateFee", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("*lnrpc.EstimateFeeRequest")).Once().Return(&lnrpc.EstimateFeeResponse{FeeSat: 123, FeerateSatPerByte: 12}, nil)
+	mockStore.On("CreateIdempotencyKey", mock.AnythingOfType("*context.valueCtx"), mock.MatchedBy(func(ik *tdrpc.IdempotencyKey) bool {
+		return ik.AccountId == account.Id && ik.Key == "key2" && ik.Endpoint == tdrpc.WithdrawEndpoint
+	})).Once().Return(nil)
+	mockStore.On("ProcessLedgerRecord", mock.AnythingOfType("*context.valueCtx"), mock.MatchedBy(func(lr *tdrpc.LedgerRecord) bool {
+		return lr.Request == request.Address
+	})).Once().Return(nil)
+	mockLClient.On("SendCoins", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("*lnrpc.SendCoinsRequest")).Once().Return(&lnrpc.SendCoinsResponse{Txid: "def5678"}, nil)
+	mockStore.On("SaveIdempotencyKeyResponse", mock.AnythingOfType("*context.emptyCtx"), account.Id, "key2", mock.AnythingOfType("[]uint8")).Once().Return(nil)
+	mockStore.On("UpdateLedgerRecordID", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("string"), "def5678", tdrpc.OUT).Once().Return(nil)
+
+	response, err = s.Withdraw(ctx, request)
+	assert.Nil(t, err)
+	assert.Equal(t, "def5678", response.Result.Id)
+
+	mockStore.AssertExpectations(t)
+	mockLClient.AssertExpectations(t)
+
+}
+
+func TestCreateIdempotency(t *testing.T) {
+
+	// Mocks
+	mockStore := new(mocks.Store)
+	mockLClient := new(mocks.LightningClient)
+	mockLClient.On("GetInfo", mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("*lnrpc.GetInfoRequest")).Once().Return(&lnrpc.GetInfoResponse{IdentityPubkey: "testing"}, nil)
+	mockDCache := new(mocks.DistCache)
+
+	// RPC Server
+	s, err := newTDRPCServer(mockStore, mockLClient, nil, mockDCache)
+	require.Nil(t, err)
+
+	account := &tdrpc.Account{
+		Id:      "pubkey:test",
+		Address: "2MsoezssHTCZbeoVcZ5NgYmtNiUpyzAc5hm",
+		Balance: 100000,
+	}
+	ctx := addAccount(context.Background(), account)
+
+	request := &tdrpc.CreateRequest{
+		Memo:           "test",
+		Value:          1000,
+		IdempotencyKey: "key1",
+	}
+	original := &tdrpc.CreateResponse{Request: "lnrequest1"}
+	originalData, err := proto.Marshal(original)
+	require.Nil(t, err)
+
+	// A created request is replayed without creating another invoice
+	mockStore.On("GetIdempotencyKey", mock.AnythingOfType("*context.valueCtx"), account.Id, "key1").Once().Return(&tdrpc.IdempotencyKey{
+		AccountId:   account.Id,
+		Key:         "key1",
+		Endpoint:    tdrpc.CreateEndpoint,
+		RequestHash: idempotencyRequestHash(request),
+		Response:    originalData,
+	}, nil)
+	response, err := s.Create(ctx, request)
+	assert.Nil(t, err)
+	assert.Equal(t, original.Request, response.Request)
+
+	// The same key and parameters used for a pre-auth
+	mockStore.On("GetIdempotencyKey", mock.AnythingOfType("*context.valueCtx"), account.Id, "key1").Once().Return(&tdrpc.IdempotencyKey{
+		AccountId:   account.Id,
+		Key:         "key1",
+		Endpoint:    tdrpc.CreateEndpoint,
+		RequestHash: idempotencyRequestHash(request),
+		Response:    originalData,
+	}, nil)
+	_, err = s.CreatePreAuth(ctx, request)
+	assert.Equal(t, tdrpc.ErrIdempotencyKeyMismatch, err)
+
+	// The same key with a different value
+	mockStore.On("GetIdempotencyKey", mock.AnythingOfType("*context.valueCtx"), account.Id, "key1").Once().Return(&tdrpc.IdempotencyKey{
+		AccountId:   account.Id,
+		Key:         "key1",
+		Endpoint:    tdrpc.CreateEndpoint,
+		RequestHash: idempotencyRequestHash(request),
+		Response:    originalData,
+	}, nil)
+	_, err = s.Create(ctx, &tdrpc.CreateRequest{
+		Memo:           "test",
+		Value:          2000,
+		IdempotencyKey: "key1",
+	})
+	assert.Equal(t, tdrpc.ErrIdempotencyKeyMismatch, err)
+
+	// A new key is claimed before creating the invoice and the response is saved
+	request.IdempotencyKey = "key2"
+	mockStore.On("GetIdempotencyKey", mock.AnythingOfType("*context.valueCtx"), account.Id, "key2").Once().Return(nil, store.ErrNotFound)
+	mockStore.On("GetLedgerRecordStats", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("map[string]string"), mock.AnythingOfType("time.Time")).Once().Return(&tdrpc.LedgerRecordStats{}, nil)
+	mockLClient.On("ListChannels", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("*lnrpc.ListChannelsRequest")).Once().Return(&lnrpc.ListChannelsResponse{
+		Channels: []*lnrpc.Channel{{RemoteBalance: 100000}},
+	}, nil)
+	mockStore.On("CreateIdempotencyKey", mock.AnythingOfType("*context.valueCtx"), mock.MatchedBy(func(ik *tdrpc.IdempotencyKey) bool {
+		return ik.AccountId == account.Id && ik.Key == "key2" && ik.Endpoint == tdrpc.CreateEndpoint
+	})).Once().Return(nil)
+	mockLClient.On("AddInvoice", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("*lnrpc.Invoice")).Once().Return(&lnrpc.AddInvoiceResponse{
+		RHash:          []byte("asdfasdfasdf"),
+		PaymentRequest: "lnrequest2",
+	}, nil)
+	mockStore.On("ProcessLedgerRecord", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("*tdrpc.LedgerRecord")).Once().Return(nil)
+	mockStore.On("SaveIdempotencyKeyResponse", mock.AnythingOfType("*context.emptyCtx"), account.Id, "key2", mock.AnythingOfType("[]uint8")).Once().Return(nil)
+
+	response, err = s.Create(ctx, request)
+	assert.Nil(t, err)
+	assert.Equal(t, "lnrequest2", response.Request)
+
+	// A pre-auth that fails releases the key so it can be retried
+	request.IdempotencyKey = "key3"
+	mockStore.On("GetIdempotencyKey", mock.AnythingOfType("*context.valueCtx"), account.Id, "key3").Once().Return(nil, store.ErrNotFound)
+	mockStore.On("CreateIdempotencyKey", mock.AnythingOfType("*context.valueCtx"), mock.MatchedBy(func(ik *tdrpc.IdempotencyKey) bool {
+		return ik.AccountId == account.Id && ik.Key == "key3" && ik.Endpoint == tdrpc.CreatePreAuthEndpoint
+	})).Once().Return(nil)
+	mockStore.On("ProcessLedgerRecord", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("*tdrpc.LedgerRecord")).Once().Return(tdrpc.ErrInsufficientFunds)
+	mockStore.On("DeleteIdempotencyKey", mock.AnythingOfType("*context.emptyCtx"), account.Id, "key3").Once().Return(nil)
+
+	_, err = s.CreatePreAuth(ctx, request)
+	assert.NotNil(t, err)
+
+	mockStore.AssertExpectations(t)
+	mockLClient.AssertExpectations(t)
+
+}
+
+func TestPayPreAuthIdempotency(t *testing.T) {
+
+	// Mocks
+	mockStore := new(mocks.Store)
+	mockLClient := new(mocks.LightningClient)
+	mockLClient.On("GetInfo", mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("*lnrpc.GetInfoRequest")).Once().Return(&lnrpc.GetInfoResponse{IdentityPubkey: "testing"}, nil)
+	mockDCache := new(mocks.DistCache)
+
+	// RPC Server
+	s, err := newTDRPCServer(mockStore, mockLClient, nil, mockDCache)
+	require.Nil(t, err)
+
+	account := &tdrpc.Account{Id: "pubkey:test", Balance: 1000}
+	ctx := addAccount(context.Background(), account)
+
+	pr := &lnrpc.PayReq{
+		Destination: "test",
+		PaymentHash: "hash1",
+		Expiry:      time.Now().Add(time.Hour).Unix(),
+	}
+	preAuthLr := &tdrpc.LedgerRecord{
+		Id:        tdrpc.PreAuthLedgerRecordIdPrefix + "1",
+		AccountId: account.Id,
+		Status:    tdrpc.PENDING,
+		Direction: tdrpc.OUT,
+		Value:     100,
+		Request:   tdrpc.PreAuthRequest,
+	}
+	request := &tdrpc.PayRequest{
+		Request:        "somerequest",
+		Value:          50,
+		PreAuthId:      preAuthLr.Id,
+		IdempotencyKey: "key1",
+	}
+
+	mockLClient.On("DecodePayReq", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("*lnrpc.PayReqString")).Twice().Return(pr, nil)
+	mockStore.On("GetScreeningEntriesByValue", mock.AnythingOfType("*context.valueCtx"), tdrpc.ScreeningTypeNode, pr.Destination).Twice().Return([]*tdrpc.ScreeningEntry{}, nil)
+	mockLClient.On("QueryRoutes", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("*lnrpc.QueryRoutesRequest")).Twice().Return(&lnrpc.QueryRoutesResponse{Routes: []*lnrpc.Route{{TotalFees: 1}}}, nil)
+	mockStore.On("GetIdempotencyKey", mock.AnythingOfType("*context.valueCtx"), account.Id, "key1").Twice().Return(nil, store.ErrNotFound)
+
+	// A concurrent retry claimed the key first, the pre-authorized record is not touched
+	mockStore.On("CreateIdempotencyKey", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("*tdrpc.IdempotencyKey")).Once().Return(store.ErrAlreadyExists)
+	_, err = s.Pay(ctx, request)
+	assert.Equal(t, tdrpc.ErrIdempotencyKeyInProgress, err)
+	mockStore.AssertNotCalled(t, "GetLedgerRecord", mock.Anything, mock.Anything, mock.Anything)
+	mockStore.AssertNotCalled(t, "UpdateLedgerRecordID", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+	// The key is released when the pre-authorized record cannot be renamed
+	mockStore.On("CreateIdempotencyKey", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("*tdrpc.IdempotencyKey")).Once().Return(nil)
+	mockStore.On("GetLedgerRecord", mock.AnythingOfType("*context.valueCtx"), preAuthLr.Id, tdrpc.OUT).Once().Return(preAuthLr, nil)
+	mockStore.On("UpdateLedgerRecordID", mock.AnythingOfType("*context.valueCtx"), preAuthLr.Id, pr.PaymentHash, tdrpc.OUT).Once().Return(store.ErrAlreadyExists)
+	mockStore.On("DeleteIdempotencyKey", mock.AnythingOfType("*context.emptyCtx"), account.Id, "key1").Once().Return(nil)
+	_, err = s.Pay(ctx, request)
+	assert.Equal(t, tdrpc.ErrRequestAlreadyPaid, err)
+
+	mockStore.AssertExpectations(t)
+	mockLClient.AssertExpectations(t)
+
+}
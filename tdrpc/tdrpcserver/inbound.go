@@ -0,0 +1,82 @@
+package tdrpcserver
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	config "github.com/spf13/viper"
+
+	"git.coinninja.net/backend/thunderdome/tdrpc"
+)
+
+// How long the inbound capacity is cached so every invoice doesn't list the channels
+const inboundCacheTime = 30 * time.Second
+
+// Values for tdome.inbound_check
+const (
+	inboundCheckWarn   = "warn"
+	inboundCheckReject = "reject"
+)
+
+// checkInbound makes sure we can receive a payment of value. Depending on tdome.inbound_check it returns a
+// warning for the response or tdrpc.ErrInsufficientInbound. A zero value only requires some inbound capacity.
+func (s *tdRPCServer) checkInbound(ctx context.Context, value int64) (string, error) {
+
+	check := config.GetString("tdome.inbound_check")
+	if check != inboundCheckWarn && check != inboundCheckReject {
+		return "", nil
+	}
+
+	inbound, err := s.inboundCapacity(ctx)
+	if err != nil {
+		// This is only advisory, don't stop the invoice from being created
+		s.logger.Errorw("Could not determine inbound capacity", "error", err)
+		return "", nil
+	}
+
+	if inbound > 0 && value <= inbound {
+		return "", nil
+	}
+
+	if check == inboundCheckReject {
+		return "", tdrpc.ErrInsufficientInbound
+	}
+
+	if value == 0 {
+		return "The node cannot currently receive payments", nil
+	}
+	return fmt.Sprintf("The node can currently receive at most %s sats in a single payment", tdrpc.FormatInt(ctx, inbound)), nil
+
+}
+
+// inboundCapacity returns the largest payment we can receive. Payments cannot be split across channels
+// so it's the most any active channel can receive less the reserve the peer has to keep.
+func (s *tdRPCServer) inboundCapacity(ctx context.Context) (int64, error) {
+
+	s.inboundLock.Lock()
+	defer s.inboundLock.Unlock()
+
+	if time.Since(s.inboundAt) < inboundCacheTime {
+		return s.inbound, nil
+	}
+
+	channels, err := s.lclient.ListChannels(ctx, &lnrpc.ListChannelsRequest{ActiveOnly: true})
+	if err != nil {
+		return 0, err
+	}
+
+	var inbound int64
+	for _, channel := range channels.Channels {
+		if receivable := channel.RemoteBalance - channel.RemoteChanReserveSat; receivable > inbound {
+			inbound = receivable
+		}
+	}
+
+	s.inbound = inbound
+	s.inboundAt = time.Now()
+
+	return inbound, nil
+
+}
@@ -28,6 +28,17 @@ func (s *tdRPCServer) Pay(ctx context.Context, request *tdrpc.PayRequest) (*tdrp
 		return nil, tdrpc.ErrAccountLocked
 	}
 
+	// Retries with the same idempotency key return the original payment
+	requestHash := idempotencyRequestHash(request)
+	if !request.Estimate {
+		replay := new(tdrpc.LedgerRecordResponse)
+		if replayed, err := s.idempotencyReplay(ctx, account.Id, tdrpc.PayEndpoint, request.IdempotencyKey, requestHash, replay); err != nil {
+			return nil, err
+		} else if replayed {
+			return replay, nil
+		}
+	}
+
 	// Decode the Request
 	pr, err := s.lclient.DecodePayReq(ctx, &lnrpc.PayReqString{PayReq: request.Request})
 	if err != nil {
@@ -62,11 +73,17 @@ func (s *tdRPCServer) Pay(ctx context.Context, request *tdrpc.PayRequest) (*tdrp
 		request.Value = pr.NumSatoshis
 	}
 
-	// If we're an agent, we are only allowed to proceed when we provide a PreAuthId
-	if isAgent(ctx) && request.PreAuthId == "" && request.Value > config.GetInt64("tdome.agent_pay_value_limit") {
+	// A bearer token may limit the value of a single payment, including one using pre-authorized funds
+	if !tokenAllowsPay(ctx, request.Value) {
 		return nil, tdrpc.ErrPermissionDenied
 	}
 
+	// Screen the destination node, it may be blocked or held for review
+	held, err := s.screenDestination(ctx, tdrpc.ScreeningTypeNode, pr.Destination)
+	if err != nil {
+		return nil, err
+	}
+
 	// Build the ledger record
 	lr := &tdrpc.LedgerRecord{
 		Id:            pr.PaymentHash,
@@ -94,6 +111,10 @@ func (s *tdRPCServer) Pay(ctx context.Context, request *tdrpc.PayRequest) (*tdrp
 		if lrIn.AccountId == lr.AccountId {
 			return nil, tdrpc.ErrCannotPaySelfInvoice
 		}
+		// Internal payments are completed immediately, they cannot wait for a hold invoice to be settled
+		if lrIn.Hold {
+			return nil, tdrpc.ErrCannotPayHoldInternal
+		}
 
 	}
 
@@ -114,6 +135,7 @@ func (s *tdRPCServer) Pay(ctx context.Context, request *tdrpc.PayRequest) (*tdrp
 		routesResponse, err := s.lclient.QueryRoutes(ctx, queryRoutesRequest)
 		if err != nil {
 			if strings.Contains(status.Convert(err).Message(), "unable to find a path") {
+				s.recordRouteFailure(ctx, pr.Destination, lr.Value)
 				return nil, tdrpc.ErrNoRouteFound
 			}
 			if strings.Contains(status.Convert(err).Message(), "target not found") {
@@ -122,6 +144,7 @@ func (s *tdRPCServer) Pay(ctx context.Context, request *tdrpc.PayRequest) (*tdrp
 			s.logger.Errorw("LND QueryRoutes Error", zap.Any("request", queryRoutesRequest), "error", err)
 			return nil, status.Errorf(codes.Internal, "LND QueryRoutes internal error")
 		} else if len(routesResponse.Routes) == 0 {
+			s.recordRouteFailure(ctx, pr.Destination, lr.Value)
 			return nil, tdrpc.ErrNoRouteFound
 		}
 		lr.NetworkFee = routesResponse.Routes[0].TotalFees
@@ -132,6 +155,11 @@ func (s *tdRPCServer) Pay(ctx context.Context, request *tdrpc.PayRequest) (*tdrp
 		return nil, status.Errorf(codes.InvalidArgument, "Required network fee too large: %d", lr.NetworkFee)
 	}
 
+	// If we're an agent, the payment including fees cannot exceed the agent key value limit, even when using pre-authorized funds
+	if agentKey := getAgentKey(ctx); agentKey != nil && lr.ValueTotal() > agentKey.ValueLimit {
+		return nil, tdrpc.ErrPermissionDenied
+	}
+
 	// If this is a payment to someone else using this service, mark the outbound records as interal
 	if pr.Destination == s.myPubKey {
 		lr.Id += tdrpc.InternalIdSuffix
@@ -144,39 +172,52 @@ func (s *tdRPCServer) Pay(ctx context.Context, request *tdrpc.PayRequest) (*tdrp
 		}, nil
 	}
 
+	// Claim the idempotency key so a concurrent retry cannot also send the payment or use the pre-authorized funds
+	if err = s.idempotencyClaim(ctx, account.Id, tdrpc.PayEndpoint, request.IdempotencyKey, requestHash); err != nil {
+		return nil, err
+	}
+
 	// Check if the request is pre-authorized and change the Id to match this request to update it
+	// If we're a delegate, the payment counts against the delegation daily limit beyond the funds it pre-authorized
+	refundDelegation := func() {}
 	if request.PreAuthId != "" {
-		preAuthLr, err := s.store.GetLedgerRecord(ctx, request.PreAuthId, tdrpc.OUT)
-		if err == store.ErrNotFound {
-			return nil, status.Errorf(codes.NotFound, "Pre-Authorized payment not found")
-		} else if err != nil {
-			s.logger.Errorw("GetLedgerRecord Error", "preauth_id", request.PreAuthId, "error", err)
-			return nil, status.Errorf(codes.Internal, "GetLedgerRecord internal error")
-		} else if preAuthLr.Status != tdrpc.PENDING || preAuthLr.Request != tdrpc.PreAuthRequest {
-			s.logger.Errorw("GetLedgerRecord Error", "preauth_id", request.PreAuthId, zap.Any("preauth_lr", preAuthLr), "error", err)
-			return nil, status.Errorf(codes.Internal, "GetLedgerRecord internal error")
+		preAuthLr, err := s.getPreAuth(ctx, account.Id, request.PreAuthId)
+		if err != nil {
+			s.idempotencyRelease(account.Id, request.IdempotencyKey)
+			return nil, err
+		}
+
+		refundDelegation, err = s.spendPreAuthDelegation(ctx, account.Id, preAuthLr, lr.ValueTotal())
+		if err != nil {
+			s.idempotencyRelease(account.Id, request.IdempotencyKey)
+			return nil, err
 		}
 
 		// We found the pre-authorizazed/reserved funds. Update the record to the current ID
 		// This could return an error if the request was already paid
-		err = s.store.UpdateLedgerRecordID(ctx, preAuthLr.Id, lr.Id, tdrpc.OUT)
+		if err = s.renamePreAuth(ctx, preAuthLr, lr.Id); err != nil {
+			refundDelegation()
+			s.idempotencyRelease(account.Id, request.IdempotencyKey)
+			return nil, err
+		}
+	} else {
+		refundDelegation, err = s.spendDelegation(ctx, account.Id, lr.ValueTotal())
 		if err != nil {
-			// Already exists means already paid
-			if err == store.ErrAlreadyExists {
-				return nil, tdrpc.ErrRequestAlreadyPaid
-			}
-			// A valid message is provided with this error
-			if status.Code(err) == codes.InvalidArgument {
-				return nil, err
-			}
-			s.logger.Errorw("UpdateLedgerRecordID Error", "prev", preAuthLr.Id, "next", lr.Id)
-			return nil, status.Errorf(codes.Internal, "UpdateLedgerRecordID internal error")
+			s.idempotencyRelease(account.Id, request.IdempotencyKey)
+			return nil, err
 		}
 	}
 
+	// Held payments reserve the funds but are not sent until they are reviewed
+	if held || (config.GetInt64("tdome.hold_pay_threshold") > 0 && lr.Value > config.GetInt64("tdome.hold_pay_threshold")) {
+		lr.Status = tdrpc.HELD
+	}
+
 	// Save the initial state - will do some sanity checking as well
 	err = s.store.ProcessLedgerRecord(ctx, lr)
 	if err != nil {
+		refundDelegation()
+		s.idempotencyRelease(account.Id, request.IdempotencyKey)
 		// A valid message is provided with this error
 		if status.Code(err) == codes.InvalidArgument {
 			return nil, err
@@ -185,6 +226,15 @@ func (s *tdRPCServer) Pay(ctx context.Context, request *tdrpc.PayRequest) (*tdrp
 		return nil, status.Errorf(codes.Internal, "ProcessLedgerRecord internal error")
 	}
 
+	if lr.Status == tdrpc.HELD {
+		s.logger.Infow("Payment Held", "id", lr.Id, "account_id", account.Id, "value", lr.Value)
+		response := &tdrpc.LedgerRecordResponse{
+			Result: lr,
+		}
+		s.idempotencySave(account.Id, request.IdempotencyKey, response)
+		return response, nil
+	}
+
 	// ********* AT THIS POINT IN TIME ALL FUNCTIONS BELOW MUST COMPLETE *********
 	// DO NOT ALLOW THE REQUEST CONTEXT TO CANCEL ANY OPERATION IN PROGRESS
 	ctx = context.Background()
@@ -193,70 +243,87 @@ func (s *tdRPCServer) Pay(ctx context.Context, request *tdrpc.PayRequest) (*tdrp
 	if pr.Destination == s.myPubKey {
 
 		// This is an internal payment, process the record
-		intLr, err := s.store.ProcessInternal(ctx, pr.PaymentHash, lr)
+		intLr, err := s.sender.Internal(ctx, pr.PaymentHash, lr)
 		if err != nil {
-
-			// Mark the original record as failed
-			lr.Status = tdrpc.FAILED
-			if prlErr := s.store.ProcessLedgerRecord(ctx, lr); prlErr != nil {
-				s.logger.Errorw("ProcessLedgerRecord Error", zap.Any("lr", lr), "error", err)
-			}
-
-			// A valid message is provided with this error
-			if status.Code(err) == codes.InvalidArgument {
-				return nil, err
-			}
-			s.logger.Errorw("ProcessInternal Error", zap.Any("lr", lr), "error", err)
-			return nil, status.Errorf(codes.Internal, "ProcessInternal error")
+			refundDelegation()
+			s.idempotencyRelease(account.Id, request.IdempotencyKey)
+			return nil, err
 		}
 
-		return &tdrpc.LedgerRecordResponse{
+		response := &tdrpc.LedgerRecordResponse{
 			Result: intLr,
-		}, nil
+		}
+		s.idempotencySave(account.Id, request.IdempotencyKey, response)
+		return response, nil
 
 	}
 
-	// Send the payment
-	sendPaymentSyncRequest := &lnrpc.SendRequest{
-		Amt:            request.Value,
-		PaymentRequest: request.Request,
+	// Send the payment and update the status and the balance - Ensure it completes outside of this request context
+	err = s.sender.Pay(ctx, lr)
+
+	// The funds are returned to the account on failure so they no longer count against the delegation
+	// A payment still in transition keeps the idempotency key claimed so retries cannot send it again
+	if lr.Status == tdrpc.FAILED {
+		refundDelegation()
+		s.idempotencyRelease(account.Id, request.IdempotencyKey)
 	}
-	response, err := s.lclient.SendPaymentSync(ctx, sendPaymentSyncRequest)
+
 	if err != nil {
-		// The payment is still in transition, it could end up getting paid. Leave it for now as pending.
-		if strings.Contains(err.Error(), "transition") { // Error should be: payment is in transition
-			lr.Status = tdrpc.PENDING
-			lr.Error = err.Error()
-		} else {
-			lr.Status = tdrpc.FAILED
-			lr.Error = err.Error()
-		}
-	} else if response.PaymentError != "" {
-		lr.Status = tdrpc.FAILED
-		lr.Error = response.PaymentError
-	} else {
-		lr.Status = tdrpc.COMPLETED
+		return nil, err
 	}
 
-	// TODO: Determine if route taken was not the same as the quoted route and account for fee difference
+	payResponse := &tdrpc.LedgerRecordResponse{
+		Result: lr,
+	}
+	if lr.Status == tdrpc.COMPLETED {
+		s.idempotencySave(account.Id, request.IdempotencyKey, payResponse)
+	}
 
-	// Update the status and the balance - Ensure it completes outside of this request context
-	if plrerr := s.store.ProcessLedgerRecord(ctx, lr); plrerr != nil {
-		// A valid message is provided with this error
-		if status.Code(plrerr) == codes.InvalidArgument {
-			return nil, plrerr
-		}
-		s.logger.Errorw("ProcessLedgerRecord Error", zap.Any("lr", lr), "error", plrerr)
-		return nil, status.Errorf(codes.Internal, "ProcessLedgerRecord internal error")
+	return payResponse, nil
+}
+
+// recordRouteFailure records a payment that could not be routed so the autopilot can propose a channel to the destination
+func (s *tdRPCServer) recordRouteFailure(ctx context.Context, destination string, value int64) {
+	if err := s.store.CreateRouteFailure(ctx, destination, value); err != nil {
+		s.logger.Errorw("CreateRouteFailure Error", "destination", destination, "value", value, "error", err)
+	}
+}
+
+// getPreAuth returns the pending pre-authorized record with id belonging to the account
+func (s *tdRPCServer) getPreAuth(ctx context.Context, accountID string, id string) (*tdrpc.LedgerRecord, error) {
+
+	preAuthLr, err := s.store.GetLedgerRecord(ctx, id, tdrpc.OUT)
+	if err == store.ErrNotFound || (err == nil && preAuthLr.AccountId != accountID) {
+		return nil, status.Errorf(codes.NotFound, "Pre-Authorized payment not found")
+	} else if err != nil {
+		s.logger.Errorw("GetLedgerRecord Error", "preauth_id", id, "error", err)
+		return nil, status.Errorf(codes.Internal, "GetLedgerRecord internal error")
+	} else if preAuthLr.Status != tdrpc.PENDING || preAuthLr.Request != tdrpc.PreAuthRequest {
+		s.logger.Errorw("GetLedgerRecord Error", "preauth_id", id, zap.Any("preauth_lr", preAuthLr), "error", err)
+		return nil, status.Errorf(codes.Internal, "GetLedgerRecord internal error")
 	}
 
-	// If there was an error, the ledger has been updated, return the error now
+	return preAuthLr, nil
+
+}
+
+// renamePreAuth changes the id of the pre-authorized record to the id of the payment using it
+func (s *tdRPCServer) renamePreAuth(ctx context.Context, preAuthLr *tdrpc.LedgerRecord, id string) error {
+
+	err := s.store.UpdateLedgerRecordID(ctx, preAuthLr.Id, id, tdrpc.OUT)
 	if err != nil {
-		s.logger.Errorw("LND SendPaymentSync Error", zap.Any("request", sendPaymentSyncRequest), "error", err)
-		return nil, status.Errorf(codes.Internal, "Could not SendPaymentSync: %v", status.Convert(err).Message())
+		// Already exists means already paid
+		if err == store.ErrAlreadyExists {
+			return tdrpc.ErrRequestAlreadyPaid
+		}
+		// A valid message is provided with this error
+		if status.Code(err) == codes.InvalidArgument {
+			return err
+		}
+		s.logger.Errorw("UpdateLedgerRecordID Error", "prev", preAuthLr.Id, "next", id)
+		return status.Errorf(codes.Internal, "UpdateLedgerRecordID internal error")
 	}
 
-	return &tdrpc.LedgerRecordResponse{
-		Result: lr,
-	}, nil
+	return nil
+
 }
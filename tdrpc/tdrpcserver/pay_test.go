@@ -23,7 +23,7 @@ func TestPay(t *testing.T) {
 	mockDCache := new(mocks.DistCache)
 
 	// RPC Server
-	s, err := NewTDRPCServer(mockStore, mockLClient, mockDCache)
+	s, err := NewTDRPCServer(mockStore, mockLClient, nil, mockDCache)
 	assert.Nil(t, err)
 
 	// Bootstrap authentication
@@ -41,6 +41,9 @@ func TestPay(t *testing.T) {
 	}
 	mockLClient.On("DecodePayReq", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("*lnrpc.PayReqString")).Once().Return(pr, nil)
 
+	// Destination screening
+	mockStore.On("GetScreeningEntriesByValue", mock.AnythingOfType("*context.valueCtx"), tdrpc.ScreeningTypeNode, pr.Destination).Once().Return([]*tdrpc.ScreeningEntry{}, nil)
+
 	// Route/Fee requests
 	route := &lnrpc.Route{
 		TotalFees: 123,
@@ -63,3 +66,97 @@ func TestPay(t *testing.T) {
 	mockLClient.AssertExpectations(t)
 
 }
+
+func TestPayNoRoute(t *testing.T) {
+
+	// Mocks
+	mockStore := new(mocks.Store)
+	mockLClient := new(mocks.LightningClient)
+	mockLClient.On("GetInfo", mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("*lnrpc.GetInfoRequest")).Once().Return(&lnrpc.GetInfoResponse{IdentityPubkey: "testing"}, nil)
+	mockDCache := new(mocks.DistCache)
+
+	// RPC Server
+	s, err := NewTDRPCServer(mockStore, mockLClient, nil, mockDCache)
+	assert.Nil(t, err)
+
+	// Bootstrap authentication
+	account := &tdrpc.Account{
+		Id:      "123123123123132132132123131123123123123132132132123131123123123333",
+		Address: "2MsoezssHTCZbeoVcZ5NgYmtNiUpyzAc5hm",
+		Balance: 10,
+	}
+	ctx := addAccount(context.Background(), account)
+
+	// Decoded payment request
+	pr := &lnrpc.PayReq{
+		Destination: "test",
+		Expiry:      time.Now().Add(time.Hour).Unix(),
+	}
+	mockLClient.On("DecodePayReq", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("*lnrpc.PayReqString")).Once().Return(pr, nil)
+
+	// Destination screening
+	mockStore.On("GetScreeningEntriesByValue", mock.AnythingOfType("*context.valueCtx"), tdrpc.ScreeningTypeNode, pr.Destination).Once().Return([]*tdrpc.ScreeningEntry{}, nil)
+
+	// No route, the failure is recorded for the autopilot
+	mockLClient.On("QueryRoutes", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("*lnrpc.QueryRoutesRequest")).Once().Return(&lnrpc.QueryRoutesResponse{}, nil)
+	mockStore.On("CreateRouteFailure", mock.AnythingOfType("*context.valueCtx"), pr.Destination, int64(20)).Once().Return(nil)
+
+	_, err = s.Pay(ctx, &tdrpc.PayRequest{
+		Request: "somerequest",
+		Value:   20,
+	})
+	assert.Equal(t, tdrpc.ErrNoRouteFound, err)
+
+	mockStore.AssertExpectations(t)
+	mockLClient.AssertExpectations(t)
+
+}
+
+func TestPayAgentValueLimit(t *testing.T) {
+
+	// Mocks
+	mockStore := new(mocks.Store)
+	mockLClient := new(mocks.LightningClient)
+	mockLClient.On("GetInfo", mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("*lnrpc.GetInfoRequest")).Once().Return(&lnrpc.GetInfoResponse{IdentityPubkey: "testing"}, nil)
+	mockDCache := new(mocks.DistCache)
+
+	// RPC Server
+	s, err := NewTDRPCServer(mockStore, mockLClient, nil, mockDCache)
+	assert.Nil(t, err)
+
+	// Bootstrap authentication as an agent
+	account := &tdrpc.Account{
+		Id:      "123123123123132132132123131123123123123132132132123131123123123333",
+		Address: "2MsoezssHTCZbeoVcZ5NgYmtNiUpyzAc5hm",
+		Balance: 1000,
+	}
+	ctx := addAgentKey(addAccount(context.Background(), account), &tdrpc.AgentKey{Id: "agent1", ValueLimit: 100})
+
+	// Decoded payment request
+	pr := &lnrpc.PayReq{
+		Destination: "test",
+		Expiry:      time.Now().Add(time.Hour).Unix(),
+	}
+	mockLClient.On("DecodePayReq", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("*lnrpc.PayReqString")).Twice().Return(pr, nil)
+	mockStore.On("GetScreeningEntriesByValue", mock.AnythingOfType("*context.valueCtx"), tdrpc.ScreeningTypeNode, pr.Destination).Twice().Return([]*tdrpc.ScreeningEntry{}, nil)
+	mockLClient.On("QueryRoutes", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("*lnrpc.QueryRoutesRequest")).Twice().Return(&lnrpc.QueryRoutesResponse{Routes: []*lnrpc.Route{{TotalFees: 20}}}, nil)
+
+	// The value is within the limit but the fee is not
+	_, err = s.Pay(ctx, &tdrpc.PayRequest{
+		Request: "somerequest",
+		Value:   90,
+	})
+	assert.Equal(t, tdrpc.ErrPermissionDenied, err)
+
+	// Pre-authorized funds do not raise the limit
+	_, err = s.Pay(ctx, &tdrpc.PayRequest{
+		Request:   "somerequest",
+		Value:     200,
+		PreAuthId: "preauth1",
+	})
+	assert.Equal(t, tdrpc.ErrPermissionDenied, err)
+
+	mockStore.AssertExpectations(t)
+	mockLClient.AssertExpectations(t)
+
+}
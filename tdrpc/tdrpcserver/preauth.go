@@ -29,6 +29,15 @@ func (s *tdRPCServer) CreatePreAuth(ctx context.Context, request *tdrpc.CreateRe
 		return nil, tdrpc.ErrAccountLocked
 	}
 
+	// Retries with the same idempotency key return the original pre-auth instead of reserving the funds again
+	requestHash := idempotencyRequestHash(request)
+	replay := new(tdrpc.LedgerRecordResponse)
+	if replayed, err := s.idempotencyReplay(ctx, account.Id, tdrpc.CreatePreAuthEndpoint, request.IdempotencyKey, requestHash, replay); err != nil {
+		return nil, err
+	} else if replayed {
+		return replay, nil
+	}
+
 	if request.Value <= 0 {
 		return nil, status.Errorf(codes.InvalidArgument, "Invalid Value")
 	}
@@ -37,8 +46,13 @@ func (s *tdRPCServer) CreatePreAuth(ctx context.Context, request *tdrpc.CreateRe
 		return nil, status.Errorf(codes.InvalidArgument, "Expires cannot be less than %s or greater than %s seconds", tdrpc.FormatInt(ctx, 300), tdrpc.FormatInt(ctx, 7776000))
 	}
 
-	// If we're an agent, we can only request a pre-auth below the tdome.agent_pay_value_limit
-	if isAgent(ctx) && request.Value > config.GetInt64("tdome.agent_pay_value_limit") {
+	// If we're an agent, we can only request a pre-auth up to the agent key value limit
+	if agentKey := getAgentKey(ctx); agentKey != nil && request.Value > agentKey.ValueLimit {
+		return nil, tdrpc.ErrPermissionDenied
+	}
+
+	// A bearer token may limit the value of a pre-auth
+	if !tokenAllowsPay(ctx, request.Value) {
 		return nil, tdrpc.ErrPermissionDenied
 	}
 
@@ -71,9 +85,33 @@ func (s *tdRPCServer) CreatePreAuth(ctx context.Context, request *tdrpc.CreateRe
 
 	s.logger.Debugw("request.preauth", zap.Any("lr", lr))
 
+	// Claim the idempotency key so a concurrent retry cannot also reserve funds
+	if err := s.idempotencyClaim(ctx, account.Id, tdrpc.CreatePreAuthEndpoint, request.IdempotencyKey, requestHash); err != nil {
+		return nil, err
+	}
+
+	// If we're a delegate, the pre-authorized funds count against the delegation daily limit
+	refundDelegation, err := s.spendDelegation(ctx, account.Id, lr.Value)
+	if err != nil {
+		s.idempotencyRelease(account.Id, request.IdempotencyKey)
+		return nil, err
+	}
+
+	// Only the delegate that created the pre-auth can pay with it
+	if delegation := getDelegation(ctx); delegation != nil {
+		if err = s.store.SavePreAuthDelegation(ctx, lr.Id, delegation.Id, account.Id); err != nil {
+			refundDelegation()
+			s.idempotencyRelease(account.Id, request.IdempotencyKey)
+			s.logger.Errorw("SavePreAuthDelegation Error", "id", lr.Id, "delegation_id", delegation.Id, "error", err)
+			return nil, status.Errorf(codes.Internal, "SavePreAuthDelegation internal error")
+		}
+	}
+
 	// Save the initial state - will do some sanity checking as well
-	err := s.store.ProcessLedgerRecord(ctx, lr)
+	err = s.store.ProcessLedgerRecord(ctx, lr)
 	if err != nil {
+		refundDelegation()
+		s.idempotencyRelease(account.Id, request.IdempotencyKey)
 		// A valid message is provided with this error
 		if status.Code(err) == codes.InvalidArgument {
 			return nil, err
@@ -82,9 +120,12 @@ func (s *tdRPCServer) CreatePreAuth(ctx context.Context, request *tdrpc.CreateRe
 		return nil, status.Errorf(codes.Internal, "ProcessLedgerRecord internal error")
 	}
 
-	return &tdrpc.LedgerRecordResponse{
+	response := &tdrpc.LedgerRecordResponse{
 		Result: lr,
-	}, nil
+	}
+	s.idempotencySave(account.Id, request.IdempotencyKey, response)
+
+	return response, nil
 
 }
 
@@ -22,7 +22,7 @@ func TestCreatePreAuth(t *testing.T) {
 	mockDCache := new(mocks.DistCache)
 
 	// RPC Server
-	s, err := NewTDRPCServer(mockStore, mockLClient, mockDCache)
+	s, err := NewTDRPCServer(mockStore, mockLClient, nil, mockDCache)
 	assert.Nil(t, err)
 
 	// Bootstrap authentication
@@ -53,6 +53,15 @@ func TestCreatePreAuth(t *testing.T) {
 	})
 	assert.Nil(t, err)
 
+	// An agent cannot exceed the value limit of its key
+	agentCtx := addAgentKey(ctx, &tdrpc.AgentKey{Id: "agent1", ValueLimit: 40})
+	_, err = s.CreatePreAuth(agentCtx, &tdrpc.CreateRequest{
+		Memo:    "test",
+		Value:   50,
+		Expires: 600,
+	})
+	assert.Equal(t, tdrpc.ErrPermissionDenied, err)
+
 	mockStore.AssertExpectations(t)
 
 }
@@ -0,0 +1,157 @@
+package tdrpcserver
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"math/big"
+
+	"github.com/btcsuite/btcd/btcec"
+
+	"git.coinninja.net/backend/thunderdome/tdrpc"
+)
+
+// Tags used for tagged hashes
+const (
+	bip340ChallengeTag = "BIP0340/challenge"
+)
+
+// TaggedHash computes the BIP340 tagged hash sha256(sha256(tag) || sha256(tag) || msgs...)
+func TaggedHash(tag string, msgs ...[]byte) []byte {
+	tagHash := sha256.Sum256([]byte(tag))
+	h := sha256.New()
+	_, _ = h.Write(tagHash[:])
+	_, _ = h.Write(tagHash[:])
+	for _, msg := range msgs {
+		_, _ = h.Write(msg)
+	}
+	return h.Sum(nil)
+}
+
+// ParseXOnlyPubKeyHexString parses a hex encoded x-only public key (or the x coordinate of a compressed public key)
+// and returns the point with an even y coordinate as specified in BIP340
+func ParseXOnlyPubKeyHexString(str string) (*btcec.PublicKey, error) {
+
+	if str == "" {
+		return nil, tdrpc.ErrInvalidPubKey
+	}
+
+	pubKeyBytes, err := hex.DecodeString(str)
+	if err != nil {
+		return nil, err
+	}
+
+	// Allow a compressed key, only the x coordinate is used
+	if len(pubKeyBytes) == btcec.PubKeyBytesLenCompressed {
+		pubKeyBytes = pubKeyBytes[1:]
+	}
+
+	if len(pubKeyBytes) != 32 {
+		return nil, tdrpc.ErrInvalidPubKey
+	}
+
+	return liftX(new(big.Int).SetBytes(pubKeyBytes))
+
+}
+
+// ParseSchnorrSignatureHexString parses a hex encoded 64 byte BIP340 signature
+func ParseSchnorrSignatureHexString(str string) ([]byte, error) {
+
+	if str == "" {
+		return nil, tdrpc.ErrInvalidSig
+	}
+
+	sig, err := hex.DecodeString(str)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(sig) != 64 {
+		return nil, tdrpc.ErrInvalidSig
+	}
+
+	return sig, nil
+}
+
+// HexEncodedXOnlyPublicKey returns a hex encoded x-only public key
+func HexEncodedXOnlyPublicKey(key *btcec.PrivateKey) string {
+	return hex.EncodeToString(key.PubKey().SerializeCompressed()[1:])
+}
+
+// VerifySchnorr verifies a BIP340 signature of a 32 byte message
+func VerifySchnorr(msg []byte, pubKey *btcec.PublicKey, sig []byte) bool {
+
+	curve := btcec.S256()
+
+	if len(msg) != 32 || len(sig) != 64 || pubKey == nil {
+		return false
+	}
+
+	// The key must be the even y point
+	P, err := liftX(pubKey.X)
+	if err != nil {
+		return false
+	}
+
+	r := new(big.Int).SetBytes(sig[:32])
+	s := new(big.Int).SetBytes(sig[32:])
+	if r.Cmp(curve.P) >= 0 || s.Cmp(curve.N) >= 0 {
+		return false
+	}
+
+	// e = int(hash(bytes(r) || bytes(P) || m)) mod n
+	e := new(big.Int).SetBytes(TaggedHash(bip340ChallengeTag, sig[:32], padBytes(P.X), msg))
+	e.Mod(e, curve.N)
+
+	// R = s*G - e*P
+	sGx, sGy := curve.ScalarBaseMult(padBytes(s))
+	ePx, ePy := curve.ScalarMult(P.X, P.Y, padBytes(new(big.Int).Sub(curve.N, e)))
+	Rx, Ry := curve.Add(sGx, sGy, ePx, ePy)
+
+	// Fail if R is infinity, has an odd y or does not match r
+	if Rx.Sign() == 0 && Ry.Sign() == 0 {
+		return false
+	}
+	if Ry.Bit(0) != 0 {
+		return false
+	}
+	return Rx.Cmp(r) == 0
+
+}
+
+// liftX returns the point with the x coordinate and an even y coordinate
+func liftX(x *big.Int) (*btcec.PublicKey, error) {
+
+	curve := btcec.S256()
+
+	if x.Cmp(curve.P) >= 0 {
+		return nil, tdrpc.ErrInvalidPubKey
+	}
+
+	// c = x^3 + 7 mod p
+	c := new(big.Int).Exp(x, big.NewInt(3), curve.P)
+	c.Add(c, curve.B)
+	c.Mod(c, curve.P)
+
+	// y = c^((p+1)/4) mod p
+	e := new(big.Int).Add(curve.P, big.NewInt(1))
+	e.Rsh(e, 2)
+	y := new(big.Int).Exp(c, e, curve.P)
+	if new(big.Int).Exp(y, big.NewInt(2), curve.P).Cmp(c) != 0 {
+		return nil, tdrpc.ErrInvalidPubKey
+	}
+
+	if y.Bit(0) != 0 {
+		y.Sub(curve.P, y)
+	}
+
+	return &btcec.PublicKey{Curve: curve, X: new(big.Int).Set(x), Y: y}, nil
+
+}
+
+// padBytes returns the 32 byte big endian representation of i
+func padBytes(i *big.Int) []byte {
+	b := make([]byte, 32)
+	ib := i.Bytes()
+	copy(b[32-len(ib):], ib)
+	return b
+}
@@ -0,0 +1,152 @@
+package tdrpcserver
+
+import (
+	"encoding/hex"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"git.coinninja.net/backend/thunderdome/tdrpc"
+)
+
+// BIP340 tags only used for signing
+const (
+	bip340AuxTag   = "BIP0340/aux"
+	bip340NonceTag = "BIP0340/nonce"
+)
+
+// SignSchnorr creates a BIP340 signature for the tests (it is not constant time) of a 32 byte message using aux as auxiliary randomness
+func SignSchnorr(key *btcec.PrivateKey, msg []byte, aux []byte) ([]byte, error) {
+
+	curve := btcec.S256()
+
+	if len(msg) != 32 || len(aux) != 32 {
+		return nil, tdrpc.ErrInvalidSig
+	}
+
+	// Use the private key matching the even y public key
+	d := new(big.Int).Set(key.D)
+	Px, Py := curve.ScalarBaseMult(padBytes(d))
+	if Py.Bit(0) != 0 {
+		d.Sub(curve.N, d)
+	}
+
+	// t = bytes(d) xor hash(a)
+	t := TaggedHash(bip340AuxTag, aux)
+	for i, b := range padBytes(d) {
+		t[i] ^= b
+	}
+
+	k := new(big.Int).SetBytes(TaggedHash(bip340NonceTag, t, padBytes(Px), msg))
+	k.Mod(k, curve.N)
+	if k.Sign() == 0 {
+		return nil, tdrpc.ErrInvalidSig
+	}
+
+	Rx, Ry := curve.ScalarBaseMult(padBytes(k))
+	if Ry.Bit(0) != 0 {
+		k.Sub(curve.N, k)
+	}
+
+	e := new(big.Int).SetBytes(TaggedHash(bip340ChallengeTag, padBytes(Rx), padBytes(Px), msg))
+	e.Mod(e, curve.N)
+
+	// s = (k + e*d) mod n
+	s := new(big.Int).Mul(e, d)
+	s.Add(s, k)
+	s.Mod(s, curve.N)
+
+	return append(padBytes(Rx), padBytes(s)...), nil
+
+}
+
+// BIP340 test vectors
+var schnorrTestVectors = []struct {
+	secKey string
+	pubKey string
+	aux    string
+	msg    string
+	sig    string
+}{
+	{
+		secKey: "0000000000000000000000000000000000000000000000000000000000000003",
+		pubKey: "F9308A019258C31049344F85F89D5229B531C845836F99B08601F113BCE036F9",
+		aux:    "0000000000000000000000000000000000000000000000000000000000000000",
+		msg:    "0000000000000000000000000000000000000000000000000000000000000000",
+		sig:    "E907831F80848D1069A5371B402410364BDF1C5F8307B0084C55F1CE2DCA821525F66A4A85EA8B71E482A74F382D2CE5EBEEE8FDB2172F477DF4900D310536C0",
+	},
+	{
+		secKey: "B7E151628AED2A6ABF7158809CF4F3C762E7160F38B4DA56A784D9045190CFEF",
+		pubKey: "DFF1D77F2A671C5F36183726DB2341BE58FEAE1DA2DECED843240F7B502BA659",
+		aux:    "0000000000000000000000000000000000000000000000000000000000000001",
+		msg:    "243F6A8885A308D313198A2E03707344A4093822299F31D0082EFA98EC4E6C89",
+		sig:    "6896BD60EEAE296DB48A229FF71DFE071BDE413E6D43F917DC8DCF8C78DE33418906D11AC976ABCCB20B091292BFF4EA897EFCB639EA871CFA95F6DE339E4B0A",
+	},
+}
+
+func mustDecodeHex(t *testing.T, s string) []byte {
+	b, err := hex.DecodeString(s)
+	require.Nil(t, err)
+	return b
+}
+
+func TestSchnorrTestVectors(t *testing.T) {
+
+	for _, v := range schnorrTestVectors {
+		key, _ := btcec.PrivKeyFromBytes(btcec.S256(), mustDecodeHex(t, v.secKey))
+		assert.Equal(t, strings.ToLower(v.pubKey), HexEncodedXOnlyPublicKey(key))
+
+		sig, err := SignSchnorr(key, mustDecodeHex(t, v.msg), mustDecodeHex(t, v.aux))
+		assert.Nil(t, err)
+		assert.Equal(t, strings.ToLower(v.sig), hex.EncodeToString(sig))
+
+		pubKey, err := ParseXOnlyPubKeyHexString(strings.ToLower(v.pubKey))
+		assert.Nil(t, err)
+		assert.True(t, VerifySchnorr(mustDecodeHex(t, v.msg), pubKey, mustDecodeHex(t, v.sig)))
+
+		// Tamper with the message
+		msg := mustDecodeHex(t, v.msg)
+		msg[0] ^= 0x01
+		assert.False(t, VerifySchnorr(msg, pubKey, mustDecodeHex(t, v.sig)))
+	}
+
+}
+
+func TestValidateSchnorrTimestampAndNonceSigntature(t *testing.T) {
+
+	key, err := NewKey()
+	require.Nil(t, err)
+	aux := make([]byte, 32)
+
+	timeString := time.Now().UTC().Format(time.RFC3339)
+	nonce := "SomeRandomString"
+
+	// Schnorr with an x-only public key
+	sig, err := SignSchnorr(key, chainhash.HashB([]byte(timeString+nonce)), aux)
+	require.Nil(t, err)
+	err = ValidateTimestampAndNonceSigntature(SigTypeSchnorr, timeString, nonce, HexEncodedXOnlyPublicKey(key), hex.EncodeToString(sig), time.Now().UTC(), 10*time.Minute)
+	assert.Nil(t, err)
+
+	// Schnorr with a compressed public key
+	err = ValidateTimestampAndNonceSigntature(SigTypeSchnorr, timeString, nonce, HexEncodedPublicKey(key), hex.EncodeToString(sig), time.Now().UTC(), 10*time.Minute)
+	assert.Nil(t, err)
+
+	// The schnorr signature is not valid as a BIP322 signature
+	err = ValidateTimestampAndNonceSigntature(SigTypeBIP322, timeString, nonce, HexEncodedXOnlyPublicKey(key), hex.EncodeToString(sig), time.Now().UTC(), 10*time.Minute)
+	assert.Equal(t, tdrpc.ErrInvalidSig, err)
+
+	// ECDSA does not accept x-only keys
+	err = ValidateTimestampAndNonceSigntature(SigTypeECDSA, timeString, nonce, HexEncodedXOnlyPublicKey(key), hex.EncodeToString(sig), time.Now().UTC(), 10*time.Minute)
+	assert.Equal(t, tdrpc.ErrInvalidPubKey, err)
+
+	// Unknown signature type
+	err = ValidateTimestampAndNonceSigntature("unknown", timeString, nonce, HexEncodedXOnlyPublicKey(key), hex.EncodeToString(sig), time.Now().UTC(), 10*time.Minute)
+	assert.Equal(t, tdrpc.ErrInvalidSigType, err)
+
+}
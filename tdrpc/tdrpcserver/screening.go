@@ -0,0 +1,47 @@
+package tdrpcserver
+
+import (
+	"context"
+
+	config "github.com/spf13/viper"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"git.coinninja.net/backend/thunderdome/tdrpc"
+)
+
+// screenDestination checks a destination against the allow and deny lists
+// It returns tdrpc.ErrDestinationBlocked if the destination is blocked and true if the transaction must be held for review
+func (s *tdRPCServer) screenDestination(ctx context.Context, screeningType string, value string) (bool, error) {
+
+	value = tdrpc.NormalizeScreeningValue(screeningType, value)
+
+	entries, err := s.store.GetScreeningEntriesByValue(ctx, screeningType, value)
+	if err != nil {
+		s.logger.Errorw("GetScreeningEntriesByValue Error", "type", screeningType, "value", value, "error", err)
+		return false, status.Errorf(codes.Internal, "GetScreeningEntriesByValue internal error")
+	}
+
+	var allowed bool
+	for _, entry := range entries {
+		switch entry.List {
+		case tdrpc.ScreeningListDeny:
+			s.logger.Warnw("Screening Match", "entry_id", entry.Id, "type", screeningType, "value", value, "action", entry.Action)
+			if entry.Action == tdrpc.ScreeningActionHold {
+				return true, nil
+			}
+			return false, tdrpc.ErrDestinationBlocked
+		case tdrpc.ScreeningListAllow:
+			allowed = true
+		}
+	}
+
+	// If only allowed destinations can be used, block anything else
+	if !allowed && config.GetBool("tdome.screening_allow_only_"+screeningType) {
+		s.logger.Warnw("Screening Not Allowed", "type", screeningType, "value", value)
+		return false, tdrpc.ErrDestinationBlocked
+	}
+
+	return false, nil
+
+}
@@ -0,0 +1,185 @@
+package tdrpcserver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	config "github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	_ "git.coinninja.net/backend/thunderdome/conf"
+	"git.coinninja.net/backend/thunderdome/mocks"
+	"git.coinninja.net/backend/thunderdome/tdrpc"
+)
+
+func TestScreenDestination(t *testing.T) {
+
+	// Mocks
+	mockStore := new(mocks.Store)
+	mockLClient := new(mocks.LightningClient)
+	mockLClient.On("GetInfo", mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("*lnrpc.GetInfoRequest")).Once().Return(&lnrpc.GetInfoResponse{IdentityPubkey: "testing"}, nil)
+	mockDCache := new(mocks.DistCache)
+
+	// RPC Server
+	s, err := newTDRPCServer(mockStore, mockLClient, nil, mockDCache)
+	require.Nil(t, err)
+
+	ctx := context.Background()
+
+	// Not on any list
+	mockStore.On("GetScreeningEntriesByValue", ctx, tdrpc.ScreeningTypeAddress, "bc1qclear").Once().Return([]*tdrpc.ScreeningEntry{}, nil)
+	held, err := s.screenDestination(ctx, tdrpc.ScreeningTypeAddress, "BC1QCLEAR")
+	assert.Nil(t, err)
+	assert.False(t, held)
+
+	// Denied
+	mockStore.On("GetScreeningEntriesByValue", ctx, tdrpc.ScreeningTypeAddress, "bc1qblock").Once().Return([]*tdrpc.ScreeningEntry{
+		{Id: "1", List: tdrpc.ScreeningListDeny, Type: tdrpc.ScreeningTypeAddress, Value: "bc1qblock", Action: tdrpc.ScreeningActionBlock},
+	}, nil)
+	_, err = s.screenDestination(ctx, tdrpc.ScreeningTypeAddress, "bc1qblock")
+	assert.Equal(t, tdrpc.ErrDestinationBlocked, err)
+
+	// Held for review
+	mockStore.On("GetScreeningEntriesByValue", ctx, tdrpc.ScreeningTypeNode, "02abc").Once().Return([]*tdrpc.ScreeningEntry{
+		{Id: "2", List: tdrpc.ScreeningListDeny, Type: tdrpc.ScreeningTypeNode, Value: "02abc", Action: tdrpc.ScreeningActionHold},
+	}, nil)
+	held, err = s.screenDestination(ctx, tdrpc.ScreeningTypeNode, "02ABC")
+	assert.Nil(t, err)
+	assert.True(t, held)
+
+	// Only allowed destinations
+	config.Set("tdome.screening_allow_only_node", true)
+	defer config.Set("tdome.screening_allow_only_node", false)
+	mockStore.On("GetScreeningEntriesByValue", ctx, tdrpc.ScreeningTypeNode, "02def").Once().Return([]*tdrpc.ScreeningEntry{}, nil)
+	_, err = s.screenDestination(ctx, tdrpc.ScreeningTypeNode, "02def")
+	assert.Equal(t, tdrpc.ErrDestinationBlocked, err)
+	mockStore.On("GetScreeningEntriesByValue", ctx, tdrpc.ScreeningTypeNode, "02fed").Once().Return([]*tdrpc.ScreeningEntry{
+		{Id: "3", List: tdrpc.ScreeningListAllow, Type: tdrpc.ScreeningTypeNode, Value: "02fed"},
+	}, nil)
+	held, err = s.screenDestination(ctx, tdrpc.ScreeningTypeNode, "02fed")
+	assert.Nil(t, err)
+	assert.False(t, held)
+
+	mockStore.AssertExpectations(t)
+
+}
+
+func TestPayHeld(t *testing.T) {
+
+	// Mocks
+	mockStore := new(mocks.Store)
+	mockLClient := new(mocks.LightningClient)
+	mockLClient.On("GetInfo", mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("*lnrpc.GetInfoRequest")).Once().Return(&lnrpc.GetInfoResponse{IdentityPubkey: "testing"}, nil)
+	mockDCache := new(mocks.DistCache)
+
+	// RPC Server
+	s, err := newTDRPCServer(mockStore, mockLClient, nil, mockDCache)
+	require.Nil(t, err)
+
+	account := &tdrpc.Account{Id: "pubkey:test", Balance: 1000}
+	ctx := addAccount(context.Background(), account)
+
+	pr := &lnrpc.PayReq{
+		Destination: "02abc",
+		NumSatoshis: 100,
+		Timestamp:   4102444800,
+	}
+	mockLClient.On("DecodePayReq", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("*lnrpc.PayReqString")).Once().Return(pr, nil)
+	mockStore.On("GetScreeningEntriesByValue", mock.AnythingOfType("*context.valueCtx"), tdrpc.ScreeningTypeNode, pr.Destination).Once().Return([]*tdrpc.ScreeningEntry{
+		{Id: "1", List: tdrpc.ScreeningListDeny, Type: tdrpc.ScreeningTypeNode, Value: pr.Destination, Action: tdrpc.ScreeningActionHold},
+	}, nil)
+	mockLClient.On("QueryRoutes", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("*lnrpc.QueryRoutesRequest")).Once().Return(&lnrpc.QueryRoutesResponse{Routes: []*lnrpc.Route{{TotalFees: 1}}}, nil)
+	mockStore.On("ProcessLedgerRecord", mock.AnythingOfType("*context.valueCtx"), mock.MatchedBy(func(lr *tdrpc.LedgerRecord) bool {
+		return lr.Status == tdrpc.HELD
+	})).Once().Return(nil)
+
+	// The payment is held and never sent
+	response, err := s.Pay(ctx, &tdrpc.PayRequest{Request: "somerequest"})
+	assert.Nil(t, err)
+	assert.Equal(t, tdrpc.HELD, response.Result.Status)
+
+	mockStore.AssertExpectations(t)
+	mockLClient.AssertExpectations(t)
+
+}
+
+func TestPayHoldThreshold(t *testing.T) {
+
+	config.Set("tdome.hold_pay_threshold", 50)
+	defer config.Set("tdome.hold_pay_threshold", 0)
+
+	// Mocks
+	mockStore := new(mocks.Store)
+	mockLClient := new(mocks.LightningClient)
+	mockLClient.On("GetInfo", mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("*lnrpc.GetInfoRequest")).Once().Return(&lnrpc.GetInfoResponse{IdentityPubkey: "testing"}, nil)
+	mockDCache := new(mocks.DistCache)
+
+	// RPC Server
+	s, err := newTDRPCServer(mockStore, mockLClient, nil, mockDCache)
+	require.Nil(t, err)
+
+	account := &tdrpc.Account{Id: "pubkey:test", Balance: 1000}
+	ctx := addAccount(context.Background(), account)
+
+	pr := &lnrpc.PayReq{
+		Destination: "02abc",
+		NumSatoshis: 100,
+		Timestamp:   4102444800,
+	}
+	mockLClient.On("DecodePayReq", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("*lnrpc.PayReqString")).Once().Return(pr, nil)
+	mockStore.On("GetScreeningEntriesByValue", mock.AnythingOfType("*context.valueCtx"), tdrpc.ScreeningTypeNode, pr.Destination).Once().Return([]*tdrpc.ScreeningEntry{}, nil)
+	mockLClient.On("QueryRoutes", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("*lnrpc.QueryRoutesRequest")).Once().Return(&lnrpc.QueryRoutesResponse{Routes: []*lnrpc.Route{{TotalFees: 1}}}, nil)
+	mockStore.On("ProcessLedgerRecord", mock.AnythingOfType("*context.valueCtx"), mock.MatchedBy(func(lr *tdrpc.LedgerRecord) bool {
+		return lr.Status == tdrpc.HELD
+	})).Once().Return(nil)
+
+	// The payment is above the threshold so it is held and never sent
+	response, err := s.Pay(ctx, &tdrpc.PayRequest{Request: "somerequest"})
+	assert.Nil(t, err)
+	assert.Equal(t, tdrpc.HELD, response.Result.Status)
+
+	mockStore.AssertExpectations(t)
+	mockLClient.AssertExpectations(t)
+
+}
+
+func TestWithdrawHoldThreshold(t *testing.T) {
+
+	config.Set("tdome.hold_withdraw_threshold", 10000)
+	defer config.Set("tdome.hold_withdraw_threshold", 0)
+
+	// Mocks
+	mockStore := new(mocks.Store)
+	mockLClient := new(mocks.LightningClient)
+	mockLClient.On("GetInfo", mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("*lnrpc.GetInfoRequest")).Once().Return(&lnrpc.GetInfoResponse{IdentityPubkey: "testing"}, nil)
+	mockDCache := new(mocks.DistCache)
+
+	// RPC Server
+	s, err := newTDRPCServer(mockStore, mockLClient, nil, mockDCache)
+	require.Nil(t, err)
+
+	account := &tdrpc.Account{Id: "pubkey:test", Balance: 100000}
+	ctx := addAccount(context.Background(), account)
+
+	address := "2MsoezssHTCZbeoVcZ5NgYmtNiUpyzAc5hm"
+	mockStore.On("GetLedgerRecordStats", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("map[string]string"), mock.AnythingOfType("time.Time")).Once().Return(&tdrpc.LedgerRecordStats{}, nil)
+	mockStore.On("GetScreeningEntriesByValue", mock.AnythingOfType("*context.valueCtx"), tdrpc.ScreeningTypeAddress, address).Once().Return([]*tdrpc.ScreeningEntry{}, nil)
+	mockLClient.On("EstimateFee", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("*lnrpc.EstimateFeeRequest")).Once().Return(&lnrpc.EstimateFeeResponse{FeeSat: 2400, FeerateSatPerByte: 12}, nil)
+
+	// The quoted fee rate is kept to send it when it's approved
+	mockStore.On("ProcessLedgerRecord", mock.AnythingOfType("*context.valueCtx"), mock.MatchedBy(func(lr *tdrpc.LedgerRecord) bool {
+		return lr.Status == tdrpc.HELD && lr.SatPerByte == 12
+	})).Once().Return(nil)
+
+	// The withdraw is above the threshold so it is held and never sent
+	response, err := s.Withdraw(ctx, &tdrpc.WithdrawRequest{Address: address, Value: 50000})
+	assert.Nil(t, err)
+	assert.Equal(t, tdrpc.HELD, response.Result.Status)
+
+	mockStore.AssertExpectations(t)
+	mockLClient.AssertExpectations(t)
+
+}
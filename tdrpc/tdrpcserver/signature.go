@@ -9,6 +9,16 @@ import (
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 )
 
+// Supported signature types passed in the sigtype metadata header
+const (
+	// SigTypeECDSA is a DER encoded ECDSA signature of the double sha256 of the payload (the default)
+	SigTypeECDSA = "ecdsa"
+	// SigTypeSchnorr is a BIP340 signature of the sha256 of the payload
+	SigTypeSchnorr = "schnorr"
+	// SigTypeBIP322 is a base64 encoded BIP322 simple signature of the payload by the taproot address of the public key
+	SigTypeBIP322 = "bip322"
+)
+
 // ParsePubKeyHexString parses a hex encoded string to a public key struct
 func ParsePubKeyHexString(str string) (*btcec.PublicKey, error) {
 
@@ -71,8 +81,45 @@ func ValidateSigntature(payload string, pubKeyHexString string, sigHexString str
 
 }
 
-// ValidateTimestampAndNonceSigntature will validate a timestamp string ensuring proper time window
-func ValidateTimestampAndNonceSigntature(timeString string, nonce string, pubKeyHexString string, sigHexString string, referenceTime time.Time) error {
+// ValidateSchnorrSigntature will validate a BIP340 signature of a 32 byte message hash
+func ValidateSchnorrSigntature(msg []byte, pubKeyHexString string, sigHexString string) error {
+
+	pubKey, err := ParseXOnlyPubKeyHexString(pubKeyHexString)
+	if err != nil {
+		return tdrpc.ErrInvalidPubKey
+	}
+
+	sig, err := ParseSchnorrSignatureHexString(sigHexString)
+	if err != nil {
+		return tdrpc.ErrInvalidSig
+	}
+
+	if !VerifySchnorr(msg, pubKey, sig) {
+		return tdrpc.ErrSigVerficationFailed
+	}
+
+	return nil
+
+}
+
+// ValidateSigntatureType will validate a signature of anything using the specified signature type
+func ValidateSigntatureType(sigType string, payload string, pubKeyHexString string, sigHexString string) error {
+
+	switch sigType {
+	case "", SigTypeECDSA:
+		return ValidateSigntature(payload, pubKeyHexString, sigHexString)
+	case SigTypeSchnorr:
+		return ValidateSchnorrSigntature(chainhash.HashB([]byte(payload)), pubKeyHexString, sigHexString)
+	case SigTypeBIP322:
+		return ValidateBIP322Signature([]byte(payload), pubKeyHexString, sigHexString)
+	}
+
+	return tdrpc.ErrInvalidSigType
+
+}
+
+// ValidateTimestampAndNonceSigntature will validate a timestamp string ensuring it is within window of referenceTime
+func ValidateTimestampAndNonceSigntature(sigType string, timeString string, nonce string, pubKeyHexString string, sigHexString string, referenceTime time.Time, window time.Duration) error {
 
 	if timeString == "" {
 		return tdrpc.ErrInvalidTimestamp
@@ -83,13 +130,12 @@ func ValidateTimestampAndNonceSigntature(timeString string, nonce string, pubKey
 		return tdrpc.ErrInvalidTimestamp
 	}
 
-	// If timestamp is +/- 10 mintues from server then fail
+	// If timestamp is outside +/- window from server then fail
 	dt := referenceTime.UTC().Sub(t)
-	delta := 10 * time.Minute
-	if dt < -delta || dt > delta {
+	if dt < -window || dt > window {
 		return tdrpc.ErrInvalidTimestampOffset
 	}
 
-	return ValidateSigntature(timeString+nonce, pubKeyHexString, sigHexString)
+	return ValidateSigntatureType(sigType, timeString+nonce, pubKeyHexString, sigHexString)
 
 }
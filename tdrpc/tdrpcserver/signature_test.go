@@ -11,6 +11,8 @@ import (
 	"github.com/davecgh/go-spew/spew"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"git.coinninja.net/backend/thunderdome/tdrpc"
 )
 
 const (
@@ -59,7 +61,15 @@ func TestValidateTimestampAndNonceSigntature(t *testing.T) {
 	require.Nil(t, err)
 	sigHexString := hex.EncodeToString(sig.Serialize())
 
-	err = ValidateTimestampAndNonceSigntature(timeString, nonce, pubKeyHexString, sigHexString, time.Now().UTC())
+	err = ValidateTimestampAndNonceSigntature(SigTypeECDSA, timeString, nonce, pubKeyHexString, sigHexString, time.Now().UTC(), 10*time.Minute)
+	assert.Nil(t, err)
+
+	// Outside of the window
+	err = ValidateTimestampAndNonceSigntature(SigTypeECDSA, timeString, nonce, pubKeyHexString, sigHexString, time.Now().UTC().Add(15*time.Minute), 10*time.Minute)
+	assert.Equal(t, tdrpc.ErrInvalidTimestampOffset, err)
+
+	// Larger window
+	err = ValidateTimestampAndNonceSigntature(SigTypeECDSA, timeString, nonce, pubKeyHexString, sigHexString, time.Now().UTC().Add(15*time.Minute), 20*time.Minute)
 	assert.Nil(t, err)
 }
 
@@ -3,13 +3,17 @@ package tdrpcserver
 import (
 	"context"
 	"fmt"
+	"sync"
+	"time"
 
 	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/invoicesrpc"
 	config "github.com/spf13/viper"
 	"go.uber.org/zap"
 
 	"git.coinninja.net/backend/thunderdome/store"
 	"git.coinninja.net/backend/thunderdome/tdrpc"
+	"git.coinninja.net/backend/thunderdome/tdrpc/sender"
 )
 
 type tdRPCServer struct {
@@ -18,13 +22,22 @@ type tdRPCServer struct {
 	cache    store.DistCache
 	myPubKey string
 	lclient  lnrpc.LightningClient
+	iclient  invoicesrpc.InvoicesClient
+	sender   *sender.Sender
+
+	// The largest payment we can receive, cached for inboundCacheTime
+	inbound     int64
+	inboundAt   time.Time
+	inboundLock sync.Mutex
 }
 
 type contextKey string
 
 const (
-	contextKeyAccount = "account"
-	contextKeyAgent   = "agent"
+	contextKeyAccount    = "account"
+	contextKeyAgent      = "agent"
+	contextKeyDelegation = "delegation"
+	contextKeyToken      = "token"
 )
 
 // addAccount will include the authenticated account to the RPC context
@@ -41,23 +54,33 @@ func getAccount(ctx context.Context) *tdrpc.Account {
 	return nil
 }
 
-// Returns if it's being called on behalf of the agent
-func isAgent(ctx context.Context) bool {
-	agent, ok := ctx.Value(contextKey(contextKeyAgent)).(bool)
+// addAgentKey will include the authenticated agent key in the RPC context
+func addAgentKey(ctx context.Context, agentKey *tdrpc.AgentKey) context.Context {
+	return context.WithValue(ctx, contextKey(contextKeyAgent), agentKey)
+}
+
+// getAgentKey returns the agent key if it's being called on behalf of an agent (returning nil if not)
+func getAgentKey(ctx context.Context) *tdrpc.AgentKey {
+	agentKey, ok := ctx.Value(contextKey(contextKeyAgent)).(*tdrpc.AgentKey)
 	if ok {
-		return agent
+		return agentKey
 	}
-	return false
+	return nil
+}
+
+// Returns if it's being called on behalf of an agent
+func isAgent(ctx context.Context) bool {
+	return getAgentKey(ctx) != nil
 }
 
 // NewTDRPCServer creates the server
-func NewTDRPCServer(store tdrpc.Store, lclient lnrpc.LightningClient, cache store.DistCache) (tdrpc.ThunderdomeRPCServer, error) {
+func NewTDRPCServer(store tdrpc.Store, lclient lnrpc.LightningClient, iclient invoicesrpc.InvoicesClient, cache store.DistCache) (tdrpc.ThunderdomeRPCServer, error) {
 
-	return newTDRPCServer(store, lclient, cache)
+	return newTDRPCServer(store, lclient, iclient, cache)
 
 }
 
-func newTDRPCServer(store tdrpc.Store, lclient lnrpc.LightningClient, cache store.DistCache) (*tdRPCServer, error) {
+func newTDRPCServer(store tdrpc.Store, lclient lnrpc.LightningClient, iclient invoicesrpc.InvoicesClient, cache store.DistCache) (*tdRPCServer, error) {
 
 	info, err := lclient.GetInfo(context.Background(), &lnrpc.GetInfoRequest{})
 	if err != nil {
@@ -71,6 +94,8 @@ func newTDRPCServer(store tdrpc.Store, lclient lnrpc.LightningClient, cache stor
 		cache:    cache,
 		myPubKey: info.IdentityPubkey,
 		lclient:  lclient,
+		iclient:  iclient,
+		sender:   sender.NewSender(store, lclient),
 	}
 
 	if config.GetBool("tdome.disable_auth") {
@@ -0,0 +1,191 @@
+package tdrpcserver
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
+
+	emptypb "github.com/golang/protobuf/ptypes/empty"
+	config "github.com/spf13/viper"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"git.coinninja.net/backend/thunderdome/tdrpc"
+)
+
+const (
+	// MetadataAuthorization is the metadata field holding a bearer token
+	MetadataAuthorization = "authorization"
+	tokenBearerPrefix     = "bearer "
+	tokenRevokedBucket    = "token_revoked"
+)
+
+// CreateToken creates a bearer token that can be used instead of signing each request
+func (s *tdRPCServer) CreateToken(ctx context.Context, request *tdrpc.CreateTokenRequest) (*tdrpc.CreateTokenResponse, error) {
+
+	// Get the authenticated user from the context
+	account := getAccount(ctx)
+	if account == nil {
+		return nil, tdrpc.ErrNotFound
+	}
+
+	// Tokens cannot be used to create more tokens
+	if getToken(ctx) != nil {
+		return nil, tdrpc.ErrPermissionDenied
+	}
+
+	rootKey := config.GetString("tdome.token_secret")
+	if rootKey == "" {
+		return nil, status.Errorf(codes.Unimplemented, "tokens are not enabled")
+	}
+
+	if request.Expires == 0 {
+		request.Expires = config.GetInt64("tdome.token_default_expires")
+	}
+	if request.Expires < 0 || request.Expires > config.GetInt64("tdome.token_max_expires") {
+		return nil, status.Errorf(codes.InvalidArgument, "Expires cannot be greater than %s seconds", tdrpc.FormatInt(ctx, config.GetInt64("tdome.token_max_expires")))
+	}
+
+	if request.MaxPay < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid max pay")
+	}
+
+	for _, rpc := range request.Rpcs {
+		if !tdrpc.TokenRPCs[rpc] {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid rpc %s", rpc)
+		}
+	}
+
+	// Generate a random id for the token
+	randomID := make([]byte, 16)
+	if _, err := rand.Read(randomID); err != nil {
+		return nil, status.Errorf(codes.Internal, "could not generate id")
+	}
+
+	expiresAt := time.Now().UTC().Add(time.Duration(request.Expires) * time.Second).Truncate(time.Second)
+	caveats := []string{tdrpc.TokenCaveatExpires + "=" + expiresAt.Format(time.RFC3339)}
+	if len(request.Rpcs) > 0 {
+		caveats = append(caveats, tdrpc.TokenCaveatRPC+"="+strings.Join(request.Rpcs, ","))
+	}
+	if request.MaxPay > 0 {
+		caveats = append(caveats, tdrpc.TokenCaveatMaxPay+"="+strconv.FormatInt(request.MaxPay, 10))
+	}
+
+	token := tdrpc.NewToken([]byte(rootKey), hex.EncodeToString(randomID), account.Id, caveats...)
+	encoded, err := token.Encode()
+	if err != nil {
+		s.logger.Errorw("Token Encode Error", "error", err)
+		return nil, status.Errorf(codes.Internal, "Token internal error")
+	}
+
+	s.logger.Infow("Token Created", "token_id", token.Id, "account_id", account.Id)
+
+	return &tdrpc.CreateTokenResponse{
+		Id:        token.Id,
+		Token:     encoded,
+		ExpiresAt: &expiresAt,
+	}, nil
+
+}
+
+// RevokeToken revokes a bearer token so it can no longer be used
+func (s *tdRPCServer) RevokeToken(ctx context.Context, request *tdrpc.Id) (*emptypb.Empty, error) {
+
+	// Get the authenticated user from the context
+	account := getAccount(ctx)
+	if account == nil {
+		return nil, tdrpc.ErrNotFound
+	}
+
+	if request.Id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid id")
+	}
+
+	// The token can be forgotten once it would have expired anyhow
+	err := s.cache.Set(tokenRevokedBucket, account.Id+":"+request.Id, 1, time.Duration(config.GetInt64("tdome.token_max_expires"))*time.Second)
+	if err != nil {
+		s.logger.Errorw("DistCache Set Error", "error", err, "token_id", request.Id)
+		return nil, status.Errorf(codes.Internal, "DistCache Error: %v", err)
+	}
+
+	s.logger.Infow("Token Revoked", "token_id", request.Id, "account_id", account.Id)
+
+	return &emptypb.Empty{}, nil
+
+}
+
+// addToken will include the bearer token used to authenticate in the RPC context
+func addToken(ctx context.Context, token *tdrpc.Token) context.Context {
+	return context.WithValue(ctx, contextKey(contextKeyToken), token)
+}
+
+// getToken returns the bearer token if one was used to authenticate (returning nil if not)
+func getToken(ctx context.Context) *tdrpc.Token {
+	token, ok := ctx.Value(contextKey(contextKeyToken)).(*tdrpc.Token)
+	if ok {
+		return token
+	}
+	return nil
+}
+
+// bearerToken returns the bearer token from the request metadata if there is one
+func bearerToken(md metadata.MD) string {
+	authorization := mdfirst(md, MetadataAuthorization)
+	if len(authorization) > len(tokenBearerPrefix) && strings.EqualFold(authorization[:len(tokenBearerPrefix)], tokenBearerPrefix) {
+		return strings.TrimSpace(authorization[len(tokenBearerPrefix):])
+	}
+	return ""
+}
+
+// authToken validates a bearer token and ensures its caveats allow calling endpoint
+func (s *tdRPCServer) authToken(endpoint string, encodedToken string) (*tdrpc.Token, error) {
+
+	rootKey := config.GetString("tdome.token_secret")
+	if rootKey == "" {
+		return nil, tdrpc.ErrInvalidToken
+	}
+
+	token, err := tdrpc.ParseToken(encodedToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if !token.Verify([]byte(rootKey)) {
+		return nil, tdrpc.ErrInvalidToken
+	}
+
+	// A token can never be used to create another token
+	if endpoint == tdrpc.CreateTokenEndpoint {
+		return nil, tdrpc.ErrPermissionDenied
+	}
+
+	if err = token.Check(endpoint, time.Now().UTC()); err != nil {
+		return nil, err
+	}
+
+	revoked, err := s.cache.Exists(tokenRevokedBucket, token.AccountId+":"+token.Id)
+	if err != nil {
+		s.logger.Errorw("DistCache Exists Error", "error", err, "token_id", token.Id)
+		return nil, status.Errorf(codes.Internal, "DistCache Error: %v", err)
+	}
+	if revoked {
+		return nil, tdrpc.ErrInvalidToken
+	}
+
+	return token, nil
+
+}
+
+// tokenAllowsPay returns false if the bearer token in the context (if any) does not allow paying value
+func tokenAllowsPay(ctx context.Context, value int64) bool {
+	if token := getToken(ctx); token != nil {
+		if maxPay, ok := token.MaxPay(); ok && value > maxPay {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,120 @@
+package tdrpcserver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	config "github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+
+	_ "git.coinninja.net/backend/thunderdome/conf"
+	"git.coinninja.net/backend/thunderdome/mocks"
+	"git.coinninja.net/backend/thunderdome/tdrpc"
+)
+
+func TestAuthFuncOverrideToken(t *testing.T) {
+
+	config.Set("tdome.token_secret", "testing")
+	defer config.Set("tdome.token_secret", "")
+
+	// Mocks
+	mockStore := new(mocks.Store)
+	mockLClient := new(mocks.LightningClient)
+	mockLClient.On("GetInfo", mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("*lnrpc.GetInfoRequest")).Once().Return(&lnrpc.GetInfoResponse{IdentityPubkey: "testing"}, nil)
+	mockDCache := new(mocks.DistCache)
+
+	// RPC Server
+	s, err := newTDRPCServer(mockStore, mockLClient, nil, mockDCache)
+	require.Nil(t, err)
+
+	account := &tdrpc.Account{Id: "pubkey:test"}
+
+	// Create a token limited to Pay
+	response, err := s.CreateToken(addAccount(context.Background(), account), &tdrpc.CreateTokenRequest{Rpcs: []string{"Pay"}, MaxPay: 100})
+	require.Nil(t, err)
+	assert.NotEmpty(t, response.Id)
+	assert.True(t, response.ExpiresAt.After(time.Now()))
+
+	// Invalid rpc
+	_, err = s.CreateToken(addAccount(context.Background(), account), &tdrpc.CreateTokenRequest{Rpcs: []string{"CreateToken"}})
+	assert.NotNil(t, err)
+
+	md := metadata.Pairs(MetadataAuthorization, "Bearer "+response.Token)
+
+	// Valid token
+	mockDCache.On("Exists", tokenRevokedBucket, account.Id+":"+response.Id).Once().Return(false, nil)
+	mockStore.On("GetAccountByID", mock.AnythingOfType("*context.valueCtx"), account.Id).Once().Return(account, nil)
+	ctx, err := s.AuthFuncOverride(metadata.NewIncomingContext(context.Background(), md), tdrpc.PayEndpoint)
+	assert.Nil(t, err)
+	assert.Equal(t, account, getAccount(ctx))
+	assert.NotNil(t, getToken(ctx))
+	assert.True(t, tokenAllowsPay(ctx, 100))
+	assert.False(t, tokenAllowsPay(ctx, 101))
+
+	// Tokens cannot create tokens
+	_, err = s.CreateToken(ctx, &tdrpc.CreateTokenRequest{})
+	assert.Equal(t, tdrpc.ErrPermissionDenied, err)
+	_, err = s.AuthFuncOverride(metadata.NewIncomingContext(context.Background(), md), tdrpc.CreateTokenEndpoint)
+	assert.Equal(t, tdrpc.ErrPermissionDenied, err)
+
+	// Not allowed by the rpc caveat
+	_, err = s.AuthFuncOverride(metadata.NewIncomingContext(context.Background(), md), tdrpc.CreateEndpoint)
+	assert.Equal(t, tdrpc.ErrPermissionDenied, err)
+
+	// Revoked
+	mockDCache.On("Set", tokenRevokedBucket, account.Id+":"+response.Id, 1, mock.AnythingOfType("time.Duration")).Once().Return(nil)
+	_, err = s.RevokeToken(addAccount(context.Background(), account), &tdrpc.Id{Id: response.Id})
+	assert.Nil(t, err)
+	mockDCache.On("Exists", tokenRevokedBucket, account.Id+":"+response.Id).Once().Return(true, nil)
+	_, err = s.AuthFuncOverride(metadata.NewIncomingContext(context.Background(), md), tdrpc.PayEndpoint)
+	assert.Equal(t, tdrpc.ErrInvalidToken, err)
+
+	// Signed with a different root key
+	config.Set("tdome.token_secret", "other")
+	_, err = s.AuthFuncOverride(metadata.NewIncomingContext(context.Background(), md), tdrpc.PayEndpoint)
+	assert.Equal(t, tdrpc.ErrInvalidToken, err)
+
+	mockStore.AssertExpectations(t)
+	mockDCache.AssertExpectations(t)
+	mockLClient.AssertExpectations(t)
+
+}
+
+func TestPayTokenMaxPay(t *testing.T) {
+
+	// Mocks
+	mockStore := new(mocks.Store)
+	mockLClient := new(mocks.LightningClient)
+	mockLClient.On("GetInfo", mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("*lnrpc.GetInfoRequest")).Once().Return(&lnrpc.GetInfoResponse{IdentityPubkey: "testing"}, nil)
+	mockDCache := new(mocks.DistCache)
+
+	// RPC Server
+	s, err := newTDRPCServer(mockStore, mockLClient, nil, mockDCache)
+	require.Nil(t, err)
+
+	account := &tdrpc.Account{Id: "pubkey:test", Balance: 1000}
+	token := tdrpc.NewToken([]byte("testing"), "token1", account.Id, tdrpc.TokenCaveatMaxPay+"=100")
+	ctx := addToken(addAccount(context.Background(), account), token)
+
+	mockLClient.On("DecodePayReq", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("*lnrpc.PayReqString")).Twice().Return(&lnrpc.PayReq{
+		Destination: "test",
+		Expiry:      time.Now().Add(time.Hour).Unix(),
+	}, nil)
+
+	// The caveat applies to the payment value
+	_, err = s.Pay(ctx, &tdrpc.PayRequest{Request: "somerequest", Value: 150})
+	assert.Equal(t, tdrpc.ErrPermissionDenied, err)
+
+	// Even when it uses pre-authorized funds
+	_, err = s.Pay(ctx, &tdrpc.PayRequest{Request: "somerequest", Value: 150, PreAuthId: tdrpc.PreAuthLedgerRecordIdPrefix + "1"})
+	assert.Equal(t, tdrpc.ErrPermissionDenied, err)
+
+	mockStore.AssertExpectations(t)
+	mockLClient.AssertExpectations(t)
+
+}
@@ -29,6 +29,17 @@ func (s *tdRPCServer) Withdraw(ctx context.Context, request *tdrpc.WithdrawReque
 		return nil, tdrpc.ErrAccountLocked
 	}
 
+	// Retries with the same idempotency key return the original withdraw and are never sent twice
+	requestHash := idempotencyRequestHash(request)
+	if !request.Estimate {
+		replay := new(tdrpc.WithdrawResponse)
+		if replayed, err := s.idempotencyReplay(ctx, account.Id, tdrpc.WithdrawEndpoint, request.IdempotencyKey, requestHash, replay); err != nil {
+			return nil, err
+		} else if replayed {
+			return replay, nil
+		}
+	}
+
 	// What we charge to withdraw (percentage)
 	withdrawFeeRate := config.GetFloat64("tdome.withdraw_fee_rate") / 100.0
 
@@ -67,10 +78,21 @@ func (s *tdRPCServer) Withdraw(ctx context.Context, request *tdrpc.WithdrawReque
 		}
 	}
 
+	// A bearer token may limit the value of a withdraw
+	if !tokenAllowsPay(ctx, request.Value) {
+		return nil, tdrpc.ErrPermissionDenied
+	}
+
 	if request.Value < config.GetInt64("tdome.withdraw_min") {
 		return nil, status.Errorf(codes.InvalidArgument, "Withdraw value must be at least %s satoshis", tdrpc.FormatInt(ctx, config.GetInt64("tdome.withdraw_min")))
 	}
 
+	// Screen the destination address, it may be blocked or held for review
+	held, err := s.screenDestination(ctx, tdrpc.ScreeningTypeAddress, request.Address)
+	if err != nil {
+		return nil, err
+	}
+
 	// The adjustedValue will be the target amount we wish to withdraw after taking processing and network fees
 	// Estimate the target value based on an estimated fee of tdome.withdraw_fee_estimate sats and the withdraw fee rate
 	// We need this to determine the SatPerByte below
@@ -148,6 +170,7 @@ func (s *tdRPCServer) Withdraw(ctx context.Context, request *tdrpc.WithdrawReque
 		NetworkFee:    networkFee,
 		ProcessingFee: processingFee,
 		Memo:          fmt.Sprintf("Withdraw %d sats with %d sat netowrk fee and %d sat processing fee to %s", request.Value, networkFee, processingFee, request.Address),
+		Request:       request.Address,
 	}
 
 	s.logger.Debugw("request.withdraw", "account_id", account.Id, zap.Any("request", lr))
@@ -163,9 +186,21 @@ func (s *tdRPCServer) Withdraw(ctx context.Context, request *tdrpc.WithdrawReque
 		}, nil
 	}
 
+	// Held withdraws reserve the funds but are not sent until they are reviewed, at the fee rate quoted now
+	if held || (config.GetInt64("tdome.hold_withdraw_threshold") > 0 && lr.Value > config.GetInt64("tdome.hold_withdraw_threshold")) {
+		lr.Status = tdrpc.HELD
+		lr.SatPerByte = request.SatPerByte
+	}
+
+	// Nothing has happened yet, claim the idempotency key so a concurrent retry cannot also send
+	if err = s.idempotencyClaim(ctx, account.Id, tdrpc.WithdrawEndpoint, request.IdempotencyKey, requestHash); err != nil {
+		return nil, err
+	}
+
 	// Save the initial state - will do some sanity checking as well and preallocate funds
 	err = s.store.ProcessLedgerRecord(ctx, lr)
 	if err != nil {
+		s.idempotencyRelease(account.Id, request.IdempotencyKey)
 		// A valid message is provided with this error
 		if status.Code(err) == codes.InvalidArgument {
 			return nil, err
@@ -174,49 +209,34 @@ func (s *tdRPCServer) Withdraw(ctx context.Context, request *tdrpc.WithdrawReque
 		return nil, status.Errorf(codes.Internal, "ProcessLedgerRecord internal error")
 	}
 
-	sendCoinsRequest := &lnrpc.SendCoinsRequest{
-		Addr:       request.Address,
-		Amount:     request.Value,
-		SatPerByte: request.SatPerByte,
+	if lr.Status == tdrpc.HELD {
+		s.logger.Infow("Withdraw Held", "id", lr.Id, "account_id", account.Id, "value", lr.Value)
+		withdrawResponse := &tdrpc.WithdrawResponse{
+			Result: lr,
+		}
+		s.idempotencySave(account.Id, request.IdempotencyKey, withdrawResponse)
+		return withdrawResponse, nil
 	}
 
-	// Send the payment
-	response, err := s.lclient.SendCoins(ctx, sendCoinsRequest)
+	// Send the payment, on failure the record is marked failed and the funds are returned
+	txid, err := s.sender.Withdraw(ctx, lr, request.SatPerByte)
 	if err != nil {
-		lr.Status = tdrpc.FAILED
-		lr.Error = err.Error()
-
-		// Update the record to failed - return funds
-		if plrerr := s.store.ProcessLedgerRecord(ctx, lr); plrerr != nil {
-			// A valid message is provided with this error
-			if status.Code(plrerr) == codes.InvalidArgument {
-				return nil, plrerr
-			}
-			s.logger.Errorw("ProcessLedgerRecord Error", zap.Any("lr", lr), "error", err)
-			return nil, status.Errorf(codes.Internal, "ProcessLedgerRecord internal error")
-		}
+		s.idempotencyRelease(account.Id, request.IdempotencyKey)
+		return nil, err
 	}
 
-	// If there was an error, the ledger has been updated, return the error now
-	if err != nil {
-		s.logger.Errorw("LND SendCoins Error", zap.Any("request", sendCoinsRequest), "error", err)
-		return nil, status.Errorf(codes.Internal, "Could not SendCoins: %v", status.Convert(err).Message())
+	// The withdraw was sent, replays return the transaction even if the rename below does not complete
+	// If the rename does not complete, the withdraw recovery monitor will rename it
+	lr.Id = txid
+	withdrawResponse := &tdrpc.WithdrawResponse{
+		Result: lr,
 	}
+	s.idempotencySave(account.Id, request.IdempotencyKey, withdrawResponse)
 
 	// Otherwise we succeeded, update the ledger record ID to be the transaction id
-	err = s.store.UpdateLedgerRecordID(ctx, tempLedgerRecordID, response.Txid, tdrpc.OUT)
-	if err != nil {
-		// A valid message is provided with this error
-		if status.Code(err) == codes.InvalidArgument {
-			return nil, err
-		}
-		s.logger.Errorw("UpdateLedgerRecordID Error", "prev", tempLedgerRecordID, "next", response.Txid)
-		return nil, status.Errorf(codes.Internal, "UpdateLedgerRecordID internal error")
+	if err = s.sender.RenameWithdraw(ctx, tempLedgerRecordID, txid); err != nil {
+		return nil, err
 	}
 
-	lr.Id = response.Txid
-
-	return &tdrpc.WithdrawResponse{
-		Result: lr,
-	}, nil
+	return withdrawResponse, nil
 }
@@ -22,7 +22,7 @@ func TestWithdraw(t *testing.T) {
 	mockDCache := new(mocks.DistCache)
 
 	// RPC Server
-	s, err := NewTDRPCServer(mockStore, mockLClient, mockDCache)
+	s, err := NewTDRPCServer(mockStore, mockLClient, nil, mockDCache)
 	assert.Nil(t, err)
 
 	// Bootstrap authentication
@@ -48,6 +48,9 @@ func TestWithdraw(t *testing.T) {
 	})
 	assert.NotNil(t, err)
 
+	// Destination screening
+	mockStore.On("GetScreeningEntriesByValue", mock.AnythingOfType("*context.valueCtx"), tdrpc.ScreeningTypeAddress, account.Address).Once().Return([]*tdrpc.ScreeningEntry{}, nil)
+
 	// AddInvoice call
 	mockLClient.On("EstimateFee", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("*lnrpc.EstimateFeeRequest")).Once().Return(
 		&lnrpc.EstimateFeeResponse{
@@ -3,6 +3,7 @@ package tdrpc
 import (
 	"context"
 	fmt "fmt"
+	"strings"
 	"time"
 
 	"golang.org/x/text/language"
@@ -17,13 +18,32 @@ const (
 	// ValueSweep is used to indicate we are going to empty the account
 	ValueSweep int64 = -1
 
+	// ThunderdomeRPCEndpointPrefix is the prefix of all ThunderdomeRPC endpoints
+	ThunderdomeRPCEndpointPrefix = "/tdrpc.ThunderdomeRPC/"
+
 	// Endpoints
 	CreateGeneratedEndpoint = "/tdrpc.ThunderdomeRPC/CreateGenerated"
-	AccountEndpoint         = "/tdrpc.ThunderdomeRPC/Account"
+	AccountEndpoint         = "/tdrpc.ThunderdomeRPC/GetAccount"
+	CreateEndpoint          = "/tdrpc.ThunderdomeRPC/Create"
+	LedgerEndpoint          = "/tdrpc.ThunderdomeRPC/Ledger"
 	DecodeEndpoint          = "/tdrpc.ThunderdomeRPC/Decode"
 	PayEndpoint             = "/tdrpc.ThunderdomeRPC/Pay"
 	CreatePreAuthEndpoint   = "/tdrpc.ThunderdomeRPC/CreatePreAuth"
 	GetPreAuthEndpoint      = "/tdrpc.ThunderdomeRPC/GetPreAuth"
+	CreateTokenEndpoint     = "/tdrpc.ThunderdomeRPC/CreateToken"
+	WithdrawEndpoint        = "/tdrpc.ThunderdomeRPC/Withdraw"
+	CreateHoldEndpoint      = "/tdrpc.ThunderdomeRPC/CreateHold"
+	SettleHoldEndpoint      = "/tdrpc.ThunderdomeRPC/SettleHold"
+	CancelHoldEndpoint      = "/tdrpc.ThunderdomeRPC/CancelHold"
+
+	// MonitorCheckpointLNSettleIndex is the last lightning invoice settle index processed by the monitor
+	MonitorCheckpointLNSettleIndex = "ln_settle_index"
+	// MonitorCheckpointBTCBlockHeight is the block height of the last confirmed transaction processed by the monitor
+	MonitorCheckpointBTCBlockHeight = "btc_block_height"
+	// MonitorCheckpointLSPLastRequest is the unix time the inbound monitor last requested a channel from the liquidity provider
+	MonitorCheckpointLSPLastRequest = "lsp_last_request"
+	// MonitorCheckpointLeaderToken is the fencing token of the current monitor leader, it's incremented by each new leader
+	MonitorCheckpointLeaderToken = "leader_token"
 
 	// TempLedgerRecordIdPrefix is used to temporary store ledger record IDs
 	TempLedgerRecordIdPrefix = "temp:"
@@ -31,6 +51,15 @@ const (
 	// PreAuthLedgerRecordIdPrefix is used to indicate an id that's for pre-authorization
 	PreAuthLedgerRecordIdPrefix = "preauth:"
 
+	// AdjustmentLedgerRecordIdPrefix is used for the ids of manual adjustments
+	AdjustmentLedgerRecordIdPrefix = "adjustment:"
+
+	// RebalanceLedgerRecordIdPrefix is used for the ids of channel rebalance costs
+	RebalanceLedgerRecordIdPrefix = "rebalance:"
+
+	// HouseAccountId is the account that pays for operating the node, like channel rebalances
+	HouseAccountId = "internal:house"
+
 	// The request field will be set to this when PreAuthing funds
 	PreAuthRequest = "PreAuth"
 
@@ -39,6 +68,11 @@ const (
 	MetadataAuthSignature    = "cn-auth-signature"
 	MetadataAuthTimestamp    = "cn-auth-timestamp"
 	MetadataAuthNonce        = "cn-auth-nonce"
+	MetadataAuthSigType      = "cn-auth-sigtype"
+
+	// These are the metadata fields used when a delegate is acting on behalf of an account
+	MetadataAuthDelegation          = "cn-auth-delegation"
+	MetadataAuthDelegationSignature = "cn-auth-delegation-signature"
 
 	// This is used to determine language settings
 	MetadataLocale = "cn-locale"
@@ -74,12 +108,71 @@ type Store interface {
 	UpdateLedgerRecordID(ctx context.Context, oldID string, newID string, direction LedgerRecord_Direction) error
 	GetLedger(ctx context.Context, filter map[string]string, after time.Time, offset int, limit int) ([]*LedgerRecord, error)
 	GetLedgerRecord(ctx context.Context, id string, direction LedgerRecord_Direction) (*LedgerRecord, error)
+	ReviewHeldLedgerRecord(ctx context.Context, id string, status LedgerRecord_Status, reason string) (*LedgerRecord, error)
+	AdjustBalance(ctx context.Context, lr *LedgerRecord, adjustment *LedgerAdjustment) (*LedgerAdjustment, error)
+	GetLedgerAdjustments(ctx context.Context, accountID string, offset int, limit int) ([]*LedgerAdjustment, error)
 	GetLedgerRecordStats(ctx context.Context, filter map[string]string, after time.Time) (*LedgerRecordStats, error)
 	GetActiveGeneratedLightningLedgerRequest(ctx context.Context, accountID string) (*LedgerRecord, error)
 	ExpireLedgerRequests(ctx context.Context) error
 	GetAccountStats(ctx context.Context) (*AccountStats, error)
 	GetEarliestActiveAddIndex(ctx context.Context) (uint64, error)
 	CheckDatabaseConsistency(ctx context.Context) error
+	CheckSchema(ctx context.Context) (int64, error)
+
+	GetAgentKeys(ctx context.Context, offset int, limit int) ([]*AgentKey, error)
+	GetAgentKey(ctx context.Context, id string) (*AgentKey, error)
+	GetAgentKeyBySecretHash(ctx context.Context, secretHash string) (*AgentKey, error)
+	CreateAgentKey(ctx context.Context, key *AgentKey, secretHash string) (*AgentKey, error)
+	SaveAgentKey(ctx context.Context, key *AgentKey) (*AgentKey, error)
+	DeleteAgentKey(ctx context.Context, id string) error
+
+	AddDelegationSpend(ctx context.Context, delegationID string, accountID string, day time.Time, value int64, limit int64) (int64, error)
+	SavePreAuthDelegation(ctx context.Context, ledgerID string, delegationID string, accountID string) error
+	GetPreAuthDelegation(ctx context.Context, ledgerID string) (string, error)
+
+	GetAccountLimits(ctx context.Context, accountID string) (*AccountLimits, error)
+	SaveAccountLimits(ctx context.Context, limits *AccountLimits) (*AccountLimits, error)
+
+	GetScreeningEntries(ctx context.Context, filter map[string]string, offset int, limit int) ([]*ScreeningEntry, error)
+	GetScreeningEntry(ctx context.Context, id string) (*ScreeningEntry, error)
+	GetScreeningEntriesByValue(ctx context.Context, screeningType string, value string) ([]*ScreeningEntry, error)
+	CreateScreeningEntry(ctx context.Context, entry *ScreeningEntry, operator string) (*ScreeningEntry, error)
+	SaveScreeningEntry(ctx context.Context, entry *ScreeningEntry, operator string) (*ScreeningEntry, error)
+	DeleteScreeningEntry(ctx context.Context, id string, operator string) error
+	GetScreeningHistory(ctx context.Context, entryID string, offset int, limit int) ([]*ScreeningHistory, error)
+
+	CreateAdminAudit(ctx context.Context, audit *AdminAudit) (*AdminAudit, error)
+	GetAdminAuditLog(ctx context.Context, filter map[string]string, after time.Time, offset int, limit int) ([]*AdminAudit, error)
+
+	GetIdempotencyKey(ctx context.Context, accountID string, key string) (*IdempotencyKey, error)
+	CreateIdempotencyKey(ctx context.Context, ik *IdempotencyKey) error
+	SaveIdempotencyKeyResponse(ctx context.Context, accountID string, key string, response []byte) error
+	DeleteIdempotencyKey(ctx context.Context, accountID string, key string) error
+	ExpireIdempotencyKeys(ctx context.Context) error
+
+	GetMonitorCheckpoint(ctx context.Context, name string) (int64, error)
+	SaveMonitorCheckpoint(ctx context.Context, name string, value int64) error
+	IncrMonitorCheckpoint(ctx context.Context, name string) (int64, error)
+
+	GetChannelProposals(ctx context.Context, filter map[string]string, after time.Time, offset int, limit int) ([]*ChannelProposal, error)
+	GetChannelProposal(ctx context.Context, id string) (*ChannelProposal, error)
+	CreateChannelProposal(ctx context.Context, proposal *ChannelProposal) (*ChannelProposal, error)
+	ReviewChannelProposal(ctx context.Context, id string, status string, operator string) (*ChannelProposal, error)
+	CompleteChannelProposal(ctx context.Context, id string, status string, txid string, errorMessage string) (*ChannelProposal, error)
+	CreateRouteFailure(ctx context.Context, destination string, value int64) error
+	GetRouteFailureStats(ctx context.Context, after time.Time) ([]*RouteFailureStats, error)
+	SaveChannelActivity(ctx context.Context, chanPoint string, numUpdates uint64) (*ChannelActivity, error)
+}
+
+// IdempotencyKey is a client supplied key that ensures a request is only processed once
+type IdempotencyKey struct {
+	AccountId   string    `db:"account_id"`
+	Key         string    `db:"key"`
+	Endpoint    string    `db:"endpoint"`
+	RequestHash string    `db:"request_hash"`
+	Response    []byte    `db:"response"`
+	CreatedAt   time.Time `db:"created_at"`
+	ExpiresAt   time.Time `db:"expires_at"`
 }
 
 type ChanBackupData []byte
@@ -90,12 +183,17 @@ type ChanBackup struct {
 	Timestamp    time.Time      `db:"timestamp"`
 	FundingTXIDs string         `db:"funding_txids"`
 	Data         ChanBackupData `db:"data"`
+	// The size of the data in bytes, GetChanBackups returns this without the data
+	Size int64 `db:"size"`
 }
 
 // ChanBackupStore handles storing channel backups
 type ChanBackupStore interface {
 	GetLastChanBackup(ctx context.Context) (*ChanBackup, error)
 	StoreChanBackup(ctx context.Context, fundingTXIDs string, data ChanBackupData) (*ChanBackup, error)
+	GetChanBackup(ctx context.Context, id int64) (*ChanBackup, error)
+	GetChanBackups(ctx context.Context, chanPoint string, offset int, limit int) ([]*ChanBackup, error)
+	PruneChanBackups(ctx context.Context, count int, before time.Time) (int64, error)
 }
 
 // LedgerRecordBus is an interface to subscribe to LedgerRecords
@@ -131,3 +229,14 @@ func (lr *LedgerRecord) ValueTotal() int64 {
 	panic(fmt.Sprintf("invalid direction: %v", lr.Direction))
 
 }
+
+// WithdrawAddress returns the address of a withdraw. It's stored in the request, older records only have it at the end of the memo.
+func (lr *LedgerRecord) WithdrawAddress() string {
+	if lr.Request != "" {
+		return lr.Request
+	}
+	if i := strings.LastIndex(lr.Memo, " to "); i >= 0 {
+		return strings.TrimSpace(lr.Memo[i+len(" to "):])
+	}
+	return ""
+}
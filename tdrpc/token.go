@@ -0,0 +1,200 @@
+package tdrpc
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Token caveats restrict what a bearer token can be used for. Each is of the form key=value.
+const (
+	// TokenCaveatRPC is a comma separated list of RPC names the token can call
+	TokenCaveatRPC = "rpc"
+	// TokenCaveatMaxPay is the max value of a single payment, pre-auth or withdraw
+	TokenCaveatMaxPay = "max_pay"
+	// TokenCaveatExpires is the RFC3339 time the token expires
+	TokenCaveatExpires = "expires"
+)
+
+// TokenRPCs are the RPCs a bearer token can be restricted to. A token can never create another token.
+var TokenRPCs = map[string]bool{
+	"GetAccount":      true,
+	"Create":          true,
+	"Pay":             true,
+	"Ledger":          true,
+	"Withdraw":        true,
+	"CreateGenerated": true,
+	"CreatePreAuth":   true,
+	"GetPreAuth":      true,
+	"ExpirePreAuth":   true,
+	"CreateHold":      true,
+	"SettleHold":      true,
+	"CancelHold":      true,
+	"RevokeToken":     true,
+}
+
+// Token is a macaroon style bearer token. The signature is an HMAC chain starting with a root key over the
+// id and account, followed by each caveat. Anyone holding a token can add caveats to restrict it further,
+// but caveats cannot be removed without knowing the root key.
+type Token struct {
+	Id        string   `json:"id"`
+	AccountId string   `json:"account_id"`
+	Caveats   []string `json:"caveats"`
+	Signature string   `json:"signature"`
+}
+
+// NewToken creates a token for an account signed with rootKey
+func NewToken(rootKey []byte, id string, accountID string, caveats ...string) *Token {
+	t := &Token{
+		Id:        id,
+		AccountId: accountID,
+		Caveats:   []string{},
+		Signature: hex.EncodeToString(tokenHMAC(rootKey, id+"@"+accountID)),
+	}
+	for _, caveat := range caveats {
+		t.AddCaveat(caveat)
+	}
+	return t
+}
+
+// ParseToken decodes a base64url encoded JSON token
+func ParseToken(encoded string) (*Token, error) {
+
+	data, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	var t = new(Token)
+	if err = json.Unmarshal(data, t); err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	if t.Id == "" || t.AccountId == "" {
+		return nil, ErrInvalidToken
+	}
+
+	return t, nil
+
+}
+
+// Encode returns the base64url encoded JSON token
+func (t *Token) Encode() (string, error) {
+	data, err := json.Marshal(t)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// AddCaveat restricts the token further
+func (t *Token) AddCaveat(caveat string) {
+	sig, _ := hex.DecodeString(t.Signature)
+	t.Caveats = append(t.Caveats, caveat)
+	t.Signature = hex.EncodeToString(tokenHMAC(sig, caveat))
+}
+
+// Verify ensures the token was created with rootKey and the caveats have not been modified
+func (t *Token) Verify(rootKey []byte) bool {
+	sig := tokenHMAC(rootKey, t.Id+"@"+t.AccountId)
+	for _, caveat := range t.Caveats {
+		sig = tokenHMAC(sig, caveat)
+	}
+	expected, err := hex.DecodeString(t.Signature)
+	if err != nil {
+		return false
+	}
+	return hmac.Equal(sig, expected)
+}
+
+// Check ensures every caveat is satisfied for a call to endpoint at time now
+func (t *Token) Check(endpoint string, now time.Time) error {
+
+	for _, caveat := range t.Caveats {
+		key, value := splitCaveat(caveat)
+		switch key {
+		case TokenCaveatRPC:
+			var allowed bool
+			for _, rpc := range strings.Split(value, ",") {
+				if ThunderdomeRPCEndpointPrefix+strings.TrimSpace(rpc) == endpoint {
+					allowed = true
+					break
+				}
+			}
+			if !allowed {
+				return ErrPermissionDenied
+			}
+		case TokenCaveatExpires:
+			expiresAt, err := time.Parse(time.RFC3339, value)
+			if err != nil || !now.Before(expiresAt) {
+				return ErrInvalidToken
+			}
+		case TokenCaveatMaxPay:
+			if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+				return ErrInvalidToken
+			}
+		default:
+			// Unknown caveats cannot be satisfied
+			return ErrInvalidToken
+		}
+	}
+
+	return nil
+
+}
+
+// MaxPay returns the smallest max_pay caveat and true if the token has one
+func (t *Token) MaxPay() (int64, bool) {
+	var maxPay int64
+	var found bool
+	for _, caveat := range t.Caveats {
+		key, value := splitCaveat(caveat)
+		if key != TokenCaveatMaxPay {
+			continue
+		}
+		v, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			v = 0
+		}
+		if !found || v < maxPay {
+			maxPay = v
+			found = true
+		}
+	}
+	return maxPay, found
+}
+
+// ExpiresAt returns the earliest expires caveat
+func (t *Token) ExpiresAt() time.Time {
+	var expiresAt time.Time
+	for _, caveat := range t.Caveats {
+		key, value := splitCaveat(caveat)
+		if key != TokenCaveatExpires {
+			continue
+		}
+		if e, err := time.Parse(time.RFC3339, value); err == nil && (expiresAt.IsZero() || e.Before(expiresAt)) {
+			expiresAt = e
+		}
+	}
+	return expiresAt
+}
+
+// splitCaveat returns the key and value of a key=value caveat
+func splitCaveat(caveat string) (string, string) {
+	parts := strings.SplitN(caveat, "=", 2)
+	if len(parts) != 2 {
+		return caveat, ""
+	}
+	return strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1])
+}
+
+func tokenHMAC(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	_, _ = mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
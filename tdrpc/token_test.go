@@ -0,0 +1,55 @@
+package tdrpc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToken(t *testing.T) {
+
+	rootKey := []byte("secret")
+	now := time.Now().UTC()
+
+	token := NewToken(rootKey, "id1", "pubkey:abc", TokenCaveatExpires+"="+now.Add(time.Hour).Format(time.RFC3339))
+	assert.True(t, token.Verify(rootKey))
+	assert.False(t, token.Verify([]byte("wrong")))
+	assert.Nil(t, token.Check(PayEndpoint, now))
+	assert.Equal(t, ErrInvalidToken, token.Check(PayEndpoint, now.Add(2*time.Hour)))
+
+	// Encode and parse
+	encoded, err := token.Encode()
+	require.Nil(t, err)
+	parsed, err := ParseToken(encoded)
+	require.Nil(t, err)
+	assert.Equal(t, token, parsed)
+	assert.True(t, parsed.Verify(rootKey))
+	_, err = ParseToken("garbage")
+	assert.Equal(t, ErrInvalidToken, err)
+
+	// Attenuate without the root key
+	_, ok := parsed.MaxPay()
+	assert.False(t, ok)
+	parsed.AddCaveat(TokenCaveatRPC + "=Pay,GetAccount")
+	parsed.AddCaveat(TokenCaveatMaxPay + "=500")
+	parsed.AddCaveat(TokenCaveatMaxPay + "=100")
+	assert.True(t, parsed.Verify(rootKey))
+	assert.Nil(t, parsed.Check(PayEndpoint, now))
+	assert.Nil(t, parsed.Check(AccountEndpoint, now))
+	assert.Equal(t, ErrPermissionDenied, parsed.Check(CreateEndpoint, now))
+	maxPay, ok := parsed.MaxPay()
+	assert.True(t, ok)
+	assert.Equal(t, int64(100), maxPay)
+
+	// Removing a caveat breaks the signature
+	parsed.Caveats = parsed.Caveats[:len(parsed.Caveats)-1]
+	assert.False(t, parsed.Verify(rootKey))
+
+	// Unknown caveats are never satisfied
+	token.AddCaveat("ip=127.0.0.1")
+	assert.True(t, token.Verify(rootKey))
+	assert.Equal(t, ErrInvalidToken, token.Check(PayEndpoint, now))
+
+}